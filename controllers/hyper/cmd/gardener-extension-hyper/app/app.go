@@ -43,6 +43,7 @@ func NewHyperCommand(ctx context.Context) *cobra.Command {
 		networkcalico.NewControllerManagerCommand(ctx),
 		dnsservice.NewServiceControllerCommand(ctx),
 		shootcertservice.NewServiceControllerCommand(ctx),
+		NewCopyBackupEntryCommand(ctx),
 	)
 
 	return cmd
@@ -0,0 +1,128 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/alicloud"
+	alicloudclient "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/alicloud/client"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure"
+	azureclient "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure/client"
+	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/gcp/client"
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack/client"
+	"github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupentry"
+	controllercmd "github.com/gardener/gardener-extensions/pkg/controller/cmd"
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+// objectStores maps the provider types to the functions creating their object stores.
+var objectStores = map[string]objectstore.FactoryFunc{
+	alicloud.Type:  alicloudclient.NewObjectStoreFromSecretRef,
+	aws.Type:       aws.NewObjectStoreFromSecretRef,
+	azure.Type:     azureclient.NewObjectStoreFromSecretRef,
+	gcp.Type:       gcpclient.NewObjectStoreFromSecretRef,
+	openstack.Type: openstackclient.NewObjectStoreFromSecretRef,
+}
+
+// copyBackupEntryOptions are the command line options of the copy-backupentry command.
+type copyBackupEntryOptions struct {
+	backupEntryName        string
+	targetBackupBucketName string
+	targetBackupEntryName  string
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (o *copyBackupEntryOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.backupEntryName, "backupentry", "", "Name of the backupentry whose snapshots are copied.")
+	fs.StringVar(&o.targetBackupBucketName, "target-backupbucket", "", "Name of the backupbucket the snapshots are copied to.")
+	fs.StringVar(&o.targetBackupEntryName, "target-backupentry", "", "Name of the backupentry the copied snapshots are meant for. It is created in the target cluster if it does not exist. Defaults to the name of the copied backupentry.")
+}
+
+// Complete implements Completer.Complete.
+func (o *copyBackupEntryOptions) Complete() error {
+	if len(o.backupEntryName) == 0 {
+		return fmt.Errorf("backupentry must be specified")
+	}
+	if len(o.targetBackupBucketName) == 0 {
+		return fmt.Errorf("target backupbucket must be specified")
+	}
+	return nil
+}
+
+// NewCopyBackupEntryCommand creates a new command for copying the etcd snapshots of a backupentry to another
+// backupbucket, e.g. when moving a shoot to a seed in another region.
+func NewCopyBackupEntryCommand(ctx context.Context) *cobra.Command {
+	var (
+		restOpts       = &controllercmd.RESTOptions{}
+		targetRestOpts = &controllercmd.RESTOptions{}
+		copyOpts       = &copyBackupEntryOptions{}
+
+		aggOption = controllercmd.NewOptionAggregator(
+			restOpts,
+			controllercmd.PrefixOption("target-", targetRestOpts),
+			copyOpts,
+		)
+	)
+
+	cmd := &cobra.Command{
+		Use:   "copy-backupentry",
+		Short: "Copies the etcd snapshots of a backupentry to another backupbucket and records them on the target backupentry.",
+
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := aggOption.Complete(); err != nil {
+				controllercmd.LogErrAndExit(err, "Error completing options")
+			}
+
+			sourceClient, err := client.New(restOpts.Completed().Config, client.Options{Scheme: controller.ExtensionsScheme})
+			if err != nil {
+				controllercmd.LogErrAndExit(err, "Could not create client")
+			}
+			targetClient, err := client.New(targetRestOpts.Completed().Config, client.Options{Scheme: controller.ExtensionsScheme})
+			if err != nil {
+				controllercmd.LogErrAndExit(err, "Could not create target client")
+			}
+
+			prefix, err := backupentry.Copy(ctx, backupentry.CopyArgs{
+				SourceClient:           sourceClient,
+				TargetClient:           targetClient,
+				ObjectStores:           objectStores,
+				BackupEntryName:        copyOpts.backupEntryName,
+				TargetBackupBucketName: copyOpts.targetBackupBucketName,
+				TargetBackupEntryName:  copyOpts.targetBackupEntryName,
+			})
+			if err != nil {
+				controllercmd.LogErrAndExit(err, "Error copying backupentry")
+			}
+
+			log.Log.Info("Copied backupentry", "backupentry", copyOpts.backupEntryName, "target", prefix, "targetBackupEntry", path.Base(prefix))
+		},
+	}
+
+	aggOption.AddFlags(cmd.Flags())
+
+	return cmd
+}
@@ -21,8 +21,17 @@ import (
 type Backend string
 
 const (
-	Bird Backend = "bird"
-	None Backend = "none"
+	Bird  Backend = "bird"
+	VXLan Backend = "vxlan"
+	None  Backend = "none"
+)
+
+type IPIPMode string
+
+const (
+	IPIPAlways      IPIPMode = "Always"
+	IPIPCrossSubnet IPIPMode = "CrossSubnet"
+	IPIPNever       IPIPMode = "Never"
 )
 
 type CIDR string
@@ -33,7 +42,8 @@ type CIDR string
 type NetworkConfig struct {
 	metav1.TypeMeta `json:",inline"`
 
-	// Backend defines whether a backend should be used or not (e.g., bird or None)
+	// Backend defines whether a backend should be used or not (e.g., bird, vxlan or none). VXLAN encapsulation cannot
+	// be enabled or disabled once the default IPv4 pool has been created.
 	Backend Backend `json:"backend"`
 	// IPAM to use for the Calico Plugin (e.g., host-local or Calico)
 	// +optional
@@ -42,6 +52,18 @@ type NetworkConfig struct {
 	// https://docs.projectcalico.org/v2.2/reference/node/configuration#ip-autodetection-methods
 	// +optional
 	IPAutoDetectionMethod *string `json:"ipAutodetectionMethod,omitempty"`
+	// IPIP is the IPIP mode of the default IPv4 pool (e.g., Always, CrossSubnet or Never). It is only
+	// relevant for the bird backend and defaults to Always. It cannot be changed once the pool has been created.
+	// +optional
+	IPIP *IPIPMode `json:"ipip,omitempty"`
+	// VethMTU is the MTU of the pod network interfaces and of the IPIP or VXLAN tunnel devices. It must
+	// account for the encapsulation overhead of the underlying network and defaults to 1440.
+	// +optional
+	VethMTU *int32 `json:"vethMTU,omitempty"`
+	// NATOutgoing defines whether outgoing traffic of pods is masqueraded when leaving the pod network.
+	// Defaults to true. It cannot be changed once the default IPv4 pool has been created.
+	// +optional
+	NATOutgoing *bool `json:"natOutgoing,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -49,6 +71,20 @@ type NetworkConfig struct {
 // NetworkStatus contains information about created Network resources.
 type NetworkStatus struct {
 	metav1.TypeMeta `json:",inline"`
+
+	// IPv4Pool contains the settings the default IPv4 pool has been created with.
+	// +optional
+	IPv4Pool *IPv4Pool `json:"ipv4Pool,omitempty"`
+}
+
+// IPv4Pool contains the settings of the default IPv4 pool that cannot be changed once it has been created.
+type IPv4Pool struct {
+	// VXLAN defines whether VXLAN encapsulation is used.
+	VXLAN bool `json:"vxlan"`
+	// IPIP is the IPIP mode.
+	IPIP IPIPMode `json:"ipip"`
+	// NATOutgoing defines whether outgoing traffic of pods is masqueraded when leaving the pod network.
+	NATOutgoing bool `json:"natOutgoing"`
 }
 
 // IPAM defines the block that configuration for the ip assignment plugin to be used
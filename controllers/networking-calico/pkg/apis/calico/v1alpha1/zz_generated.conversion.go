@@ -45,6 +45,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*IPv4Pool)(nil), (*calico.IPv4Pool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPv4Pool_To_calico_IPv4Pool(a.(*IPv4Pool), b.(*calico.IPv4Pool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.IPv4Pool)(nil), (*IPv4Pool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_IPv4Pool_To_v1alpha1_IPv4Pool(a.(*calico.IPv4Pool), b.(*IPv4Pool), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NetworkConfig)(nil), (*calico.NetworkConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NetworkConfig_To_calico_NetworkConfig(a.(*NetworkConfig), b.(*calico.NetworkConfig), scope)
 	}); err != nil {
@@ -90,10 +100,37 @@ func Convert_calico_IPAM_To_v1alpha1_IPAM(in *calico.IPAM, out *IPAM, s conversi
 	return autoConvert_calico_IPAM_To_v1alpha1_IPAM(in, out, s)
 }
 
+func autoConvert_v1alpha1_IPv4Pool_To_calico_IPv4Pool(in *IPv4Pool, out *calico.IPv4Pool, s conversion.Scope) error {
+	out.VXLAN = in.VXLAN
+	out.IPIP = calico.IPIPMode(in.IPIP)
+	out.NATOutgoing = in.NATOutgoing
+	return nil
+}
+
+// Convert_v1alpha1_IPv4Pool_To_calico_IPv4Pool is an autogenerated conversion function.
+func Convert_v1alpha1_IPv4Pool_To_calico_IPv4Pool(in *IPv4Pool, out *calico.IPv4Pool, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPv4Pool_To_calico_IPv4Pool(in, out, s)
+}
+
+func autoConvert_calico_IPv4Pool_To_v1alpha1_IPv4Pool(in *calico.IPv4Pool, out *IPv4Pool, s conversion.Scope) error {
+	out.VXLAN = in.VXLAN
+	out.IPIP = IPIPMode(in.IPIP)
+	out.NATOutgoing = in.NATOutgoing
+	return nil
+}
+
+// Convert_calico_IPv4Pool_To_v1alpha1_IPv4Pool is an autogenerated conversion function.
+func Convert_calico_IPv4Pool_To_v1alpha1_IPv4Pool(in *calico.IPv4Pool, out *IPv4Pool, s conversion.Scope) error {
+	return autoConvert_calico_IPv4Pool_To_v1alpha1_IPv4Pool(in, out, s)
+}
+
 func autoConvert_v1alpha1_NetworkConfig_To_calico_NetworkConfig(in *NetworkConfig, out *calico.NetworkConfig, s conversion.Scope) error {
 	out.Backend = calico.Backend(in.Backend)
 	out.IPAM = (*calico.IPAM)(unsafe.Pointer(in.IPAM))
 	out.IPAutoDetectionMethod = (*string)(unsafe.Pointer(in.IPAutoDetectionMethod))
+	out.IPIP = (*calico.IPIPMode)(unsafe.Pointer(in.IPIP))
+	out.VethMTU = (*int32)(unsafe.Pointer(in.VethMTU))
+	out.NATOutgoing = (*bool)(unsafe.Pointer(in.NATOutgoing))
 	return nil
 }
 
@@ -106,6 +143,9 @@ func autoConvert_calico_NetworkConfig_To_v1alpha1_NetworkConfig(in *calico.Netwo
 	out.Backend = Backend(in.Backend)
 	out.IPAM = (*IPAM)(unsafe.Pointer(in.IPAM))
 	out.IPAutoDetectionMethod = (*string)(unsafe.Pointer(in.IPAutoDetectionMethod))
+	out.IPIP = (*IPIPMode)(unsafe.Pointer(in.IPIP))
+	out.VethMTU = (*int32)(unsafe.Pointer(in.VethMTU))
+	out.NATOutgoing = (*bool)(unsafe.Pointer(in.NATOutgoing))
 	return nil
 }
 
@@ -115,6 +155,7 @@ func Convert_calico_NetworkConfig_To_v1alpha1_NetworkConfig(in *calico.NetworkCo
 }
 
 func autoConvert_v1alpha1_NetworkStatus_To_calico_NetworkStatus(in *NetworkStatus, out *calico.NetworkStatus, s conversion.Scope) error {
+	out.IPv4Pool = (*calico.IPv4Pool)(unsafe.Pointer(in.IPv4Pool))
 	return nil
 }
 
@@ -124,6 +165,7 @@ func Convert_v1alpha1_NetworkStatus_To_calico_NetworkStatus(in *NetworkStatus, o
 }
 
 func autoConvert_calico_NetworkStatus_To_v1alpha1_NetworkStatus(in *calico.NetworkStatus, out *NetworkStatus, s conversion.Scope) error {
+	out.IPv4Pool = (*IPv4Pool)(unsafe.Pointer(in.IPv4Pool))
 	return nil
 }
 
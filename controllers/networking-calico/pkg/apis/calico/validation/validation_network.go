@@ -0,0 +1,90 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package validation
+
+import (
+	apiscalico "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/apis/calico"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	// minVethMTU is the minimum MTU an IPv4 interface must support (RFC 791).
+	minVethMTU = 68
+	// maxVethMTU is the maximum MTU of an interface.
+	maxVethMTU = 65535
+)
+
+var (
+	supportedBackends  = sets.NewString(string(apiscalico.Bird), string(apiscalico.VXLan), string(apiscalico.None))
+	supportedIPIPModes = sets.NewString(string(apiscalico.IPIPAlways), string(apiscalico.IPIPCrossSubnet), string(apiscalico.IPIPNever))
+)
+
+// ValidateNetworkConfig validates a NetworkConfig object.
+func ValidateNetworkConfig(networkConfig *apiscalico.NetworkConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !supportedBackends.Has(string(networkConfig.Backend)) {
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("backend"), networkConfig.Backend, supportedBackends.List()))
+	}
+
+	if ipip := networkConfig.IPIP; ipip != nil {
+		ipipPath := field.NewPath("ipip")
+
+		if !supportedIPIPModes.Has(string(*ipip)) {
+			allErrs = append(allErrs, field.NotSupported(ipipPath, *ipip, supportedIPIPModes.List()))
+		} else if *ipip != apiscalico.IPIPNever && networkConfig.Backend != apiscalico.Bird {
+			allErrs = append(allErrs, field.Forbidden(ipipPath, "IPIP encapsulation requires the bird backend"))
+		}
+	}
+
+	if vethMTU := networkConfig.VethMTU; vethMTU != nil && (*vethMTU < minVethMTU || *vethMTU > maxVethMTU) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("vethMTU"), *vethMTU, "must be between 68 and 65535"))
+	}
+
+	return allErrs
+}
+
+// ValidateNetworkConfigUpdate validates a NetworkConfig object update. The encapsulation and NAT settings of the
+// default IPv4 pool are only applied when the pool is created, hence they cannot be changed afterwards.
+func ValidateNetworkConfigUpdate(oldConfig, newConfig *apiscalico.NetworkConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if (oldConfig.Backend == apiscalico.VXLan) != (newConfig.Backend == apiscalico.VXLan) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("backend"), "VXLAN encapsulation cannot be enabled or disabled"))
+	}
+	if getIPIPMode(oldConfig) != getIPIPMode(newConfig) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("ipip"), "field is immutable"))
+	}
+	if getNATOutgoing(oldConfig) != getNATOutgoing(newConfig) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("natOutgoing"), "field is immutable"))
+	}
+
+	return allErrs
+}
+
+func getIPIPMode(networkConfig *apiscalico.NetworkConfig) apiscalico.IPIPMode {
+	if networkConfig.IPIP != nil {
+		return *networkConfig.IPIP
+	}
+	if networkConfig.Backend == apiscalico.Bird {
+		return apiscalico.IPIPAlways
+	}
+	return apiscalico.IPIPNever
+}
+
+func getNATOutgoing(networkConfig *apiscalico.NetworkConfig) bool {
+	return networkConfig.NATOutgoing == nil || *networkConfig.NATOutgoing
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package validation_test
+
+import (
+	apiscalico "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/apis/calico"
+	. "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/apis/calico/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("NetworkConfig validation", func() {
+	var networkConfig *apiscalico.NetworkConfig
+
+	BeforeEach(func() {
+		networkConfig = &apiscalico.NetworkConfig{
+			Backend: apiscalico.Bird,
+		}
+	})
+
+	Describe("#ValidateNetworkConfig", func() {
+		It("should allow a minimal configuration", func() {
+			Expect(ValidateNetworkConfig(networkConfig)).To(BeEmpty())
+		})
+
+		It("should allow a valid IPIP configuration", func() {
+			var (
+				ipip        = apiscalico.IPIPCrossSubnet
+				vethMTU     = int32(1440)
+				natOutgoing = false
+			)
+			networkConfig.IPIP = &ipip
+			networkConfig.VethMTU = &vethMTU
+			networkConfig.NATOutgoing = &natOutgoing
+
+			Expect(ValidateNetworkConfig(networkConfig)).To(BeEmpty())
+		})
+
+		It("should allow a valid VXLAN configuration", func() {
+			var (
+				ipip    = apiscalico.IPIPNever
+				vethMTU = int32(1450)
+			)
+			networkConfig.Backend = apiscalico.VXLan
+			networkConfig.IPIP = &ipip
+			networkConfig.VethMTU = &vethMTU
+
+			Expect(ValidateNetworkConfig(networkConfig)).To(BeEmpty())
+		})
+
+		It("should forbid IPIP encapsulation without the bird backend", func() {
+			ipip := apiscalico.IPIPAlways
+			networkConfig.Backend = apiscalico.VXLan
+			networkConfig.IPIP = &ipip
+
+			Expect(ValidateNetworkConfig(networkConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("ipip"),
+				})),
+			))
+		})
+
+		It("should forbid an invalid configuration", func() {
+			var (
+				ipip    = apiscalico.IPIPMode("Sometimes")
+				vethMTU = int32(0)
+			)
+			networkConfig.Backend = "flannel"
+			networkConfig.IPIP = &ipip
+			networkConfig.VethMTU = &vethMTU
+
+			Expect(ValidateNetworkConfig(networkConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("backend"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("ipip"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("vethMTU"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateNetworkConfigUpdate", func() {
+		It("should allow updates that keep the IP pool settings", func() {
+			var (
+				ipip    = apiscalico.IPIPAlways
+				vethMTU = int32(1400)
+			)
+			newConfig := networkConfig.DeepCopy()
+			newConfig.IPIP = &ipip
+			newConfig.VethMTU = &vethMTU
+
+			Expect(ValidateNetworkConfigUpdate(networkConfig, newConfig)).To(BeEmpty())
+		})
+
+		It("should forbid changing the encapsulation or NAT settings", func() {
+			natOutgoing := false
+			newConfig := networkConfig.DeepCopy()
+			newConfig.Backend = apiscalico.VXLan
+			newConfig.NATOutgoing = &natOutgoing
+
+			Expect(ValidateNetworkConfigUpdate(networkConfig, newConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("backend"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("ipip"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("natOutgoing"),
+				})),
+			))
+		})
+	})
+})
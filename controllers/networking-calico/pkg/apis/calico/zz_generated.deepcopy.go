@@ -45,6 +45,22 @@ func (in *IPAM) DeepCopy() *IPAM {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPv4Pool) DeepCopyInto(out *IPv4Pool) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv4Pool.
+func (in *IPv4Pool) DeepCopy() *IPv4Pool {
+	if in == nil {
+		return nil
+	}
+	out := new(IPv4Pool)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
 	*out = *in
@@ -59,6 +75,21 @@ func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IPIP != nil {
+		in, out := &in.IPIP, &out.IPIP
+		*out = new(IPIPMode)
+		**out = **in
+	}
+	if in.VethMTU != nil {
+		in, out := &in.VethMTU, &out.VethMTU
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NATOutgoing != nil {
+		in, out := &in.NATOutgoing, &out.NATOutgoing
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -84,6 +115,11 @@ func (in *NetworkConfig) DeepCopyObject() runtime.Object {
 func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
+	if in.IPv4Pool != nil {
+		in, out := &in.IPv4Pool, &out.IPv4Pool
+		*out = new(IPv4Pool)
+		**out = **in
+	}
 	return
 }
 
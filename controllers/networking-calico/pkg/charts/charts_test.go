@@ -77,7 +77,10 @@ var _ = Describe("Chart package test", func() {
 					"podCIDR": network.Spec.PodCIDR,
 				},
 				"config": map[string]interface{}{
-					"backend": networkConfig.Backend,
+					"backend":     networkConfig.Backend,
+					"ipip":        calicov1alpha1.IPIPNever,
+					"vethMTU":     "1440",
+					"natOutgoing": true,
 					"ipam": map[string]interface{}{
 						"type":   networkConfig.IPAM.Type,
 						"subnet": *networkConfig.IPAM.CIDR,
@@ -85,6 +88,46 @@ var _ = Describe("Chart package test", func() {
 				},
 			}))
 		})
+
+		It("should correctly compute the calico chart values for the vxlan backend", func() {
+			var (
+				vethMTU     = int32(1450)
+				natOutgoing = false
+			)
+			networkConfig.Backend = calicov1alpha1.VXLan
+			networkConfig.VethMTU = &vethMTU
+			networkConfig.NATOutgoing = &natOutgoing
+
+			values := charts.ComputeCalicoChartValues(network, networkConfig)
+			Expect(values["config"]).To(Equal(map[string]interface{}{
+				"backend":     calicov1alpha1.VXLan,
+				"ipip":        calicov1alpha1.IPIPNever,
+				"vethMTU":     "1450",
+				"natOutgoing": false,
+				"ipam": map[string]interface{}{
+					"type":   networkConfig.IPAM.Type,
+					"subnet": *networkConfig.IPAM.CIDR,
+				},
+			}))
+		})
+
+		It("should correctly compute the calico chart values for the bird backend", func() {
+			ipip := calicov1alpha1.IPIPCrossSubnet
+			networkConfig.Backend = calicov1alpha1.Bird
+			networkConfig.IPIP = &ipip
+
+			values := charts.ComputeCalicoChartValues(network, networkConfig)
+			Expect(values["config"]).To(Equal(map[string]interface{}{
+				"backend":     calicov1alpha1.Bird,
+				"ipip":        calicov1alpha1.IPIPCrossSubnet,
+				"vethMTU":     "1440",
+				"natOutgoing": true,
+				"ipam": map[string]interface{}{
+					"type":   networkConfig.IPAM.Type,
+					"subnet": *networkConfig.IPAM.CIDR,
+				},
+			}))
+		})
 	})
 
 	Describe("#RenderCalicoChart", func() {
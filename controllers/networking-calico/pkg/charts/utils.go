@@ -15,6 +15,8 @@
 package charts
 
 import (
+	"strconv"
+
 	calicov1alpha1 "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/apis/calico/v1alpha1"
 	"github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/calico"
 	"github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/imagevector"
@@ -24,6 +26,8 @@ import (
 const (
 	hostLocal  = "host-local"
 	usePodCIDR = "usePodCidr"
+
+	defaultVethMTU = 1440
 )
 
 // ComputeCalicoChartValues computes the values for the calico chart.
@@ -44,7 +48,10 @@ func ComputeCalicoChartValues(network *extensionsv1alpha1.Network, config *calic
 			},
 		}
 		calicoConfigValues = map[string]interface{}{
-			"backend": calicov1alpha1.Bird,
+			"backend":     calicov1alpha1.Bird,
+			"ipip":        calicov1alpha1.IPIPAlways,
+			"vethMTU":     strconv.Itoa(defaultVethMTU),
+			"natOutgoing": true,
 		}
 		ipamConfig = map[string]interface{}{
 			"type":   hostLocal,
@@ -55,6 +62,20 @@ func ComputeCalicoChartValues(network *extensionsv1alpha1.Network, config *calic
 	if config != nil {
 		calicoConfigValues["backend"] = config.Backend
 
+		// IPIP encapsulation is only supported by the bird backend.
+		if config.Backend != calicov1alpha1.Bird {
+			calicoConfigValues["ipip"] = calicov1alpha1.IPIPNever
+		}
+		if config.IPIP != nil {
+			calicoConfigValues["ipip"] = *config.IPIP
+		}
+		if config.VethMTU != nil {
+			calicoConfigValues["vethMTU"] = strconv.Itoa(int(*config.VethMTU))
+		}
+		if config.NATOutgoing != nil {
+			calicoConfigValues["natOutgoing"] = *config.NATOutgoing
+		}
+
 		if config.IPAM != nil {
 			if len(config.IPAM.Type) > 0 {
 				ipamConfig["type"] = config.IPAM.Type
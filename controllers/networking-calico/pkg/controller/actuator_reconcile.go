@@ -17,9 +17,12 @@ package controller
 import (
 	"context"
 
+	apiscalico "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/apis/calico"
 	calicov1alpha1 "github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/apis/calico/v1alpha1"
+	"github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/apis/calico/validation"
 	"github.com/gardener/gardener-extensions/controllers/networking-calico/pkg/charts"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/util"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 
 	"github.com/gardener/gardener-resource-manager/pkg/manager"
@@ -50,8 +53,9 @@ func calicoSecret(cl client.Client, calicoConfig []byte, namespace string) (*man
 // Reconcile implements Network.Actuator.
 func (a *actuator) Reconcile(ctx context.Context, network *extensionsv1alpha1.Network, cluster *extensionscontroller.Cluster) error {
 	var (
-		networkConfig *calicov1alpha1.NetworkConfig
-		err           error
+		networkConfig         *calicov1alpha1.NetworkConfig
+		internalNetworkConfig = &apiscalico.NetworkConfig{Backend: apiscalico.Bird}
+		err                   error
 	)
 
 	if network.Spec.ProviderConfig != nil {
@@ -59,6 +63,18 @@ func (a *actuator) Reconcile(ctx context.Context, network *extensionsv1alpha1.Ne
 		if err != nil {
 			return err
 		}
+
+		internalNetworkConfig = &apiscalico.NetworkConfig{}
+		if err := Scheme.Convert(networkConfig, internalNetworkConfig, nil); err != nil {
+			return errors.Wrapf(err, "could not convert providerConfig of network '%s'", util.ObjectName(network))
+		}
+		if errs := validation.ValidateNetworkConfig(internalNetworkConfig); len(errs) > 0 {
+			return errors.Wrapf(errs.ToAggregate(), "invalid providerConfig of network '%s'", util.ObjectName(network))
+		}
+	}
+
+	if err := validateNetworkConfigUpdate(network, internalNetworkConfig); err != nil {
+		return err
 	}
 
 	// Create shoot chart renderer
@@ -88,3 +104,32 @@ func (a *actuator) Reconcile(ctx context.Context, network *extensionsv1alpha1.Ne
 
 	return a.updateProviderStatus(ctx, network, networkConfig)
 }
+
+// validateNetworkConfigUpdate validates the given network config against the settings the default IPv4 pool has been
+// created with, as recorded in the provider status of the given network.
+func validateNetworkConfigUpdate(network *extensionsv1alpha1.Network, networkConfig *apiscalico.NetworkConfig) error {
+	if network.Status.ProviderStatus == nil || network.Status.ProviderStatus.Raw == nil {
+		return nil
+	}
+
+	status := &apiscalico.NetworkStatus{}
+	if _, _, err := decoder.Decode(network.Status.ProviderStatus.Raw, nil, status); err != nil {
+		return errors.Wrapf(err, "could not decode providerStatus of network '%s'", util.ObjectName(network))
+	}
+	if status.IPv4Pool == nil {
+		return nil
+	}
+
+	oldNetworkConfig := &apiscalico.NetworkConfig{
+		Backend:     apiscalico.None,
+		IPIP:        &status.IPv4Pool.IPIP,
+		NATOutgoing: &status.IPv4Pool.NATOutgoing,
+	}
+	if status.IPv4Pool.VXLAN {
+		oldNetworkConfig.Backend = apiscalico.VXLan
+	}
+	if errs := validation.ValidateNetworkConfigUpdate(oldNetworkConfig, networkConfig); len(errs) > 0 {
+		return errors.Wrapf(errs.ToAggregate(), "invalid update of providerConfig of network '%s'", util.ObjectName(network))
+	}
+	return nil
+}
@@ -50,8 +50,33 @@ func (a *actuator) ComputeNetworkStatus(networkConfig *calicov1alpha1.NetworkCon
 	var (
 		status = &calicov1alpha1.NetworkStatus{
 			TypeMeta: StatusTypeMeta,
+			IPv4Pool: computeIPv4Pool(networkConfig),
 		}
 	)
 
 	return status, nil
 }
+
+// computeIPv4Pool returns the settings of the default IPv4 pool deployed for the given network config.
+func computeIPv4Pool(networkConfig *calicov1alpha1.NetworkConfig) *calicov1alpha1.IPv4Pool {
+	pool := &calicov1alpha1.IPv4Pool{
+		IPIP:        calicov1alpha1.IPIPAlways,
+		NATOutgoing: true,
+	}
+	if networkConfig == nil {
+		return pool
+	}
+
+	pool.VXLAN = networkConfig.Backend == calicov1alpha1.VXLan
+	// IPIP encapsulation is only supported by the bird backend.
+	if networkConfig.Backend != calicov1alpha1.Bird {
+		pool.IPIP = calicov1alpha1.IPIPNever
+	}
+	if networkConfig.IPIP != nil {
+		pool.IPIP = *networkConfig.IPIP
+	}
+	if networkConfig.NATOutgoing != nil {
+		pool.NATOutgoing = *networkConfig.NATOutgoing
+	}
+	return pool
+}
@@ -0,0 +1,6 @@
+{{- define "alicloud-infra.snatIPs" -}}
+{{- $index := .index -}}
+{{- range $eipIndex := until .eipCount -}}
+{{- if $eipIndex }},${alicloud_eip.eip_natgw_z{{ $index }}_{{ $eipIndex }}.ip_address}{{ else }}${alicloud_eip.eip_natgw_z{{ $index }}.ip_address}{{ end -}}
+{{- end -}}
+{{- end -}}
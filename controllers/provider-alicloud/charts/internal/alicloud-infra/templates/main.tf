@@ -33,10 +33,12 @@ resource "alicloud_vswitch" "vsw_z{{ $index }}" {
   availability_zone = "{{ required "zone.name is required" $zone.name }}"
 }
 
+{{ if $.Values.create.snat -}}
+{{- $eipCount := int (default 1 $zone.eipCount) -}}
 // Create a new EIP.
 resource "alicloud_eip" "eip_natgw_z{{ $index }}" {
   name                 = "{{ required "clusterName is required" $.Values.clusterName }}-eip-natgw-z{{ $index }}"
-  bandwidth            = "100"
+  bandwidth            = "{{ required "vpc.eipBandwidth is required" $.Values.vpc.eipBandwidth }}"
   instance_charge_type = "PostPaid"
   internet_charge_type = "{{ required "vpc.internetChargeType is required" $.Values.vpc.internetChargeType }}"
 }
@@ -45,13 +47,32 @@ resource "alicloud_eip_association" "eip_natgw_asso_z{{ $index }}" {
   allocation_id = "${alicloud_eip.eip_natgw_z{{ $index }}.id}"
   instance_id   = "{{ required "natGatewayID is required" $.Values.vpc.natGatewayID }}"
 }
+{{- range $eipIndex := until $eipCount }}{{ if $eipIndex }}
+
+resource "alicloud_eip" "eip_natgw_z{{ $index }}_{{ $eipIndex }}" {
+  name                 = "{{ required "clusterName is required" $.Values.clusterName }}-eip-natgw-z{{ $index }}-{{ $eipIndex }}"
+  bandwidth            = "{{ required "vpc.eipBandwidth is required" $.Values.vpc.eipBandwidth }}"
+  instance_charge_type = "PostPaid"
+  internet_charge_type = "{{ required "vpc.internetChargeType is required" $.Values.vpc.internetChargeType }}"
+}
+
+resource "alicloud_eip_association" "eip_natgw_asso_z{{ $index }}_{{ $eipIndex }}" {
+  allocation_id = "${alicloud_eip.eip_natgw_z{{ $index }}_{{ $eipIndex }}.id}"
+  instance_id   = "{{ required "natGatewayID is required" $.Values.vpc.natGatewayID }}"
+}
+{{- end }}{{ end }}
 
 resource "alicloud_snat_entry" "snat_z{{ $index }}" {
   snat_table_id     = "{{ required "snatTableID is required" $.Values.vpc.snatTableID }}"
   source_vswitch_id = "${alicloud_vswitch.vsw_z{{ $index }}.id}"
-  snat_ip           = "${alicloud_eip.eip_natgw_z{{ $index }}.ip_address}"
+  snat_ip           = "{{ template "alicloud-infra.snatIPs" (dict "index" $index "eipCount" $eipCount) }}"
 }
 
+output "{{ $.Values.outputKeys.snatIPsPrefix }}{{ $index }}" {
+  value = "{{ template "alicloud-infra.snatIPs" (dict "index" $index "eipCount" $eipCount) }}"
+}
+{{- end }}
+
 // Output
 output "{{ $.Values.outputKeys.vswitchNodesPrefix }}{{ $index }}" {
   value = "${alicloud_vswitch.vsw_z{{ $index }}.id}"
@@ -60,6 +60,8 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 		backupEntryCtrlOpts = &controllercmd.ControllerOptions{
 			MaxConcurrentReconciles: 5,
 		}
+		backupEntryDeletionOpts       = &controllercmd.DeletionOptions{}
+		backupEntryCtrlOptsUnprefixed = controllercmd.NewOptionAggregator(backupEntryCtrlOpts, backupEntryDeletionOpts)
 
 		// options for the controlplane controller
 		controlPlaneCtrlOpts = &controllercmd.ControllerOptions{
@@ -95,7 +97,7 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			restOpts,
 			mgrOpts,
 			controllercmd.PrefixOption("backupbucket-", backupBucketCtrlOpts),
-			controllercmd.PrefixOption("backupentry-", backupEntryCtrlOpts),
+			controllercmd.PrefixOption("backupentry-", &backupEntryCtrlOptsUnprefixed),
 			controllercmd.PrefixOption("controlplane-", controlPlaneCtrlOpts),
 			controllercmd.PrefixOption("infrastructure-", infraCtrlOpts),
 			controllercmd.PrefixOption("worker-", &workerCtrlOptsUnprefixed),
@@ -136,10 +138,14 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			}
 
 			configFileOpts.Completed().ApplyMachineImages(&alicloudworker.DefaultAddOptions.MachineImages)
+			configFileOpts.Completed().ApplyControlPlaneComponents(&alicloudcontrolplane.DefaultAddOptions.ControlPlaneComponents)
+			configFileOpts.Completed().ApplyControlPlaneComponents(&alicloudworker.DefaultAddOptions.ControlPlaneComponents)
 			configFileOpts.Completed().ApplyETCDStorage(&alicloudcontrolplaneexposure.DefaultAddOptions.ETCDStorage)
 			configFileOpts.Completed().ApplyETCDBackup(&alicloudcontrolplanebackup.DefaultAddOptions.ETCDBackup)
+			configFileOpts.Completed().ApplyBackupBucketConfig(&alicloudbackupbucket.DefaultAddOptions.BackupBucketConfig)
 			backupBucketCtrlOpts.Completed().Apply(&alicloudbackupbucket.DefaultAddOptions.Controller)
 			backupEntryCtrlOpts.Completed().Apply(&alicloudbackupentry.DefaultAddOptions.Controller)
+			backupEntryDeletionOpts.Completed().Apply(&alicloudbackupentry.DefaultAddOptions.Deletion.GracePeriod, &alicloudbackupentry.DefaultAddOptions.Deletion.GarbageCollectionPeriod)
 			controlPlaneCtrlOpts.Completed().Apply(&alicloudcontrolplane.DefaultAddOptions.Controller)
 			infraCtrlOpts.Completed().Apply(&alicloudinfrastructure.DefaultAddOptions.Controller)
 			reconcileOpts.Completed().Apply(&alicloudinfrastructure.DefaultAddOptions.IgnoreOperationAnnotation)
@@ -17,11 +17,14 @@ package client
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"time"
 
 	alicloudvpc "github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/alicloud"
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
 
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -63,6 +66,12 @@ func NewStorageClientFromSecretRef(ctx context.Context, client client.Client, se
 	return storageClient, nil
 }
 
+// NewObjectStoreFromSecretRef creates a new object store for the OSS buckets accessible with the credentials from
+// <secretRef> in <region>.
+func NewObjectStoreFromSecretRef(ctx context.Context, client client.Client, secretRef corev1.SecretReference, region string) (objectstore.ObjectStore, error) {
+	return NewStorageClientFromSecretRef(ctx, client, &secretRef, region)
+}
+
 // DeleteObjectsWithPrefix deletes the s3 objects with the specific <prefix> from <bucketName>. If it does not exist,
 // no error is returned.
 func (c *storageClient) DeleteObjectsWithPrefix(ctx context.Context, bucketName, prefix string) error {
@@ -104,6 +113,65 @@ func (c *storageClient) DeleteObjectsWithPrefix(ctx context.Context, bucketName,
 	}
 }
 
+// ListObjectsWithPrefix returns the keys of the OSS objects with the specific <prefix> in <bucketName> together with
+// their last modification time.
+func (c *storageClient) ListObjectsWithPrefix(ctx context.Context, bucketName, prefix string) (map[string]time.Time, error) {
+	bucket, err := c.client.Bucket(bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	options := []oss.Option{oss.Prefix(prefix), oss.MaxKeys(1000)}
+	if t, ok := ctx.Deadline(); ok {
+		options = append(options, oss.Expires(t))
+	}
+
+	objects := make(map[string]time.Time)
+	marker := ""
+	for {
+		lsRes, err := bucket.ListObjects(append(options, oss.Marker(marker))...)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, object := range lsRes.Objects {
+			objects[object.Key] = object.LastModified
+		}
+
+		if !lsRes.IsTruncated {
+			return objects, nil
+		}
+		marker = lsRes.NextMarker
+	}
+}
+
+// GetObject returns the contents of the OSS object with the key <key> in <bucketName>.
+func (c *storageClient) GetObject(ctx context.Context, bucketName, key string) (io.ReadCloser, error) {
+	bucket, err := c.client.Bucket(bucketName)
+	if err != nil {
+		return nil, err
+	}
+	return bucket.GetObject(key)
+}
+
+// PutObject stores the contents of <body> as the OSS object with the key <key> in <bucketName>.
+func (c *storageClient) PutObject(ctx context.Context, bucketName, key string, body io.ReadSeeker) error {
+	bucket, err := c.client.Bucket(bucketName)
+	if err != nil {
+		return err
+	}
+	return bucket.PutObject(key, body)
+}
+
+// DeleteObject deletes the OSS object with the key <key> from <bucketName>. If it does not exist, no error is returned.
+func (c *storageClient) DeleteObject(ctx context.Context, bucketName, key string) error {
+	bucket, err := c.client.Bucket(bucketName)
+	if err != nil {
+		return err
+	}
+	return bucket.DeleteObject(key)
+}
+
 // CreateBucketIfNotExists creates the OSS bucket with name <bucketName> in <region>. If it already exist,
 // no error is returned.
 func (c *storageClient) CreateBucketIfNotExists(ctx context.Context, bucketName string) error {
@@ -124,6 +192,38 @@ func (c *storageClient) CreateBucketIfNotExists(ctx context.Context, bucketName
 	return nil
 }
 
+// UpdateBucketVersioning enables or suspends the object versioning of the OSS bucket with name <bucketName>.
+func (c *storageClient) UpdateBucketVersioning(ctx context.Context, bucketName string, enabled bool) error {
+	status := oss.VersionSuspended
+	if enabled {
+		status = oss.VersionEnabled
+	}
+
+	return c.client.SetBucketVersioning(bucketName, oss.VersioningConfig{Status: string(status)})
+}
+
+// UpdateBucketEncryption sets the default server-side encryption of the OSS bucket with name <bucketName>. If
+// <kmsKeyID> is nil, the objects are encrypted with OSS-managed keys, otherwise with the given KMS key.
+func (c *storageClient) UpdateBucketEncryption(ctx context.Context, bucketName string, kmsKeyID *string) error {
+	sseDefault := oss.SSEDefaultRule{SSEAlgorithm: string(oss.AESAlgorithm)}
+	if kmsKeyID != nil {
+		sseDefault = oss.SSEDefaultRule{
+			SSEAlgorithm:   string(oss.KMSAlgorithm),
+			KMSMasterKeyID: *kmsKeyID,
+		}
+	}
+
+	return c.client.SetBucketEncryption(bucketName, oss.ServerEncryptionRule{SSEDefault: sseDefault})
+}
+
+// UpdateBucketLifecycle sets the expiry rule of the OSS bucket with name <bucketName>. Objects expire
+// <expirationDays> after their last modification.
+func (c *storageClient) UpdateBucketLifecycle(ctx context.Context, bucketName string, expirationDays int) error {
+	return c.client.SetBucketLifecycle(bucketName, []oss.LifecycleRule{
+		oss.BuildLifecycleRuleByDays(bucketLifecycleRuleID, "", true, expirationDays),
+	})
+}
+
 // DeleteBucketIfExists deletes the Alicloud OSS bucket with name <bucketName>. If it does not exist,
 // no error is returned.
 func (c *storageClient) DeleteBucketIfExists(ctx context.Context, bucketName string) error {
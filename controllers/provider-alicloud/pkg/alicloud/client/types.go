@@ -16,6 +16,8 @@ package client
 
 import (
 	"context"
+	"io"
+	"time"
 
 	alicloudvpc "github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 )
@@ -23,6 +25,9 @@ import (
 // DefaultInternetChargeType is used for EIP
 const DefaultInternetChargeType = "PayByTraffic"
 
+// bucketLifecycleRuleID is the id of the lifecycle rule managed for backup buckets.
+const bucketLifecycleRuleID = "gardener-backup-expiry"
+
 // VPC is the interface to the Alicloud VPC service.
 type VPC interface {
 	// DescribeVpcs describes the VPCs for the request.
@@ -42,6 +47,13 @@ type Factory interface {
 // Storage is an interface which must be implemented by alicloud oss storage clients.
 type Storage interface {
 	DeleteObjectsWithPrefix(ctx context.Context, bucketName, prefix string) error
+	DeleteObject(ctx context.Context, bucketName, key string) error
+	ListObjectsWithPrefix(ctx context.Context, bucketName, prefix string) (map[string]time.Time, error)
+	GetObject(ctx context.Context, bucketName, key string) (io.ReadCloser, error)
+	PutObject(ctx context.Context, bucketName, key string, body io.ReadSeeker) error
 	CreateBucketIfNotExists(ctx context.Context, bucketName string) error
+	UpdateBucketVersioning(ctx context.Context, bucketName string, enabled bool) error
+	UpdateBucketEncryption(ctx context.Context, bucketName string, kmsKeyID *string) error
+	UpdateBucketLifecycle(ctx context.Context, bucketName string, expirationDays int) error
 	DeleteBucketIfExists(ctx context.Context, bucketName string) error
 }
@@ -18,6 +18,7 @@ import (
 	"fmt"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
+	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane"
 )
 
 // FindVSwitchForPurposeAndZone takes a list of vswitches and tries to find the first entry
@@ -55,3 +56,18 @@ func FindMachineImage(machineImages []alicloud.MachineImage, name, version strin
 	}
 	return nil, fmt.Errorf("no machine image name %q in version %q found", name, version)
 }
+
+// GetBackupRestoreConfig returns the backup-restore configuration for the given etcd backup configuration of a shoot.
+func GetBackupRestoreConfig(etcdBackup *alicloud.ETCDBackupConfig) *controlplane.BackupRestoreConfig {
+	if etcdBackup == nil {
+		return nil
+	}
+	return &controlplane.BackupRestoreConfig{
+		DeltaSnapshotPeriod:      etcdBackup.DeltaSnapshotPeriod,
+		DeltaSnapshotMemoryLimit: etcdBackup.DeltaSnapshotMemoryLimit,
+		GarbageCollectionPeriod:  etcdBackup.GarbageCollectionPeriod,
+		GarbageCollectionPolicy:  etcdBackup.GarbageCollectionPolicy,
+		MaxBackups:               etcdBackup.MaxBackups,
+		Resources:                etcdBackup.Resources,
+	}
+}
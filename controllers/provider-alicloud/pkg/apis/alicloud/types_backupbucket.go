@@ -0,0 +1,48 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alicloud
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackupBucketConfig contains configuration settings for the backup bucket.
+type BackupBucketConfig struct {
+	metav1.TypeMeta
+
+	// Versioning indicates whether object versioning shall be enabled for the bucket.
+	Versioning *bool
+
+	// Encryption contains the server-side encryption settings for the bucket.
+	Encryption *EncryptionConfig
+
+	// Lifecycle contains the expiry rules for objects in the bucket.
+	Lifecycle *LifecycleConfig
+}
+
+// EncryptionConfig contains the server-side encryption settings for the bucket.
+type EncryptionConfig struct {
+	// KMSKeyID is the id of the KMS key used for encrypting the objects. If not set, the objects are encrypted
+	// with OSS-managed keys.
+	KMSKeyID *string
+}
+
+// LifecycleConfig contains the expiry rules for objects in the bucket.
+type LifecycleConfig struct {
+	// ExpirationDays is the number of days after the last modification after which objects expire.
+	ExpirationDays int32
+}
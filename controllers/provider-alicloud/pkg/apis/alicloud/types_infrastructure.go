@@ -35,6 +35,20 @@ type Networks struct {
 
 	// Zones are the network zones for an infrastructure.
 	Zones []Zone
+
+	// EIP contains the configuration of the EIPs attached to the NAT gateway.
+	// +optional
+	EIP *EIP
+}
+
+// EIP contains the configuration of the EIPs attached to the NAT gateway.
+type EIP struct {
+	// Bandwidth is the maximum bandwidth of each EIP in Mbps.
+	// +optional
+	Bandwidth *int32
+	// InternetChargeType is the internet charge type of the EIPs, either PayByTraffic or PayByBandwidth.
+	// +optional
+	InternetChargeType *string
 }
 
 // VPC contains information about whether to create a new or use an existing VPC.
@@ -45,6 +59,13 @@ type VPC struct {
 	// CIDR is the CIDR of a VPC to create.
 	// +optional
 	CIDR *string
+	// NATGatewayID is the ID of an existing NAT gateway of the existing VPC.
+	// +optional
+	NATGatewayID *string
+	// ExistingSNATEntries indicates that the SNAT entries of the existing NAT gateway already cover the worker
+	// vswitches, hence no EIPs and SNAT entries are created.
+	// +optional
+	ExistingSNATEntries bool
 }
 
 // VPCStatus contains output information about the VPC.
@@ -75,6 +96,9 @@ type VSwitch struct {
 	ID string
 	// Zone is the name of the zone.
 	Zone string
+	// SNATIPs are the IP addresses of the EIPs used for source NAT of the vswitch.
+	// +optional
+	SNATIPs []string
 }
 
 // SecurityGroup contains information about a security group.
@@ -91,6 +115,9 @@ type Zone struct {
 	Name string
 	// Worker specifies the worker CIDR to use.
 	Worker string
+	// EIPCount is the number of EIPs used for source NAT of the zone. Defaults to 1.
+	// +optional
+	EIPCount *int32
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
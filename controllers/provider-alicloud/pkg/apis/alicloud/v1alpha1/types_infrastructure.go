@@ -35,6 +35,20 @@ type Networks struct {
 
 	// Zones are the network zones for an infrastructure.
 	Zones []Zone `json:"zones"`
+
+	// EIP contains the configuration of the EIPs attached to the NAT gateway.
+	// +optional
+	EIP *EIP `json:"eip,omitempty"`
+}
+
+// EIP contains the configuration of the EIPs attached to the NAT gateway.
+type EIP struct {
+	// Bandwidth is the maximum bandwidth of each EIP in Mbps.
+	// +optional
+	Bandwidth *int32 `json:"bandwidth,omitempty"`
+	// InternetChargeType is the internet charge type of the EIPs, either PayByTraffic or PayByBandwidth.
+	// +optional
+	InternetChargeType *string `json:"internetChargeType,omitempty"`
 }
 
 // VPC contains information about whether to create a new or use an existing VPC.
@@ -45,6 +59,13 @@ type VPC struct {
 	// CIDR is the CIDR of a VPC to create.
 	// +optional
 	CIDR *string `json:"cidr,omitempty"`
+	// NATGatewayID is the ID of an existing NAT gateway of the existing VPC.
+	// +optional
+	NATGatewayID *string `json:"natGatewayID,omitempty"`
+	// ExistingSNATEntries indicates that the SNAT entries of the existing NAT gateway already cover the worker
+	// vswitches, hence no EIPs and SNAT entries are created.
+	// +optional
+	ExistingSNATEntries bool `json:"existingSNATEntries,omitempty"`
 }
 
 // VPCStatus contains output information about the VPC.
@@ -75,6 +96,9 @@ type VSwitch struct {
 	ID string `json:"id"`
 	// Zone is the name of the zone.
 	Zone string `json:"zone"`
+	// SNATIPs are the IP addresses of the EIPs used for source NAT of the vswitch.
+	// +optional
+	SNATIPs []string `json:"snatIPs,omitempty"`
 }
 
 // SecurityGroup contains information about a security group.
@@ -91,6 +115,9 @@ type Zone struct {
 	Name string `json:"name"`
 	// Worker specifies the worker CIDR to use.
 	Worker string `json:"worker"`
+	// EIPCount is the number of EIPs used for source NAT of the zone. Defaults to 1.
+	// +optional
+	EIPCount *int32 `json:"eipCount,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
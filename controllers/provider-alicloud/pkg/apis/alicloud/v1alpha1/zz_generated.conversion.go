@@ -24,6 +24,10 @@ import (
 	unsafe "unsafe"
 
 	alicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -35,6 +39,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*BackupBucketConfig)(nil), (*alicloud.BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketConfig_To_alicloud_BackupBucketConfig(a.(*BackupBucketConfig), b.(*alicloud.BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.BackupBucketConfig)(nil), (*BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(a.(*alicloud.BackupBucketConfig), b.(*BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudControllerManagerConfig)(nil), (*alicloud.CloudControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudControllerManagerConfig_To_alicloud_CloudControllerManagerConfig(a.(*CloudControllerManagerConfig), b.(*alicloud.CloudControllerManagerConfig), scope)
 	}); err != nil {
@@ -65,6 +79,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EIP)(nil), (*alicloud.EIP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EIP_To_alicloud_EIP(a.(*EIP), b.(*alicloud.EIP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.EIP)(nil), (*EIP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_EIP_To_v1alpha1_EIP(a.(*alicloud.EIP), b.(*EIP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ETCDBackupConfig)(nil), (*alicloud.ETCDBackupConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ETCDBackupConfig_To_alicloud_ETCDBackupConfig(a.(*ETCDBackupConfig), b.(*alicloud.ETCDBackupConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.ETCDBackupConfig)(nil), (*ETCDBackupConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_ETCDBackupConfig_To_v1alpha1_ETCDBackupConfig(a.(*alicloud.ETCDBackupConfig), b.(*ETCDBackupConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EncryptionConfig)(nil), (*alicloud.EncryptionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EncryptionConfig_To_alicloud_EncryptionConfig(a.(*EncryptionConfig), b.(*alicloud.EncryptionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.EncryptionConfig)(nil), (*EncryptionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_EncryptionConfig_To_v1alpha1_EncryptionConfig(a.(*alicloud.EncryptionConfig), b.(*EncryptionConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InfrastructureConfig)(nil), (*alicloud.InfrastructureConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InfrastructureConfig_To_alicloud_InfrastructureConfig(a.(*InfrastructureConfig), b.(*alicloud.InfrastructureConfig), scope)
 	}); err != nil {
@@ -85,6 +129,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*LifecycleConfig)(nil), (*alicloud.LifecycleConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LifecycleConfig_To_alicloud_LifecycleConfig(a.(*LifecycleConfig), b.(*alicloud.LifecycleConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.LifecycleConfig)(nil), (*LifecycleConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_LifecycleConfig_To_v1alpha1_LifecycleConfig(a.(*alicloud.LifecycleConfig), b.(*LifecycleConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineImage)(nil), (*alicloud.MachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachineImage_To_alicloud_MachineImage(a.(*MachineImage), b.(*alicloud.MachineImage), scope)
 	}); err != nil {
@@ -135,6 +189,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*StorageClass)(nil), (*alicloud.StorageClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageClass_To_alicloud_StorageClass(a.(*StorageClass), b.(*alicloud.StorageClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.StorageClass)(nil), (*StorageClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_StorageClass_To_v1alpha1_StorageClass(a.(*alicloud.StorageClass), b.(*StorageClass), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VPC)(nil), (*alicloud.VPC)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_VPC_To_alicloud_VPC(a.(*VPC), b.(*alicloud.VPC), scope)
 	}); err != nil {
@@ -188,8 +252,35 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha1_BackupBucketConfig_To_alicloud_BackupBucketConfig(in *BackupBucketConfig, out *alicloud.BackupBucketConfig, s conversion.Scope) error {
+	out.Versioning = (*bool)(unsafe.Pointer(in.Versioning))
+	out.Encryption = (*alicloud.EncryptionConfig)(unsafe.Pointer(in.Encryption))
+	out.Lifecycle = (*alicloud.LifecycleConfig)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketConfig_To_alicloud_BackupBucketConfig is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketConfig_To_alicloud_BackupBucketConfig(in *BackupBucketConfig, out *alicloud.BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketConfig_To_alicloud_BackupBucketConfig(in, out, s)
+}
+
+func autoConvert_alicloud_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *alicloud.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	out.Versioning = (*bool)(unsafe.Pointer(in.Versioning))
+	out.Encryption = (*EncryptionConfig)(unsafe.Pointer(in.Encryption))
+	out.Lifecycle = (*LifecycleConfig)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_alicloud_BackupBucketConfig_To_v1alpha1_BackupBucketConfig is an autogenerated conversion function.
+func Convert_alicloud_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *alicloud.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_alicloud_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudControllerManagerConfig_To_alicloud_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *alicloud.CloudControllerManagerConfig, s conversion.Scope) error {
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.ConcurrentServiceSyncs = (*int32)(unsafe.Pointer(in.ConcurrentServiceSyncs))
+	out.NodeMonitorPeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorPeriod))
+	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
 	return nil
 }
 
@@ -200,6 +291,9 @@ func Convert_v1alpha1_CloudControllerManagerConfig_To_alicloud_CloudControllerMa
 
 func autoConvert_alicloud_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in *alicloud.CloudControllerManagerConfig, out *CloudControllerManagerConfig, s conversion.Scope) error {
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.ConcurrentServiceSyncs = (*int32)(unsafe.Pointer(in.ConcurrentServiceSyncs))
+	out.NodeMonitorPeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorPeriod))
+	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
 	return nil
 }
 
@@ -231,6 +325,8 @@ func Convert_alicloud_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *alic
 func autoConvert_v1alpha1_ControlPlaneConfig_To_alicloud_ControlPlaneConfig(in *ControlPlaneConfig, out *alicloud.ControlPlaneConfig, s conversion.Scope) error {
 	out.Zone = in.Zone
 	out.CloudControllerManager = (*alicloud.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.StorageClasses = *(*[]alicloud.StorageClass)(unsafe.Pointer(&in.StorageClasses))
+	out.ETCDBackup = (*alicloud.ETCDBackupConfig)(unsafe.Pointer(in.ETCDBackup))
 	return nil
 }
 
@@ -242,6 +338,8 @@ func Convert_v1alpha1_ControlPlaneConfig_To_alicloud_ControlPlaneConfig(in *Cont
 func autoConvert_alicloud_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *alicloud.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
 	out.Zone = in.Zone
 	out.CloudControllerManager = (*CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.StorageClasses = *(*[]StorageClass)(unsafe.Pointer(&in.StorageClasses))
+	out.ETCDBackup = (*ETCDBackupConfig)(unsafe.Pointer(in.ETCDBackup))
 	return nil
 }
 
@@ -250,6 +348,78 @@ func Convert_alicloud_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *alic
 	return autoConvert_alicloud_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_EIP_To_alicloud_EIP(in *EIP, out *alicloud.EIP, s conversion.Scope) error {
+	out.Bandwidth = (*int32)(unsafe.Pointer(in.Bandwidth))
+	out.InternetChargeType = (*string)(unsafe.Pointer(in.InternetChargeType))
+	return nil
+}
+
+// Convert_v1alpha1_EIP_To_alicloud_EIP is an autogenerated conversion function.
+func Convert_v1alpha1_EIP_To_alicloud_EIP(in *EIP, out *alicloud.EIP, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EIP_To_alicloud_EIP(in, out, s)
+}
+
+func autoConvert_alicloud_EIP_To_v1alpha1_EIP(in *alicloud.EIP, out *EIP, s conversion.Scope) error {
+	out.Bandwidth = (*int32)(unsafe.Pointer(in.Bandwidth))
+	out.InternetChargeType = (*string)(unsafe.Pointer(in.InternetChargeType))
+	return nil
+}
+
+// Convert_alicloud_EIP_To_v1alpha1_EIP is an autogenerated conversion function.
+func Convert_alicloud_EIP_To_v1alpha1_EIP(in *alicloud.EIP, out *EIP, s conversion.Scope) error {
+	return autoConvert_alicloud_EIP_To_v1alpha1_EIP(in, out, s)
+}
+
+func autoConvert_v1alpha1_ETCDBackupConfig_To_alicloud_ETCDBackupConfig(in *ETCDBackupConfig, out *alicloud.ETCDBackupConfig, s conversion.Scope) error {
+	out.DeltaSnapshotPeriod = (*v1.Duration)(unsafe.Pointer(in.DeltaSnapshotPeriod))
+	out.DeltaSnapshotMemoryLimit = (*resource.Quantity)(unsafe.Pointer(in.DeltaSnapshotMemoryLimit))
+	out.GarbageCollectionPeriod = (*v1.Duration)(unsafe.Pointer(in.GarbageCollectionPeriod))
+	out.GarbageCollectionPolicy = (*string)(unsafe.Pointer(in.GarbageCollectionPolicy))
+	out.MaxBackups = (*int32)(unsafe.Pointer(in.MaxBackups))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	return nil
+}
+
+// Convert_v1alpha1_ETCDBackupConfig_To_alicloud_ETCDBackupConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ETCDBackupConfig_To_alicloud_ETCDBackupConfig(in *ETCDBackupConfig, out *alicloud.ETCDBackupConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ETCDBackupConfig_To_alicloud_ETCDBackupConfig(in, out, s)
+}
+
+func autoConvert_alicloud_ETCDBackupConfig_To_v1alpha1_ETCDBackupConfig(in *alicloud.ETCDBackupConfig, out *ETCDBackupConfig, s conversion.Scope) error {
+	out.DeltaSnapshotPeriod = (*v1.Duration)(unsafe.Pointer(in.DeltaSnapshotPeriod))
+	out.DeltaSnapshotMemoryLimit = (*resource.Quantity)(unsafe.Pointer(in.DeltaSnapshotMemoryLimit))
+	out.GarbageCollectionPeriod = (*v1.Duration)(unsafe.Pointer(in.GarbageCollectionPeriod))
+	out.GarbageCollectionPolicy = (*string)(unsafe.Pointer(in.GarbageCollectionPolicy))
+	out.MaxBackups = (*int32)(unsafe.Pointer(in.MaxBackups))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	return nil
+}
+
+// Convert_alicloud_ETCDBackupConfig_To_v1alpha1_ETCDBackupConfig is an autogenerated conversion function.
+func Convert_alicloud_ETCDBackupConfig_To_v1alpha1_ETCDBackupConfig(in *alicloud.ETCDBackupConfig, out *ETCDBackupConfig, s conversion.Scope) error {
+	return autoConvert_alicloud_ETCDBackupConfig_To_v1alpha1_ETCDBackupConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_EncryptionConfig_To_alicloud_EncryptionConfig(in *EncryptionConfig, out *alicloud.EncryptionConfig, s conversion.Scope) error {
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
+	return nil
+}
+
+// Convert_v1alpha1_EncryptionConfig_To_alicloud_EncryptionConfig is an autogenerated conversion function.
+func Convert_v1alpha1_EncryptionConfig_To_alicloud_EncryptionConfig(in *EncryptionConfig, out *alicloud.EncryptionConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EncryptionConfig_To_alicloud_EncryptionConfig(in, out, s)
+}
+
+func autoConvert_alicloud_EncryptionConfig_To_v1alpha1_EncryptionConfig(in *alicloud.EncryptionConfig, out *EncryptionConfig, s conversion.Scope) error {
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
+	return nil
+}
+
+// Convert_alicloud_EncryptionConfig_To_v1alpha1_EncryptionConfig is an autogenerated conversion function.
+func Convert_alicloud_EncryptionConfig_To_v1alpha1_EncryptionConfig(in *alicloud.EncryptionConfig, out *EncryptionConfig, s conversion.Scope) error {
+	return autoConvert_alicloud_EncryptionConfig_To_v1alpha1_EncryptionConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_InfrastructureConfig_To_alicloud_InfrastructureConfig(in *InfrastructureConfig, out *alicloud.InfrastructureConfig, s conversion.Scope) error {
 	if err := Convert_v1alpha1_Networks_To_alicloud_Networks(&in.Networks, &out.Networks, s); err != nil {
 		return err
@@ -300,6 +470,26 @@ func Convert_alicloud_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *
 	return autoConvert_alicloud_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_LifecycleConfig_To_alicloud_LifecycleConfig(in *LifecycleConfig, out *alicloud.LifecycleConfig, s conversion.Scope) error {
+	out.ExpirationDays = in.ExpirationDays
+	return nil
+}
+
+// Convert_v1alpha1_LifecycleConfig_To_alicloud_LifecycleConfig is an autogenerated conversion function.
+func Convert_v1alpha1_LifecycleConfig_To_alicloud_LifecycleConfig(in *LifecycleConfig, out *alicloud.LifecycleConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LifecycleConfig_To_alicloud_LifecycleConfig(in, out, s)
+}
+
+func autoConvert_alicloud_LifecycleConfig_To_v1alpha1_LifecycleConfig(in *alicloud.LifecycleConfig, out *LifecycleConfig, s conversion.Scope) error {
+	out.ExpirationDays = in.ExpirationDays
+	return nil
+}
+
+// Convert_alicloud_LifecycleConfig_To_v1alpha1_LifecycleConfig is an autogenerated conversion function.
+func Convert_alicloud_LifecycleConfig_To_v1alpha1_LifecycleConfig(in *alicloud.LifecycleConfig, out *LifecycleConfig, s conversion.Scope) error {
+	return autoConvert_alicloud_LifecycleConfig_To_v1alpha1_LifecycleConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_MachineImage_To_alicloud_MachineImage(in *MachineImage, out *alicloud.MachineImage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Version = in.Version
@@ -373,6 +563,7 @@ func autoConvert_v1alpha1_Networks_To_alicloud_Networks(in *Networks, out *alicl
 		return err
 	}
 	out.Zones = *(*[]alicloud.Zone)(unsafe.Pointer(&in.Zones))
+	out.EIP = (*alicloud.EIP)(unsafe.Pointer(in.EIP))
 	return nil
 }
 
@@ -386,6 +577,7 @@ func autoConvert_alicloud_Networks_To_v1alpha1_Networks(in *alicloud.Networks, o
 		return err
 	}
 	out.Zones = *(*[]Zone)(unsafe.Pointer(&in.Zones))
+	out.EIP = (*EIP)(unsafe.Pointer(in.EIP))
 	return nil
 }
 
@@ -416,9 +608,41 @@ func Convert_alicloud_SecurityGroup_To_v1alpha1_SecurityGroup(in *alicloud.Secur
 	return autoConvert_alicloud_SecurityGroup_To_v1alpha1_SecurityGroup(in, out, s)
 }
 
+func autoConvert_v1alpha1_StorageClass_To_alicloud_StorageClass(in *StorageClass, out *alicloud.StorageClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	out.ReclaimPolicy = (*corev1.PersistentVolumeReclaimPolicy)(unsafe.Pointer(in.ReclaimPolicy))
+	out.VolumeBindingMode = (*storagev1.VolumeBindingMode)(unsafe.Pointer(in.VolumeBindingMode))
+	out.Default = (*bool)(unsafe.Pointer(in.Default))
+	return nil
+}
+
+// Convert_v1alpha1_StorageClass_To_alicloud_StorageClass is an autogenerated conversion function.
+func Convert_v1alpha1_StorageClass_To_alicloud_StorageClass(in *StorageClass, out *alicloud.StorageClass, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageClass_To_alicloud_StorageClass(in, out, s)
+}
+
+func autoConvert_alicloud_StorageClass_To_v1alpha1_StorageClass(in *alicloud.StorageClass, out *StorageClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	out.ReclaimPolicy = (*corev1.PersistentVolumeReclaimPolicy)(unsafe.Pointer(in.ReclaimPolicy))
+	out.VolumeBindingMode = (*storagev1.VolumeBindingMode)(unsafe.Pointer(in.VolumeBindingMode))
+	out.Default = (*bool)(unsafe.Pointer(in.Default))
+	return nil
+}
+
+// Convert_alicloud_StorageClass_To_v1alpha1_StorageClass is an autogenerated conversion function.
+func Convert_alicloud_StorageClass_To_v1alpha1_StorageClass(in *alicloud.StorageClass, out *StorageClass, s conversion.Scope) error {
+	return autoConvert_alicloud_StorageClass_To_v1alpha1_StorageClass(in, out, s)
+}
+
 func autoConvert_v1alpha1_VPC_To_alicloud_VPC(in *VPC, out *alicloud.VPC, s conversion.Scope) error {
 	out.ID = (*string)(unsafe.Pointer(in.ID))
 	out.CIDR = (*string)(unsafe.Pointer(in.CIDR))
+	out.NATGatewayID = (*string)(unsafe.Pointer(in.NATGatewayID))
+	out.ExistingSNATEntries = in.ExistingSNATEntries
 	return nil
 }
 
@@ -430,6 +654,8 @@ func Convert_v1alpha1_VPC_To_alicloud_VPC(in *VPC, out *alicloud.VPC, s conversi
 func autoConvert_alicloud_VPC_To_v1alpha1_VPC(in *alicloud.VPC, out *VPC, s conversion.Scope) error {
 	out.ID = (*string)(unsafe.Pointer(in.ID))
 	out.CIDR = (*string)(unsafe.Pointer(in.CIDR))
+	out.NATGatewayID = (*string)(unsafe.Pointer(in.NATGatewayID))
+	out.ExistingSNATEntries = in.ExistingSNATEntries
 	return nil
 }
 
@@ -466,6 +692,7 @@ func autoConvert_v1alpha1_VSwitch_To_alicloud_VSwitch(in *VSwitch, out *alicloud
 	out.Purpose = alicloud.Purpose(in.Purpose)
 	out.ID = in.ID
 	out.Zone = in.Zone
+	out.SNATIPs = *(*[]string)(unsafe.Pointer(&in.SNATIPs))
 	return nil
 }
 
@@ -478,6 +705,7 @@ func autoConvert_alicloud_VSwitch_To_v1alpha1_VSwitch(in *alicloud.VSwitch, out
 	out.Purpose = Purpose(in.Purpose)
 	out.ID = in.ID
 	out.Zone = in.Zone
+	out.SNATIPs = *(*[]string)(unsafe.Pointer(&in.SNATIPs))
 	return nil
 }
 
@@ -509,6 +737,7 @@ func Convert_alicloud_WorkerStatus_To_v1alpha1_WorkerStatus(in *alicloud.WorkerS
 func autoConvert_v1alpha1_Zone_To_alicloud_Zone(in *Zone, out *alicloud.Zone, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Worker = in.Worker
+	out.EIPCount = (*int32)(unsafe.Pointer(in.EIPCount))
 	return nil
 }
 
@@ -520,6 +749,7 @@ func Convert_v1alpha1_Zone_To_alicloud_Zone(in *Zone, out *alicloud.Zone, s conv
 func autoConvert_alicloud_Zone_To_v1alpha1_Zone(in *alicloud.Zone, out *Zone, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Worker = in.Worker
+	out.EIPCount = (*int32)(unsafe.Pointer(in.EIPCount))
 	return nil
 }
 
@@ -21,9 +21,52 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupBucketConfig) DeepCopyInto(out *BackupBucketConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Versioning != nil {
+		in, out := &in.Versioning, &out.Versioning
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(EncryptionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = new(LifecycleConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupBucketConfig.
+func (in *BackupBucketConfig) DeepCopy() *BackupBucketConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupBucketConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupBucketConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudControllerManagerConfig) DeepCopyInto(out *CloudControllerManagerConfig) {
 	*out = *in
@@ -34,6 +77,21 @@ func (in *CloudControllerManagerConfig) DeepCopyInto(out *CloudControllerManager
 			(*out)[key] = val
 		}
 	}
+	if in.ConcurrentServiceSyncs != nil {
+		in, out := &in.ConcurrentServiceSyncs, &out.ConcurrentServiceSyncs
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NodeMonitorPeriod != nil {
+		in, out := &in.NodeMonitorPeriod, &out.NodeMonitorPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Verbosity != nil {
+		in, out := &in.Verbosity, &out.Verbosity
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -88,6 +146,18 @@ func (in *ControlPlaneConfig) DeepCopyInto(out *ControlPlaneConfig) {
 		*out = new(CloudControllerManagerConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StorageClasses != nil {
+		in, out := &in.StorageClasses, &out.StorageClasses
+		*out = make([]StorageClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ETCDBackup != nil {
+		in, out := &in.ETCDBackup, &out.ETCDBackup
+		*out = new(ETCDBackupConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -109,6 +179,99 @@ func (in *ControlPlaneConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EIP) DeepCopyInto(out *EIP) {
+	*out = *in
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(int32)
+		**out = **in
+	}
+	if in.InternetChargeType != nil {
+		in, out := &in.InternetChargeType, &out.InternetChargeType
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EIP.
+func (in *EIP) DeepCopy() *EIP {
+	if in == nil {
+		return nil
+	}
+	out := new(EIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ETCDBackupConfig) DeepCopyInto(out *ETCDBackupConfig) {
+	*out = *in
+	if in.DeltaSnapshotPeriod != nil {
+		in, out := &in.DeltaSnapshotPeriod, &out.DeltaSnapshotPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DeltaSnapshotMemoryLimit != nil {
+		in, out := &in.DeltaSnapshotMemoryLimit, &out.DeltaSnapshotMemoryLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.GarbageCollectionPeriod != nil {
+		in, out := &in.GarbageCollectionPeriod, &out.GarbageCollectionPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.GarbageCollectionPolicy != nil {
+		in, out := &in.GarbageCollectionPolicy, &out.GarbageCollectionPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxBackups != nil {
+		in, out := &in.MaxBackups, &out.MaxBackups
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ETCDBackupConfig.
+func (in *ETCDBackupConfig) DeepCopy() *ETCDBackupConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ETCDBackupConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionConfig) DeepCopyInto(out *EncryptionConfig) {
+	*out = *in
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionConfig.
+func (in *EncryptionConfig) DeepCopy() *EncryptionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InfrastructureConfig) DeepCopyInto(out *InfrastructureConfig) {
 	*out = *in
@@ -161,6 +324,22 @@ func (in *InfrastructureStatus) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecycleConfig) DeepCopyInto(out *LifecycleConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleConfig.
+func (in *LifecycleConfig) DeepCopy() *LifecycleConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecycleConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineImage) DeepCopyInto(out *MachineImage) {
 	*out = *in
@@ -221,7 +400,14 @@ func (in *Networks) DeepCopyInto(out *Networks) {
 	if in.Zones != nil {
 		in, out := &in.Zones, &out.Zones
 		*out = make([]Zone, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EIP != nil {
+		in, out := &in.EIP, &out.EIP
+		*out = new(EIP)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -252,6 +438,42 @@ func (in *SecurityGroup) DeepCopy() *SecurityGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageClass) DeepCopyInto(out *StorageClass) {
+	*out = *in
+	if in.Encrypted != nil {
+		in, out := &in.Encrypted, &out.Encrypted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReclaimPolicy != nil {
+		in, out := &in.ReclaimPolicy, &out.ReclaimPolicy
+		*out = new(corev1.PersistentVolumeReclaimPolicy)
+		**out = **in
+	}
+	if in.VolumeBindingMode != nil {
+		in, out := &in.VolumeBindingMode, &out.VolumeBindingMode
+		*out = new(storagev1.VolumeBindingMode)
+		**out = **in
+	}
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageClass.
+func (in *StorageClass) DeepCopy() *StorageClass {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPC) DeepCopyInto(out *VPC) {
 	*out = *in
@@ -265,6 +487,11 @@ func (in *VPC) DeepCopyInto(out *VPC) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.NATGatewayID != nil {
+		in, out := &in.NATGatewayID, &out.NATGatewayID
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -284,7 +511,9 @@ func (in *VPCStatus) DeepCopyInto(out *VPCStatus) {
 	if in.VSwitches != nil {
 		in, out := &in.VSwitches, &out.VSwitches
 		*out = make([]VSwitch, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.SecurityGroups != nil {
 		in, out := &in.SecurityGroups, &out.SecurityGroups
@@ -307,6 +536,11 @@ func (in *VPCStatus) DeepCopy() *VPCStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VSwitch) DeepCopyInto(out *VSwitch) {
 	*out = *in
+	if in.SNATIPs != nil {
+		in, out := &in.SNATIPs, &out.SNATIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -353,6 +587,11 @@ func (in *WorkerStatus) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Zone) DeepCopyInto(out *Zone) {
 	*out = *in
+	if in.EIPCount != nil {
+		in, out := &in.EIPCount, &out.EIPCount
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -0,0 +1,68 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisalicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
+	. "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("BackupBucketConfig validation", func() {
+	var backupBucketConfig *apisalicloud.BackupBucketConfig
+
+	BeforeEach(func() {
+		backupBucketConfig = &apisalicloud.BackupBucketConfig{}
+	})
+
+	Describe("#ValidateBackupBucketConfig", func() {
+		It("should allow an empty configuration", func() {
+			Expect(ValidateBackupBucketConfig(backupBucketConfig)).To(BeEmpty())
+		})
+
+		It("should allow a valid configuration", func() {
+			var (
+				versioning = true
+				kmsKeyID   = "key-id"
+			)
+			backupBucketConfig.Versioning = &versioning
+			backupBucketConfig.Encryption = &apisalicloud.EncryptionConfig{KMSKeyID: &kmsKeyID}
+			backupBucketConfig.Lifecycle = &apisalicloud.LifecycleConfig{ExpirationDays: 30}
+
+			Expect(ValidateBackupBucketConfig(backupBucketConfig)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid configuration", func() {
+			kmsKeyID := ""
+			backupBucketConfig.Encryption = &apisalicloud.EncryptionConfig{KMSKeyID: &kmsKeyID}
+			backupBucketConfig.Lifecycle = &apisalicloud.LifecycleConfig{}
+
+			Expect(ValidateBackupBucketConfig(backupBucketConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("encryption.kmsKeyID"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("lifecycle.expirationDays"),
+				})),
+			))
+		})
+	})
+})
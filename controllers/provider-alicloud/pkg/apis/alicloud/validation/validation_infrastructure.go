@@ -0,0 +1,80 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apisalicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var supportedInternetChargeTypes = sets.NewString("PayByTraffic", "PayByBandwidth")
+
+// ValidateInfrastructureConfig validates a InfrastructureConfig object.
+func ValidateInfrastructureConfig(infra *apisalicloud.InfrastructureConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var (
+		networksPath = field.NewPath("networks")
+		vpcPath      = networksPath.Child("vpc")
+		vpc          = infra.Networks.VPC
+	)
+
+	if (vpc.ID == nil) == (vpc.CIDR == nil) {
+		allErrs = append(allErrs, field.Invalid(vpcPath, vpc, "must specify either the id of an existing vpc or the cidr of a vpc to create"))
+	}
+	if vpc.NATGatewayID != nil && vpc.ID == nil {
+		allErrs = append(allErrs, field.Forbidden(vpcPath.Child("natGatewayID"), "an existing NAT gateway can only be used with an existing vpc"))
+	}
+	if vpc.ExistingSNATEntries && vpc.ID == nil {
+		allErrs = append(allErrs, field.Forbidden(vpcPath.Child("existingSNATEntries"), "existing SNAT entries can only be used with an existing vpc"))
+	}
+
+	zonesPath := networksPath.Child("zones")
+	if len(infra.Networks.Zones) == 0 {
+		allErrs = append(allErrs, field.Required(zonesPath, "must provide at least one zone"))
+	}
+	for i, zone := range infra.Networks.Zones {
+		idxPath := zonesPath.Index(i)
+
+		if len(zone.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		}
+		if len(zone.Worker) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("worker"), "must specify the network range for the worker network"))
+		}
+		if zone.EIPCount != nil {
+			if vpc.ExistingSNATEntries {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("eipCount"), "no EIPs are created for existing SNAT entries"))
+			} else if *zone.EIPCount < 1 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("eipCount"), *zone.EIPCount, "must be at least 1"))
+			}
+		}
+	}
+
+	if eip := infra.Networks.EIP; eip != nil {
+		eipPath := networksPath.Child("eip")
+
+		if eip.Bandwidth != nil && (*eip.Bandwidth < 1 || *eip.Bandwidth > 500) {
+			allErrs = append(allErrs, field.Invalid(eipPath.Child("bandwidth"), *eip.Bandwidth, "must be between 1 and 500"))
+		}
+		if eip.InternetChargeType != nil && !supportedInternetChargeTypes.Has(*eip.InternetChargeType) {
+			allErrs = append(allErrs, field.NotSupported(eipPath.Child("internetChargeType"), *eip.InternetChargeType, supportedInternetChargeTypes.List()))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisalicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
+	. "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("InfrastructureConfig validation", func() {
+	var (
+		infrastructureConfig *apisalicloud.InfrastructureConfig
+
+		vpcID        = "vpc-id"
+		vpcCIDR      = "10.250.0.0/16"
+		natGatewayID = "nat-id"
+	)
+
+	BeforeEach(func() {
+		infrastructureConfig = &apisalicloud.InfrastructureConfig{
+			Networks: apisalicloud.Networks{
+				VPC: apisalicloud.VPC{
+					CIDR: &vpcCIDR,
+				},
+				Zones: []apisalicloud.Zone{
+					{Name: "cn-beijing-a", Worker: "10.250.0.0/19"},
+				},
+			},
+		}
+	})
+
+	Describe("#ValidateInfrastructureConfig", func() {
+		It("should allow a valid configuration", func() {
+			var (
+				eipCount           int32 = 2
+				bandwidth          int32 = 200
+				internetChargeType       = "PayByBandwidth"
+			)
+			infrastructureConfig.Networks.Zones[0].EIPCount = &eipCount
+			infrastructureConfig.Networks.EIP = &apisalicloud.EIP{
+				Bandwidth:          &bandwidth,
+				InternetChargeType: &internetChargeType,
+			}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig)).To(BeEmpty())
+		})
+
+		It("should allow an existing NAT gateway with existing SNAT entries", func() {
+			infrastructureConfig.Networks.VPC = apisalicloud.VPC{
+				ID:                  &vpcID,
+				NATGatewayID:        &natGatewayID,
+				ExistingSNATEntries: true,
+			}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig)).To(BeEmpty())
+		})
+
+		It("should forbid an existing NAT gateway and SNAT entries for a new vpc", func() {
+			infrastructureConfig.Networks.VPC.NATGatewayID = &natGatewayID
+			infrastructureConfig.Networks.VPC.ExistingSNATEntries = true
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.vpc.natGatewayID"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.vpc.existingSNATEntries"),
+				})),
+			))
+		})
+
+		It("should forbid specifying both vpc id and cidr", func() {
+			infrastructureConfig.Networks.VPC.ID = &vpcID
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.vpc"),
+			}))))
+		})
+
+		It("should forbid invalid zones", func() {
+			var eipCount int32
+			infrastructureConfig.Networks.Zones = []apisalicloud.Zone{{EIPCount: &eipCount}}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.zones[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.zones[0].worker"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[0].eipCount"),
+				})),
+			))
+		})
+
+		It("should forbid invalid EIP settings", func() {
+			var (
+				bandwidth          int32 = 1000
+				internetChargeType       = "foo"
+			)
+			infrastructureConfig.Networks.EIP = &apisalicloud.EIP{
+				Bandwidth:          &bandwidth,
+				InternetChargeType: &internetChargeType,
+			}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.eip.bandwidth"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.eip.internetChargeType"),
+				})),
+			))
+		})
+	})
+})
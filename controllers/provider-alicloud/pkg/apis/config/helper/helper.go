@@ -18,6 +18,10 @@ import (
 	"fmt"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/config"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 // FindImageForRegion takes a list of machine images, and the desired image name, version and region. It tries
@@ -38,3 +42,49 @@ func FindImageForRegion(machineImages []config.MachineImage, imageName, version,
 
 	return "", fmt.Errorf("could not find an image for region %q and name %q in version %q", regionID, imageName, version)
 }
+
+// GetControlPlaneComponentChartValues returns the chart values for the vertical pod autoscaling, PodDisruptionBudget
+// and pod anti-affinity settings of the control plane components deployed by the extension.
+func GetControlPlaneComponentChartValues(controlPlaneComponents config.ControlPlaneComponents) map[string]interface{} {
+	var (
+		vpaEnabled    *bool
+		vpaUpdateMode *string
+	)
+
+	if vpa := controlPlaneComponents.VPA; vpa != nil {
+		vpaEnabled = vpa.Enabled
+		vpaUpdateMode = vpa.UpdateMode
+	}
+
+	return extensionscontroller.GetControlPlaneComponentChartValues(vpaEnabled, vpaUpdateMode, controlPlaneComponents.PodDisruptionBudget, controlPlaneComponents.PodAntiAffinity)
+}
+
+// GetControlPlaneComponentReplicas returns the number of replicas of the control plane components deployed by the extension.
+func GetControlPlaneComponentReplicas(controlPlaneComponents config.ControlPlaneComponents) int {
+	return extensionscontroller.GetControlPlaneComponentReplicas(controlPlaneComponents.Replicas)
+}
+
+// ValidateControlPlaneComponents validates the configuration of the control plane components deployed by the extension.
+func ValidateControlPlaneComponents(controlPlaneComponents config.ControlPlaneComponents, fldPath *field.Path) field.ErrorList {
+	var vpaUpdateMode *string
+	if vpa := controlPlaneComponents.VPA; vpa != nil {
+		vpaUpdateMode = vpa.UpdateMode
+	}
+
+	return extensionscontroller.ValidateControlPlaneComponents(vpaUpdateMode, controlPlaneComponents.Replicas, fldPath)
+}
+
+// GetBackupRestoreConfig returns the backup-restore configuration for the given etcd backup configuration of the controller.
+func GetBackupRestoreConfig(etcdBackup *config.ETCDBackup) *controlplane.BackupRestoreConfig {
+	if etcdBackup == nil {
+		return nil
+	}
+	return &controlplane.BackupRestoreConfig{
+		DeltaSnapshotPeriod:      etcdBackup.DeltaSnapshotPeriod,
+		DeltaSnapshotMemoryLimit: etcdBackup.DeltaSnapshotMemoryLimit,
+		GarbageCollectionPeriod:  etcdBackup.GarbageCollectionPeriod,
+		GarbageCollectionPolicy:  etcdBackup.GarbageCollectionPolicy,
+		MaxBackups:               etcdBackup.MaxBackups,
+		Resources:                etcdBackup.Resources,
+	}
+}
@@ -21,10 +21,48 @@ limitations under the License.
 package config
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	componentbaseconfig "k8s.io/component-base/config"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneComponents) DeepCopyInto(out *ControlPlaneComponents) {
+	*out = *in
+	if in.VPA != nil {
+		in, out := &in.VPA, &out.VPA
+		*out = new(VPA)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PodAntiAffinity != nil {
+		in, out := &in.PodAntiAffinity, &out.PodAntiAffinity
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneComponents.
+func (in *ControlPlaneComponents) DeepCopy() *ControlPlaneComponents {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneComponents)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 	*out = *in
@@ -42,6 +80,12 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 		}
 	}
 	in.ETCD.DeepCopyInto(&out.ETCD)
+	in.ControlPlaneComponents.DeepCopyInto(&out.ControlPlaneComponents)
+	if in.BackupBucket != nil {
+		in, out := &in.BackupBucket, &out.BackupBucket
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -89,6 +133,36 @@ func (in *ETCDBackup) DeepCopyInto(out *ETCDBackup) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.DeltaSnapshotPeriod != nil {
+		in, out := &in.DeltaSnapshotPeriod, &out.DeltaSnapshotPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DeltaSnapshotMemoryLimit != nil {
+		in, out := &in.DeltaSnapshotMemoryLimit, &out.DeltaSnapshotMemoryLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.GarbageCollectionPeriod != nil {
+		in, out := &in.GarbageCollectionPeriod, &out.GarbageCollectionPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.GarbageCollectionPolicy != nil {
+		in, out := &in.GarbageCollectionPolicy, &out.GarbageCollectionPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxBackups != nil {
+		in, out := &in.MaxBackups, &out.MaxBackups
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -164,3 +238,29 @@ func (in *RegionImageMapping) DeepCopy() *RegionImageMapping {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPA) DeepCopyInto(out *VPA) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UpdateMode != nil {
+		in, out := &in.UpdateMode, &out.UpdateMode
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPA.
+func (in *VPA) DeepCopy() *VPA {
+	if in == nil {
+		return nil
+	}
+	out := new(VPA)
+	in.DeepCopyInto(out)
+	return out
+}
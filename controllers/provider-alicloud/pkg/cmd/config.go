@@ -18,9 +18,12 @@ import (
 	"fmt"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/config"
+	confighelper "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/config/helper"
 	configloader "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/config/loader"
 
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 // ConfigOptions are command line options that can be set for config.ControllerConfiguration.
@@ -51,6 +54,10 @@ func (c *ConfigOptions) Complete() error {
 		return err
 	}
 
+	if errs := confighelper.ValidateControlPlaneComponents(config.ControlPlaneComponents, field.NewPath("controlPlaneComponents")); len(errs) > 0 {
+		return fmt.Errorf("invalid control plane component configuration: %v", errs.ToAggregate())
+	}
+
 	c.config = &Config{config}
 	return nil
 }
@@ -85,6 +92,16 @@ func (c *Config) ApplyETCDBackup(etcdBackup *config.ETCDBackup) {
 	*etcdBackup = c.Config.ETCD.Backup
 }
 
+// ApplyControlPlaneComponents sets the given control plane component configuration to that of this Config.
+func (c *Config) ApplyControlPlaneComponents(controlPlaneComponents *config.ControlPlaneComponents) {
+	*controlPlaneComponents = c.Config.ControlPlaneComponents
+}
+
+// ApplyBackupBucketConfig sets the given backup bucket configuration to that of this Config.
+func (c *Config) ApplyBackupBucketConfig(backupBucketConfig **runtime.RawExtension) {
+	*backupBucketConfig = c.Config.BackupBucket
+}
+
 // Options initializes empty config.ControllerConfiguration, applies the set values and returns it.
 func (c *Config) Options() config.ControllerConfiguration {
 	var cfg config.ControllerConfiguration
@@ -18,23 +18,28 @@ import (
 	"context"
 
 	alicloudclient "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/alicloud/client"
-	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket"
+	apisalicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
+	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud/validation"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket/genericactuator"
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
 
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
 )
 
 type actuator struct {
-	backupbucket.Actuator
-	client client.Client
-	logger logr.Logger
+	bbConfig *apisalicloud.BackupBucketConfig
+	client   client.Client
+	logger   logr.Logger
 }
 
-func newActuator() backupbucket.Actuator {
+func newActuator(bbConfig *apisalicloud.BackupBucketConfig) genericactuator.BackupBucketDelegate {
 	return &actuator{
-		logger: log.Log.WithName("alicloud-backupbucket-actuator"),
+		bbConfig: bbConfig,
+		logger:   logger,
 	}
 }
 
@@ -43,20 +48,57 @@ func (a *actuator) InjectClient(client client.Client) error {
 	return nil
 }
 
-func (a *actuator) Reconcile(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+func (a *actuator) GetObjectStore(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) (objectstore.ObjectStore, error) {
+	return alicloudclient.NewObjectStoreFromSecretRef(ctx, a.client, bb.Spec.SecretRef, bb.Spec.Region)
+}
+
+func (a *actuator) ReconcileBucketConfig(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
 	alicloudClient, err := alicloudclient.NewStorageClientFromSecretRef(ctx, a.client, &bb.Spec.SecretRef, bb.Spec.Region)
 	if err != nil {
 		return err
 	}
 
-	return alicloudClient.CreateBucketIfNotExists(ctx, bb.Name)
+	return reconcileBucketConfig(ctx, alicloudClient, bb.Name, a.bbConfig)
 }
 
-func (a *actuator) Delete(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
-	alicloudClient, err := alicloudclient.NewStorageClientFromSecretRef(ctx, a.client, &bb.Spec.SecretRef, bb.Spec.Region)
-	if err != nil {
-		return err
+// decodeBackupBucketConfig decodes and validates the given BackupBucketConfig of the controller configuration.
+func decodeBackupBucketConfig(decoder runtime.Decoder, backupBucketConfig *runtime.RawExtension) (*apisalicloud.BackupBucketConfig, error) {
+	bbConfig := &apisalicloud.BackupBucketConfig{}
+	if backupBucketConfig == nil {
+		return bbConfig, nil
 	}
 
-	return alicloudClient.DeleteBucketIfExists(ctx, bb.Name)
+	if _, _, err := decoder.Decode(backupBucketConfig.Raw, nil, bbConfig); err != nil {
+		return nil, errors.Wrap(err, "could not decode the backup bucket configuration")
+	}
+	if errs := validation.ValidateBackupBucketConfig(bbConfig); len(errs) > 0 {
+		return nil, errors.Wrap(errs.ToAggregate(), "invalid backup bucket configuration")
+	}
+
+	return bbConfig, nil
+}
+
+// reconcileBucketConfig applies the settings of the given BackupBucketConfig to the bucket. The settings are applied
+// on every reconciliation to revert any changes that were made to the bucket outside of Gardener. Settings that are
+// not configured are left untouched.
+func reconcileBucketConfig(ctx context.Context, alicloudClient alicloudclient.Storage, bucketName string, bbConfig *apisalicloud.BackupBucketConfig) error {
+	if bbConfig.Versioning != nil {
+		if err := alicloudClient.UpdateBucketVersioning(ctx, bucketName, *bbConfig.Versioning); err != nil {
+			return errors.Wrapf(err, "could not update versioning of bucket '%s'", bucketName)
+		}
+	}
+
+	if encryption := bbConfig.Encryption; encryption != nil {
+		if err := alicloudClient.UpdateBucketEncryption(ctx, bucketName, encryption.KMSKeyID); err != nil {
+			return errors.Wrapf(err, "could not update encryption of bucket '%s'", bucketName)
+		}
+	}
+
+	if lifecycle := bbConfig.Lifecycle; lifecycle != nil {
+		if err := alicloudClient.UpdateBucketLifecycle(ctx, bucketName, int(lifecycle.ExpirationDays)); err != nil {
+			return errors.Wrapf(err, "could not update lifecycle of bucket '%s'", bucketName)
+		}
+	}
+
+	return nil
 }
@@ -17,6 +17,7 @@ package backupentry
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/alicloud"
 	alicloudclient "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/alicloud/client"
@@ -56,3 +57,12 @@ func (a *actuator) Delete(ctx context.Context, be *extensionsv1alpha1.BackupEntr
 
 	return cli.DeleteObjectsWithPrefix(ctx, be.Spec.BucketName, fmt.Sprintf("%s/", be.Name))
 }
+
+func (a *actuator) ListObjects(ctx context.Context, be *extensionsv1alpha1.BackupEntry, prefix string) (map[string]time.Time, error) {
+	cli, err := alicloudclient.NewStorageClientFromSecretRef(ctx, a.client, &be.Spec.SecretRef, be.Spec.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	return cli.ListObjectsWithPrefix(ctx, be.Spec.BucketName, fmt.Sprintf("%s/%s", be.Name, prefix))
+}
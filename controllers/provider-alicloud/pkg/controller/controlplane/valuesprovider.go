@@ -19,16 +19,20 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"path/filepath"
+	"strconv"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/alicloud"
 	apisalicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
 	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud/helper"
+	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/config"
+	confighelper "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/config/helper"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/controlplane/genericactuator"
 	"github.com/gardener/gardener-extensions/pkg/util"
 
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/chart"
 	"github.com/gardener/gardener/pkg/utils/secrets"
 	"github.com/go-logr/logr"
@@ -130,6 +134,8 @@ var controlPlaneChart = &chart.Chart{
 			Objects: []*chart.Object{
 				{Type: &corev1.Service{}, Name: "cloud-controller-manager"},
 				{Type: &appsv1.Deployment{}, Name: "cloud-controller-manager"},
+				{Type: extensionscontroller.GetVerticalPodAutoscalerObject(), Name: "cloud-controller-manager-vpa"},
+				{Type: &policyv1beta1.PodDisruptionBudget{}, Name: "cloud-controller-manager"},
 				{Type: &corev1.ConfigMap{}, Name: "cloud-controller-manager-monitoring-config"},
 			},
 		},
@@ -138,6 +144,8 @@ var controlPlaneChart = &chart.Chart{
 			Images: []string{alicloud.CSIAttacherImageName, alicloud.CSIProvisionerImageName, alicloud.CSISnapshotterImageName, alicloud.CSIPluginImageName},
 			Objects: []*chart.Object{
 				{Type: &appsv1.Deployment{}, Name: "csi-plugin-controller"},
+				{Type: extensionscontroller.GetVerticalPodAutoscalerObject(), Name: "csi-plugin-controller-vpa"},
+				{Type: &policyv1beta1.PodDisruptionBudget{}, Name: "csi-plugin-controller"},
 			},
 		},
 	},
@@ -186,18 +194,20 @@ var storageClassChart = &chart.Chart{
 }
 
 // NewValuesProvider creates a new ValuesProvider for the generic actuator.
-func NewValuesProvider(logger logr.Logger) genericactuator.ValuesProvider {
+func NewValuesProvider(controlPlaneComponents config.ControlPlaneComponents, logger logr.Logger) genericactuator.ValuesProvider {
 	return &valuesProvider{
-		logger: logger.WithName("alicloud-values-provider"),
+		controlPlaneComponents: controlPlaneComponents,
+		logger:                 logger.WithName("alicloud-values-provider"),
 	}
 }
 
 // valuesProvider is a ValuesProvider that provides AWS-specific values for the 2 charts applied by the generic actuator.
 type valuesProvider struct {
 	genericactuator.NoopValuesProvider
-	decoder runtime.Decoder
-	client  client.Client
-	logger  logr.Logger
+	controlPlaneComponents config.ControlPlaneComponents
+	decoder                runtime.Decoder
+	client                 client.Client
+	logger                 logr.Logger
 }
 
 // InjectScheme injects the given scheme into the valuesProvider.
@@ -255,7 +265,20 @@ func (vp *valuesProvider) GetControlPlaneChartValues(
 	}
 
 	// Get control plane chart values
-	return getControlPlaneChartValues(cpConfig, cp, cluster, checksums, scaledDown)
+	values, err := getControlPlaneChartValues(cpConfig, cp, cluster, checksums, scaledDown, confighelper.GetControlPlaneComponentReplicas(vp.controlPlaneComponents))
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply the vertical pod autoscaling and high availability settings to all control plane components
+	componentValues := confighelper.GetControlPlaneComponentChartValues(vp.controlPlaneComponents)
+	for _, subChart := range controlPlaneChart.SubCharts {
+		if subChartValues, ok := values[subChart.Name].(map[string]interface{}); ok {
+			values[subChart.Name] = utils.MergeMaps(subChartValues, componentValues)
+		}
+	}
+
+	return values, nil
 }
 
 // GetControlPlaneShootChartValues returns the values for the control plane shoot chart applied by the generic actuator.
@@ -274,6 +297,22 @@ func (vp *valuesProvider) GetControlPlaneShootChartValues(
 	return getControlPlaneShootChartValues(cluster, credentials)
 }
 
+// GetStorageClassesChartValues returns the values for the storage classes chart applied by the generic actuator.
+func (vp *valuesProvider) GetStorageClassesChartValues(
+	ctx context.Context,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+) (map[string]interface{}, error) {
+	// Decode providerConfig
+	cpConfig := &apisalicloud.ControlPlaneConfig{}
+	if _, _, err := vp.decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, cpConfig); err != nil {
+		return nil, errors.Wrapf(err, "could not decode providerConfig of controlplane '%s'", util.ObjectName(cp))
+	}
+
+	// Get storage classes chart values
+	return getStorageClassesChartValues(cpConfig), nil
+}
+
 // cloudConfig wraps the settings for the Alicloud provider.
 // See https://github.com/kubernetes/cloud-provider-alibaba-cloud/blob/master/cloud-controller-manager/alicloud.go
 type cloudConfig struct {
@@ -331,10 +370,11 @@ func getControlPlaneChartValues(
 	cluster *extensionscontroller.Cluster,
 	checksums map[string]string,
 	scaledDown bool,
+	replicas int,
 ) (map[string]interface{}, error) {
 	values := map[string]interface{}{
 		"alicloud-cloud-controller-manager": map[string]interface{}{
-			"replicas":          extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
+			"replicas":          extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, replicas),
 			"clusterName":       cp.Namespace,
 			"kubernetesVersion": extensionscontroller.GetKubernetesVersion(cluster),
 			"podNetwork":        extensionscontroller.GetPodNetwork(cluster),
@@ -345,7 +385,7 @@ func getControlPlaneChartValues(
 			},
 		},
 		"csi-alicloud": map[string]interface{}{
-			"replicas":          extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
+			"replicas":          extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, replicas),
 			"kubernetesVersion": extensionscontroller.GetKubernetesVersion(cluster),
 			"regionID":          cp.Spec.Region,
 			"podAnnotations": map[string]interface{}{
@@ -357,8 +397,18 @@ func getControlPlaneChartValues(
 		},
 	}
 
-	if cpConfig.CloudControllerManager != nil {
-		values["alicloud-cloud-controller-manager"].(map[string]interface{})["featureGates"] = cpConfig.CloudControllerManager.FeatureGates
+	if ccmConfig := cpConfig.CloudControllerManager; ccmConfig != nil {
+		ccmValues := values["alicloud-cloud-controller-manager"].(map[string]interface{})
+		ccmValues["featureGates"] = ccmConfig.FeatureGates
+		if ccmConfig.ConcurrentServiceSyncs != nil {
+			ccmValues["concurrentServiceSyncs"] = *ccmConfig.ConcurrentServiceSyncs
+		}
+		if ccmConfig.NodeMonitorPeriod != nil {
+			ccmValues["nodeMonitorPeriod"] = ccmConfig.NodeMonitorPeriod.Duration.String()
+		}
+		if ccmConfig.Verbosity != nil {
+			ccmValues["verbosity"] = *ccmConfig.Verbosity
+		}
 	}
 
 	return values, nil
@@ -381,3 +431,53 @@ func getControlPlaneShootChartValues(
 
 	return values, nil
 }
+
+// getStorageClassesChartValues collects and returns the storage classes chart values.
+func getStorageClassesChartValues(cpConfig *apisalicloud.ControlPlaneConfig) map[string]interface{} {
+	storageClasses, customDefaultStorageClass := getStorageClasses(cpConfig.StorageClasses)
+
+	return map[string]interface{}{
+		"storageClasses":            storageClasses,
+		"customDefaultStorageClass": customDefaultStorageClass,
+	}
+}
+
+// getStorageClasses collects and returns the chart values for the given additional storage classes. It also
+// returns whether one of them shall be the default storage class of the shoot cluster.
+func getStorageClasses(storageClasses []apisalicloud.StorageClass) ([]map[string]interface{}, bool) {
+	var (
+		values     = make([]map[string]interface{}, 0, len(storageClasses))
+		hasDefault bool
+	)
+
+	for _, sc := range storageClasses {
+		parameters := map[string]interface{}{
+			"csi.storage.k8s.io/fstype": "ext4",
+			"type":                      sc.Type,
+			"readOnly":                  "false",
+		}
+		if sc.Encrypted != nil {
+			parameters["encrypted"] = strconv.FormatBool(*sc.Encrypted)
+		}
+
+		isDefault := sc.Default != nil && *sc.Default
+		if isDefault {
+			hasDefault = true
+		}
+
+		value := map[string]interface{}{
+			"name":       sc.Name,
+			"default":    isDefault,
+			"parameters": parameters,
+		}
+		if sc.ReclaimPolicy != nil {
+			value["reclaimPolicy"] = string(*sc.ReclaimPolicy)
+		}
+		if sc.VolumeBindingMode != nil {
+			value["volumeBindingMode"] = string(*sc.VolumeBindingMode)
+		}
+		values = append(values, value)
+	}
+
+	return values, hasDefault
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/alicloud"
 	apisalicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
+	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/config"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
 
@@ -49,6 +50,8 @@ var _ = Describe("ValuesProvider", func() {
 		scheme = runtime.NewScheme()
 		_      = apisalicloud.AddToScheme(scheme)
 
+		verbosity int32 = 4
+
 		cp = &extensionsv1alpha1.ControlPlane{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "control-plane",
@@ -67,6 +70,7 @@ var _ = Describe("ValuesProvider", func() {
 							FeatureGates: map[string]bool{
 								"CustomResourceValidation": true,
 							},
+							Verbosity: &verbosity,
 						},
 					}),
 				},
@@ -127,6 +131,16 @@ var _ = Describe("ValuesProvider", func() {
 			"cloudConfig": `{"Global":{"KubernetesClusterTag":"test","uid":"","vpcid":"vpc-1234","region":"eu-central-1","zoneid":"eu-central-1a","vswitchid":"vswitch-acbd1234","accessKeyID":"Zm9v","accessKeySecret":"YmFy"}}`,
 		}
 
+		vpaChartValues = map[string]interface{}{
+			"enabled": true,
+			"updatePolicy": map[string]interface{}{
+				"updateMode": "Auto",
+			},
+		}
+		disabledChartValues = map[string]interface{}{
+			"enabled": false,
+		}
+
 		controlPlaneChartValues = map[string]interface{}{
 			"alicloud-cloud-controller-manager": map[string]interface{}{
 				"replicas":          1,
@@ -141,6 +155,10 @@ var _ = Describe("ValuesProvider", func() {
 				"featureGates": map[string]bool{
 					"CustomResourceValidation": true,
 				},
+				"verbosity":           verbosity,
+				"vpa":                 vpaChartValues,
+				"podDisruptionBudget": disabledChartValues,
+				"podAntiAffinity":     disabledChartValues,
 			},
 			"csi-alicloud": map[string]interface{}{
 				"replicas":          1,
@@ -152,6 +170,9 @@ var _ = Describe("ValuesProvider", func() {
 					"checksum/secret-csi-snapshotter": "bf417dd97dc3e8c2092bb5b2ba7b0f1093ebc4bb5952091ee554cf5b7ea74508",
 					"checksum/secret-cloudprovider":   "8bafb35ff1ac60275d62e1cbd495aceb511fb354f74a20f7d06ecb48b3a68432",
 				},
+				"vpa":                 vpaChartValues,
+				"podDisruptionBudget": disabledChartValues,
+				"podAntiAffinity":     disabledChartValues,
 			},
 		}
 
@@ -183,7 +204,7 @@ var _ = Describe("ValuesProvider", func() {
 			client.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
 
 			// Create valuesProvider
-			vp := NewValuesProvider(logger)
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
 			err := vp.(inject.Scheme).InjectScheme(scheme)
 			Expect(err).NotTo(HaveOccurred())
 			err = vp.(inject.Client).InjectClient(client)
@@ -199,7 +220,7 @@ var _ = Describe("ValuesProvider", func() {
 	Describe("#GetControlPlaneChartValues", func() {
 		It("should return correct control plane chart values", func() {
 			// Create valuesProvider
-			vp := NewValuesProvider(logger)
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
 			err := vp.(inject.Scheme).InjectScheme(scheme)
 			Expect(err).NotTo(HaveOccurred())
 
@@ -217,7 +238,7 @@ var _ = Describe("ValuesProvider", func() {
 			client.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
 
 			// Create valuesProvider
-			vp := NewValuesProvider(logger)
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
 			err := vp.(inject.Client).InjectClient(client)
 			Expect(err).NotTo(HaveOccurred())
 
@@ -227,6 +248,56 @@ var _ = Describe("ValuesProvider", func() {
 			Expect(values).To(Equal(controlPlaneShootChartValues))
 		})
 	})
+
+	Describe("#GetStorageClassesChartValues", func() {
+		It("should return correct storage classes chart values", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetStorageClassesChartValues method and check the result
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{"storageClasses": []map[string]interface{}{}, "customDefaultStorageClass": false}))
+		})
+
+		It("should return correct storage classes chart values for additional storage classes", func() {
+			var (
+				encrypted = true
+				retain    = corev1.PersistentVolumeReclaimRetain
+				isDefault = true
+			)
+			cpWithStorageClasses := cp.DeepCopy()
+			cpWithStorageClasses.Spec.ProviderConfig = &runtime.RawExtension{
+				Raw: encode(&apisalicloud.ControlPlaneConfig{
+					StorageClasses: []apisalicloud.StorageClass{
+						{Name: "fast", Type: "cloud_essd", Encrypted: &encrypted, ReclaimPolicy: &retain, Default: &isDefault},
+					},
+				}),
+			}
+
+			// Create valuesProvider
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetStorageClassesChartValues method and check the result
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), cpWithStorageClasses, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"storageClasses": []map[string]interface{}{
+					{
+						"name":          "fast",
+						"default":       true,
+						"reclaimPolicy": "Retain",
+						"parameters":    map[string]interface{}{"csi.storage.k8s.io/fstype": "ext4", "type": "cloud_essd", "readOnly": "false", "encrypted": "true"},
+					},
+				},
+				"customDefaultStorageClass": true,
+			}))
+		})
+	})
 })
 
 func encode(obj runtime.Object) []byte {
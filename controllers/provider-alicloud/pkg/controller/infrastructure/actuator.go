@@ -169,7 +169,7 @@ func (a *actuator) getInitializerValues(
 
 	vpcID := *config.Networks.VPC.ID
 
-	vpcInfo, err := GetVPCInfo(vpcClient, vpcID)
+	vpcInfo, err := GetVPCInfo(vpcClient, vpcID, config.Networks.VPC.NATGatewayID)
 	if err != nil {
 		return nil, err
 	}
@@ -206,6 +206,9 @@ func (a *actuator) extractStatus(tf extensionsterraformer.Interface, infraConfig
 
 	for zoneIndex := range infraConfig.Networks.Zones {
 		outputVarKeys = append(outputVarKeys, fmt.Sprintf("%s%d", TerraformerOutputKeyVSwitchNodesPrefix, zoneIndex))
+		if !infraConfig.Networks.VPC.ExistingSNATEntries {
+			outputVarKeys = append(outputVarKeys, fmt.Sprintf("%s%d", TerraformerOutputKeySNATIPsPrefix, zoneIndex))
+		}
 	}
 
 	vars, err := tf.GetStateOutputVariables(outputVarKeys...)
@@ -256,11 +259,19 @@ func computeProviderStatusVSwitches(infrastructure *alicloudv1alpha1.Infrastruct
 		if err != nil {
 			return nil, err
 		}
-		vswitchesToReturn = append(vswitchesToReturn, alicloudv1alpha1.VSwitch{
+		if zoneID < 0 || zoneID >= len(infrastructure.Networks.Zones) {
+			return nil, fmt.Errorf("no zone found for output variable %q", key)
+		}
+
+		vswitch := alicloudv1alpha1.VSwitch{
 			ID:      value,
 			Purpose: purpose,
 			Zone:    infrastructure.Networks.Zones[zoneID].Name,
-		})
+		}
+		if snatIPs, ok := values[fmt.Sprintf("%s%d", TerraformerOutputKeySNATIPsPrefix, zoneID)]; ok && len(snatIPs) > 0 {
+			vswitch.SNATIPs = strings.Split(snatIPs, ",")
+		}
+		vswitchesToReturn = append(vswitchesToReturn, vswitch)
 	}
 
 	return vswitchesToReturn, nil
@@ -23,8 +23,9 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 )
 
-// GetVPCInfo gets info of an existing VPC.
-func GetVPCInfo(vpcClient alicloudclient.VPC, vpcID string) (*VPCInfo, error) {
+// GetVPCInfo gets info of an existing VPC. If natGatewayID is given, the info of this NAT gateway is used instead of
+// the only NAT gateway of the VPC.
+func GetVPCInfo(vpcClient alicloudclient.VPC, vpcID string, natGatewayID *string) (*VPCInfo, error) {
 	describeVPCsReq := vpc.CreateDescribeVpcsRequest()
 	describeVPCsReq.VpcId = vpcID
 	describeVPCsRes, err := vpcClient.DescribeVpcs(describeVPCsReq)
@@ -40,6 +41,9 @@ func GetVPCInfo(vpcClient alicloudclient.VPC, vpcID string) (*VPCInfo, error) {
 
 	describeNATGatewaysReq := vpc.CreateDescribeNatGatewaysRequest()
 	describeNATGatewaysReq.VpcId = vpcID
+	if natGatewayID != nil {
+		describeNATGatewaysReq.NatGatewayId = *natGatewayID
+	}
 	describeNatGatewaysRes, err := vpcClient.DescribeNatGateways(describeNATGatewaysReq)
 	if err != nil {
 		return nil, err
@@ -50,17 +54,16 @@ func GetVPCInfo(vpcClient alicloudclient.VPC, vpcID string) (*VPCInfo, error) {
 	}
 
 	natGateway := describeNatGatewaysRes.NatGateways.NatGateway[0]
-	natGatewayID := natGateway.NatGatewayId
 	sNATTableIDs := strings.Join(natGateway.SnatTableIds.SnatTableId, ",")
 
-	internetChargeType, err := FetchEIPInternetChargeType(vpcClient, vpcID)
+	internetChargeType, err := fetchNATGatewayEIPInternetChargeType(vpcClient, natGateway)
 	if err != nil {
 		return nil, err
 	}
 
 	return &VPCInfo{
 		CIDR:               vpcCIDR,
-		NATGatewayID:       natGatewayID,
+		NATGatewayID:       natGateway.NatGatewayId,
 		SNATTableIDs:       sNATTableIDs,
 		InternetChargeType: internetChargeType,
 	}, nil
@@ -80,8 +83,10 @@ func FetchEIPInternetChargeType(vpcClient alicloudclient.VPC, vpcID string) (str
 		return alicloudclient.DefaultInternetChargeType, nil
 	}
 
-	natGateway := describeNatGatewaysRes.NatGateways.NatGateway[0]
+	return fetchNATGatewayEIPInternetChargeType(vpcClient, describeNatGatewaysRes.NatGateways.NatGateway[0])
+}
 
+func fetchNATGatewayEIPInternetChargeType(vpcClient alicloudclient.VPC, natGateway vpc.NatGateway) (string, error) {
 	if len(natGateway.IpLists.IpList) == 0 {
 		return alicloudclient.DefaultInternetChargeType, nil
 	}
@@ -75,10 +75,10 @@ var _ = Describe("Client", func() {
 							},
 						},
 					},
-				}, nil).Times(2),
+				}, nil),
 			)
 
-			info, err := GetVPCInfo(client, vpcID)
+			info, err := GetVPCInfo(client, vpcID, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(info).To(Equal(&VPCInfo{
 				CIDR:               vpcCIDR,
@@ -87,5 +87,54 @@ var _ = Describe("Client", func() {
 				InternetChargeType: alicloudclient.DefaultInternetChargeType,
 			}))
 		})
+
+		It("should get info about the specified NAT gateway of the VPC", func() {
+			var (
+				client       = mockclient.NewMockVPC(ctrl)
+				vpcID        = "vpcID"
+				vpcCIDR      = "vpcCIDR"
+				natGatewayID = "natGatewayID"
+				sNATTableID  = "sNATTableID"
+			)
+
+			describeVPCsReq := vpc.CreateDescribeVpcsRequest()
+			describeVPCsReq.VpcId = vpcID
+
+			describeNATGatewaysReq := vpc.CreateDescribeNatGatewaysRequest()
+			describeNATGatewaysReq.VpcId = vpcID
+			describeNATGatewaysReq.NatGatewayId = natGatewayID
+
+			gomock.InOrder(
+				client.EXPECT().DescribeVpcs(describeVPCsReq).Return(&vpc.DescribeVpcsResponse{
+					Vpcs: vpc.Vpcs{
+						Vpc: []vpc.Vpc{
+							{CidrBlock: vpcCIDR},
+						},
+					},
+				}, nil),
+
+				client.EXPECT().DescribeNatGateways(describeNATGatewaysReq).Return(&vpc.DescribeNatGatewaysResponse{
+					NatGateways: vpc.NatGateways{
+						NatGateway: []vpc.NatGateway{
+							{
+								NatGatewayId: natGatewayID,
+								SnatTableIds: vpc.SnatTableIdsInDescribeNatGateways{
+									SnatTableId: []string{sNATTableID},
+								},
+							},
+						},
+					},
+				}, nil),
+			)
+
+			info, err := GetVPCInfo(client, vpcID, &natGatewayID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info).To(Equal(&VPCInfo{
+				CIDR:               vpcCIDR,
+				NATGatewayID:       natGatewayID,
+				SNATTableIDs:       sNATTableID,
+				InternetChargeType: alicloudclient.DefaultInternetChargeType,
+			}))
+		})
 	})
 })
@@ -34,7 +34,7 @@ func (terraformOps) ComputeCreateVPCInitializerValues(config *v1alpha1.Infrastru
 		VPCCIDR:            string(*config.Networks.VPC.CIDR),
 		NATGatewayID:       TerraformDefaultNATGatewayID,
 		SNATTableIDs:       TerraformDefaultSNATTableIDs,
-		InternetChargeType: internetChargeType,
+		InternetChargeType: eipInternetChargeType(config, internetChargeType),
 	}
 }
 
@@ -46,10 +46,19 @@ func (terraformOps) ComputeUseVPCInitializerValues(config *v1alpha1.Infrastructu
 		VPCCIDR:            info.CIDR,
 		NATGatewayID:       info.NATGatewayID,
 		SNATTableIDs:       info.SNATTableIDs,
-		InternetChargeType: info.InternetChargeType,
+		InternetChargeType: eipInternetChargeType(config, info.InternetChargeType),
 	}
 }
 
+// eipInternetChargeType returns the explicitly configured internet charge type of the EIPs or
+// the given discovered one otherwise.
+func eipInternetChargeType(config *v1alpha1.InfrastructureConfig, discovered string) string {
+	if config.Networks.EIP != nil && config.Networks.EIP.InternetChargeType != nil {
+		return *config.Networks.EIP.InternetChargeType
+	}
+	return discovered
+}
+
 // ComputeTerraformerChartValues computes the values necessary for the infrastructure Terraform chart.
 func (terraformOps) ComputeChartValues(
 	infra *extensionsv1alpha1.Infrastructure,
@@ -58,20 +67,32 @@ func (terraformOps) ComputeChartValues(
 ) map[string]interface{} {
 	zones := make([]map[string]interface{}, 0, len(config.Networks.Zones))
 	for _, zone := range config.Networks.Zones {
+		eipCount := DefaultEIPCount
+		if zone.EIPCount != nil {
+			eipCount = *zone.EIPCount
+		}
+
 		zones = append(zones, map[string]interface{}{
 			"name": zone.Name,
 			"cidr": map[string]interface{}{
 				"worker": string(zone.Worker),
 			},
+			"eipCount": eipCount,
 		})
 	}
 
+	eipBandwidth := DefaultEIPBandwidth
+	if config.Networks.EIP != nil && config.Networks.EIP.Bandwidth != nil {
+		eipBandwidth = *config.Networks.EIP.Bandwidth
+	}
+
 	return map[string]interface{}{
 		"alicloud": map[string]interface{}{
 			"region": infra.Spec.Region,
 		},
 		"create": map[string]interface{}{
-			"vpc": values.CreateVPC,
+			"vpc":  values.CreateVPC,
+			"snat": !config.Networks.VPC.ExistingSNATEntries,
 		},
 		"vpc": map[string]interface{}{
 			"cidr":               values.VPCCIDR,
@@ -79,6 +100,7 @@ func (terraformOps) ComputeChartValues(
 			"natGatewayID":       values.NATGatewayID,
 			"snatTableID":        values.SNATTableIDs,
 			"internetChargeType": values.InternetChargeType,
+			"eipBandwidth":       eipBandwidth,
 		},
 		"clusterName":  infra.Namespace,
 		"sshPublicKey": string(infra.Spec.SSHPublicKey),
@@ -89,6 +111,7 @@ func (terraformOps) ComputeChartValues(
 			"securityGroupID":    TerraformerOutputKeySecurityGroupID,
 			"keyPairName":        TerraformerOutputKeyKeyPairName,
 			"vswitchNodesPrefix": TerraformerOutputKeyVSwitchNodesPrefix,
+			"snatIPsPrefix":      TerraformerOutputKeySNATIPsPrefix,
 		},
 	}
 }
@@ -92,6 +92,27 @@ var _ = Describe("TerraformChartOps", func() {
 		})
 	})
 
+	Describe("#ComputeCreateVPCInitializerValues with EIP configuration", func() {
+		It("should prefer the configured internet charge type", func() {
+			var (
+				cidr               = "192.168.0.0/16"
+				internetChargeType = "PayByBandwidth"
+				config             = v1alpha1.InfrastructureConfig{
+					Networks: v1alpha1.Networks{
+						VPC: v1alpha1.VPC{
+							CIDR: &cidr,
+						},
+						EIP: &v1alpha1.EIP{
+							InternetChargeType: &internetChargeType,
+						},
+					},
+				}
+			)
+
+			Expect(ops.ComputeCreateVPCInitializerValues(&config, "PayByTraffic").InternetChargeType).To(Equal(internetChargeType))
+		})
+	})
+
 	Describe("#ComputeTerraformerChartValues", func() {
 		It("should compute the terraformer chart values", func() {
 			var (
@@ -112,8 +133,9 @@ var _ = Describe("TerraformChartOps", func() {
 				zone1Name   = "zone1"
 				zone1Worker = "192.168.0.0/16"
 
-				zone2Name   = "zone2"
-				zone2Worker = "192.169.0.0/16"
+				zone2Name         = "zone2"
+				zone2Worker       = "192.169.0.0/16"
+				eipCount    int32 = 3
 
 				config = v1alpha1.InfrastructureConfig{
 					Networks: v1alpha1.Networks{
@@ -123,8 +145,9 @@ var _ = Describe("TerraformChartOps", func() {
 								Worker: zone1Worker,
 							},
 							{
-								Name:   zone2Name,
-								Worker: zone2Worker,
+								Name:     zone2Name,
+								Worker:   zone2Worker,
+								EIPCount: &eipCount,
 							},
 						},
 					},
@@ -150,7 +173,8 @@ var _ = Describe("TerraformChartOps", func() {
 					"region": region,
 				},
 				"create": map[string]interface{}{
-					"vpc": true,
+					"vpc":  true,
+					"snat": true,
 				},
 				"vpc": map[string]interface{}{
 					"cidr":               vpcCIDR,
@@ -158,6 +182,7 @@ var _ = Describe("TerraformChartOps", func() {
 					"natGatewayID":       natGatewayID,
 					"snatTableID":        sNATTableIDs,
 					"internetChargeType": internetChargeType,
+					"eipBandwidth":       DefaultEIPBandwidth,
 				},
 				"clusterName":  namespace,
 				"sshPublicKey": sshPublicKey,
@@ -167,12 +192,14 @@ var _ = Describe("TerraformChartOps", func() {
 						"cidr": map[string]interface{}{
 							"worker": zone1Worker,
 						},
+						"eipCount": DefaultEIPCount,
 					},
 					{
 						"name": zone2Name,
 						"cidr": map[string]interface{}{
 							"worker": zone2Worker,
 						},
+						"eipCount": eipCount,
 					},
 				},
 				"outputKeys": map[string]interface{}{
@@ -181,8 +208,30 @@ var _ = Describe("TerraformChartOps", func() {
 					"securityGroupID":    TerraformerOutputKeySecurityGroupID,
 					"keyPairName":        TerraformerOutputKeyKeyPairName,
 					"vswitchNodesPrefix": TerraformerOutputKeyVSwitchNodesPrefix,
+					"snatIPsPrefix":      TerraformerOutputKeySNATIPsPrefix,
 				},
 			}))
 		})
+
+		It("should compute the terraformer chart values for existing SNAT entries and a custom EIP bandwidth", func() {
+			var (
+				bandwidth int32 = 200
+				infra           = extensionsv1alpha1.Infrastructure{}
+				config          = v1alpha1.InfrastructureConfig{
+					Networks: v1alpha1.Networks{
+						VPC: v1alpha1.VPC{
+							ExistingSNATEntries: true,
+						},
+						EIP: &v1alpha1.EIP{
+							Bandwidth: &bandwidth,
+						},
+					},
+				}
+			)
+
+			values := ops.ComputeChartValues(&infra, &config, &InitializerValues{})
+			Expect(values["create"]).To(HaveKeyWithValue("snat", false))
+			Expect(values["vpc"]).To(HaveKeyWithValue("eipBandwidth", bandwidth))
+		})
 	})
 })
@@ -33,6 +33,8 @@ const (
 	TerraformerOutputKeyKeyPairName = "key_pair_name"
 	// TerraformerOutputKeyVSwitchNodesPrefix is the prefix for the vswitches.
 	TerraformerOutputKeyVSwitchNodesPrefix = "vswitch_id_z"
+	// TerraformerOutputKeySNATIPsPrefix is the prefix for the SNAT IPs of the vswitches.
+	TerraformerOutputKeySNATIPsPrefix = "snat_ips_z"
 
 	// TerraformDefaultVPCID is the default value for the VPC ID in the chart.
 	TerraformDefaultVPCID = "${alicloud_vpc.vpc.id}"
@@ -40,6 +42,11 @@ const (
 	TerraformDefaultNATGatewayID = "${alicloud_nat_gateway.nat_gateway.id}"
 	// TerraformDefaultSNATTableIDs is the default value for the SNAT table IDs in the chart.
 	TerraformDefaultSNATTableIDs = "${alicloud_nat_gateway.nat_gateway.snat_table_ids}"
+
+	// DefaultEIPBandwidth is the default maximum bandwidth of an EIP in Mbps.
+	DefaultEIPBandwidth int32 = 100
+	// DefaultEIPCount is the default number of EIPs per zone.
+	DefaultEIPCount int32 = 1
 )
 
 // VPCInfo contains info about an existing VPC.
@@ -37,6 +37,8 @@ type AddOptions struct {
 	IgnoreOperationAnnotation bool
 	// MachineImages is the default list of machine images.
 	MachineImages []config.MachineImage
+	// ControlPlaneComponents are the vertical pod autoscaling and high availability settings of the machine-controller-manager.
+	ControlPlaneComponents config.ControlPlaneComponents
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
@@ -51,7 +53,7 @@ func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
 	}
 
 	return worker.Add(mgr, worker.AddArgs{
-		Actuator:          NewActuator(opts.MachineImages),
+		Actuator:          NewActuator(opts.MachineImages, opts.ControlPlaneComponents),
 		ControllerOptions: opts.Controller,
 		Predicates:        worker.DefaultPredicates(alicloud.Type, opts.IgnoreOperationAnnotation),
 	})
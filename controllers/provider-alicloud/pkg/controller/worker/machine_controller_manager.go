@@ -20,12 +20,15 @@ import (
 	"path/filepath"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/alicloud"
+	confighelper "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/config/helper"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 
+	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/chart"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	rbacv1 "k8s.io/api/rbac/v1"
 )
 
@@ -40,6 +43,7 @@ var (
 			{Type: &corev1.ServiceAccount{}, Name: alicloud.MachineControllerManagerName},
 			{Type: &corev1.Secret{}, Name: alicloud.MachineControllerManagerName},
 			{Type: extensionscontroller.GetVerticalPodAutoscalerObject(), Name: alicloud.MachineControllerManagerVpaName},
+			{Type: &policyv1beta1.PodDisruptionBudget{}, Name: alicloud.MachineControllerManagerName},
 			{Type: &corev1.ConfigMap{}, Name: alicloud.MachineControllerManagerMonitoringConfigName},
 		},
 	}
@@ -60,12 +64,15 @@ func (w *workerDelegate) GetMachineControllerManagerChartValues(ctx context.Cont
 		return nil, err
 	}
 
-	return map[string]interface{}{
+	values := map[string]interface{}{
 		"providerName": alicloud.Name,
 		"namespace": map[string]interface{}{
 			"uid": namespace.UID,
 		},
-	}, nil
+		"replicas": confighelper.GetControlPlaneComponentReplicas(w.controlPlaneComponents),
+	}
+
+	return utils.MergeMaps(values, confighelper.GetControlPlaneComponentChartValues(w.controlPlaneComponents)), nil
 }
 
 func (w *workerDelegate) GetMachineControllerManagerShootChartValues(ctx context.Context) (map[string]interface{}, error) {
@@ -18,8 +18,12 @@ import (
 	"context"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/alicloud"
+	apisalicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
+	apisalicloudhelper "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud/helper"
 	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/config"
+	confighelper "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/config/helper"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupentry"
 	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane/genericmutator"
@@ -31,14 +35,16 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // NewEnsurer creates a new controlplaneexposure ensurer.
-func NewEnsurer(etcdBackup *config.ETCDBackup, imageVector imagevector.ImageVector, logger logr.Logger) genericmutator.Ensurer {
+func NewEnsurer(etcdBackup *config.ETCDBackup, imageVector imagevector.ImageVector, decoder runtime.Decoder, logger logr.Logger) genericmutator.Ensurer {
 	return &ensurer{
 		etcdBackup:  etcdBackup,
 		imageVector: imageVector,
+		decoder:     decoder,
 		logger:      logger.WithName("alicloud-controlplanebackup-ensurer"),
 	}
 }
@@ -47,6 +53,7 @@ type ensurer struct {
 	genericmutator.NoopEnsurer
 	etcdBackup  *config.ETCDBackup
 	imageVector imagevector.ImageVector
+	decoder     runtime.Decoder
 	client      client.Client
 	logger      logr.Logger
 }
@@ -59,15 +66,15 @@ func (e *ensurer) InjectClient(client client.Client) error {
 
 // EnsureETCDStatefulSet ensures that the etcd stateful sets conform to the provider requirements.
 func (e *ensurer) EnsureETCDStatefulSet(ctx context.Context, ss *appsv1.StatefulSet, cluster *extensionscontroller.Cluster) error {
-	if err := e.ensureContainers(&ss.Spec.Template.Spec, ss.Name, cluster); err != nil {
+	if err := e.ensureContainers(ctx, &ss.Spec.Template.Spec, ss.Namespace, ss.Name, cluster); err != nil {
 		return err
 	}
 	return e.ensureChecksumAnnotations(ctx, &ss.Spec.Template, ss.Namespace, ss.Name, !extensionscontroller.IsSeedBackupNil(cluster))
 }
 
-func (e *ensurer) ensureContainers(ps *corev1.PodSpec, name string, cluster *extensionscontroller.Cluster) error {
+func (e *ensurer) ensureContainers(ctx context.Context, ps *corev1.PodSpec, namespace, name string, cluster *extensionscontroller.Cluster) error {
 	backupRestoreContainer := extensionswebhook.ContainerWithName(ps.Containers, controlplane.BackupRestoreContainerName)
-	c, err := e.ensureBackupRestoreContainer(backupRestoreContainer, name, cluster)
+	c, err := e.ensureBackupRestoreContainer(ctx, backupRestoreContainer, namespace, name, cluster)
 	if err != nil {
 		return err
 	}
@@ -82,7 +89,7 @@ func (e *ensurer) ensureChecksumAnnotations(ctx context.Context, template *corev
 	return nil
 }
 
-func (e *ensurer) ensureBackupRestoreContainer(existingContainer *corev1.Container, name string, cluster *extensionscontroller.Cluster) (*corev1.Container, error) {
+func (e *ensurer) ensureBackupRestoreContainer(ctx context.Context, existingContainer *corev1.Container, namespace, name string, cluster *extensionscontroller.Cluster) (*corev1.Container, error) {
 	// Find etcd-backup-restore image
 	// TODO Get seed version from clientset when it's possible to inject it
 	image, err := e.imageVector.FindImage(alicloud.ETCDBackupRestoreImageName, imagevector.TargetVersion(extensionscontroller.GetKubernetesVersion(cluster)))
@@ -102,7 +109,11 @@ func (e *ensurer) ensureBackupRestoreContainer(existingContainer *corev1.Contain
 		if extensionscontroller.IsSeedBackupNil(cluster) {
 			e.logger.Info("Backup profile is not configured; backup will not be taken for etcd-main")
 		} else {
-			prefix = common.GenerateBackupEntryName(extensionscontroller.GetTechnicalID(cluster), extensionscontroller.GetUID(cluster))
+			backupEntryName := common.GenerateBackupEntryName(extensionscontroller.GetTechnicalID(cluster), extensionscontroller.GetUID(cluster))
+			if prefix, err = backupentry.GetStorePrefixByName(ctx, e.client, backupEntryName); err != nil {
+				prefix = controlplane.GetStorePrefixFromContainer(existingContainer, name, backupEntryName)
+				e.logger.Error(err, "Could not determine the store prefix of the etcd backups, keeping the current one", "prefix", prefix)
+			}
 			provider = alicloud.StorageProviderName
 			env = []corev1.EnvVar{
 				{
@@ -156,5 +167,22 @@ func (e *ensurer) ensureBackupRestoreContainer(existingContainer *corev1.Contain
 		}
 	}
 
-	return controlplane.GetBackupRestoreContainer(name, volumeClaimTemplateName, schedule, provider, prefix, image.String(), nil, env, nil), nil
+	backupRestoreConfig, err := e.getBackupRestoreConfig(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return controlplane.GetBackupRestoreContainer(name, volumeClaimTemplateName, schedule, provider, prefix, image.String(), backupRestoreConfig, nil, env, nil), nil
+}
+
+// getBackupRestoreConfig returns the backup-restore configuration for the shoot in the given namespace. Settings in the
+// ControlPlaneConfig of the shoot take precedence over the etcd backup configuration of the controller.
+func (e *ensurer) getBackupRestoreConfig(ctx context.Context, namespace string) (*controlplane.BackupRestoreConfig, error) {
+	return controlplane.GetBackupRestoreConfig(ctx, e.client, namespace, alicloud.Type, confighelper.GetBackupRestoreConfig(e.etcdBackup), func(providerConfig []byte) (*controlplane.BackupRestoreConfig, error) {
+		cpConfig := &apisalicloud.ControlPlaneConfig{}
+		if _, _, err := e.decoder.Decode(providerConfig, nil, cpConfig); err != nil {
+			return nil, err
+		}
+		return apisalicloudhelper.GetBackupRestoreConfig(cpConfig.ETCDBackup), nil
+	})
 }
@@ -16,9 +16,12 @@ package controlplanebackup
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/alicloud"
+	apisalicloud "github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/alicloud"
 	"github.com/gardener/gardener-extensions/controllers/provider-alicloud/pkg/apis/config"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
@@ -28,14 +31,17 @@ import (
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
@@ -55,6 +61,10 @@ var _ = Describe("Ensurer", func() {
 		var (
 			ctrl *gomock.Controller
 
+			scheme  = runtime.NewScheme()
+			_       = apisalicloud.AddToScheme(scheme)
+			decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
+
 			etcdBackup = &config.ETCDBackup{
 				Schedule: util.StringPtr("0 */24 * * *"),
 			}
@@ -86,8 +96,9 @@ var _ = Describe("Ensurer", func() {
 				},
 			}
 
-			secretKey = client.ObjectKey{Namespace: namespace, Name: alicloud.BackupSecretName}
-			secret    = &corev1.Secret{
+			secretKey      = client.ObjectKey{Namespace: namespace, Name: alicloud.BackupSecretName}
+			backupEntryKey = client.ObjectKey{Name: "shoot--test--sample--test-uid"}
+			secret         = &corev1.Secret{
 				ObjectMeta: metav1.ObjectMeta{Name: alicloud.BackupSecretName, Namespace: namespace},
 				Data:       map[string][]byte{"foo": []byte("bar")},
 			}
@@ -114,10 +125,12 @@ var _ = Describe("Ensurer", func() {
 
 			// Create mock client
 			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), &extensionsv1alpha1.ControlPlaneList{}, gomock.Any()).AnyTimes()
 			client.EXPECT().Get(context.TODO(), secretKey, &corev1.Secret{}).DoAndReturn(clientGet(secret))
+			client.EXPECT().Get(context.TODO(), backupEntryKey, &extensionsv1alpha1.BackupEntry{}).Return(apierrors.NewNotFound(extensionsv1alpha1.Resource("backupentries"), backupEntryKey.Name))
 
 			// Create ensurer
-			ensurer := NewEnsurer(etcdBackup, imageVector, logger)
+			ensurer := NewEnsurer(etcdBackup, imageVector, decoder, logger)
 			err := ensurer.(inject.Client).InjectClient(client)
 			Expect(err).To(Not(HaveOccurred()))
 
@@ -147,10 +160,12 @@ var _ = Describe("Ensurer", func() {
 
 			// Create mock client
 			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), &extensionsv1alpha1.ControlPlaneList{}, gomock.Any()).AnyTimes()
 			client.EXPECT().Get(context.TODO(), secretKey, &corev1.Secret{}).DoAndReturn(clientGet(secret))
+			client.EXPECT().Get(context.TODO(), backupEntryKey, &extensionsv1alpha1.BackupEntry{}).Return(apierrors.NewNotFound(extensionsv1alpha1.Resource("backupentries"), backupEntryKey.Name))
 
 			// Create ensurer
-			ensurer := NewEnsurer(etcdBackup, imageVector, logger)
+			ensurer := NewEnsurer(etcdBackup, imageVector, decoder, logger)
 			err := ensurer.(inject.Client).InjectClient(client)
 			Expect(err).To(Not(HaveOccurred()))
 
@@ -168,9 +183,10 @@ var _ = Describe("Ensurer", func() {
 
 			// Create mock client
 			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), &extensionsv1alpha1.ControlPlaneList{}, gomock.Any()).AnyTimes()
 
 			// Create ensurer
-			ensurer := NewEnsurer(etcdBackup, imageVector, logger)
+			ensurer := NewEnsurer(etcdBackup, imageVector, decoder, logger)
 			err := ensurer.(inject.Client).InjectClient(client)
 			Expect(err).To(Not(HaveOccurred()))
 
@@ -189,10 +205,11 @@ var _ = Describe("Ensurer", func() {
 
 			// Create mock client
 			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), &extensionsv1alpha1.ControlPlaneList{}, gomock.Any()).AnyTimes()
 			//client.EXPECT().Get(context.TODO(), secretKey, &corev1.Secret{}).DoAndReturn(clientGet(secret))
 
 			// Create ensurer
-			ensurer := NewEnsurer(etcdBackup, imageVector, logger)
+			ensurer := NewEnsurer(etcdBackup, imageVector, decoder, logger)
 			err := ensurer.(inject.Client).InjectClient(client)
 			Expect(err).To(Not(HaveOccurred()))
 
@@ -215,11 +232,17 @@ var _ = Describe("Ensurer", func() {
 				}
 			)
 
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), &extensionsv1alpha1.ControlPlaneList{}, gomock.Any()).AnyTimes()
+
 			// Create ensurer
-			ensurer := NewEnsurer(etcdBackup, imageVector, logger)
+			ensurer := NewEnsurer(etcdBackup, imageVector, decoder, logger)
+			err := ensurer.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
 
 			// Call EnsureETCDStatefulSet method and check the result
-			err := ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
+			err = ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
 			Expect(err).To(Not(HaveOccurred()))
 			checkETCDEventsStatefulSet(ss)
 		})
@@ -242,14 +265,73 @@ var _ = Describe("Ensurer", func() {
 				}
 			)
 
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), &extensionsv1alpha1.ControlPlaneList{}, gomock.Any()).AnyTimes()
+
 			// Create ensurer
-			ensurer := NewEnsurer(etcdBackup, imageVector, logger)
+			ensurer := NewEnsurer(etcdBackup, imageVector, decoder, logger)
+			err := ensurer.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
 
 			// Call EnsureETCDStatefulSet method and check the result
-			err := ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
+			err = ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
 			Expect(err).To(Not(HaveOccurred()))
 			checkETCDEventsStatefulSet(ss)
 		})
+
+		It("should apply the backup-restore configuration of the controller and the shoot", func() {
+			var (
+				ss = &appsv1.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: v1alpha1constants.StatefulSetNameETCDEvents},
+				}
+				limitBased = controlplane.GarbageCollectionPolicyLimitBased
+				maxBackups = int32(3)
+				cp         = extensionsv1alpha1.ControlPlane{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "control-plane"},
+					Spec: extensionsv1alpha1.ControlPlaneSpec{
+						DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: alicloud.Type},
+						ProviderConfig: &runtime.RawExtension{
+							Raw: encode(&apisalicloud.ControlPlaneConfig{
+								ETCDBackup: &apisalicloud.ETCDBackupConfig{
+									GarbageCollectionPeriod: &metav1.Duration{Duration: time.Hour},
+									GarbageCollectionPolicy: &limitBased,
+									MaxBackups:              &maxBackups,
+								},
+							}),
+						},
+					},
+				}
+				etcdBackup = &config.ETCDBackup{
+					Schedule:                util.StringPtr("0 */24 * * *"),
+					DeltaSnapshotPeriod:     &metav1.Duration{Duration: time.Minute},
+					GarbageCollectionPeriod: &metav1.Duration{Duration: 2 * time.Hour},
+				}
+			)
+
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), &extensionsv1alpha1.ControlPlaneList{}, gomock.Any()).
+				DoAndReturn(func(_ context.Context, list *extensionsv1alpha1.ControlPlaneList, _ ...interface{}) error {
+					list.Items = []extensionsv1alpha1.ControlPlane{cp}
+					return nil
+				})
+
+			// Create ensurer
+			ensurer := NewEnsurer(etcdBackup, imageVector, decoder, logger)
+			err := ensurer.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
+
+			// Call EnsureETCDStatefulSet method and check the result
+			err = ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
+			Expect(err).To(Not(HaveOccurred()))
+			c := extensionswebhook.ContainerWithName(ss.Spec.Template.Spec.Containers, "backup-restore")
+			Expect(c).NotTo(BeNil())
+			Expect(c.Command).To(ContainElement("--delta-snapshot-period-seconds=60"))
+			Expect(c.Command).To(ContainElement("--garbage-collection-period-seconds=3600"))
+			Expect(c.Command).To(ContainElement("--garbage-collection-policy=LimitBased"))
+			Expect(c.Command).To(ContainElement("--max-backups=3"))
+		})
 	})
 })
 
@@ -297,21 +379,21 @@ func checkETCDMainStatefulSet(ss *appsv1.StatefulSet, annotations map[string]str
 
 	c := extensionswebhook.ContainerWithName(ss.Spec.Template.Spec.Containers, "backup-restore")
 	Expect(c).To(Equal(controlplane.GetBackupRestoreContainer(v1alpha1constants.StatefulSetNameETCDMain, controlplane.EtcdMainVolumeClaimTemplateName, "0 */24 * * *", alicloud.StorageProviderName, "shoot--test--sample--test-uid",
-		"test-repository:test-tag", nil, env, nil)))
+		"test-repository:test-tag", nil, nil, env, nil)))
 	Expect(ss.Spec.Template.Annotations).To(Equal(annotations))
 }
 
 func checkETCDMainStatefulSetWithoutBackup(ss *appsv1.StatefulSet, annotations map[string]string) {
 	c := extensionswebhook.ContainerWithName(ss.Spec.Template.Spec.Containers, "backup-restore")
 	Expect(c).To(Equal(controlplane.GetBackupRestoreContainer(v1alpha1constants.StatefulSetNameETCDMain, controlplane.EtcdMainVolumeClaimTemplateName, "0 */24 * * *", "", "",
-		"test-repository:test-tag", nil, nil, nil)))
+		"test-repository:test-tag", nil, nil, nil, nil)))
 	Expect(ss.Spec.Template.Annotations).To(BeNil())
 }
 
 func checkETCDEventsStatefulSet(ss *appsv1.StatefulSet) {
 	c := extensionswebhook.ContainerWithName(ss.Spec.Template.Spec.Containers, "backup-restore")
 	Expect(c).To(Equal(controlplane.GetBackupRestoreContainer(v1alpha1constants.StatefulSetNameETCDEvents, v1alpha1constants.StatefulSetNameETCDEvents, "0 */24 * * *", "", "",
-		"test-repository:test-tag", nil, nil, nil)))
+		"test-repository:test-tag", nil, nil, nil, nil)))
 }
 
 func clientGet(result runtime.Object) interface{} {
@@ -323,3 +405,8 @@ func clientGet(result runtime.Object) interface{} {
 		return nil
 	}
 }
+
+func encode(obj runtime.Object) []byte {
+	data, _ := json.Marshal(obj)
+	return data
+}
@@ -20,6 +20,10 @@ resource "aws_vpc" "vpc" {
   cidr_block           = "{{ required "vpc.cidr is required" .Values.vpc.cidr }}"
   enable_dns_support   = true
   enable_dns_hostnames = true
+{{- if .Values.dualStack }}
+
+  assign_generated_ipv6_cidr_block = true
+{{- end }}
 
 {{ include "aws-infra.common-tags" .Values | indent 2 }}
 }
@@ -35,6 +39,12 @@ resource "aws_internet_gateway" "igw" {
 {{ include "aws-infra.common-tags" .Values | indent 2 }}
 }
 {{- end}}
+{{- if .Values.dualStack }}
+
+resource "aws_egress_only_internet_gateway" "egw" {
+  vpc_id = "{{ required "vpc.id is required" .Values.vpc.id }}"
+}
+{{- end}}
 
 resource "aws_route_table" "routetable_main" {
   vpc_id = "{{ required "vpc.id is required" .Values.vpc.id }}"
@@ -47,6 +57,14 @@ resource "aws_route" "public" {
   destination_cidr_block = "0.0.0.0/0"
   gateway_id             = "{{ required "vpc.internetGatewayID is required" .Values.vpc.internetGatewayID }}"
 }
+{{- if .Values.dualStack }}
+
+resource "aws_route" "public_ipv6" {
+  route_table_id              = "${aws_route_table.routetable_main.id}"
+  destination_ipv6_cidr_block = "::/0"
+  gateway_id                  = "{{ required "vpc.internetGatewayID is required" .Values.vpc.internetGatewayID }}"
+}
+{{- end }}
 
 resource "aws_security_group" "bastions" {
   name        = "{{ required "clusterName is required" .Values.clusterName }}-bastions"
@@ -62,6 +80,9 @@ resource "aws_security_group_rule" "bastion_ssh_bastion" {
   to_port           = 22
   protocol          = "tcp"
   cidr_blocks       = ["0.0.0.0/0"]
+{{- if .Values.dualStack }}
+  ipv6_cidr_blocks  = ["::/0"]
+{{- end }}
   security_group_id = "${aws_security_group.bastions.id}"
 }
 
@@ -71,6 +92,9 @@ resource "aws_security_group_rule" "bastions_egress_all" {
   to_port           = 0
   protocol          = "-1"
   cidr_blocks       = ["0.0.0.0/0"]
+{{- if .Values.dualStack }}
+  ipv6_cidr_blocks  = ["::/0"]
+{{- end }}
   security_group_id = "${aws_security_group.bastions.id}"
 }
 
@@ -97,6 +121,9 @@ resource "aws_security_group_rule" "nodes_tcp_all" {
   to_port           = 32767
   protocol          = "tcp"
   cidr_blocks       = ["0.0.0.0/0"]
+{{- if .Values.dualStack }}
+  ipv6_cidr_blocks  = ["::/0"]
+{{- end }}
   security_group_id = "${aws_security_group.nodes.id}"
 }
 
@@ -106,6 +133,9 @@ resource "aws_security_group_rule" "nodes_udp_all" {
   to_port           = 32767
   protocol          = "udp"
   cidr_blocks       = ["0.0.0.0/0"]
+{{- if .Values.dualStack }}
+  ipv6_cidr_blocks  = ["::/0"]
+{{- end }}
   security_group_id = "${aws_security_group.nodes.id}"
 }
 
@@ -124,6 +154,9 @@ resource "aws_security_group_rule" "nodes_egress_all" {
   to_port           = 0
   protocol          = "-1"
   cidr_blocks       = ["0.0.0.0/0"]
+{{- if .Values.dualStack }}
+  ipv6_cidr_blocks  = ["::/0"]
+{{- end }}
   security_group_id = "${aws_security_group.nodes.id}"
 }
 
@@ -132,6 +165,11 @@ resource "aws_subnet" "nodes_z{{ $index }}" {
   vpc_id            = "{{ required "vpc.id is required" $.Values.vpc.id }}"
   cidr_block        = "{{ required "zone.worker is required" $zone.worker }}"
   availability_zone = "{{ required "zone.name is required" $zone.name }}"
+{{- if $.Values.dualStack }}
+
+  ipv6_cidr_block                 = "${cidrsubnet("{{ required "vpc.ipv6CIDR is required" $.Values.vpc.ipv6CIDR }}", 8, {{ add (mul $index 3) 0 }})}"
+  assign_ipv6_address_on_creation = true
+{{- end }}
 
 {{ include "aws-infra.tags-with-suffix" (set $.Values "suffix" (print "nodes-z" $index)) | indent 2 }}
 }
@@ -139,11 +177,22 @@ resource "aws_subnet" "nodes_z{{ $index }}" {
 output "{{ $.Values.outputKeys.subnetsNodesPrefix }}{{ $index }}" {
   value = "${aws_subnet.nodes_z{{ $index }}.id}"
 }
+{{- if $.Values.dualStack }}
+
+output "{{ $.Values.outputKeys.subnetsNodesIPv6Prefix }}{{ $index }}" {
+  value = "${aws_subnet.nodes_z{{ $index }}.ipv6_cidr_block}"
+}
+{{- end }}
 
 resource "aws_subnet" "private_utility_z{{ $index }}" {
   vpc_id            = "{{ required "vpc.id is required" $.Values.vpc.id }}"
   cidr_block        = "{{ required "zone.internal is required" $zone.internal }}"
   availability_zone = "{{ required "zone.name is required" $zone.name }}"
+{{- if $.Values.dualStack }}
+
+  ipv6_cidr_block                 = "${cidrsubnet("{{ required "vpc.ipv6CIDR is required" $.Values.vpc.ipv6CIDR }}", 8, {{ add (mul $index 3) 1 }})}"
+  assign_ipv6_address_on_creation = true
+{{- end }}
 
   tags = {
     Name = "{{ required "clusterName is required" $.Values.clusterName }}-private-utility-z{{ $index }}"
@@ -174,6 +223,11 @@ resource "aws_subnet" "public_utility_z{{ $index }}" {
   vpc_id            = "{{ required "vpc.id is required" $.Values.vpc.id }}"
   cidr_block        = "{{ required "zone.public is required" $zone.public }}"
   availability_zone = "{{ required "zone.name is required" $zone.name }}"
+{{- if $.Values.dualStack }}
+
+  ipv6_cidr_block                 = "${cidrsubnet("{{ required "vpc.ipv6CIDR is required" $.Values.vpc.ipv6CIDR }}", 8, {{ add (mul $index 3) 2 }})}"
+  assign_ipv6_address_on_creation = true
+{{- end }}
 
   tags = {
     Name = "{{ required "clusterName is required" $.Values.clusterName }}-public-utility-z{{ $index }}"
@@ -185,6 +239,12 @@ resource "aws_subnet" "public_utility_z{{ $index }}" {
 output "{{ $.Values.outputKeys.subnetsPublicPrefix }}{{ $index }}" {
   value = "${aws_subnet.public_utility_z{{ $index }}.id}"
 }
+{{- if $.Values.dualStack }}
+
+output "{{ $.Values.outputKeys.subnetsPublicIPv6Prefix }}{{ $index }}" {
+  value = "${aws_subnet.public_utility_z{{ $index }}.ipv6_cidr_block}"
+}
+{{- end }}
 
 resource "aws_security_group_rule" "nodes_tcp_public_z{{ $index }}" {
   type              = "ingress"
@@ -234,6 +294,14 @@ resource "aws_route" "private_utility_z{{ $index }}_nat" {
   destination_cidr_block = "0.0.0.0/0"
   nat_gateway_id         = "${aws_nat_gateway.natgw_z{{ $index }}.id}"
 }
+{{- if $.Values.dualStack }}
+
+resource "aws_route" "private_utility_z{{ $index }}_egw" {
+  route_table_id              = "${aws_route_table.routetable_private_utility_z{{ $index }}.id}"
+  destination_ipv6_cidr_block = "::/0"
+  egress_only_gateway_id      = "${aws_egress_only_internet_gateway.egw.id}"
+}
+{{- end }}
 
 resource "aws_route_table_association" "routetable_private_utility_z{{ $index }}_association_private_utility_z{{ $index }}" {
   subnet_id      = "${aws_subnet.private_utility_z{{ $index }}.id}"
@@ -380,6 +448,12 @@ resource "aws_key_pair" "kubernetes" {
 output "{{ .Values.outputKeys.vpcIdKey }}" {
   value = "{{ required "vpc.id is required" .Values.vpc.id }}"
 }
+{{- if .Values.dualStack }}
+
+output "{{ .Values.outputKeys.vpcIPv6CIDRKey }}" {
+  value = "{{ required "vpc.ipv6CIDR is required" .Values.vpc.ipv6CIDR }}"
+}
+{{- end }}
 
 output "{{ .Values.outputKeys.iamInstanceProfileNodes }}" {
   value = "${aws_iam_instance_profile.nodes.name}"
@@ -5,3 +5,11 @@ storage.k8s.io/v1
 storage.k8s.io/v1beta1
 {{- end -}}
 {{- end -}}
+
+{{- define "provisioner" -}}
+{{- if .Values.useCSI -}}
+ebs.csi.aws.com
+{{- else -}}
+kubernetes.io/aws-ebs
+{{- end -}}
+{{- end -}}
@@ -60,6 +60,8 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 		backupEntryCtrlOpts = &controllercmd.ControllerOptions{
 			MaxConcurrentReconciles: 5,
 		}
+		backupEntryDeletionOpts       = &controllercmd.DeletionOptions{}
+		backupEntryCtrlOptsUnprefixed = controllercmd.NewOptionAggregator(backupEntryCtrlOpts, backupEntryDeletionOpts)
 
 		// options for the controlplane controller
 		controlPlaneCtrlOpts = &controllercmd.ControllerOptions{
@@ -95,7 +97,7 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			restOpts,
 			mgrOpts,
 			controllercmd.PrefixOption("backupbucket-", backupBucketCtrlOpts),
-			controllercmd.PrefixOption("backupentry-", backupEntryCtrlOpts),
+			controllercmd.PrefixOption("backupentry-", &backupEntryCtrlOptsUnprefixed),
 			controllercmd.PrefixOption("controlplane-", controlPlaneCtrlOpts),
 			controllercmd.PrefixOption("infrastructure-", infraCtrlOpts),
 			controllercmd.PrefixOption("worker-", &workerCtrlOptsUnprefixed),
@@ -136,10 +138,14 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			}
 
 			configFileOpts.Completed().ApplyMachineImages(&awsworker.DefaultAddOptions.MachineImagesToAMIMapping)
+			configFileOpts.Completed().ApplyControlPlaneComponents(&awscontrolplane.DefaultAddOptions.ControlPlaneComponents)
+			configFileOpts.Completed().ApplyControlPlaneComponents(&awsworker.DefaultAddOptions.ControlPlaneComponents)
 			configFileOpts.Completed().ApplyETCDStorage(&awscontrolplaneexposure.DefaultAddOptions.ETCDStorage)
 			configFileOpts.Completed().ApplyETCDBackup(&awscontrolplanebackup.DefaultAddOptions.ETCDBackup)
+			configFileOpts.Completed().ApplyBackupBucketConfig(&awsbackupbucket.DefaultAddOptions.BackupBucketConfig)
 			backupBucketCtrlOpts.Completed().Apply(&awsbackupbucket.DefaultAddOptions.Controller)
 			backupEntryCtrlOpts.Completed().Apply(&awsbackupentry.DefaultAddOptions.Controller)
+			backupEntryDeletionOpts.Completed().Apply(&awsbackupentry.DefaultAddOptions.Deletion.GracePeriod, &awsbackupentry.DefaultAddOptions.Deletion.GarbageCollectionPeriod)
 			controlPlaneCtrlOpts.Completed().Apply(&awscontrolplane.DefaultAddOptions.Controller)
 			infraCtrlOpts.Completed().Apply(&awsinfrastructure.DefaultAddOptions.Controller)
 			reconcileOpts.Completed().Apply(&awsinfrastructure.DefaultAddOptions.IgnoreOperationAnnotation)
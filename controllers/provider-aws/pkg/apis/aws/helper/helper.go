@@ -18,6 +18,11 @@ import (
 	"fmt"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // FindInstanceProfileForPurpose takes a list of instance profiles and tries to find the first entry
@@ -91,3 +96,46 @@ func FindMachineImage(machineImages []aws.MachineImage, name, version string) (*
 	}
 	return nil, fmt.Errorf("no machine image with name %q, version %q found", name, version)
 }
+
+// ControlPlaneConfigFromCluster decodes the control plane config of the shoot in the given cluster. An empty
+// control plane config is returned if the shoot does not specify one.
+func ControlPlaneConfigFromCluster(decoder runtime.Decoder, cluster *extensionscontroller.Cluster) (*aws.ControlPlaneConfig, error) {
+	cpConfig := &aws.ControlPlaneConfig{}
+	if cluster == nil || cluster.CoreShoot == nil {
+		return cpConfig, nil
+	}
+
+	providerConfig := cluster.CoreShoot.Spec.Provider.ControlPlaneConfig
+	if providerConfig == nil || len(providerConfig.Raw) == 0 {
+		return cpConfig, nil
+	}
+
+	if _, _, err := decoder.Decode(providerConfig.Raw, nil, cpConfig); err != nil {
+		return nil, errors.Wrapf(err, "could not decode controlPlaneConfig of shoot '%s'", cluster.CoreShoot.Name)
+	}
+	return cpConfig, nil
+}
+
+// GetLoadBalancerType returns the type of the load balancer of the kube-apiserver service configured in the given
+// control plane config. It defaults to the classic load balancer type.
+func GetLoadBalancerType(cpConfig *aws.ControlPlaneConfig) aws.LoadBalancerType {
+	if cpConfig.LoadBalancer != nil && cpConfig.LoadBalancer.Type != nil {
+		return *cpConfig.LoadBalancer.Type
+	}
+	return aws.LoadBalancerTypeClassic
+}
+
+// GetBackupRestoreConfig returns the backup-restore configuration for the given etcd backup configuration of a shoot.
+func GetBackupRestoreConfig(etcdBackup *aws.ETCDBackupConfig) *controlplane.BackupRestoreConfig {
+	if etcdBackup == nil {
+		return nil
+	}
+	return &controlplane.BackupRestoreConfig{
+		DeltaSnapshotPeriod:      etcdBackup.DeltaSnapshotPeriod,
+		DeltaSnapshotMemoryLimit: etcdBackup.DeltaSnapshotMemoryLimit,
+		GarbageCollectionPeriod:  etcdBackup.GarbageCollectionPeriod,
+		GarbageCollectionPolicy:  etcdBackup.GarbageCollectionPolicy,
+		MaxBackups:               etcdBackup.MaxBackups,
+		Resources:                etcdBackup.Resources,
+	}
+}
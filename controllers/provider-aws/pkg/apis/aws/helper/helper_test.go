@@ -17,10 +17,15 @@ package helper_test
 import (
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
 	. "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/helper"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/install"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
 )
 
 var _ = Describe("Helper", func() {
@@ -85,8 +90,59 @@ var _ = Describe("Helper", func() {
 		Entry("entry not found (no version)", []aws.MachineImage{{Name: "bar", Version: "1.2.3"}}, "foo", "1.2.3", nil, true),
 		Entry("entry exists", []aws.MachineImage{{Name: "bar", Version: "1.2.3"}}, "bar", "1.2.3", &aws.MachineImage{Name: "bar", Version: "1.2.3"}, false),
 	)
+
+	Describe("#ControlPlaneConfigFromCluster", func() {
+		var decoder runtime.Decoder
+
+		BeforeEach(func() {
+			scheme := runtime.NewScheme()
+			install.Install(scheme)
+			decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
+		})
+
+		It("should return an empty config if the shoot does not specify one", func() {
+			cpConfig, err := ControlPlaneConfigFromCluster(decoder, &extensionscontroller.Cluster{CoreShoot: &gardencorev1alpha1.Shoot{}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cpConfig).To(Equal(&aws.ControlPlaneConfig{}))
+		})
+
+		It("should decode the control plane config of the shoot", func() {
+			cluster := &extensionscontroller.Cluster{
+				CoreShoot: &gardencorev1alpha1.Shoot{
+					Spec: gardencorev1alpha1.ShootSpec{
+						Provider: gardencorev1alpha1.Provider{
+							ControlPlaneConfig: &gardencorev1alpha1.ProviderConfig{
+								RawExtension: runtime.RawExtension{
+									Raw: []byte(`{"apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig","loadBalancer":{"type":"nlb"}}`),
+								},
+							},
+						},
+					},
+				},
+			}
+			nlb := aws.LoadBalancerTypeNLB
+
+			cpConfig, err := ControlPlaneConfigFromCluster(decoder, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cpConfig.LoadBalancer).To(Equal(&aws.LoadBalancerConfig{Type: &nlb}))
+		})
+	})
+
+	DescribeTable("#GetLoadBalancerType",
+		func(cpConfig *aws.ControlPlaneConfig, expectedType aws.LoadBalancerType) {
+			Expect(GetLoadBalancerType(cpConfig)).To(Equal(expectedType))
+		},
+
+		Entry("no load balancer config", &aws.ControlPlaneConfig{}, aws.LoadBalancerTypeClassic),
+		Entry("no load balancer type", &aws.ControlPlaneConfig{LoadBalancer: &aws.LoadBalancerConfig{}}, aws.LoadBalancerTypeClassic),
+		Entry("load balancer type set", &aws.ControlPlaneConfig{LoadBalancer: &aws.LoadBalancerConfig{Type: loadBalancerTypePtr(aws.LoadBalancerTypeNLB)}}, aws.LoadBalancerTypeNLB),
+	)
 })
 
+func loadBalancerTypePtr(t aws.LoadBalancerType) *aws.LoadBalancerType {
+	return &t
+}
+
 func expectResults(result, expected interface{}, err error, expectErr bool) {
 	if !expectErr {
 		Expect(result).To(Equal(expected))
@@ -50,6 +50,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&InfrastructureStatus{},
 		&ControlPlaneConfig{},
 		&WorkerStatus{},
+		&BackupBucketConfig{},
 	)
 	return nil
 }
@@ -15,6 +15,9 @@
 package aws
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -26,10 +29,99 @@ type ControlPlaneConfig struct {
 
 	// CloudControllerManager contains configuration settings for the cloud-controller-manager.
 	CloudControllerManager *CloudControllerManagerConfig
+
+	// LoadBalancer contains configuration settings for the load balancer of the kube-apiserver service.
+	LoadBalancer *LoadBalancerConfig
+
+	// StorageClasses contains additional storage classes that shall be deployed into the shoot cluster.
+	// If the CSI driver is used, each of them is additionally deployed with the CSI provisioner under its name
+	// suffixed with "-csi", and the default annotation moves to these storage classes.
+	StorageClasses []StorageClass
+
+	// ETCDBackup contains configuration settings for the etcd backup-restore sidecar.
+	ETCDBackup *ETCDBackupConfig
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
 type CloudControllerManagerConfig struct {
 	// FeatureGates contains information about enabled feature gates.
 	FeatureGates map[string]bool
+
+	// ConcurrentServiceSyncs is the number of services that are allowed to sync concurrently. Defaults to 10.
+	ConcurrentServiceSyncs *int32
+
+	// NodeMonitorPeriod is the period for syncing the status of the nodes with the cloud provider.
+	NodeMonitorPeriod *metav1.Duration
+
+	// Verbosity is the log verbosity of the cloud-controller-manager. Defaults to 2.
+	Verbosity *int32
+
+	// KubernetesClusterTag is the value of the legacy `KubernetesCluster` tag the cloud provider uses to identify the
+	// resources of the cluster. Defaults to the name of the cluster.
+	KubernetesClusterTag *string
+}
+
+// LoadBalancerConfig contains configuration settings for the load balancer of the kube-apiserver service.
+type LoadBalancerConfig struct {
+	// Type is the type of the AWS load balancer, either "classic" or "nlb". Defaults to "classic".
+	// It cannot be changed once the kube-apiserver service has been created. It is also the default type of new services
+	// of type LoadBalancer in the shoot which neither select a type nor require the proxy protocol.
+	Type *LoadBalancerType
+
+	// CrossZoneLoadBalancing indicates whether cross-zone load balancing shall be enabled.
+	CrossZoneLoadBalancing *bool
+
+	// Internal indicates whether the load balancer shall be internal instead of internet-facing.
+	Internal *bool
+}
+
+// LoadBalancerType is a string alias.
+type LoadBalancerType string
+
+const (
+	// LoadBalancerTypeClassic is the classic Elastic Load Balancer type.
+	LoadBalancerTypeClassic LoadBalancerType = "classic"
+	// LoadBalancerTypeNLB is the Network Load Balancer type.
+	LoadBalancerTypeNLB LoadBalancerType = "nlb"
+)
+
+// StorageClass contains configuration for a storage class deployed into the shoot cluster.
+type StorageClass struct {
+	// Name is the name of the storage class.
+	Name string
+
+	// Type is the volume type provisioned by the storage class.
+	Type string
+
+	// IOPS is the number of I/O operations per second per GiB. It may only be set for io1 volumes.
+	IOPS *int64
+
+	// Encrypted indicates whether the provisioned volumes shall be encrypted.
+	Encrypted *bool
+
+	// ReclaimPolicy is the reclaim policy of the dynamically provisioned volumes. Defaults to Delete.
+	ReclaimPolicy *corev1.PersistentVolumeReclaimPolicy
+
+	// VolumeBindingMode indicates how volumes shall be provisioned and bound. Defaults to Immediate.
+	VolumeBindingMode *storagev1.VolumeBindingMode
+
+	// Default indicates whether the storage class shall be the default storage class of the shoot cluster.
+	Default *bool
+}
+
+// ETCDBackupConfig contains configuration settings for the etcd backup-restore sidecar.
+type ETCDBackupConfig struct {
+	// DeltaSnapshotPeriod is the period after which delta snapshots are taken. Defaults to 5m.
+	DeltaSnapshotPeriod *metav1.Duration
+	// DeltaSnapshotMemoryLimit is the size of accumulated events after which a delta snapshot is taken
+	// before the delta snapshot period has passed. Defaults to 100Mi.
+	DeltaSnapshotMemoryLimit *resource.Quantity
+	// GarbageCollectionPeriod is the period after which old snapshots are garbage collected. Defaults to 12h.
+	GarbageCollectionPeriod *metav1.Duration
+	// GarbageCollectionPolicy is the policy for garbage collecting old snapshots, either Exponential or LimitBased.
+	GarbageCollectionPolicy *string
+	// MaxBackups is the maximum number of full snapshots kept by the LimitBased garbage collection policy.
+	MaxBackups *int32
+	// Resources are the compute resources of the backup-restore sidecar.
+	Resources *corev1.ResourceRequirements
 }
@@ -48,6 +48,16 @@ type Networks struct {
 	VPC VPC
 	// Zones belonging to the same region
 	Zones []Zone
+	// DualStack contains the IPv6 settings of the VPC and the subnets.
+	DualStack *DualStack
+}
+
+// DualStack contains the IPv6 settings of the VPC and the subnets.
+type DualStack struct {
+	// Enabled indicates whether IPv6 CIDR blocks are assigned to the VPC and all subnets, and whether
+	// an egress-only internet gateway and IPv6 security group rules are created. Machines do not get IPv6 addresses
+	// yet as the machine-controller-manager does not support them.
+	Enabled bool
 }
 
 // Zone describes the properties of a zone
@@ -88,6 +98,8 @@ type VPC struct {
 type VPCStatus struct {
 	// ID is the VPC id.
 	ID string
+	// IPv6CIDR is the IPv6 CIDR block of the VPC (only set for dual-stack infrastructures).
+	IPv6CIDR string
 	// Subnets is a list of subnets that have been created.
 	Subnets []Subnet
 	// SecurityGroups is a list of security groups that have been created.
@@ -127,6 +139,8 @@ type Subnet struct {
 	ID string
 	// Zone is the availability zone into which the subnet has been created.
 	Zone string
+	// IPv6CIDR is the IPv6 CIDR block of the subnet (only set for dual-stack infrastructures).
+	IPv6CIDR string
 }
 
 // SecurityGroup is an AWS security group related to a VPC.
@@ -15,6 +15,9 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -27,6 +30,20 @@ type ControlPlaneConfig struct {
 	// CloudControllerManager contains configuration settings for the cloud-controller-manager.
 	// +optional
 	CloudControllerManager *CloudControllerManagerConfig `json:"cloudControllerManager,omitempty"`
+
+	// LoadBalancer contains configuration settings for the load balancer of the kube-apiserver service.
+	// +optional
+	LoadBalancer *LoadBalancerConfig `json:"loadBalancer,omitempty"`
+
+	// StorageClasses contains additional storage classes that shall be deployed into the shoot cluster.
+	// If the CSI driver is used, each of them is additionally deployed with the CSI provisioner under its name
+	// suffixed with "-csi", and the default annotation moves to these storage classes.
+	// +optional
+	StorageClasses []StorageClass `json:"storageClasses,omitempty"`
+
+	// ETCDBackup contains configuration settings for the etcd backup-restore sidecar.
+	// +optional
+	ETCDBackup *ETCDBackupConfig `json:"etcdBackup,omitempty"`
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
@@ -34,4 +51,100 @@ type CloudControllerManagerConfig struct {
 	// FeatureGates contains information about enabled feature gates.
 	// +optional
 	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+
+	// ConcurrentServiceSyncs is the number of services that are allowed to sync concurrently. Defaults to 10.
+	// +optional
+	ConcurrentServiceSyncs *int32 `json:"concurrentServiceSyncs,omitempty"`
+
+	// NodeMonitorPeriod is the period for syncing the status of the nodes with the cloud provider.
+	// +optional
+	NodeMonitorPeriod *metav1.Duration `json:"nodeMonitorPeriod,omitempty"`
+
+	// Verbosity is the log verbosity of the cloud-controller-manager. Defaults to 2.
+	// +optional
+	Verbosity *int32 `json:"verbosity,omitempty"`
+
+	// KubernetesClusterTag is the value of the legacy `KubernetesCluster` tag the cloud provider uses to identify the
+	// resources of the cluster. Defaults to the name of the cluster.
+	// +optional
+	KubernetesClusterTag *string `json:"kubernetesClusterTag,omitempty"`
+}
+
+// LoadBalancerConfig contains configuration settings for the load balancer of the kube-apiserver service.
+type LoadBalancerConfig struct {
+	// Type is the type of the AWS load balancer, either "classic" or "nlb". Defaults to "classic".
+	// It cannot be changed once the kube-apiserver service has been created. It is also the default type of new services
+	// of type LoadBalancer in the shoot which neither select a type nor require the proxy protocol.
+	// +optional
+	Type *LoadBalancerType `json:"type,omitempty"`
+
+	// CrossZoneLoadBalancing indicates whether cross-zone load balancing shall be enabled.
+	// +optional
+	CrossZoneLoadBalancing *bool `json:"crossZoneLoadBalancing,omitempty"`
+
+	// Internal indicates whether the load balancer shall be internal instead of internet-facing.
+	// +optional
+	Internal *bool `json:"internal,omitempty"`
+}
+
+// LoadBalancerType is a string alias.
+type LoadBalancerType string
+
+const (
+	// LoadBalancerTypeClassic is the classic Elastic Load Balancer type.
+	LoadBalancerTypeClassic LoadBalancerType = "classic"
+	// LoadBalancerTypeNLB is the Network Load Balancer type.
+	LoadBalancerTypeNLB LoadBalancerType = "nlb"
+)
+
+// StorageClass contains configuration for a storage class deployed into the shoot cluster.
+type StorageClass struct {
+	// Name is the name of the storage class.
+	Name string `json:"name"`
+
+	// Type is the volume type provisioned by the storage class.
+	Type string `json:"type"`
+
+	// IOPS is the number of I/O operations per second per GiB. It may only be set for io1 volumes.
+	// +optional
+	IOPS *int64 `json:"iops,omitempty"`
+
+	// Encrypted indicates whether the provisioned volumes shall be encrypted.
+	// +optional
+	Encrypted *bool `json:"encrypted,omitempty"`
+
+	// ReclaimPolicy is the reclaim policy of the dynamically provisioned volumes. Defaults to Delete.
+	// +optional
+	ReclaimPolicy *corev1.PersistentVolumeReclaimPolicy `json:"reclaimPolicy,omitempty"`
+
+	// VolumeBindingMode indicates how volumes shall be provisioned and bound. Defaults to Immediate.
+	// +optional
+	VolumeBindingMode *storagev1.VolumeBindingMode `json:"volumeBindingMode,omitempty"`
+
+	// Default indicates whether the storage class shall be the default storage class of the shoot cluster.
+	// +optional
+	Default *bool `json:"default,omitempty"`
+}
+
+// ETCDBackupConfig contains configuration settings for the etcd backup-restore sidecar.
+type ETCDBackupConfig struct {
+	// DeltaSnapshotPeriod is the period after which delta snapshots are taken. Defaults to 5m.
+	// +optional
+	DeltaSnapshotPeriod *metav1.Duration `json:"deltaSnapshotPeriod,omitempty"`
+	// DeltaSnapshotMemoryLimit is the size of accumulated events after which a delta snapshot is taken
+	// before the delta snapshot period has passed. Defaults to 100Mi.
+	// +optional
+	DeltaSnapshotMemoryLimit *resource.Quantity `json:"deltaSnapshotMemoryLimit,omitempty"`
+	// GarbageCollectionPeriod is the period after which old snapshots are garbage collected. Defaults to 12h.
+	// +optional
+	GarbageCollectionPeriod *metav1.Duration `json:"garbageCollectionPeriod,omitempty"`
+	// GarbageCollectionPolicy is the policy for garbage collecting old snapshots, either Exponential or LimitBased.
+	// +optional
+	GarbageCollectionPolicy *string `json:"garbageCollectionPolicy,omitempty"`
+	// MaxBackups is the maximum number of full snapshots kept by the LimitBased garbage collection policy.
+	// +optional
+	MaxBackups *int32 `json:"maxBackups,omitempty"`
+	// Resources are the compute resources of the backup-restore sidecar.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
 }
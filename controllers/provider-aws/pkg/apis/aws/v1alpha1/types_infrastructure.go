@@ -48,6 +48,17 @@ type Networks struct {
 	VPC VPC `json:"vpc"`
 	// Zones belonging to the same region
 	Zones []Zone `json:"zones"`
+	// DualStack contains the IPv6 settings of the VPC and the subnets.
+	// +optional
+	DualStack *DualStack `json:"dualStack,omitempty"`
+}
+
+// DualStack contains the IPv6 settings of the VPC and the subnets.
+type DualStack struct {
+	// Enabled indicates whether IPv6 CIDR blocks are assigned to the VPC and all subnets, and whether
+	// an egress-only internet gateway and IPv6 security group rules are created. Machines do not get IPv6 addresses
+	// yet as the machine-controller-manager does not support them.
+	Enabled bool `json:"enabled"`
 }
 
 // Zone describes the properties of a zone
@@ -90,6 +101,9 @@ type VPC struct {
 type VPCStatus struct {
 	// ID is the VPC id.
 	ID string `json:"id"`
+	// IPv6CIDR is the IPv6 CIDR block of the VPC (only set for dual-stack infrastructures).
+	// +optional
+	IPv6CIDR string `json:"ipv6CIDR,omitempty"`
 	// Subnets is a list of subnets that have been created.
 	Subnets []Subnet `json:"subnets"`
 	// SecurityGroups is a list of security groups that have been created.
@@ -129,6 +143,9 @@ type Subnet struct {
 	ID string `json:"id"`
 	// Zone is the availability zone into which the subnet has been created.
 	Zone string `json:"zone"`
+	// IPv6CIDR is the IPv6 CIDR block of the subnet (only set for dual-stack infrastructures).
+	// +optional
+	IPv6CIDR string `json:"ipv6CIDR,omitempty"`
 }
 
 // SecurityGroup is an AWS security group related to a VPC.
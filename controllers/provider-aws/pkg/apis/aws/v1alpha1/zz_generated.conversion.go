@@ -24,6 +24,10 @@ import (
 	unsafe "unsafe"
 
 	aws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -35,6 +39,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*BackupBucketConfig)(nil), (*aws.BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketConfig_To_aws_BackupBucketConfig(a.(*BackupBucketConfig), b.(*aws.BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.BackupBucketConfig)(nil), (*BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(a.(*aws.BackupBucketConfig), b.(*BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudControllerManagerConfig)(nil), (*aws.CloudControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudControllerManagerConfig_To_aws_CloudControllerManagerConfig(a.(*CloudControllerManagerConfig), b.(*aws.CloudControllerManagerConfig), scope)
 	}); err != nil {
@@ -65,6 +79,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DualStack)(nil), (*aws.DualStack)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DualStack_To_aws_DualStack(a.(*DualStack), b.(*aws.DualStack), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.DualStack)(nil), (*DualStack)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_DualStack_To_v1alpha1_DualStack(a.(*aws.DualStack), b.(*DualStack), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*EC2)(nil), (*aws.EC2)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_EC2_To_aws_EC2(a.(*EC2), b.(*aws.EC2), scope)
 	}); err != nil {
@@ -75,6 +99,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ETCDBackupConfig)(nil), (*aws.ETCDBackupConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ETCDBackupConfig_To_aws_ETCDBackupConfig(a.(*ETCDBackupConfig), b.(*aws.ETCDBackupConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.ETCDBackupConfig)(nil), (*ETCDBackupConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_ETCDBackupConfig_To_v1alpha1_ETCDBackupConfig(a.(*aws.ETCDBackupConfig), b.(*ETCDBackupConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EncryptionConfig)(nil), (*aws.EncryptionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EncryptionConfig_To_aws_EncryptionConfig(a.(*EncryptionConfig), b.(*aws.EncryptionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.EncryptionConfig)(nil), (*EncryptionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_EncryptionConfig_To_v1alpha1_EncryptionConfig(a.(*aws.EncryptionConfig), b.(*EncryptionConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*IAM)(nil), (*aws.IAM)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_IAM_To_aws_IAM(a.(*IAM), b.(*aws.IAM), scope)
 	}); err != nil {
@@ -115,6 +159,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*LifecycleConfig)(nil), (*aws.LifecycleConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LifecycleConfig_To_aws_LifecycleConfig(a.(*LifecycleConfig), b.(*aws.LifecycleConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.LifecycleConfig)(nil), (*LifecycleConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_LifecycleConfig_To_v1alpha1_LifecycleConfig(a.(*aws.LifecycleConfig), b.(*LifecycleConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerConfig)(nil), (*aws.LoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerConfig_To_aws_LoadBalancerConfig(a.(*LoadBalancerConfig), b.(*aws.LoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.LoadBalancerConfig)(nil), (*LoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(a.(*aws.LoadBalancerConfig), b.(*LoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineImage)(nil), (*aws.MachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachineImage_To_aws_MachineImage(a.(*MachineImage), b.(*aws.MachineImage), scope)
 	}); err != nil {
@@ -155,6 +219,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ObjectLockConfig)(nil), (*aws.ObjectLockConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ObjectLockConfig_To_aws_ObjectLockConfig(a.(*ObjectLockConfig), b.(*aws.ObjectLockConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.ObjectLockConfig)(nil), (*ObjectLockConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_ObjectLockConfig_To_v1alpha1_ObjectLockConfig(a.(*aws.ObjectLockConfig), b.(*ObjectLockConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RegionAMIMapping)(nil), (*aws.RegionAMIMapping)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RegionAMIMapping_To_aws_RegionAMIMapping(a.(*RegionAMIMapping), b.(*aws.RegionAMIMapping), scope)
 	}); err != nil {
@@ -185,6 +259,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*StorageClass)(nil), (*aws.StorageClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageClass_To_aws_StorageClass(a.(*StorageClass), b.(*aws.StorageClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.StorageClass)(nil), (*StorageClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_StorageClass_To_v1alpha1_StorageClass(a.(*aws.StorageClass), b.(*StorageClass), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Subnet)(nil), (*aws.Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Subnet_To_aws_Subnet(a.(*Subnet), b.(*aws.Subnet), scope)
 	}); err != nil {
@@ -238,8 +322,38 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha1_BackupBucketConfig_To_aws_BackupBucketConfig(in *BackupBucketConfig, out *aws.BackupBucketConfig, s conversion.Scope) error {
+	out.Versioning = (*bool)(unsafe.Pointer(in.Versioning))
+	out.ObjectLock = (*aws.ObjectLockConfig)(unsafe.Pointer(in.ObjectLock))
+	out.Encryption = (*aws.EncryptionConfig)(unsafe.Pointer(in.Encryption))
+	out.Lifecycle = (*aws.LifecycleConfig)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketConfig_To_aws_BackupBucketConfig is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketConfig_To_aws_BackupBucketConfig(in *BackupBucketConfig, out *aws.BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketConfig_To_aws_BackupBucketConfig(in, out, s)
+}
+
+func autoConvert_aws_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *aws.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	out.Versioning = (*bool)(unsafe.Pointer(in.Versioning))
+	out.ObjectLock = (*ObjectLockConfig)(unsafe.Pointer(in.ObjectLock))
+	out.Encryption = (*EncryptionConfig)(unsafe.Pointer(in.Encryption))
+	out.Lifecycle = (*LifecycleConfig)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_aws_BackupBucketConfig_To_v1alpha1_BackupBucketConfig is an autogenerated conversion function.
+func Convert_aws_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *aws.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_aws_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudControllerManagerConfig_To_aws_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *aws.CloudControllerManagerConfig, s conversion.Scope) error {
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.ConcurrentServiceSyncs = (*int32)(unsafe.Pointer(in.ConcurrentServiceSyncs))
+	out.NodeMonitorPeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorPeriod))
+	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
+	out.KubernetesClusterTag = (*string)(unsafe.Pointer(in.KubernetesClusterTag))
 	return nil
 }
 
@@ -250,6 +364,10 @@ func Convert_v1alpha1_CloudControllerManagerConfig_To_aws_CloudControllerManager
 
 func autoConvert_aws_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in *aws.CloudControllerManagerConfig, out *CloudControllerManagerConfig, s conversion.Scope) error {
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.ConcurrentServiceSyncs = (*int32)(unsafe.Pointer(in.ConcurrentServiceSyncs))
+	out.NodeMonitorPeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorPeriod))
+	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
+	out.KubernetesClusterTag = (*string)(unsafe.Pointer(in.KubernetesClusterTag))
 	return nil
 }
 
@@ -280,6 +398,9 @@ func Convert_aws_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *aws.Cloud
 
 func autoConvert_v1alpha1_ControlPlaneConfig_To_aws_ControlPlaneConfig(in *ControlPlaneConfig, out *aws.ControlPlaneConfig, s conversion.Scope) error {
 	out.CloudControllerManager = (*aws.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.LoadBalancer = (*aws.LoadBalancerConfig)(unsafe.Pointer(in.LoadBalancer))
+	out.StorageClasses = *(*[]aws.StorageClass)(unsafe.Pointer(&in.StorageClasses))
+	out.ETCDBackup = (*aws.ETCDBackupConfig)(unsafe.Pointer(in.ETCDBackup))
 	return nil
 }
 
@@ -290,6 +411,9 @@ func Convert_v1alpha1_ControlPlaneConfig_To_aws_ControlPlaneConfig(in *ControlPl
 
 func autoConvert_aws_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *aws.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
 	out.CloudControllerManager = (*CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.LoadBalancer = (*LoadBalancerConfig)(unsafe.Pointer(in.LoadBalancer))
+	out.StorageClasses = *(*[]StorageClass)(unsafe.Pointer(&in.StorageClasses))
+	out.ETCDBackup = (*ETCDBackupConfig)(unsafe.Pointer(in.ETCDBackup))
 	return nil
 }
 
@@ -298,6 +422,26 @@ func Convert_aws_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *aws.Contr
 	return autoConvert_aws_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_DualStack_To_aws_DualStack(in *DualStack, out *aws.DualStack, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_DualStack_To_aws_DualStack is an autogenerated conversion function.
+func Convert_v1alpha1_DualStack_To_aws_DualStack(in *DualStack, out *aws.DualStack, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DualStack_To_aws_DualStack(in, out, s)
+}
+
+func autoConvert_aws_DualStack_To_v1alpha1_DualStack(in *aws.DualStack, out *DualStack, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_aws_DualStack_To_v1alpha1_DualStack is an autogenerated conversion function.
+func Convert_aws_DualStack_To_v1alpha1_DualStack(in *aws.DualStack, out *DualStack, s conversion.Scope) error {
+	return autoConvert_aws_DualStack_To_v1alpha1_DualStack(in, out, s)
+}
+
 func autoConvert_v1alpha1_EC2_To_aws_EC2(in *EC2, out *aws.EC2, s conversion.Scope) error {
 	out.KeyName = in.KeyName
 	return nil
@@ -318,6 +462,56 @@ func Convert_aws_EC2_To_v1alpha1_EC2(in *aws.EC2, out *EC2, s conversion.Scope)
 	return autoConvert_aws_EC2_To_v1alpha1_EC2(in, out, s)
 }
 
+func autoConvert_v1alpha1_ETCDBackupConfig_To_aws_ETCDBackupConfig(in *ETCDBackupConfig, out *aws.ETCDBackupConfig, s conversion.Scope) error {
+	out.DeltaSnapshotPeriod = (*v1.Duration)(unsafe.Pointer(in.DeltaSnapshotPeriod))
+	out.DeltaSnapshotMemoryLimit = (*resource.Quantity)(unsafe.Pointer(in.DeltaSnapshotMemoryLimit))
+	out.GarbageCollectionPeriod = (*v1.Duration)(unsafe.Pointer(in.GarbageCollectionPeriod))
+	out.GarbageCollectionPolicy = (*string)(unsafe.Pointer(in.GarbageCollectionPolicy))
+	out.MaxBackups = (*int32)(unsafe.Pointer(in.MaxBackups))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	return nil
+}
+
+// Convert_v1alpha1_ETCDBackupConfig_To_aws_ETCDBackupConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ETCDBackupConfig_To_aws_ETCDBackupConfig(in *ETCDBackupConfig, out *aws.ETCDBackupConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ETCDBackupConfig_To_aws_ETCDBackupConfig(in, out, s)
+}
+
+func autoConvert_aws_ETCDBackupConfig_To_v1alpha1_ETCDBackupConfig(in *aws.ETCDBackupConfig, out *ETCDBackupConfig, s conversion.Scope) error {
+	out.DeltaSnapshotPeriod = (*v1.Duration)(unsafe.Pointer(in.DeltaSnapshotPeriod))
+	out.DeltaSnapshotMemoryLimit = (*resource.Quantity)(unsafe.Pointer(in.DeltaSnapshotMemoryLimit))
+	out.GarbageCollectionPeriod = (*v1.Duration)(unsafe.Pointer(in.GarbageCollectionPeriod))
+	out.GarbageCollectionPolicy = (*string)(unsafe.Pointer(in.GarbageCollectionPolicy))
+	out.MaxBackups = (*int32)(unsafe.Pointer(in.MaxBackups))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	return nil
+}
+
+// Convert_aws_ETCDBackupConfig_To_v1alpha1_ETCDBackupConfig is an autogenerated conversion function.
+func Convert_aws_ETCDBackupConfig_To_v1alpha1_ETCDBackupConfig(in *aws.ETCDBackupConfig, out *ETCDBackupConfig, s conversion.Scope) error {
+	return autoConvert_aws_ETCDBackupConfig_To_v1alpha1_ETCDBackupConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_EncryptionConfig_To_aws_EncryptionConfig(in *EncryptionConfig, out *aws.EncryptionConfig, s conversion.Scope) error {
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
+	return nil
+}
+
+// Convert_v1alpha1_EncryptionConfig_To_aws_EncryptionConfig is an autogenerated conversion function.
+func Convert_v1alpha1_EncryptionConfig_To_aws_EncryptionConfig(in *EncryptionConfig, out *aws.EncryptionConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EncryptionConfig_To_aws_EncryptionConfig(in, out, s)
+}
+
+func autoConvert_aws_EncryptionConfig_To_v1alpha1_EncryptionConfig(in *aws.EncryptionConfig, out *EncryptionConfig, s conversion.Scope) error {
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
+	return nil
+}
+
+// Convert_aws_EncryptionConfig_To_v1alpha1_EncryptionConfig is an autogenerated conversion function.
+func Convert_aws_EncryptionConfig_To_v1alpha1_EncryptionConfig(in *aws.EncryptionConfig, out *EncryptionConfig, s conversion.Scope) error {
+	return autoConvert_aws_EncryptionConfig_To_v1alpha1_EncryptionConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_IAM_To_aws_IAM(in *IAM, out *aws.IAM, s conversion.Scope) error {
 	out.InstanceProfiles = *(*[]aws.InstanceProfile)(unsafe.Pointer(&in.InstanceProfiles))
 	out.Roles = *(*[]aws.Role)(unsafe.Pointer(&in.Roles))
@@ -422,6 +616,52 @@ func Convert_aws_InstanceProfile_To_v1alpha1_InstanceProfile(in *aws.InstancePro
 	return autoConvert_aws_InstanceProfile_To_v1alpha1_InstanceProfile(in, out, s)
 }
 
+func autoConvert_v1alpha1_LifecycleConfig_To_aws_LifecycleConfig(in *LifecycleConfig, out *aws.LifecycleConfig, s conversion.Scope) error {
+	out.ExpirationDays = (*int32)(unsafe.Pointer(in.ExpirationDays))
+	out.NoncurrentVersionExpirationDays = (*int32)(unsafe.Pointer(in.NoncurrentVersionExpirationDays))
+	return nil
+}
+
+// Convert_v1alpha1_LifecycleConfig_To_aws_LifecycleConfig is an autogenerated conversion function.
+func Convert_v1alpha1_LifecycleConfig_To_aws_LifecycleConfig(in *LifecycleConfig, out *aws.LifecycleConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LifecycleConfig_To_aws_LifecycleConfig(in, out, s)
+}
+
+func autoConvert_aws_LifecycleConfig_To_v1alpha1_LifecycleConfig(in *aws.LifecycleConfig, out *LifecycleConfig, s conversion.Scope) error {
+	out.ExpirationDays = (*int32)(unsafe.Pointer(in.ExpirationDays))
+	out.NoncurrentVersionExpirationDays = (*int32)(unsafe.Pointer(in.NoncurrentVersionExpirationDays))
+	return nil
+}
+
+// Convert_aws_LifecycleConfig_To_v1alpha1_LifecycleConfig is an autogenerated conversion function.
+func Convert_aws_LifecycleConfig_To_v1alpha1_LifecycleConfig(in *aws.LifecycleConfig, out *LifecycleConfig, s conversion.Scope) error {
+	return autoConvert_aws_LifecycleConfig_To_v1alpha1_LifecycleConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_LoadBalancerConfig_To_aws_LoadBalancerConfig(in *LoadBalancerConfig, out *aws.LoadBalancerConfig, s conversion.Scope) error {
+	out.Type = (*aws.LoadBalancerType)(unsafe.Pointer(in.Type))
+	out.CrossZoneLoadBalancing = (*bool)(unsafe.Pointer(in.CrossZoneLoadBalancing))
+	out.Internal = (*bool)(unsafe.Pointer(in.Internal))
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancerConfig_To_aws_LoadBalancerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_LoadBalancerConfig_To_aws_LoadBalancerConfig(in *LoadBalancerConfig, out *aws.LoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoadBalancerConfig_To_aws_LoadBalancerConfig(in, out, s)
+}
+
+func autoConvert_aws_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in *aws.LoadBalancerConfig, out *LoadBalancerConfig, s conversion.Scope) error {
+	out.Type = (*LoadBalancerType)(unsafe.Pointer(in.Type))
+	out.CrossZoneLoadBalancing = (*bool)(unsafe.Pointer(in.CrossZoneLoadBalancing))
+	out.Internal = (*bool)(unsafe.Pointer(in.Internal))
+	return nil
+}
+
+// Convert_aws_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig is an autogenerated conversion function.
+func Convert_aws_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in *aws.LoadBalancerConfig, out *LoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_aws_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_MachineImage_To_aws_MachineImage(in *MachineImage, out *aws.MachineImage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Version = in.Version
@@ -495,6 +735,7 @@ func autoConvert_v1alpha1_Networks_To_aws_Networks(in *Networks, out *aws.Networ
 		return err
 	}
 	out.Zones = *(*[]aws.Zone)(unsafe.Pointer(&in.Zones))
+	out.DualStack = (*aws.DualStack)(unsafe.Pointer(in.DualStack))
 	return nil
 }
 
@@ -508,6 +749,7 @@ func autoConvert_aws_Networks_To_v1alpha1_Networks(in *aws.Networks, out *Networ
 		return err
 	}
 	out.Zones = *(*[]Zone)(unsafe.Pointer(&in.Zones))
+	out.DualStack = (*DualStack)(unsafe.Pointer(in.DualStack))
 	return nil
 }
 
@@ -516,6 +758,28 @@ func Convert_aws_Networks_To_v1alpha1_Networks(in *aws.Networks, out *Networks,
 	return autoConvert_aws_Networks_To_v1alpha1_Networks(in, out, s)
 }
 
+func autoConvert_v1alpha1_ObjectLockConfig_To_aws_ObjectLockConfig(in *ObjectLockConfig, out *aws.ObjectLockConfig, s conversion.Scope) error {
+	out.Mode = aws.ObjectLockMode(in.Mode)
+	out.Days = in.Days
+	return nil
+}
+
+// Convert_v1alpha1_ObjectLockConfig_To_aws_ObjectLockConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ObjectLockConfig_To_aws_ObjectLockConfig(in *ObjectLockConfig, out *aws.ObjectLockConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ObjectLockConfig_To_aws_ObjectLockConfig(in, out, s)
+}
+
+func autoConvert_aws_ObjectLockConfig_To_v1alpha1_ObjectLockConfig(in *aws.ObjectLockConfig, out *ObjectLockConfig, s conversion.Scope) error {
+	out.Mode = ObjectLockMode(in.Mode)
+	out.Days = in.Days
+	return nil
+}
+
+// Convert_aws_ObjectLockConfig_To_v1alpha1_ObjectLockConfig is an autogenerated conversion function.
+func Convert_aws_ObjectLockConfig_To_v1alpha1_ObjectLockConfig(in *aws.ObjectLockConfig, out *ObjectLockConfig, s conversion.Scope) error {
+	return autoConvert_aws_ObjectLockConfig_To_v1alpha1_ObjectLockConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_RegionAMIMapping_To_aws_RegionAMIMapping(in *RegionAMIMapping, out *aws.RegionAMIMapping, s conversion.Scope) error {
 	out.Name = in.Name
 	out.AMI = in.AMI
@@ -582,10 +846,43 @@ func Convert_aws_SecurityGroup_To_v1alpha1_SecurityGroup(in *aws.SecurityGroup,
 	return autoConvert_aws_SecurityGroup_To_v1alpha1_SecurityGroup(in, out, s)
 }
 
+func autoConvert_v1alpha1_StorageClass_To_aws_StorageClass(in *StorageClass, out *aws.StorageClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	out.IOPS = (*int64)(unsafe.Pointer(in.IOPS))
+	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	out.ReclaimPolicy = (*corev1.PersistentVolumeReclaimPolicy)(unsafe.Pointer(in.ReclaimPolicy))
+	out.VolumeBindingMode = (*storagev1.VolumeBindingMode)(unsafe.Pointer(in.VolumeBindingMode))
+	out.Default = (*bool)(unsafe.Pointer(in.Default))
+	return nil
+}
+
+// Convert_v1alpha1_StorageClass_To_aws_StorageClass is an autogenerated conversion function.
+func Convert_v1alpha1_StorageClass_To_aws_StorageClass(in *StorageClass, out *aws.StorageClass, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageClass_To_aws_StorageClass(in, out, s)
+}
+
+func autoConvert_aws_StorageClass_To_v1alpha1_StorageClass(in *aws.StorageClass, out *StorageClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	out.IOPS = (*int64)(unsafe.Pointer(in.IOPS))
+	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	out.ReclaimPolicy = (*corev1.PersistentVolumeReclaimPolicy)(unsafe.Pointer(in.ReclaimPolicy))
+	out.VolumeBindingMode = (*storagev1.VolumeBindingMode)(unsafe.Pointer(in.VolumeBindingMode))
+	out.Default = (*bool)(unsafe.Pointer(in.Default))
+	return nil
+}
+
+// Convert_aws_StorageClass_To_v1alpha1_StorageClass is an autogenerated conversion function.
+func Convert_aws_StorageClass_To_v1alpha1_StorageClass(in *aws.StorageClass, out *StorageClass, s conversion.Scope) error {
+	return autoConvert_aws_StorageClass_To_v1alpha1_StorageClass(in, out, s)
+}
+
 func autoConvert_v1alpha1_Subnet_To_aws_Subnet(in *Subnet, out *aws.Subnet, s conversion.Scope) error {
 	out.Purpose = in.Purpose
 	out.ID = in.ID
 	out.Zone = in.Zone
+	out.IPv6CIDR = in.IPv6CIDR
 	return nil
 }
 
@@ -598,6 +895,7 @@ func autoConvert_aws_Subnet_To_v1alpha1_Subnet(in *aws.Subnet, out *Subnet, s co
 	out.Purpose = in.Purpose
 	out.ID = in.ID
 	out.Zone = in.Zone
+	out.IPv6CIDR = in.IPv6CIDR
 	return nil
 }
 
@@ -630,6 +928,7 @@ func Convert_aws_VPC_To_v1alpha1_VPC(in *aws.VPC, out *VPC, s conversion.Scope)
 
 func autoConvert_v1alpha1_VPCStatus_To_aws_VPCStatus(in *VPCStatus, out *aws.VPCStatus, s conversion.Scope) error {
 	out.ID = in.ID
+	out.IPv6CIDR = in.IPv6CIDR
 	out.Subnets = *(*[]aws.Subnet)(unsafe.Pointer(&in.Subnets))
 	out.SecurityGroups = *(*[]aws.SecurityGroup)(unsafe.Pointer(&in.SecurityGroups))
 	return nil
@@ -642,6 +941,7 @@ func Convert_v1alpha1_VPCStatus_To_aws_VPCStatus(in *VPCStatus, out *aws.VPCStat
 
 func autoConvert_aws_VPCStatus_To_v1alpha1_VPCStatus(in *aws.VPCStatus, out *VPCStatus, s conversion.Scope) error {
 	out.ID = in.ID
+	out.IPv6CIDR = in.IPv6CIDR
 	out.Subnets = *(*[]Subnet)(unsafe.Pointer(&in.Subnets))
 	out.SecurityGroups = *(*[]SecurityGroup)(unsafe.Pointer(&in.SecurityGroups))
 	return nil
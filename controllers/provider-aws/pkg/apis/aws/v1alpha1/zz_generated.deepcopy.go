@@ -21,9 +21,57 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupBucketConfig) DeepCopyInto(out *BackupBucketConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Versioning != nil {
+		in, out := &in.Versioning, &out.Versioning
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ObjectLock != nil {
+		in, out := &in.ObjectLock, &out.ObjectLock
+		*out = new(ObjectLockConfig)
+		**out = **in
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(EncryptionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = new(LifecycleConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupBucketConfig.
+func (in *BackupBucketConfig) DeepCopy() *BackupBucketConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupBucketConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupBucketConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudControllerManagerConfig) DeepCopyInto(out *CloudControllerManagerConfig) {
 	*out = *in
@@ -34,6 +82,26 @@ func (in *CloudControllerManagerConfig) DeepCopyInto(out *CloudControllerManager
 			(*out)[key] = val
 		}
 	}
+	if in.ConcurrentServiceSyncs != nil {
+		in, out := &in.ConcurrentServiceSyncs, &out.ConcurrentServiceSyncs
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NodeMonitorPeriod != nil {
+		in, out := &in.NodeMonitorPeriod, &out.NodeMonitorPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Verbosity != nil {
+		in, out := &in.Verbosity, &out.Verbosity
+		*out = new(int32)
+		**out = **in
+	}
+	if in.KubernetesClusterTag != nil {
+		in, out := &in.KubernetesClusterTag, &out.KubernetesClusterTag
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -88,6 +156,23 @@ func (in *ControlPlaneConfig) DeepCopyInto(out *ControlPlaneConfig) {
 		*out = new(CloudControllerManagerConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(LoadBalancerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StorageClasses != nil {
+		in, out := &in.StorageClasses, &out.StorageClasses
+		*out = make([]StorageClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ETCDBackup != nil {
+		in, out := &in.ETCDBackup, &out.ETCDBackup
+		*out = new(ETCDBackupConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -109,6 +194,22 @@ func (in *ControlPlaneConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DualStack) DeepCopyInto(out *DualStack) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DualStack.
+func (in *DualStack) DeepCopy() *DualStack {
+	if in == nil {
+		return nil
+	}
+	out := new(DualStack)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EC2) DeepCopyInto(out *EC2) {
 	*out = *in
@@ -125,6 +226,73 @@ func (in *EC2) DeepCopy() *EC2 {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ETCDBackupConfig) DeepCopyInto(out *ETCDBackupConfig) {
+	*out = *in
+	if in.DeltaSnapshotPeriod != nil {
+		in, out := &in.DeltaSnapshotPeriod, &out.DeltaSnapshotPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DeltaSnapshotMemoryLimit != nil {
+		in, out := &in.DeltaSnapshotMemoryLimit, &out.DeltaSnapshotMemoryLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.GarbageCollectionPeriod != nil {
+		in, out := &in.GarbageCollectionPeriod, &out.GarbageCollectionPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.GarbageCollectionPolicy != nil {
+		in, out := &in.GarbageCollectionPolicy, &out.GarbageCollectionPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxBackups != nil {
+		in, out := &in.MaxBackups, &out.MaxBackups
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ETCDBackupConfig.
+func (in *ETCDBackupConfig) DeepCopy() *ETCDBackupConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ETCDBackupConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionConfig) DeepCopyInto(out *EncryptionConfig) {
+	*out = *in
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionConfig.
+func (in *EncryptionConfig) DeepCopy() *EncryptionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IAM) DeepCopyInto(out *IAM) {
 	*out = *in
@@ -221,6 +389,63 @@ func (in *InstanceProfile) DeepCopy() *InstanceProfile {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecycleConfig) DeepCopyInto(out *LifecycleConfig) {
+	*out = *in
+	if in.ExpirationDays != nil {
+		in, out := &in.ExpirationDays, &out.ExpirationDays
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NoncurrentVersionExpirationDays != nil {
+		in, out := &in.NoncurrentVersionExpirationDays, &out.NoncurrentVersionExpirationDays
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleConfig.
+func (in *LifecycleConfig) DeepCopy() *LifecycleConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecycleConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerConfig) DeepCopyInto(out *LoadBalancerConfig) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(LoadBalancerType)
+		**out = **in
+	}
+	if in.CrossZoneLoadBalancing != nil {
+		in, out := &in.CrossZoneLoadBalancing, &out.CrossZoneLoadBalancing
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Internal != nil {
+		in, out := &in.Internal, &out.Internal
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerConfig.
+func (in *LoadBalancerConfig) DeepCopy() *LoadBalancerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineImage) DeepCopyInto(out *MachineImage) {
 	*out = *in
@@ -290,6 +515,11 @@ func (in *Networks) DeepCopyInto(out *Networks) {
 		*out = make([]Zone, len(*in))
 		copy(*out, *in)
 	}
+	if in.DualStack != nil {
+		in, out := &in.DualStack, &out.DualStack
+		*out = new(DualStack)
+		**out = **in
+	}
 	return
 }
 
@@ -303,6 +533,22 @@ func (in *Networks) DeepCopy() *Networks {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLockConfig) DeepCopyInto(out *ObjectLockConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLockConfig.
+func (in *ObjectLockConfig) DeepCopy() *ObjectLockConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLockConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RegionAMIMapping) DeepCopyInto(out *RegionAMIMapping) {
 	*out = *in
@@ -351,6 +597,47 @@ func (in *SecurityGroup) DeepCopy() *SecurityGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageClass) DeepCopyInto(out *StorageClass) {
+	*out = *in
+	if in.IOPS != nil {
+		in, out := &in.IOPS, &out.IOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Encrypted != nil {
+		in, out := &in.Encrypted, &out.Encrypted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReclaimPolicy != nil {
+		in, out := &in.ReclaimPolicy, &out.ReclaimPolicy
+		*out = new(corev1.PersistentVolumeReclaimPolicy)
+		**out = **in
+	}
+	if in.VolumeBindingMode != nil {
+		in, out := &in.VolumeBindingMode, &out.VolumeBindingMode
+		*out = new(storagev1.VolumeBindingMode)
+		**out = **in
+	}
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageClass.
+func (in *StorageClass) DeepCopy() *StorageClass {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Subnet) DeepCopyInto(out *Subnet) {
 	*out = *in
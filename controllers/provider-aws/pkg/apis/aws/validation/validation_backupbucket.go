@@ -0,0 +1,74 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var supportedObjectLockModes = sets.NewString(string(apisaws.ObjectLockModeGovernance), string(apisaws.ObjectLockModeCompliance))
+
+// ValidateBackupBucketConfig validates a BackupBucketConfig object.
+func ValidateBackupBucketConfig(backupBucketConfig *apisaws.BackupBucketConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if objectLock := backupBucketConfig.ObjectLock; objectLock != nil {
+		objectLockPath := field.NewPath("objectLock")
+
+		if backupBucketConfig.Versioning != nil && !*backupBucketConfig.Versioning {
+			allErrs = append(allErrs, field.Forbidden(objectLockPath, "object lock requires versioning to be enabled"))
+		}
+		if !supportedObjectLockModes.Has(string(objectLock.Mode)) {
+			allErrs = append(allErrs, field.NotSupported(objectLockPath.Child("mode"), objectLock.Mode, supportedObjectLockModes.List()))
+		}
+		if objectLock.Days <= 0 {
+			allErrs = append(allErrs, field.Invalid(objectLockPath.Child("days"), objectLock.Days, "must be greater than 0"))
+		}
+	}
+
+	if encryption := backupBucketConfig.Encryption; encryption != nil && encryption.KMSKeyID != nil && len(*encryption.KMSKeyID) == 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("encryption", "kmsKeyID"), *encryption.KMSKeyID, "must not be empty"))
+	}
+
+	if lifecycle := backupBucketConfig.Lifecycle; lifecycle != nil {
+		lifecyclePath := field.NewPath("lifecycle")
+
+		if lifecycle.ExpirationDays != nil && *lifecycle.ExpirationDays <= 0 {
+			allErrs = append(allErrs, field.Invalid(lifecyclePath.Child("expirationDays"), *lifecycle.ExpirationDays, "must be greater than 0"))
+		}
+		if lifecycle.NoncurrentVersionExpirationDays != nil && *lifecycle.NoncurrentVersionExpirationDays <= 0 {
+			allErrs = append(allErrs, field.Invalid(lifecyclePath.Child("noncurrentVersionExpirationDays"), *lifecycle.NoncurrentVersionExpirationDays, "must be greater than 0"))
+		}
+		if objectLock := backupBucketConfig.ObjectLock; objectLock != nil && lifecycle.ExpirationDays != nil && *lifecycle.ExpirationDays < objectLock.Days {
+			allErrs = append(allErrs, field.Invalid(lifecyclePath.Child("expirationDays"), *lifecycle.ExpirationDays, "must not be less than the object lock retention days"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateBackupBucketConfigUpdate validates a BackupBucketConfig object update.
+func ValidateBackupBucketConfigUpdate(oldConfig, newConfig *apisaws.BackupBucketConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if (oldConfig.ObjectLock == nil) != (newConfig.ObjectLock == nil) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("objectLock"), "object lock can only be enabled or disabled when the bucket is created"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	. "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("BackupBucketConfig validation", func() {
+	var backupBucketConfig *apisaws.BackupBucketConfig
+
+	BeforeEach(func() {
+		backupBucketConfig = &apisaws.BackupBucketConfig{}
+	})
+
+	Describe("#ValidateBackupBucketConfig", func() {
+		It("should allow an empty configuration", func() {
+			Expect(ValidateBackupBucketConfig(backupBucketConfig)).To(BeEmpty())
+		})
+
+		It("should allow a valid configuration", func() {
+			var (
+				versioning                      = true
+				kmsKeyID                        = "key-id"
+				expirationDays                  = int32(30)
+				noncurrentVersionExpirationDays = int32(7)
+			)
+			backupBucketConfig.Versioning = &versioning
+			backupBucketConfig.ObjectLock = &apisaws.ObjectLockConfig{
+				Mode: apisaws.ObjectLockModeCompliance,
+				Days: 14,
+			}
+			backupBucketConfig.Encryption = &apisaws.EncryptionConfig{KMSKeyID: &kmsKeyID}
+			backupBucketConfig.Lifecycle = &apisaws.LifecycleConfig{
+				ExpirationDays:                  &expirationDays,
+				NoncurrentVersionExpirationDays: &noncurrentVersionExpirationDays,
+			}
+
+			Expect(ValidateBackupBucketConfig(backupBucketConfig)).To(BeEmpty())
+		})
+
+		It("should forbid object lock if versioning is disabled", func() {
+			versioning := false
+			backupBucketConfig.Versioning = &versioning
+			backupBucketConfig.ObjectLock = &apisaws.ObjectLockConfig{
+				Mode: apisaws.ObjectLockModeGovernance,
+				Days: 14,
+			}
+
+			Expect(ValidateBackupBucketConfig(backupBucketConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("objectLock"),
+				})),
+			))
+		})
+
+		It("should forbid an invalid configuration", func() {
+			var (
+				kmsKeyID                        = ""
+				expirationDays                  = int32(7)
+				noncurrentVersionExpirationDays = int32(0)
+			)
+			backupBucketConfig.ObjectLock = &apisaws.ObjectLockConfig{
+				Mode: "Legal",
+				Days: 14,
+			}
+			backupBucketConfig.Encryption = &apisaws.EncryptionConfig{KMSKeyID: &kmsKeyID}
+			backupBucketConfig.Lifecycle = &apisaws.LifecycleConfig{
+				ExpirationDays:                  &expirationDays,
+				NoncurrentVersionExpirationDays: &noncurrentVersionExpirationDays,
+			}
+
+			Expect(ValidateBackupBucketConfig(backupBucketConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("objectLock.mode"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("encryption.kmsKeyID"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("lifecycle.noncurrentVersionExpirationDays"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("lifecycle.expirationDays"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateBackupBucketConfigUpdate", func() {
+		It("should allow changing the default retention", func() {
+			backupBucketConfig.ObjectLock = &apisaws.ObjectLockConfig{Mode: apisaws.ObjectLockModeGovernance, Days: 7}
+			newConfig := backupBucketConfig.DeepCopy()
+			newConfig.ObjectLock = &apisaws.ObjectLockConfig{Mode: apisaws.ObjectLockModeCompliance, Days: 30}
+
+			Expect(ValidateBackupBucketConfigUpdate(backupBucketConfig, newConfig)).To(BeEmpty())
+		})
+
+		It("should forbid enabling object lock", func() {
+			newConfig := backupBucketConfig.DeepCopy()
+			newConfig.ObjectLock = &apisaws.ObjectLockConfig{Mode: apisaws.ObjectLockModeGovernance, Days: 7}
+
+			Expect(ValidateBackupBucketConfigUpdate(backupBucketConfig, newConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("objectLock"),
+				})),
+			))
+		})
+	})
+})
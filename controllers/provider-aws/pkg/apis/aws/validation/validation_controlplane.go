@@ -0,0 +1,147 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/helper"
+	extensionsvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	// maxCloudControllerManagerVerbosity is the highest log verbosity supported by the cloud-controller-manager.
+	maxCloudControllerManagerVerbosity = 10
+
+	// csiStorageClassNameSuffix is the suffix of the names of the storage classes which use the CSI provisioner.
+	csiStorageClassNameSuffix = "-csi"
+)
+
+var (
+	supportedStorageClassTypes = sets.NewString("gp2", "io1", "st1", "sc1")
+	defaultStorageClassNames   = sets.NewString("default", "gp2")
+	supportedLoadBalancerTypes = sets.NewString(string(apisaws.LoadBalancerTypeClassic), string(apisaws.LoadBalancerTypeNLB))
+)
+
+// ValidateControlPlaneConfig validates a ControlPlaneConfig object.
+func ValidateControlPlaneConfig(controlPlaneConfig *apisaws.ControlPlaneConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if controlPlaneConfig.CloudControllerManager != nil {
+		allErrs = append(allErrs, validateCloudControllerManager(controlPlaneConfig.CloudControllerManager, field.NewPath("cloudControllerManager"))...)
+	}
+	allErrs = append(allErrs, validateStorageClasses(controlPlaneConfig.StorageClasses, field.NewPath("storageClasses"))...)
+
+	if controlPlaneConfig.ETCDBackup != nil {
+		allErrs = append(allErrs, controlplane.ValidateBackupRestoreConfig(helper.GetBackupRestoreConfig(controlPlaneConfig.ETCDBackup), field.NewPath("etcdBackup"))...)
+	}
+
+	if lb := controlPlaneConfig.LoadBalancer; lb != nil && lb.Type != nil && !supportedLoadBalancerTypes.Has(string(*lb.Type)) {
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("loadBalancer", "type"), *lb.Type, supportedLoadBalancerTypes.List()))
+	}
+
+	return allErrs
+}
+
+// ValidateControlPlaneConfigUpdate validates a ControlPlaneConfig object update.
+func ValidateControlPlaneConfigUpdate(oldConfig, newConfig *apisaws.ControlPlaneConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var (
+		lbPath       = field.NewPath("loadBalancer")
+		oldLB, newLB = oldConfig.LoadBalancer, newConfig.LoadBalancer
+		oldType      = helper.GetLoadBalancerType(oldConfig)
+		newType      = helper.GetLoadBalancerType(newConfig)
+		oldInternal  = oldLB != nil && oldLB.Internal != nil && *oldLB.Internal
+		newInternal  = newLB != nil && newLB.Internal != nil && *newLB.Internal
+	)
+
+	if oldType != newType {
+		allErrs = append(allErrs, field.Forbidden(lbPath.Child("type"), "field is immutable"))
+	}
+	if oldInternal != newInternal {
+		allErrs = append(allErrs, field.Forbidden(lbPath.Child("internal"), "field is immutable"))
+	}
+
+	var oldTag, newTag *string
+	if oldConfig.CloudControllerManager != nil {
+		oldTag = oldConfig.CloudControllerManager.KubernetesClusterTag
+	}
+	if newConfig.CloudControllerManager != nil {
+		newTag = newConfig.CloudControllerManager.KubernetesClusterTag
+	}
+	if !equality.Semantic.DeepEqual(oldTag, newTag) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("cloudControllerManager", "kubernetesClusterTag"), "field is immutable"))
+	}
+
+	return allErrs
+}
+
+func validateCloudControllerManager(ccm *apisaws.CloudControllerManagerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if ccm.ConcurrentServiceSyncs != nil && *ccm.ConcurrentServiceSyncs < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("concurrentServiceSyncs"), *ccm.ConcurrentServiceSyncs, "must be at least 1"))
+	}
+	if ccm.NodeMonitorPeriod != nil && ccm.NodeMonitorPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("nodeMonitorPeriod"), ccm.NodeMonitorPeriod.Duration.String(), "must be greater than 0"))
+	}
+	if ccm.Verbosity != nil && (*ccm.Verbosity < 0 || *ccm.Verbosity > maxCloudControllerManagerVerbosity) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("verbosity"), *ccm.Verbosity, fmt.Sprintf("must be between 0 and %d", maxCloudControllerManagerVerbosity)))
+	}
+	if ccm.KubernetesClusterTag != nil && len(*ccm.KubernetesClusterTag) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("kubernetesClusterTag"), *ccm.KubernetesClusterTag, "must not be empty"))
+	}
+
+	return allErrs
+}
+
+func validateStorageClasses(storageClasses []apisaws.StorageClass, fldPath *field.Path) field.ErrorList {
+	genericStorageClasses := make([]extensionsvalidation.StorageClass, 0, len(storageClasses))
+	for _, sc := range storageClasses {
+		genericStorageClasses = append(genericStorageClasses, extensionsvalidation.StorageClass{
+			Name:              sc.Name,
+			ReclaimPolicy:     sc.ReclaimPolicy,
+			VolumeBindingMode: sc.VolumeBindingMode,
+			Default:           sc.Default,
+		})
+	}
+
+	allErrs := extensionsvalidation.ValidateStorageClasses(genericStorageClasses, defaultStorageClassNames, []string{csiStorageClassNameSuffix}, fldPath)
+
+	for i, sc := range storageClasses {
+		idxPath := fldPath.Index(i)
+
+		if !supportedStorageClassTypes.Has(sc.Type) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("type"), sc.Type, supportedStorageClassTypes.List()))
+		}
+		if sc.IOPS != nil {
+			if *sc.IOPS <= 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("iops"), *sc.IOPS, "must be greater than 0"))
+			}
+			if sc.Type != "io1" {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("iops"), "may only be set for volume type io1"))
+			}
+		}
+	}
+
+	return allErrs
+}
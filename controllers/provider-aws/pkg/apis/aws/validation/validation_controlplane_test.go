@@ -0,0 +1,284 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	"time"
+
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	. "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("ControlPlaneConfig validation", func() {
+	var controlPlaneConfig *apisaws.ControlPlaneConfig
+
+	BeforeEach(func() {
+		controlPlaneConfig = &apisaws.ControlPlaneConfig{}
+	})
+
+	Describe("#ValidateControlPlaneConfig", func() {
+		It("should allow a configuration without storage classes", func() {
+			Expect(ValidateControlPlaneConfig(controlPlaneConfig)).To(BeEmpty())
+		})
+
+		It("should allow valid cloud-controller-manager settings", func() {
+			var (
+				syncs     int32 = 5
+				verbosity int32 = 4
+				tag             = "legacy"
+			)
+			controlPlaneConfig.CloudControllerManager = &apisaws.CloudControllerManagerConfig{
+				ConcurrentServiceSyncs: &syncs,
+				NodeMonitorPeriod:      &metav1.Duration{Duration: 5 * time.Second},
+				Verbosity:              &verbosity,
+				KubernetesClusterTag:   &tag,
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlaneConfig)).To(BeEmpty())
+		})
+
+		It("should forbid invalid cloud-controller-manager settings", func() {
+			var (
+				zero       int32
+				tooVerbose int32 = 11
+				empty            = ""
+			)
+			controlPlaneConfig.CloudControllerManager = &apisaws.CloudControllerManagerConfig{
+				ConcurrentServiceSyncs: &zero,
+				NodeMonitorPeriod:      &metav1.Duration{Duration: -time.Second},
+				Verbosity:              &tooVerbose,
+				KubernetesClusterTag:   &empty,
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlaneConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.concurrentServiceSyncs"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.nodeMonitorPeriod"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.verbosity"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.kubernetesClusterTag"),
+				})),
+			))
+		})
+
+		It("should allow a valid etcd backup configuration", func() {
+			var (
+				memoryLimit = resource.MustParse("50Mi")
+				limitBased  = "LimitBased"
+				maxBackups  = int32(5)
+			)
+			controlPlaneConfig.ETCDBackup = &apisaws.ETCDBackupConfig{
+				DeltaSnapshotPeriod:      &metav1.Duration{Duration: time.Minute},
+				DeltaSnapshotMemoryLimit: &memoryLimit,
+				GarbageCollectionPeriod:  &metav1.Duration{Duration: time.Hour},
+				GarbageCollectionPolicy:  &limitBased,
+				MaxBackups:               &maxBackups,
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlaneConfig)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid etcd backup configuration", func() {
+			var (
+				memoryLimit = resource.MustParse("0")
+				policy      = "Linear"
+				maxBackups  = int32(5)
+			)
+			controlPlaneConfig.ETCDBackup = &apisaws.ETCDBackupConfig{
+				DeltaSnapshotPeriod:      &metav1.Duration{Duration: time.Millisecond},
+				DeltaSnapshotMemoryLimit: &memoryLimit,
+				GarbageCollectionPeriod:  &metav1.Duration{},
+				GarbageCollectionPolicy:  &policy,
+				MaxBackups:               &maxBackups,
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlaneConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("etcdBackup.deltaSnapshotPeriod"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("etcdBackup.deltaSnapshotMemoryLimit"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("etcdBackup.garbageCollectionPeriod"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("etcdBackup.garbageCollectionPolicy"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("etcdBackup.maxBackups"),
+				})),
+			))
+		})
+
+		It("should allow valid storage classes", func() {
+			var (
+				retain                = corev1.PersistentVolumeReclaimRetain
+				waitForConsumer       = storagev1.VolumeBindingWaitForFirstConsumer
+				isDefault             = true
+				iops            int64 = 10
+			)
+			controlPlaneConfig.StorageClasses = []apisaws.StorageClass{
+				{
+					Name:              "fast",
+					Type:              "io1",
+					IOPS:              &iops,
+					ReclaimPolicy:     &retain,
+					VolumeBindingMode: &waitForConsumer,
+					Default:           &isDefault,
+				},
+				{Name: "slow", Type: "st1"},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlaneConfig)).To(BeEmpty())
+		})
+
+		It("should forbid the names of the storage classes deployed by default and their CSI variants", func() {
+			controlPlaneConfig.StorageClasses = []apisaws.StorageClass{
+				{Name: "default", Type: "st1"},
+				{Name: "default-csi", Type: "st1"},
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlaneConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("storageClasses[0].name"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("storageClasses[1].name"),
+			}))))
+		})
+
+		It("should forbid unsupported types", func() {
+			controlPlaneConfig.StorageClasses = []apisaws.StorageClass{
+				{Name: "fast", Type: "foo"},
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlaneConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("storageClasses[0].type"),
+			}))))
+		})
+
+		It("should forbid invalid IOPS settings", func() {
+			var (
+				zero int64 = 0
+				ten  int64 = 10
+			)
+			controlPlaneConfig.StorageClasses = []apisaws.StorageClass{
+				{Name: "foo", Type: "io1", IOPS: &zero},
+				{Name: "bar", Type: "gp2", IOPS: &ten},
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlaneConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("storageClasses[0].iops"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("storageClasses[1].iops"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateControlPlaneConfig load balancer", func() {
+		It("should allow the network load balancer type", func() {
+			nlb := apisaws.LoadBalancerTypeNLB
+			controlPlaneConfig.LoadBalancer = &apisaws.LoadBalancerConfig{Type: &nlb}
+
+			Expect(ValidateControlPlaneConfig(controlPlaneConfig)).To(BeEmpty())
+		})
+
+		It("should forbid unsupported load balancer types", func() {
+			alb := apisaws.LoadBalancerType("alb")
+			controlPlaneConfig.LoadBalancer = &apisaws.LoadBalancerConfig{Type: &alb}
+
+			Expect(ValidateControlPlaneConfig(controlPlaneConfig)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("loadBalancer.type"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateControlPlaneConfigUpdate", func() {
+		It("should allow updates that keep the load balancer settings", func() {
+			classic := apisaws.LoadBalancerTypeClassic
+			newConfig := controlPlaneConfig.DeepCopy()
+			newConfig.LoadBalancer = &apisaws.LoadBalancerConfig{Type: &classic}
+
+			Expect(ValidateControlPlaneConfigUpdate(controlPlaneConfig, newConfig)).To(BeEmpty())
+		})
+
+		It("should forbid changing the load balancer type or the internal flag", func() {
+			var (
+				nlb      = apisaws.LoadBalancerTypeNLB
+				internal = true
+			)
+			newConfig := controlPlaneConfig.DeepCopy()
+			newConfig.LoadBalancer = &apisaws.LoadBalancerConfig{Type: &nlb, Internal: &internal}
+
+			Expect(ValidateControlPlaneConfigUpdate(controlPlaneConfig, newConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("loadBalancer.type"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("loadBalancer.internal"),
+				})),
+			))
+		})
+
+		It("should forbid changing the kubernetes cluster tag", func() {
+			tag := "legacy"
+			newConfig := controlPlaneConfig.DeepCopy()
+			newConfig.CloudControllerManager = &apisaws.CloudControllerManagerConfig{KubernetesClusterTag: &tag}
+
+			Expect(ValidateControlPlaneConfigUpdate(controlPlaneConfig, newConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("cloudControllerManager.kubernetesClusterTag"),
+				})),
+			))
+		})
+	})
+})
@@ -43,6 +43,43 @@ var _ = Describe("Helper", func() {
 		Entry("entry not found (region does not exist)", makeMachineImages("ubuntu", "1", "asia", "0"), "ubuntu", "1", "europe", ""),
 		Entry("entry", makeMachineImages("ubuntu", "1", "europe", "ami-1234"), "ubuntu", "1", "europe", "ami-1234"),
 	)
+
+	Describe("#GetControlPlaneComponentChartValues", func() {
+		It("should return the default values if nothing is configured", func() {
+			Expect(GetControlPlaneComponentChartValues(config.ControlPlaneComponents{})).To(Equal(map[string]interface{}{
+				"vpa": map[string]interface{}{
+					"enabled": true,
+					"updatePolicy": map[string]interface{}{
+						"updateMode": "Auto",
+					},
+				},
+				"podDisruptionBudget": map[string]interface{}{"enabled": false},
+				"podAntiAffinity":     map[string]interface{}{"enabled": false},
+			}))
+		})
+
+		It("should return the configured values", func() {
+			var (
+				enabled    = true
+				updateMode = "Off"
+			)
+
+			Expect(GetControlPlaneComponentChartValues(config.ControlPlaneComponents{
+				VPA:                 &config.VPA{UpdateMode: &updateMode},
+				PodDisruptionBudget: &enabled,
+				PodAntiAffinity:     &enabled,
+			})).To(Equal(map[string]interface{}{
+				"vpa": map[string]interface{}{
+					"enabled": true,
+					"updatePolicy": map[string]interface{}{
+						"updateMode": "Off",
+					},
+				},
+				"podDisruptionBudget": map[string]interface{}{"enabled": true},
+				"podAntiAffinity":     map[string]interface{}{"enabled": true},
+			}))
+		})
+	})
 })
 
 func makeMachineImages(name, version, region, ami string) []config.MachineImage {
@@ -15,8 +15,10 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
 
@@ -35,6 +37,13 @@ type ControllerConfiguration struct {
 	MachineImages []MachineImage `json:"machineImages,omitempty"`
 	// ETCD is the etcd configuration.
 	ETCD ETCD `json:"etcd"`
+	// ControlPlaneComponents is the configuration of the control plane components deployed by the controller.
+	// +optional
+	ControlPlaneComponents ControlPlaneComponents `json:"controlPlaneComponents"`
+	// BackupBucket is the BackupBucketConfig (aws.provider.extensions.gardener.cloud/v1alpha1) applied to all backup buckets
+	// managed by the controller.
+	// +optional
+	BackupBucket *runtime.RawExtension `json:"backupBucket,omitempty"`
 }
 
 // MachineImage is a mapping from logical names and versions to AWS-specific identifiers, i.e. AMIs.
@@ -78,4 +87,51 @@ type ETCDBackup struct {
 	// Schedule is the etcd backup schedule.
 	// +optional
 	Schedule *string `json:"schedule,omitempty"`
+	// DeltaSnapshotPeriod is the period after which delta snapshots are taken. Defaults to 5m.
+	// +optional
+	DeltaSnapshotPeriod *metav1.Duration `json:"deltaSnapshotPeriod,omitempty"`
+	// DeltaSnapshotMemoryLimit is the size of accumulated events after which a delta snapshot is taken
+	// before the delta snapshot period has passed. Defaults to 100Mi.
+	// +optional
+	DeltaSnapshotMemoryLimit *resource.Quantity `json:"deltaSnapshotMemoryLimit,omitempty"`
+	// GarbageCollectionPeriod is the period after which old snapshots are garbage collected. Defaults to 12h.
+	// +optional
+	GarbageCollectionPeriod *metav1.Duration `json:"garbageCollectionPeriod,omitempty"`
+	// GarbageCollectionPolicy is the policy for garbage collecting old snapshots, either Exponential or LimitBased.
+	// +optional
+	GarbageCollectionPolicy *string `json:"garbageCollectionPolicy,omitempty"`
+	// MaxBackups is the maximum number of full snapshots kept by the LimitBased garbage collection policy.
+	// +optional
+	MaxBackups *int32 `json:"maxBackups,omitempty"`
+	// Resources are the compute resources of the backup-restore sidecar.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// ControlPlaneComponents is the configuration of the cloud-controller-manager, the CSI controllers and the
+// machine-controller-manager deployed into the shoot namespaces of the seed.
+type ControlPlaneComponents struct {
+	// VPA is the vertical pod autoscaling configuration of the components.
+	// +optional
+	VPA *VPA `json:"vpa,omitempty"`
+	// PodDisruptionBudget specifies whether PodDisruptionBudgets are deployed for the components. Defaults to false.
+	// +optional
+	PodDisruptionBudget *bool `json:"podDisruptionBudget,omitempty"`
+	// PodAntiAffinity specifies whether the replicas of the components are spread across the seed nodes. Defaults to false.
+	// +optional
+	PodAntiAffinity *bool `json:"podAntiAffinity,omitempty"`
+	// Replicas is the number of replicas of the components while the shoot is awake. The PodDisruptionBudgets and the
+	// pod anti-affinity only take effect with more than one replica. Defaults to 1.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+}
+
+// VPA is a vertical pod autoscaling configuration.
+type VPA struct {
+	// Enabled specifies whether VerticalPodAutoscalers are deployed. Defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// UpdateMode is the update mode of the VerticalPodAutoscalers, one of Off, Initial, Recreate or Auto. Defaults to Auto.
+	// +optional
+	UpdateMode *string `json:"updateMode,omitempty"`
 }
@@ -24,7 +24,9 @@ import (
 	unsafe "unsafe"
 
 	config "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/config"
+	corev1 "k8s.io/api/core/v1"
 	resource "k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	componentbaseconfig "k8s.io/component-base/config"
@@ -38,6 +40,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*ControlPlaneComponents)(nil), (*config.ControlPlaneComponents)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ControlPlaneComponents_To_config_ControlPlaneComponents(a.(*ControlPlaneComponents), b.(*config.ControlPlaneComponents), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ControlPlaneComponents)(nil), (*ControlPlaneComponents)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ControlPlaneComponents_To_v1alpha1_ControlPlaneComponents(a.(*config.ControlPlaneComponents), b.(*ControlPlaneComponents), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerConfiguration)(nil), (*config.ControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration(a.(*ControllerConfiguration), b.(*config.ControllerConfiguration), scope)
 	}); err != nil {
@@ -98,15 +110,55 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VPA)(nil), (*config.VPA)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VPA_To_config_VPA(a.(*VPA), b.(*config.VPA), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.VPA)(nil), (*VPA)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_VPA_To_v1alpha1_VPA(a.(*config.VPA), b.(*VPA), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_ControlPlaneComponents_To_config_ControlPlaneComponents(in *ControlPlaneComponents, out *config.ControlPlaneComponents, s conversion.Scope) error {
+	out.VPA = (*config.VPA)(unsafe.Pointer(in.VPA))
+	out.PodDisruptionBudget = (*bool)(unsafe.Pointer(in.PodDisruptionBudget))
+	out.PodAntiAffinity = (*bool)(unsafe.Pointer(in.PodAntiAffinity))
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	return nil
+}
+
+// Convert_v1alpha1_ControlPlaneComponents_To_config_ControlPlaneComponents is an autogenerated conversion function.
+func Convert_v1alpha1_ControlPlaneComponents_To_config_ControlPlaneComponents(in *ControlPlaneComponents, out *config.ControlPlaneComponents, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControlPlaneComponents_To_config_ControlPlaneComponents(in, out, s)
+}
+
+func autoConvert_config_ControlPlaneComponents_To_v1alpha1_ControlPlaneComponents(in *config.ControlPlaneComponents, out *ControlPlaneComponents, s conversion.Scope) error {
+	out.VPA = (*VPA)(unsafe.Pointer(in.VPA))
+	out.PodDisruptionBudget = (*bool)(unsafe.Pointer(in.PodDisruptionBudget))
+	out.PodAntiAffinity = (*bool)(unsafe.Pointer(in.PodAntiAffinity))
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
 	return nil
 }
 
+// Convert_config_ControlPlaneComponents_To_v1alpha1_ControlPlaneComponents is an autogenerated conversion function.
+func Convert_config_ControlPlaneComponents_To_v1alpha1_ControlPlaneComponents(in *config.ControlPlaneComponents, out *ControlPlaneComponents, s conversion.Scope) error {
+	return autoConvert_config_ControlPlaneComponents_To_v1alpha1_ControlPlaneComponents(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration(in *ControllerConfiguration, out *config.ControllerConfiguration, s conversion.Scope) error {
 	out.ClientConnection = (*componentbaseconfig.ClientConnectionConfiguration)(unsafe.Pointer(in.ClientConnection))
 	out.MachineImages = *(*[]config.MachineImage)(unsafe.Pointer(&in.MachineImages))
 	if err := Convert_v1alpha1_ETCD_To_config_ETCD(&in.ETCD, &out.ETCD, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_ControlPlaneComponents_To_config_ControlPlaneComponents(&in.ControlPlaneComponents, &out.ControlPlaneComponents, s); err != nil {
+		return err
+	}
+	out.BackupBucket = (*runtime.RawExtension)(unsafe.Pointer(in.BackupBucket))
 	return nil
 }
 
@@ -121,6 +173,10 @@ func autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfigurat
 	if err := Convert_config_ETCD_To_v1alpha1_ETCD(&in.ETCD, &out.ETCD, s); err != nil {
 		return err
 	}
+	if err := Convert_config_ControlPlaneComponents_To_v1alpha1_ControlPlaneComponents(&in.ControlPlaneComponents, &out.ControlPlaneComponents, s); err != nil {
+		return err
+	}
+	out.BackupBucket = (*runtime.RawExtension)(unsafe.Pointer(in.BackupBucket))
 	return nil
 }
 
@@ -161,6 +217,12 @@ func Convert_config_ETCD_To_v1alpha1_ETCD(in *config.ETCD, out *ETCD, s conversi
 
 func autoConvert_v1alpha1_ETCDBackup_To_config_ETCDBackup(in *ETCDBackup, out *config.ETCDBackup, s conversion.Scope) error {
 	out.Schedule = (*string)(unsafe.Pointer(in.Schedule))
+	out.DeltaSnapshotPeriod = (*v1.Duration)(unsafe.Pointer(in.DeltaSnapshotPeriod))
+	out.DeltaSnapshotMemoryLimit = (*resource.Quantity)(unsafe.Pointer(in.DeltaSnapshotMemoryLimit))
+	out.GarbageCollectionPeriod = (*v1.Duration)(unsafe.Pointer(in.GarbageCollectionPeriod))
+	out.GarbageCollectionPolicy = (*string)(unsafe.Pointer(in.GarbageCollectionPolicy))
+	out.MaxBackups = (*int32)(unsafe.Pointer(in.MaxBackups))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
 	return nil
 }
 
@@ -171,6 +233,12 @@ func Convert_v1alpha1_ETCDBackup_To_config_ETCDBackup(in *ETCDBackup, out *confi
 
 func autoConvert_config_ETCDBackup_To_v1alpha1_ETCDBackup(in *config.ETCDBackup, out *ETCDBackup, s conversion.Scope) error {
 	out.Schedule = (*string)(unsafe.Pointer(in.Schedule))
+	out.DeltaSnapshotPeriod = (*v1.Duration)(unsafe.Pointer(in.DeltaSnapshotPeriod))
+	out.DeltaSnapshotMemoryLimit = (*resource.Quantity)(unsafe.Pointer(in.DeltaSnapshotMemoryLimit))
+	out.GarbageCollectionPeriod = (*v1.Duration)(unsafe.Pointer(in.GarbageCollectionPeriod))
+	out.GarbageCollectionPolicy = (*string)(unsafe.Pointer(in.GarbageCollectionPolicy))
+	out.MaxBackups = (*int32)(unsafe.Pointer(in.MaxBackups))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
 	return nil
 }
 
@@ -246,3 +314,25 @@ func autoConvert_config_RegionAMIMapping_To_v1alpha1_RegionAMIMapping(in *config
 func Convert_config_RegionAMIMapping_To_v1alpha1_RegionAMIMapping(in *config.RegionAMIMapping, out *RegionAMIMapping, s conversion.Scope) error {
 	return autoConvert_config_RegionAMIMapping_To_v1alpha1_RegionAMIMapping(in, out, s)
 }
+
+func autoConvert_v1alpha1_VPA_To_config_VPA(in *VPA, out *config.VPA, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.UpdateMode = (*string)(unsafe.Pointer(in.UpdateMode))
+	return nil
+}
+
+// Convert_v1alpha1_VPA_To_config_VPA is an autogenerated conversion function.
+func Convert_v1alpha1_VPA_To_config_VPA(in *VPA, out *config.VPA, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VPA_To_config_VPA(in, out, s)
+}
+
+func autoConvert_config_VPA_To_v1alpha1_VPA(in *config.VPA, out *VPA, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.UpdateMode = (*string)(unsafe.Pointer(in.UpdateMode))
+	return nil
+}
+
+// Convert_config_VPA_To_v1alpha1_VPA is an autogenerated conversion function.
+func Convert_config_VPA_To_v1alpha1_VPA(in *config.VPA, out *VPA, s conversion.Scope) error {
+	return autoConvert_config_VPA_To_v1alpha1_VPA(in, out, s)
+}
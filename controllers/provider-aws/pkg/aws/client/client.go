@@ -17,6 +17,8 @@ package client
 import (
 	"context"
 	"fmt"
+	"io"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -88,6 +90,27 @@ func (c *Client) GetInternetGateway(ctx context.Context, vpcID string) (string,
 	return "", fmt.Errorf("no attached internet gateway found for vpc %s", vpcID)
 }
 
+// GetVPCIPv6CIDRBlock returns the IPv6 CIDR block associated with the VPC with id <vpcID>. If the VPC has no
+// associated IPv6 CIDR block, an empty string is returned.
+func (c *Client) GetVPCIPv6CIDRBlock(ctx context.Context, vpcID string) (string, error) {
+	describeVpcsOutput, err := c.EC2.DescribeVpcsWithContext(ctx, &ec2.DescribeVpcsInput{
+		VpcIds: []*string{aws.String(vpcID)},
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(describeVpcsOutput.Vpcs) == 0 {
+		return "", fmt.Errorf("vpc %s not found", vpcID)
+	}
+
+	for _, association := range describeVpcsOutput.Vpcs[0].Ipv6CidrBlockAssociationSet {
+		if association.Ipv6CidrBlockState != nil && aws.StringValue(association.Ipv6CidrBlockState.State) == ec2.VpcCidrBlockStateCodeAssociated {
+			return aws.StringValue(association.Ipv6CidrBlock), nil
+		}
+	}
+	return "", nil
+}
+
 // The following functions are only temporary needed due to https://github.com/gardener/gardener/issues/129.
 
 // ListKubernetesELBs returns the list of load balancers in the given <vpcID> tagged with <clusterName>.
@@ -185,34 +208,50 @@ func (c *Client) DeleteSecurityGroup(ctx context.Context, id string) error {
 	return nil
 }
 
-// DeleteObjectsWithPrefix deletes the s3 objects with the specific <prefix> from <bucket>. If it does not exist,
-// no error is returned.
+// DeleteObjectsWithPrefix deletes the s3 objects with the specific <prefix> from <bucket>. The objects are deleted
+// page by page as s3 limits the number of objects deleted at once. If it does not exist, no error is returned.
 func (c *Client) DeleteObjectsWithPrefix(ctx context.Context, bucket, prefix string) error {
 	in := &s3.ListObjectsInput{
 		Bucket: aws.String(bucket),
 		Prefix: aws.String(prefix),
 	}
-	objectIDs := make([]*s3.ObjectIdentifier, 0)
+
+	var deleteErr error
 	if err := c.S3.ListObjectsPagesWithContext(ctx, in, func(page *s3.ListObjectsOutput, lastPage bool) bool {
+		objectIDs := make([]*s3.ObjectIdentifier, 0, len(page.Contents))
 		for _, key := range page.Contents {
 			obj := &s3.ObjectIdentifier{
 				Key: key.Key,
 			}
 			objectIDs = append(objectIDs, obj)
 		}
+		if len(objectIDs) == 0 {
+			return !lastPage
+		}
+
+		if _, err := c.S3.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objectIDs,
+			},
+		}); err != nil {
+			if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != s3.ErrCodeNoSuchKey {
+				deleteErr = err
+				return false
+			}
+		}
 		return !lastPage
 	}); err != nil {
 		return err
 	}
-	if len(objectIDs) == 0 {
-		return nil
-	}
+	return deleteErr
+}
 
-	if _, err := c.S3.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+// DeleteObject deletes the s3 object with the key <key> from <bucket>. If it does not exist, no error is returned.
+func (c *Client) DeleteObject(ctx context.Context, bucket, key string) error {
+	if _, err := c.S3.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
 		Bucket: aws.String(bucket),
-		Delete: &s3.Delete{
-			Objects: objectIDs,
-		},
+		Key:    aws.String(key),
 	}); err != nil {
 		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
 			return nil
@@ -222,9 +261,50 @@ func (c *Client) DeleteObjectsWithPrefix(ctx context.Context, bucket, prefix str
 	return nil
 }
 
-// CreateBucketIfNotExists creates the s3 bucket with name <bucket> in <region>. If it already exist,
-// no error is returned.
-func (c *Client) CreateBucketIfNotExists(ctx context.Context, bucket, region string) error {
+// ListObjectsWithPrefix returns the keys of the s3 objects with the specific <prefix> in <bucket> together with
+// their last modification time.
+func (c *Client) ListObjectsWithPrefix(ctx context.Context, bucket, prefix string) (map[string]time.Time, error) {
+	in := &s3.ListObjectsInput{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	}
+	objects := make(map[string]time.Time)
+	if err := c.S3.ListObjectsPagesWithContext(ctx, in, func(page *s3.ListObjectsOutput, lastPage bool) bool {
+		for _, object := range page.Contents {
+			objects[aws.StringValue(object.Key)] = aws.TimeValue(object.LastModified)
+		}
+		return !lastPage
+	}); err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+// GetObject returns the contents of the s3 object with the key <key> in <bucket>.
+func (c *Client) GetObject(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	out, err := c.S3.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.Body, nil
+}
+
+// PutObject stores the contents of <body> as the s3 object with the key <key> in <bucket>.
+func (c *Client) PutObject(ctx context.Context, bucket, key string, body io.ReadSeeker) error {
+	_, err := c.S3.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Body:   body,
+	})
+	return err
+}
+
+// CreateBucketIfNotExists creates the s3 bucket with name <bucket> in <region>. If <objectLockEnabled> is true,
+// object lock is enabled for the new bucket. If it already exist, no error is returned.
+func (c *Client) CreateBucketIfNotExists(ctx context.Context, bucket, region string, objectLockEnabled bool) error {
 	createBucketInput := &s3.CreateBucketInput{
 		Bucket: aws.String(bucket),
 		ACL:    aws.String(s3.BucketCannedACLPrivate),
@@ -233,6 +313,10 @@ func (c *Client) CreateBucketIfNotExists(ctx context.Context, bucket, region str
 		},
 	}
 
+	if objectLockEnabled {
+		createBucketInput.ObjectLockEnabledForBucket = aws.Bool(true)
+	}
+
 	if region == "us-east-1" {
 		createBucketInput.CreateBucketConfiguration = nil
 	}
@@ -246,22 +330,156 @@ func (c *Client) CreateBucketIfNotExists(ctx context.Context, bucket, region str
 	return nil
 }
 
+// UpdateBucketVersioning enables or suspends the object versioning of the s3 bucket with name <bucket>.
+func (c *Client) UpdateBucketVersioning(ctx context.Context, bucket string, enabled bool) error {
+	status := s3.BucketVersioningStatusSuspended
+	if enabled {
+		status = s3.BucketVersioningStatusEnabled
+	}
+
+	_, err := c.S3.PutBucketVersioningWithContext(ctx, &s3.PutBucketVersioningInput{
+		Bucket:                  aws.String(bucket),
+		VersioningConfiguration: &s3.VersioningConfiguration{Status: aws.String(status)},
+	})
+	return err
+}
+
+// UpdateBucketObjectLock sets the default retention of the s3 bucket with name <bucket> to <days> in the
+// given <mode>. Object lock must have been enabled when the bucket was created.
+func (c *Client) UpdateBucketObjectLock(ctx context.Context, bucket, mode string, days int64) error {
+	_, err := c.S3.PutObjectLockConfigurationWithContext(ctx, &s3.PutObjectLockConfigurationInput{
+		Bucket: aws.String(bucket),
+		ObjectLockConfiguration: &s3.ObjectLockConfiguration{
+			ObjectLockEnabled: aws.String(s3.ObjectLockEnabledEnabled),
+			Rule: &s3.ObjectLockRule{
+				DefaultRetention: &s3.DefaultRetention{
+					Mode: aws.String(mode),
+					Days: aws.Int64(days),
+				},
+			},
+		},
+	})
+	return err
+}
+
+// UpdateBucketEncryption sets the default server-side encryption of the s3 bucket with name <bucket>. If <kmsKeyID>
+// is nil, the objects are encrypted with S3-managed keys, otherwise with the given KMS key.
+func (c *Client) UpdateBucketEncryption(ctx context.Context, bucket string, kmsKeyID *string) error {
+	encryptionByDefault := &s3.ServerSideEncryptionByDefault{SSEAlgorithm: aws.String(s3.ServerSideEncryptionAes256)}
+	if kmsKeyID != nil {
+		encryptionByDefault = &s3.ServerSideEncryptionByDefault{
+			SSEAlgorithm:   aws.String(s3.ServerSideEncryptionAwsKms),
+			KMSMasterKeyID: kmsKeyID,
+		}
+	}
+
+	_, err := c.S3.PutBucketEncryptionWithContext(ctx, &s3.PutBucketEncryptionInput{
+		Bucket: aws.String(bucket),
+		ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{
+			Rules: []*s3.ServerSideEncryptionRule{
+				{ApplyServerSideEncryptionByDefault: encryptionByDefault},
+			},
+		},
+	})
+	return err
+}
+
+// UpdateBucketLifecycle sets the expiry rule of the s3 bucket with name <bucket>. Objects expire after
+// <expirationDays> and noncurrent object versions after <noncurrentVersionExpirationDays>. If both are nil,
+// the lifecycle configuration of the bucket is removed.
+func (c *Client) UpdateBucketLifecycle(ctx context.Context, bucket string, expirationDays, noncurrentVersionExpirationDays *int64) error {
+	if expirationDays == nil && noncurrentVersionExpirationDays == nil {
+		_, err := c.S3.DeleteBucketLifecycleWithContext(ctx, &s3.DeleteBucketLifecycleInput{Bucket: aws.String(bucket)})
+		return err
+	}
+
+	rule := &s3.LifecycleRule{
+		ID:     aws.String(bucketLifecycleRuleID),
+		Filter: &s3.LifecycleRuleFilter{Prefix: aws.String("")},
+		Status: aws.String(s3.ExpirationStatusEnabled),
+	}
+	if expirationDays != nil {
+		rule.Expiration = &s3.LifecycleExpiration{Days: expirationDays}
+	}
+	if noncurrentVersionExpirationDays != nil {
+		rule.NoncurrentVersionExpiration = &s3.NoncurrentVersionExpiration{NoncurrentDays: noncurrentVersionExpirationDays}
+	}
+
+	_, err := c.S3.PutBucketLifecycleConfigurationWithContext(ctx, &s3.PutBucketLifecycleConfigurationInput{
+		Bucket:                 aws.String(bucket),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{Rules: []*s3.LifecycleRule{rule}},
+	})
+	return err
+}
+
 // DeleteBucketIfExists deletes the s3 bucket with name <bucket>. If it does not exist,
-// no error is returned.
+// no error is returned. All object versions and delete markers of a non-empty bucket are deleted first,
+// an error is returned if the bucket cannot be emptied.
 func (c *Client) DeleteBucketIfExists(ctx context.Context, bucket string) error {
-	if _, err := c.S3.DeleteBucketWithContext(ctx, &s3.DeleteBucketInput{Bucket: aws.String(bucket)}); err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			if aerr.Code() == s3.ErrCodeNoSuchBucket {
-				return nil
-			}
-			if aerr.Code() == errCodeBucketNotEmpty {
-				if err := c.DeleteObjectsWithPrefix(ctx, bucket, ""); err != nil {
-					return err
-				}
-				return c.DeleteBucketIfExists(ctx, bucket)
-			}
+	for attempt := 0; ; attempt++ {
+		_, err := c.S3.DeleteBucketWithContext(ctx, &s3.DeleteBucketInput{Bucket: aws.String(bucket)})
+		if err == nil {
+			return nil
+		}
+
+		aerr, ok := err.(awserr.Error)
+		if !ok {
+			return err
+		}
+		if aerr.Code() == s3.ErrCodeNoSuchBucket {
+			return nil
+		}
+		if aerr.Code() != errCodeBucketNotEmpty {
+			return err
+		}
+		if attempt == maxBucketEmptyingAttempts {
+			return fmt.Errorf("bucket '%s' could not be emptied after %d attempts", bucket, maxBucketEmptyingAttempts)
+		}
+
+		if err := c.deleteObjectVersions(ctx, bucket); err != nil {
+			return err
+		}
+	}
+}
+
+// deleteObjectVersions deletes all object versions and delete markers of the s3 bucket with name <bucket>.
+// Object versions under retention are not deleted, hence the bucket cannot be deleted before their retention expired.
+func (c *Client) deleteObjectVersions(ctx context.Context, bucket string) error {
+	in := &s3.ListObjectVersionsInput{
+		Bucket: aws.String(bucket),
+	}
+
+	var deleteErr error
+	if err := c.S3.ListObjectVersionsPagesWithContext(ctx, in, func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
+		objectIDs := make([]*s3.ObjectIdentifier, 0, len(page.Versions)+len(page.DeleteMarkers))
+		for _, version := range page.Versions {
+			objectIDs = append(objectIDs, &s3.ObjectIdentifier{Key: version.Key, VersionId: version.VersionId})
 		}
+		for _, marker := range page.DeleteMarkers {
+			objectIDs = append(objectIDs, &s3.ObjectIdentifier{Key: marker.Key, VersionId: marker.VersionId})
+		}
+		if len(objectIDs) == 0 {
+			return !lastPage
+		}
+
+		out, err := c.S3.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objectIDs,
+			},
+		})
+		if err != nil {
+			deleteErr = err
+			return false
+		}
+		if len(out.Errors) > 0 {
+			deleteErr = fmt.Errorf("could not delete %d object versions of bucket '%s', first error for key '%s': %s",
+				len(out.Errors), bucket, aws.StringValue(out.Errors[0].Key), aws.StringValue(out.Errors[0].Message))
+			return false
+		}
+		return !lastPage
+	}); err != nil {
 		return err
 	}
-	return nil
+	return deleteErr
 }
@@ -16,6 +16,8 @@ package client
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/elb/elbiface"
@@ -32,16 +34,31 @@ const (
 	//
 	// The specified bucket us exist.
 	errCodeBucketNotEmpty = "BucketNotEmpty"
+
+	// maxBucketEmptyingAttempts is the maximum number of attempts to empty a bucket before it is deleted.
+	maxBucketEmptyingAttempts = 3
+
+	// bucketLifecycleRuleID is the id of the lifecycle rule managed for backup buckets.
+	bucketLifecycleRuleID = "gardener-backup-expiry"
 )
 
 // Interface is an interface which must be implemented by AWS clients.
 type Interface interface {
 	GetAccountID(ctx context.Context) (string, error)
 	GetInternetGateway(ctx context.Context, vpcID string) (string, error)
+	GetVPCIPv6CIDRBlock(ctx context.Context, vpcID string) (string, error)
 
 	// S3 wrappers
 	DeleteObjectsWithPrefix(ctx context.Context, bucket, prefix string) error
-	CreateBucketIfNotExists(ctx context.Context, bucket, region string) error
+	DeleteObject(ctx context.Context, bucket, key string) error
+	ListObjectsWithPrefix(ctx context.Context, bucket, prefix string) (map[string]time.Time, error)
+	GetObject(ctx context.Context, bucket, key string) (io.ReadCloser, error)
+	PutObject(ctx context.Context, bucket, key string, body io.ReadSeeker) error
+	CreateBucketIfNotExists(ctx context.Context, bucket, region string, objectLockEnabled bool) error
+	UpdateBucketVersioning(ctx context.Context, bucket string, enabled bool) error
+	UpdateBucketObjectLock(ctx context.Context, bucket, mode string, days int64) error
+	UpdateBucketEncryption(ctx context.Context, bucket string, kmsKeyID *string) error
+	UpdateBucketLifecycle(ctx context.Context, bucket string, expirationDays, noncurrentVersionExpirationDays *int64) error
 	DeleteBucketIfExists(ctx context.Context, bucket string) error
 
 	// The following functions are only temporary needed due to https://github.com/gardener/gardener/issues/129.
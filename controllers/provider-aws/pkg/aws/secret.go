@@ -20,6 +20,7 @@ import (
 
 	awsclient "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws/client"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
 
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -62,3 +63,13 @@ func NewClientFromSecretRef(ctx context.Context, client client.Client, secretRef
 
 	return awsclient.NewClient(string(credentials.AccessKeyID), string(credentials.SecretAccessKey), region)
 }
+
+// NewObjectStoreFromSecretRef creates a new object store for the S3 buckets accessible with the AWS credentials from
+// given k8s <secretRef> in the AWS region <region>.
+func NewObjectStoreFromSecretRef(ctx context.Context, client client.Client, secretRef corev1.SecretReference, region string) (objectstore.ObjectStore, error) {
+	awsClient, err := NewClientFromSecretRef(ctx, client, secretRef, region)
+	if err != nil {
+		return nil, err
+	}
+	return awsclient.NewObjectStore(awsClient, region, false), nil
+}
@@ -14,7 +14,11 @@
 
 package aws
 
-import "path/filepath"
+import (
+	"path/filepath"
+
+	"github.com/gardener/gardener/pkg/utils"
+)
 
 const (
 	// Name is the name of the AWS provider.
@@ -32,6 +36,18 @@ const (
 	ETCDBackupRestoreImageName = "etcd-backup-restore"
 	// AWSLBReadvertiserImageName is the name of the AWSLBReadvertiser image.
 	AWSLBReadvertiserImageName = "aws-lb-readvertiser"
+	// CSIDriverImageName is the name of the AWS EBS CSI driver image.
+	CSIDriverImageName = "csi-driver-aws"
+	// CSIProvisionerImageName is the name of the CSI provisioner image.
+	CSIProvisionerImageName = "csi-provisioner"
+	// CSIAttacherImageName is the name of the CSI attacher image.
+	CSIAttacherImageName = "csi-attacher"
+	// CSIResizerImageName is the name of the CSI resizer image.
+	CSIResizerImageName = "csi-resizer"
+	// CSINodeDriverRegistrarImageName is the name of the CSI node driver registrar image.
+	CSINodeDriverRegistrarImageName = "csi-node-driver-registrar"
+	// CSILivenessProbeImageName is the name of the CSI liveness probe image.
+	CSILivenessProbeImageName = "csi-liveness-probe"
 
 	// AccessKeyID is a constant for the key in a cloud provider secret and backup secret that holds the AWS access key id.
 	AccessKeyID = "accessKeyID"
@@ -51,6 +67,12 @@ const (
 	SubnetPublicPrefix = "subnet_public_utility_z"
 	// SubnetNodesPrefix is the prefix for the subnets
 	SubnetNodesPrefix = "subnet_nodes_z"
+	// VPCIPv6CIDRKey is the vpc_ipv6_cidr tf state key
+	VPCIPv6CIDRKey = "vpc_ipv6_cidr"
+	// SubnetPublicIPv6Prefix is the prefix for the IPv6 CIDR blocks of the public subnets
+	SubnetPublicIPv6Prefix = "subnet_public_utility_ipv6_z"
+	// SubnetNodesIPv6Prefix is the prefix for the IPv6 CIDR blocks of the nodes subnets
+	SubnetNodesIPv6Prefix = "subnet_nodes_ipv6_z"
 	// SecurityGroupsNodes is the key for accessing nodes security groups from outputs in terraform
 	SecurityGroupsNodes = "security_group_nodes"
 	// SSHKeyName key for accessing SSH key name from outputs in terraform
@@ -74,6 +96,12 @@ const (
 	MachineControllerManagerMonitoringConfigName = "machine-controller-manager-monitoring-config"
 	// BackupSecretName is the name of the secret containing the credentials for storing the backups of Shoot clusters.
 	BackupSecretName = "etcd-backup"
+
+	// CSIDriverName is the name of the AWS EBS CSI driver.
+	CSIDriverName = "ebs.csi.aws.com"
+	// CSIMinimumKubernetesVersion is the minimum Kubernetes version for which the AWS EBS CSI driver is used
+	// instead of the in-tree volume plugin.
+	CSIMinimumKubernetesVersion = "1.18"
 )
 
 var (
@@ -83,6 +111,12 @@ var (
 	InternalChartsPath = filepath.Join(ChartsPath, "internal")
 )
 
+// UseCSI returns true if the AWS EBS CSI driver shall be used instead of the in-tree volume plugin
+// for the given Kubernetes version.
+func UseCSI(kubernetesVersion string) (bool, error) {
+	return utils.CompareVersions(kubernetesVersion, ">=", CSIMinimumKubernetesVersion)
+}
+
 // Credentials stores AWS credentials.
 type Credentials struct {
 	AccessKeyID     []byte
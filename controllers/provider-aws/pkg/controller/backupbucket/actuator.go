@@ -17,24 +17,30 @@ package backupbucket
 import (
 	"context"
 
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/validation"
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
-	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket"
+	awsclient "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws/client"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket/genericactuator"
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
 
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
 )
 
 type actuator struct {
-	backupbucket.Actuator
-	client client.Client
-	logger logr.Logger
+	bbConfig *apisaws.BackupBucketConfig
+	client   client.Client
+	logger   logr.Logger
 }
 
-func newActuator() backupbucket.Actuator {
+func newActuator(bbConfig *apisaws.BackupBucketConfig) genericactuator.BackupBucketDelegate {
 	return &actuator{
-		logger: log.Log.WithName("aws-backupbucket-actuator"),
+		bbConfig: bbConfig,
+		logger:   logger,
 	}
 }
 
@@ -43,20 +49,77 @@ func (a *actuator) InjectClient(client client.Client) error {
 	return nil
 }
 
-func (a *actuator) Reconcile(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+func (a *actuator) GetObjectStore(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) (objectstore.ObjectStore, error) {
 	awsClient, err := aws.NewClientFromSecretRef(ctx, a.client, bb.Spec.SecretRef, bb.Spec.Region)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return awsClient.CreateBucketIfNotExists(ctx, bb.Name, bb.Spec.Region)
+	// Object lock can only be enabled when the bucket is created.
+	return awsclient.NewObjectStore(awsClient, bb.Spec.Region, a.bbConfig.ObjectLock != nil), nil
 }
 
-func (a *actuator) Delete(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+func (a *actuator) ReconcileBucketConfig(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
 	awsClient, err := aws.NewClientFromSecretRef(ctx, a.client, bb.Spec.SecretRef, bb.Spec.Region)
 	if err != nil {
 		return err
 	}
 
-	return awsClient.DeleteBucketIfExists(ctx, bb.Name)
+	return reconcileBucketConfig(ctx, awsClient, bb.Name, a.bbConfig)
+}
+
+// decodeBackupBucketConfig decodes and validates the given BackupBucketConfig of the controller configuration.
+func decodeBackupBucketConfig(decoder runtime.Decoder, backupBucketConfig *runtime.RawExtension) (*apisaws.BackupBucketConfig, error) {
+	bbConfig := &apisaws.BackupBucketConfig{}
+	if backupBucketConfig == nil {
+		return bbConfig, nil
+	}
+
+	if _, _, err := decoder.Decode(backupBucketConfig.Raw, nil, bbConfig); err != nil {
+		return nil, errors.Wrap(err, "could not decode the backup bucket configuration")
+	}
+	if errs := validation.ValidateBackupBucketConfig(bbConfig); len(errs) > 0 {
+		return nil, errors.Wrap(errs.ToAggregate(), "invalid backup bucket configuration")
+	}
+
+	return bbConfig, nil
+}
+
+// reconcileBucketConfig applies the settings of the given BackupBucketConfig to the bucket. The settings are applied
+// on every reconciliation to revert any changes that were made to the bucket outside of Gardener. Settings that are
+// not configured are left untouched.
+func reconcileBucketConfig(ctx context.Context, awsClient awsclient.Interface, bucket string, bbConfig *apisaws.BackupBucketConfig) error {
+	if bbConfig.Versioning != nil {
+		if err := awsClient.UpdateBucketVersioning(ctx, bucket, *bbConfig.Versioning); err != nil {
+			return errors.Wrapf(err, "could not update versioning of bucket '%s'", bucket)
+		}
+	}
+
+	if objectLock := bbConfig.ObjectLock; objectLock != nil {
+		if err := awsClient.UpdateBucketObjectLock(ctx, bucket, string(objectLock.Mode), int64(objectLock.Days)); err != nil {
+			return errors.Wrapf(err, "could not update object lock of bucket '%s'", bucket)
+		}
+	}
+
+	if encryption := bbConfig.Encryption; encryption != nil {
+		if err := awsClient.UpdateBucketEncryption(ctx, bucket, encryption.KMSKeyID); err != nil {
+			return errors.Wrapf(err, "could not update encryption of bucket '%s'", bucket)
+		}
+	}
+
+	if lifecycle := bbConfig.Lifecycle; lifecycle != nil {
+		if err := awsClient.UpdateBucketLifecycle(ctx, bucket, toInt64Ptr(lifecycle.ExpirationDays), toInt64Ptr(lifecycle.NoncurrentVersionExpirationDays)); err != nil {
+			return errors.Wrapf(err, "could not update lifecycle of bucket '%s'", bucket)
+		}
+	}
+
+	return nil
+}
+
+func toInt64Ptr(i *int32) *int64 {
+	if i == nil {
+		return nil
+	}
+	v := int64(*i)
+	return &v
 }
@@ -17,14 +17,20 @@ package backupbucket
 import (
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
 	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket/genericactuator"
 
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
 )
 
 var (
 	// DefaultAddOptions are the default AddOptions for AddToManager.
 	DefaultAddOptions = AddOptions{}
+
+	logger = log.Log.WithName("aws-backupbucket-actuator")
 )
 
 // AddOptions are options to apply when adding the AWS backupbucket controller to the manager.
@@ -33,13 +39,20 @@ type AddOptions struct {
 	Controller controller.Options
 	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
 	IgnoreOperationAnnotation bool
+	// BackupBucketConfig is the BackupBucketConfig applied to all backup buckets.
+	BackupBucketConfig *runtime.RawExtension
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
+	bbConfig, err := decodeBackupBucketConfig(serializer.NewCodecFactory(mgr.GetScheme()).UniversalDecoder(), opts.BackupBucketConfig)
+	if err != nil {
+		return err
+	}
+
 	return backupbucket.Add(mgr, backupbucket.AddArgs{
-		Actuator:          newActuator(),
+		Actuator:          genericactuator.NewActuator(newActuator(bbConfig), logger),
 		ControllerOptions: opts.Controller,
 		Predicates:        backupbucket.DefaultPredicates(aws.Type, opts.IgnoreOperationAnnotation),
 	})
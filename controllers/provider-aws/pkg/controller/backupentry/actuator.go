@@ -17,6 +17,7 @@ package backupentry
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
 	"github.com/gardener/gardener-extensions/pkg/controller/backupentry/genericactuator"
@@ -55,3 +56,12 @@ func (a *actuator) Delete(ctx context.Context, be *extensionsv1alpha1.BackupEntr
 
 	return awsClient.DeleteObjectsWithPrefix(ctx, be.Spec.BucketName, fmt.Sprintf("%s/", be.Name))
 }
+
+func (a *actuator) ListObjects(ctx context.Context, be *extensionsv1alpha1.BackupEntry, prefix string) (map[string]time.Time, error) {
+	awsClient, err := aws.NewClientFromSecretRef(ctx, a.client, be.Spec.SecretRef, be.Spec.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	return awsClient.ListObjectsWithPrefix(ctx, be.Spec.BucketName, fmt.Sprintf("%s/%s", be.Name, prefix))
+}
@@ -15,6 +15,7 @@
 package controlplane
 
 import (
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/config"
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/imagevector"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
@@ -43,14 +44,16 @@ type AddOptions struct {
 	IgnoreOperationAnnotation bool
 	// ShootWebhooks specifies the list of desired shoot webhooks.
 	ShootWebhooks []admissionregistrationv1beta1.Webhook
+	// ControlPlaneComponents are the vertical pod autoscaling and high availability settings of the control plane components.
+	ControlPlaneComponents config.ControlPlaneComponents
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
 	return controlplane.Add(mgr, controlplane.AddArgs{
-		Actuator: genericactuator.NewActuator(aws.Name, controlPlaneSecrets, controlPlaneExposureSecrets, configChart, ccmChart, ccmShootChart,
-			storageClassChart, cpExposureChart, NewValuesProvider(logger), extensionscontroller.ChartRendererFactoryFunc(util.NewChartRendererForShoot),
+		Actuator: genericactuator.NewActuator(aws.Name, controlPlaneSecrets, controlPlaneExposureSecrets, configChart, controlPlaneChart, controlPlaneShootChart,
+			storageClassChart, cpExposureChart, NewValuesProvider(opts.ControlPlaneComponents, logger), extensionscontroller.ChartRendererFactoryFunc(util.NewChartRendererForShoot),
 			imagevector.ImageVector(), aws.CloudProviderConfigName, opts.ShootWebhooks, mgr.GetWebhookServer().Port, logger),
 		ControllerOptions: opts.Controller,
 		Predicates:        controlplane.DefaultPredicates(aws.Type, opts.IgnoreOperationAnnotation),
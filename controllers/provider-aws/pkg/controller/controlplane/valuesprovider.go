@@ -17,9 +17,12 @@ package controlplane
 import (
 	"context"
 	"path/filepath"
+	"strconv"
 
 	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/helper"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/config"
+	confighelper "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/config/helper"
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/controlplane"
@@ -28,6 +31,7 @@ import (
 
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/chart"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/secrets"
@@ -35,7 +39,9 @@ import (
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1beta1 "k8s.io/api/storage/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apiserver/pkg/authentication/user"
@@ -47,6 +53,10 @@ const (
 	cloudControllerManagerDeploymentName = "cloud-controller-manager"
 	cloudControllerManagerServerName     = "cloud-controller-manager-server"
 	awsLBReadvertiserDeploymentName      = "aws-lb-readvertiser"
+	csiDriverControllerDeploymentName    = "csi-driver-controller"
+	csiProvisionerName                   = "csi-provisioner"
+	csiAttacherName                      = "csi-attacher"
+	csiResizerName                       = "csi-resizer"
 )
 
 var controlPlaneSecrets = &secrets.Secrets{
@@ -81,6 +91,45 @@ var controlPlaneSecrets = &secrets.Secrets{
 					SigningCA:  cas[v1alpha1constants.SecretNameCACluster],
 				},
 			},
+			&secrets.ControlPlaneSecretConfig{
+				CertificateSecretConfig: &secrets.CertificateSecretConfig{
+					Name:         csiProvisionerName,
+					CommonName:   "system:csi-provisioner",
+					Organization: []string{user.SystemPrivilegedGroup},
+					CertType:     secrets.ClientCert,
+					SigningCA:    cas[v1alpha1constants.SecretNameCACluster],
+				},
+				KubeConfigRequest: &secrets.KubeConfigRequest{
+					ClusterName:  clusterName,
+					APIServerURL: v1alpha1constants.DeploymentNameKubeAPIServer,
+				},
+			},
+			&secrets.ControlPlaneSecretConfig{
+				CertificateSecretConfig: &secrets.CertificateSecretConfig{
+					Name:         csiAttacherName,
+					CommonName:   "system:csi-attacher",
+					Organization: []string{user.SystemPrivilegedGroup},
+					CertType:     secrets.ClientCert,
+					SigningCA:    cas[v1alpha1constants.SecretNameCACluster],
+				},
+				KubeConfigRequest: &secrets.KubeConfigRequest{
+					ClusterName:  clusterName,
+					APIServerURL: v1alpha1constants.DeploymentNameKubeAPIServer,
+				},
+			},
+			&secrets.ControlPlaneSecretConfig{
+				CertificateSecretConfig: &secrets.CertificateSecretConfig{
+					Name:         csiResizerName,
+					CommonName:   "system:csi-resizer",
+					Organization: []string{user.SystemPrivilegedGroup},
+					CertType:     secrets.ClientCert,
+					SigningCA:    cas[v1alpha1constants.SecretNameCACluster],
+				},
+				KubeConfigRequest: &secrets.KubeConfigRequest{
+					ClusterName:  clusterName,
+					APIServerURL: v1alpha1constants.DeploymentNameKubeAPIServer,
+				},
+			},
 		}
 	},
 }
@@ -126,23 +175,74 @@ var configChart = &chart.Chart{
 	},
 }
 
-var ccmChart = &chart.Chart{
-	Name:   "cloud-controller-manager",
-	Path:   filepath.Join(aws.InternalChartsPath, "cloud-controller-manager"),
-	Images: []string{aws.HyperkubeImageName},
-	Objects: []*chart.Object{
-		{Type: &corev1.Service{}, Name: "cloud-controller-manager"},
-		{Type: &appsv1.Deployment{}, Name: "cloud-controller-manager"},
-		{Type: &corev1.ConfigMap{}, Name: "cloud-controller-manager-monitoring-config"},
+var controlPlaneChart = &chart.Chart{
+	Name: "seed-controlplane",
+	Path: filepath.Join(aws.InternalChartsPath, "seed-controlplane"),
+	SubCharts: []*chart.Chart{
+		{
+			Name:   "cloud-controller-manager",
+			Images: []string{aws.HyperkubeImageName},
+			Objects: []*chart.Object{
+				{Type: &corev1.Service{}, Name: "cloud-controller-manager"},
+				{Type: &appsv1.Deployment{}, Name: "cloud-controller-manager"},
+				{Type: extensionscontroller.GetVerticalPodAutoscalerObject(), Name: "cloud-controller-manager-vpa"},
+				{Type: &policyv1beta1.PodDisruptionBudget{}, Name: "cloud-controller-manager"},
+				{Type: &corev1.ConfigMap{}, Name: "cloud-controller-manager-monitoring-config"},
+			},
+		},
+		{
+			Name: "csi-driver-controller",
+			Images: []string{
+				aws.CSIDriverImageName,
+				aws.CSIProvisionerImageName,
+				aws.CSIAttacherImageName,
+				aws.CSIResizerImageName,
+				aws.CSILivenessProbeImageName,
+			},
+			Objects: []*chart.Object{
+				{Type: &appsv1.Deployment{}, Name: csiDriverControllerDeploymentName},
+				{Type: extensionscontroller.GetVerticalPodAutoscalerObject(), Name: csiDriverControllerDeploymentName + "-vpa"},
+				{Type: &policyv1beta1.PodDisruptionBudget{}, Name: csiDriverControllerDeploymentName},
+			},
+		},
 	},
 }
 
-var ccmShootChart = &chart.Chart{
-	Name: "cloud-controller-manager-shoot",
-	Path: filepath.Join(aws.InternalChartsPath, "cloud-controller-manager-shoot"),
-	Objects: []*chart.Object{
-		{Type: &rbacv1.ClusterRole{}, Name: "system:controller:cloud-node-controller"},
-		{Type: &rbacv1.ClusterRoleBinding{}, Name: "system:controller:cloud-node-controller"},
+var controlPlaneShootChart = &chart.Chart{
+	Name: "shoot-system-components",
+	Path: filepath.Join(aws.InternalChartsPath, "shoot-system-components"),
+	SubCharts: []*chart.Chart{
+		{
+			Name: "cloud-controller-manager",
+			Objects: []*chart.Object{
+				{Type: &rbacv1.ClusterRole{}, Name: "system:controller:cloud-node-controller"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "system:controller:cloud-node-controller"},
+			},
+		},
+		{
+			Name: "csi-driver-node",
+			Images: []string{
+				aws.CSIDriverImageName,
+				aws.CSINodeDriverRegistrarImageName,
+				aws.CSILivenessProbeImageName,
+			},
+			Objects: []*chart.Object{
+				{Type: &appsv1.DaemonSet{}, Name: "csi-driver-node"},
+				{Type: &storagev1beta1.CSIDriver{}, Name: aws.CSIDriverName},
+				{Type: &corev1.ServiceAccount{}, Name: "csi-driver-node"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:psp:kube-system:csi-driver-node"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:psp:csi-driver-node"},
+				{Type: &policyv1beta1.PodSecurityPolicy{}, Name: "gardener.kube-system.csi-driver-node"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:kube-system:csi-provisioner"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:csi-provisioner"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:kube-system:csi-attacher"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:csi-attacher"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:kube-system:csi-resizer"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:csi-resizer"},
+				{Type: &rbacv1.Role{}, Name: "csi-leader-election"},
+				{Type: &rbacv1.RoleBinding{}, Name: "csi-leader-election"},
+			},
+		},
 	},
 }
 
@@ -162,18 +262,20 @@ var cpExposureChart = &chart.Chart{
 }
 
 // NewValuesProvider creates a new ValuesProvider for the generic actuator.
-func NewValuesProvider(logger logr.Logger) genericactuator.ValuesProvider {
+func NewValuesProvider(controlPlaneComponents config.ControlPlaneComponents, logger logr.Logger) genericactuator.ValuesProvider {
 	return &valuesProvider{
-		logger: logger.WithName("aws-values-provider"),
+		controlPlaneComponents: controlPlaneComponents,
+		logger:                 logger.WithName("aws-values-provider"),
 	}
 }
 
 // valuesProvider is a ValuesProvider that provides AWS-specific values for the 2 charts applied by the generic actuator.
 type valuesProvider struct {
 	genericactuator.NoopValuesProvider
-	decoder runtime.Decoder
-	client  client.Client
-	logger  logr.Logger
+	controlPlaneComponents config.ControlPlaneComponents
+	decoder                runtime.Decoder
+	client                 client.Client
+	logger                 logr.Logger
 }
 
 // InjectScheme injects the given scheme into the valuesProvider.
@@ -194,6 +296,12 @@ func (vp *valuesProvider) GetConfigChartValues(
 	cp *extensionsv1alpha1.ControlPlane,
 	cluster *extensionscontroller.Cluster,
 ) (map[string]interface{}, error) {
+	// Decode providerConfig
+	cpConfig := &apisaws.ControlPlaneConfig{}
+	if _, _, err := vp.decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, cpConfig); err != nil {
+		return nil, errors.Wrapf(err, "could not decode providerConfig of controlplane '%s'", util.ObjectName(cp))
+	}
+
 	// Decode infrastructureProviderStatus
 	infraStatus := &apisaws.InfrastructureStatus{}
 	if _, _, err := vp.decoder.Decode(cp.Spec.InfrastructureProviderStatus.Raw, nil, infraStatus); err != nil {
@@ -201,7 +309,7 @@ func (vp *valuesProvider) GetConfigChartValues(
 	}
 
 	// Get config chart values
-	return getConfigChartValues(infraStatus, cp)
+	return getConfigChartValues(cpConfig, infraStatus, cp)
 }
 
 // GetControlPlaneChartValues returns the values for the control plane chart applied by the generic actuator.
@@ -218,8 +326,47 @@ func (vp *valuesProvider) GetControlPlaneChartValues(
 		return nil, errors.Wrapf(err, "could not decode providerConfig of controlplane '%s'", util.ObjectName(cp))
 	}
 
-	// Get CCM chart values
-	return getCCMChartValues(cpConfig, cp, cluster, checksums, scaledDown)
+	// Get control plane chart values
+	values, err := getControlPlaneChartValues(cpConfig, cp, cluster, checksums, scaledDown, confighelper.GetControlPlaneComponentReplicas(vp.controlPlaneComponents))
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply the vertical pod autoscaling and high availability settings to all control plane components
+	componentValues := confighelper.GetControlPlaneComponentChartValues(vp.controlPlaneComponents)
+	for _, subChart := range controlPlaneChart.SubCharts {
+		if subChartValues, ok := values[subChart.Name].(map[string]interface{}); ok {
+			values[subChart.Name] = utils.MergeMaps(subChartValues, componentValues)
+		}
+	}
+
+	return values, nil
+}
+
+// GetControlPlaneShootChartValues returns the values for the control plane shoot chart applied by the generic actuator.
+func (vp *valuesProvider) GetControlPlaneShootChartValues(
+	ctx context.Context,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+) (map[string]interface{}, error) {
+	// Get control plane shoot chart values
+	return getControlPlaneShootChartValues(cluster)
+}
+
+// GetStorageClassesChartValues returns the values for the storage classes chart applied by the generic actuator.
+func (vp *valuesProvider) GetStorageClassesChartValues(
+	ctx context.Context,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+) (map[string]interface{}, error) {
+	// Decode providerConfig
+	cpConfig := &apisaws.ControlPlaneConfig{}
+	if _, _, err := vp.decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, cpConfig); err != nil {
+		return nil, errors.Wrapf(err, "could not decode providerConfig of controlplane '%s'", util.ObjectName(cp))
+	}
+
+	// Get storage classes chart values
+	return getStorageClassesChartValues(cpConfig, cluster)
 }
 
 // GetControlPlaneExposureChartValues deploys the aws-lb-readvertiser.
@@ -229,6 +376,18 @@ func (vp *valuesProvider) GetControlPlaneExposureChartValues(
 	cluster *extensionscontroller.Cluster,
 	checksums map[string]string,
 ) (map[string]interface{}, error) {
+	// The exposure controlplane doesn't carry a providerConfig, hence the config is taken from the shoot
+	cpConfig, err := helper.ControlPlaneConfigFromCluster(vp.decoder, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	// Network load balancers have static IP addresses, hence the aws-lb-readvertiser is not needed
+	if helper.GetLoadBalancerType(cpConfig) == apisaws.LoadBalancerTypeNLB {
+		return map[string]interface{}{
+			"enabled": false,
+		}, nil
+	}
 
 	// Get load balancer address of the kube-apiserver service
 	address, err := kutil.GetLoadBalancerIngress(ctx, vp.client, cp.Namespace, v1alpha1constants.DeploymentNameKubeAPIServer)
@@ -247,6 +406,7 @@ func (vp *valuesProvider) GetControlPlaneExposureChartValues(
 
 // getConfigChartValues collects and returns the configuration chart values.
 func getConfigChartValues(
+	cpConfig *apisaws.ControlPlaneConfig,
 	infraStatus *apisaws.InfrastructureStatus,
 	cp *extensionsv1alpha1.ControlPlane,
 ) (map[string]interface{}, error) {
@@ -257,11 +417,42 @@ func getConfigChartValues(
 	}
 
 	// Collect config chart values
-	return map[string]interface{}{
+	values := map[string]interface{}{
 		"vpcID":       infraStatus.VPC.ID,
 		"subnetID":    subnet.ID,
 		"clusterName": cp.Namespace,
 		"zone":        subnet.Zone,
+	}
+
+	if cpConfig.CloudControllerManager != nil && cpConfig.CloudControllerManager.KubernetesClusterTag != nil {
+		values["kubernetesClusterTag"] = *cpConfig.CloudControllerManager.KubernetesClusterTag
+	}
+
+	return values, nil
+}
+
+// getControlPlaneChartValues collects and returns the control plane chart values.
+func getControlPlaneChartValues(
+	cpConfig *apisaws.ControlPlaneConfig,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+	checksums map[string]string,
+	scaledDown bool,
+	replicas int,
+) (map[string]interface{}, error) {
+	ccm, err := getCCMChartValues(cpConfig, cp, cluster, checksums, scaledDown, replicas)
+	if err != nil {
+		return nil, err
+	}
+
+	csi, err := getCSIControllerChartValues(cp, cluster, checksums, scaledDown, replicas)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"cloud-controller-manager": ccm,
+		"csi-driver-controller":    csi,
 	}, nil
 }
 
@@ -272,9 +463,10 @@ func getCCMChartValues(
 	cluster *extensionscontroller.Cluster,
 	checksums map[string]string,
 	scaledDown bool,
+	replicas int,
 ) (map[string]interface{}, error) {
 	values := map[string]interface{}{
-		"replicas":          extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
+		"replicas":          extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, replicas),
 		"clusterName":       cp.Namespace,
 		"kubernetesVersion": extensionscontroller.GetKubernetesVersion(cluster),
 		"podNetwork":        extensionscontroller.GetPodNetwork(cluster),
@@ -286,9 +478,131 @@ func getCCMChartValues(
 		},
 	}
 
-	if cpConfig.CloudControllerManager != nil {
-		values["featureGates"] = cpConfig.CloudControllerManager.FeatureGates
+	if ccmConfig := cpConfig.CloudControllerManager; ccmConfig != nil {
+		values["featureGates"] = ccmConfig.FeatureGates
+		if ccmConfig.ConcurrentServiceSyncs != nil {
+			values["concurrentServiceSyncs"] = *ccmConfig.ConcurrentServiceSyncs
+		}
+		if ccmConfig.NodeMonitorPeriod != nil {
+			values["nodeMonitorPeriod"] = ccmConfig.NodeMonitorPeriod.Duration.String()
+		}
+		if ccmConfig.Verbosity != nil {
+			values["verbosity"] = *ccmConfig.Verbosity
+		}
 	}
 
 	return values, nil
 }
+
+// getCSIControllerChartValues collects and returns the CSI controller chart values.
+func getCSIControllerChartValues(
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+	checksums map[string]string,
+	scaledDown bool,
+	replicas int,
+) (map[string]interface{}, error) {
+	useCSI, err := useCSI(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	if !useCSI {
+		return map[string]interface{}{"enabled": false}, nil
+	}
+
+	return map[string]interface{}{
+		"enabled":  true,
+		"replicas": extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, replicas),
+		"region":   cp.Spec.Region,
+		"podAnnotations": map[string]interface{}{
+			"checksum/secret-" + csiProvisionerName:                        checksums[csiProvisionerName],
+			"checksum/secret-" + csiAttacherName:                           checksums[csiAttacherName],
+			"checksum/secret-" + csiResizerName:                            checksums[csiResizerName],
+			"checksum/secret-" + v1alpha1constants.SecretNameCloudProvider: checksums[v1alpha1constants.SecretNameCloudProvider],
+		},
+	}, nil
+}
+
+// getControlPlaneShootChartValues collects and returns the control plane shoot chart values.
+func getControlPlaneShootChartValues(cluster *extensionscontroller.Cluster) (map[string]interface{}, error) {
+	useCSI, err := useCSI(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"csi-driver-node": map[string]interface{}{
+			"enabled": useCSI,
+		},
+	}, nil
+}
+
+// getStorageClassesChartValues collects and returns the storage classes chart values.
+func getStorageClassesChartValues(
+	cpConfig *apisaws.ControlPlaneConfig,
+	cluster *extensionscontroller.Cluster,
+) (map[string]interface{}, error) {
+	useCSI, err := useCSI(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	storageClasses, customDefaultStorageClass := getStorageClasses(cpConfig.StorageClasses)
+
+	return map[string]interface{}{
+		"useCSI":                    useCSI,
+		"storageClasses":            storageClasses,
+		"customDefaultStorageClass": customDefaultStorageClass,
+	}, nil
+}
+
+// getStorageClasses collects and returns the chart values for the given additional storage classes. It also
+// returns whether one of them shall be the default storage class of the shoot cluster.
+func getStorageClasses(storageClasses []apisaws.StorageClass) ([]map[string]interface{}, bool) {
+	var (
+		values     = make([]map[string]interface{}, 0, len(storageClasses))
+		hasDefault bool
+	)
+
+	for _, sc := range storageClasses {
+		parameters := map[string]interface{}{
+			"type": sc.Type,
+		}
+		if sc.IOPS != nil {
+			parameters["iopsPerGB"] = strconv.FormatInt(*sc.IOPS, 10)
+		}
+		if sc.Encrypted != nil {
+			parameters["encrypted"] = strconv.FormatBool(*sc.Encrypted)
+		}
+
+		isDefault := sc.Default != nil && *sc.Default
+		if isDefault {
+			hasDefault = true
+		}
+
+		value := map[string]interface{}{
+			"name":       sc.Name,
+			"default":    isDefault,
+			"parameters": parameters,
+		}
+		if sc.ReclaimPolicy != nil {
+			value["reclaimPolicy"] = string(*sc.ReclaimPolicy)
+		}
+		if sc.VolumeBindingMode != nil {
+			value["volumeBindingMode"] = string(*sc.VolumeBindingMode)
+		}
+		values = append(values, value)
+	}
+
+	return values, hasDefault
+}
+
+// useCSI returns true if the AWS EBS CSI driver shall be used for the given cluster.
+func useCSI(cluster *extensionscontroller.Cluster) (bool, error) {
+	useCSI, err := aws.UseCSI(extensionscontroller.GetKubernetesVersion(cluster))
+	if err != nil {
+		return false, errors.Wrapf(err, "could not determine whether the CSI driver shall be used")
+	}
+	return useCSI, nil
+}
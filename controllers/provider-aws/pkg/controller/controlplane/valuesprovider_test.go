@@ -17,10 +17,13 @@ package controlplane
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/config"
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/controlplane"
 	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
@@ -49,18 +52,27 @@ var _ = Describe("ValuesProvider", func() {
 		scheme = runtime.NewScheme()
 		_      = apisaws.AddToScheme(scheme)
 
+		concurrentServiceSyncs int32 = 5
+		verbosity              int32 = 4
+		clusterTag                   = "legacy-cluster"
+
 		cp = &extensionsv1alpha1.ControlPlane{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "control-plane",
 				Namespace: namespace,
 			},
 			Spec: extensionsv1alpha1.ControlPlaneSpec{
+				Region: "eu-west-1",
 				ProviderConfig: &runtime.RawExtension{
 					Raw: encode(&apisaws.ControlPlaneConfig{
 						CloudControllerManager: &apisaws.CloudControllerManagerConfig{
 							FeatureGates: map[string]bool{
 								"CustomResourceValidation": true,
 							},
+							ConcurrentServiceSyncs: &concurrentServiceSyncs,
+							NodeMonitorPeriod:      &metav1.Duration{Duration: 10 * time.Second},
+							Verbosity:              &verbosity,
+							KubernetesClusterTag:   &clusterTag,
 						},
 					}),
 				},
@@ -94,6 +106,18 @@ var _ = Describe("ValuesProvider", func() {
 				},
 			},
 		}
+		csiCluster = &extensionscontroller.Cluster{
+			CoreShoot: &gardencorev1alpha1.Shoot{
+				Spec: gardencorev1alpha1.ShootSpec{
+					Networking: gardencorev1alpha1.Networking{
+						Pods: &cidr,
+					},
+					Kubernetes: gardencorev1alpha1.Kubernetes{
+						Version: "1.18.0",
+					},
+				},
+			},
+		}
 		cpService = &corev1.Service{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      v1alpha1constants.DeploymentNameKubeAPIServer,
@@ -114,28 +138,56 @@ var _ = Describe("ValuesProvider", func() {
 			"cloud-controller-manager":                "3d791b164a808638da9a8df03924be2a41e34cd664e42231c00fe369e3588272",
 			"cloud-controller-manager-server":         "6dff2a2e6f14444b66d8e4a351c049f7e89ee24ba3eaab95dbec40ba6bdebb52",
 			awsLBReadvertiserDeploymentName:           "599aeee0cbbfdab4ea29c642cb04a6c9a3eb90ec21b41570efb987958f99d4b1",
+			csiProvisionerName:                        "65b1dac6b50673535cff480564c2e5c71077ed19b1b6e0e2291207225bdf77d4",
+			csiAttacherName:                           "2da58ad61c401a2af779a909d22fb42eed93a1524cbfdab974ceedb413fcb914",
+			csiResizerName:                            "a77e663ba1af340fb3dd7f6f8a1be47c7aa9e658198695480641e6b934c0b9ed",
 		}
 
 		configChartValues = map[string]interface{}{
-			"vpcID":       "vpc-1234",
-			"subnetID":    "subnet-acbd1234",
-			"clusterName": namespace,
-			"zone":        "eu-west-1a",
+			"vpcID":                "vpc-1234",
+			"subnetID":             "subnet-acbd1234",
+			"clusterName":          namespace,
+			"zone":                 "eu-west-1a",
+			"kubernetesClusterTag": clusterTag,
 		}
 
-		ccmChartValues = map[string]interface{}{
-			"replicas":          1,
-			"clusterName":       namespace,
-			"kubernetesVersion": "1.13.4",
-			"podNetwork":        cidr,
-			"podAnnotations": map[string]interface{}{
-				"checksum/secret-cloud-controller-manager":        "3d791b164a808638da9a8df03924be2a41e34cd664e42231c00fe369e3588272",
-				"checksum/secret-cloud-controller-manager-server": "6dff2a2e6f14444b66d8e4a351c049f7e89ee24ba3eaab95dbec40ba6bdebb52",
-				"checksum/secret-cloudprovider":                   "8bafb35ff1ac60275d62e1cbd495aceb511fb354f74a20f7d06ecb48b3a68432",
-				"checksum/configmap-cloud-provider-config":        "08a7bc7fe8f59b055f173145e211760a83f02cf89635cef26ebb351378635606",
+		vpaChartValues = map[string]interface{}{
+			"enabled": true,
+			"updatePolicy": map[string]interface{}{
+				"updateMode": "Auto",
 			},
-			"featureGates": map[string]bool{
-				"CustomResourceValidation": true,
+		}
+		disabledChartValues = map[string]interface{}{
+			"enabled": false,
+		}
+
+		controlPlaneChartValues = map[string]interface{}{
+			"cloud-controller-manager": map[string]interface{}{
+				"replicas":          1,
+				"clusterName":       namespace,
+				"kubernetesVersion": "1.13.4",
+				"podNetwork":        cidr,
+				"podAnnotations": map[string]interface{}{
+					"checksum/secret-cloud-controller-manager":        "3d791b164a808638da9a8df03924be2a41e34cd664e42231c00fe369e3588272",
+					"checksum/secret-cloud-controller-manager-server": "6dff2a2e6f14444b66d8e4a351c049f7e89ee24ba3eaab95dbec40ba6bdebb52",
+					"checksum/secret-cloudprovider":                   "8bafb35ff1ac60275d62e1cbd495aceb511fb354f74a20f7d06ecb48b3a68432",
+					"checksum/configmap-cloud-provider-config":        "08a7bc7fe8f59b055f173145e211760a83f02cf89635cef26ebb351378635606",
+				},
+				"featureGates": map[string]bool{
+					"CustomResourceValidation": true,
+				},
+				"concurrentServiceSyncs": concurrentServiceSyncs,
+				"nodeMonitorPeriod":      "10s",
+				"verbosity":              verbosity,
+				"vpa":                    vpaChartValues,
+				"podDisruptionBudget":    disabledChartValues,
+				"podAntiAffinity":        disabledChartValues,
+			},
+			"csi-driver-controller": map[string]interface{}{
+				"enabled":             false,
+				"vpa":                 vpaChartValues,
+				"podDisruptionBudget": disabledChartValues,
+				"podAntiAffinity":     disabledChartValues,
 			},
 		}
 
@@ -161,7 +213,7 @@ var _ = Describe("ValuesProvider", func() {
 	Describe("#GetConfigChartValues", func() {
 		It("should return correct config chart values", func() {
 			// Create valuesProvider
-			vp := NewValuesProvider(logger)
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
 			err := vp.(inject.Scheme).InjectScheme(scheme)
 			Expect(err).NotTo(HaveOccurred())
 
@@ -175,14 +227,153 @@ var _ = Describe("ValuesProvider", func() {
 	Describe("#GetControlPlaneChartValues", func() {
 		It("should return correct control plane chart values", func() {
 			// Create valuesProvider
-			vp := NewValuesProvider(logger)
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetControlPlaneChartValues method and check the result
+			values, err := vp.GetControlPlaneChartValues(context.TODO(), cp, cluster, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(controlPlaneChartValues))
+		})
+
+		It("should return correct control plane chart values if the CSI driver is used", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetControlPlaneChartValues method and check the result
+			values, err := vp.GetControlPlaneChartValues(context.TODO(), cp, csiCluster, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("csi-driver-controller", map[string]interface{}{
+				"enabled":  true,
+				"replicas": 1,
+				"region":   "eu-west-1",
+				"podAnnotations": map[string]interface{}{
+					"checksum/secret-csi-provisioner": "65b1dac6b50673535cff480564c2e5c71077ed19b1b6e0e2291207225bdf77d4",
+					"checksum/secret-csi-attacher":    "2da58ad61c401a2af779a909d22fb42eed93a1524cbfdab974ceedb413fcb914",
+					"checksum/secret-csi-resizer":     "a77e663ba1af340fb3dd7f6f8a1be47c7aa9e658198695480641e6b934c0b9ed",
+					"checksum/secret-cloudprovider":   "8bafb35ff1ac60275d62e1cbd495aceb511fb354f74a20f7d06ecb48b3a68432",
+				},
+				"vpa":                 vpaChartValues,
+				"podDisruptionBudget": disabledChartValues,
+				"podAntiAffinity":     disabledChartValues,
+			}))
+		})
+
+		It("should return control plane chart values with the configured control plane component settings", func() {
+			var (
+				enabled    = true
+				disabled   = false
+				updateMode = "Initial"
+				replicas   = int32(2)
+			)
+
+			// Create valuesProvider
+			vp := NewValuesProvider(config.ControlPlaneComponents{
+				VPA: &config.VPA{
+					Enabled:    &disabled,
+					UpdateMode: &updateMode,
+				},
+				PodDisruptionBudget: &enabled,
+				PodAntiAffinity:     &enabled,
+				Replicas:            &replicas,
+			}, logger)
 			err := vp.(inject.Scheme).InjectScheme(scheme)
 			Expect(err).NotTo(HaveOccurred())
 
 			// Call GetControlPlaneChartValues method and check the result
 			values, err := vp.GetControlPlaneChartValues(context.TODO(), cp, cluster, checksums, false)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(values).To(Equal(ccmChartValues))
+			for _, name := range []string{"cloud-controller-manager", "csi-driver-controller"} {
+				Expect(values).To(HaveKey(name))
+				Expect(values[name]).To(HaveKeyWithValue("vpa", map[string]interface{}{
+					"enabled": false,
+					"updatePolicy": map[string]interface{}{
+						"updateMode": "Initial",
+					},
+				}))
+				Expect(values[name]).To(HaveKeyWithValue("podDisruptionBudget", map[string]interface{}{"enabled": true}))
+				Expect(values[name]).To(HaveKeyWithValue("podAntiAffinity", map[string]interface{}{"enabled": true}))
+			}
+			Expect(values["cloud-controller-manager"]).To(HaveKeyWithValue("replicas", 2))
+		})
+	})
+
+	Describe("#GetControlPlaneShootChartValues", func() {
+		It("should return correct control plane shoot chart values", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
+
+			// Call GetControlPlaneShootChartValues method and check the result
+			values, err := vp.GetControlPlaneShootChartValues(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"csi-driver-node": map[string]interface{}{"enabled": false},
+			}))
+
+			values, err = vp.GetControlPlaneShootChartValues(context.TODO(), cp, csiCluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"csi-driver-node": map[string]interface{}{"enabled": true},
+			}))
+		})
+	})
+
+	Describe("#GetStorageClassesChartValues", func() {
+		It("should return correct storage classes chart values", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetStorageClassesChartValues method and check the result
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{"useCSI": false, "storageClasses": []map[string]interface{}{}, "customDefaultStorageClass": false}))
+
+			values, err = vp.GetStorageClassesChartValues(context.TODO(), cp, csiCluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{"useCSI": true, "storageClasses": []map[string]interface{}{}, "customDefaultStorageClass": false}))
+		})
+
+		It("should return correct storage classes chart values for additional storage classes", func() {
+			var (
+				iops      int64 = 10
+				encrypted       = true
+				retain          = corev1.PersistentVolumeReclaimRetain
+				isDefault       = true
+			)
+			cpWithStorageClasses := cp.DeepCopy()
+			cpWithStorageClasses.Spec.ProviderConfig = &runtime.RawExtension{
+				Raw: encode(&apisaws.ControlPlaneConfig{
+					StorageClasses: []apisaws.StorageClass{
+						{Name: "fast", Type: "io1", IOPS: &iops, Encrypted: &encrypted, ReclaimPolicy: &retain, Default: &isDefault},
+					},
+				}),
+			}
+
+			// Create valuesProvider
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetStorageClassesChartValues method and check the result
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), cpWithStorageClasses, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"useCSI": false,
+				"storageClasses": []map[string]interface{}{
+					{
+						"name":          "fast",
+						"default":       true,
+						"reclaimPolicy": "Retain",
+						"parameters":    map[string]interface{}{"type": "io1", "iopsPerGB": "10", "encrypted": "true"},
+					},
+				},
+				"customDefaultStorageClass": true,
+			}))
 		})
 	})
 
@@ -194,7 +385,7 @@ var _ = Describe("ValuesProvider", func() {
 			client.EXPECT().Get(context.TODO(), serviceKey, &corev1.Service{}).DoAndReturn(clientGet(cpService))
 
 			// Create valuesProvider
-			vp := NewValuesProvider(logger)
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
 			err := vp.(inject.Scheme).InjectScheme(scheme)
 			Expect(err).NotTo(HaveOccurred())
 			err = vp.(inject.Client).InjectClient(client)
@@ -205,6 +396,47 @@ var _ = Describe("ValuesProvider", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(values).To(Equal(cpExposureChartValues))
 		})
+
+		It("should disable the aws-lb-readvertiser for network load balancers", func() {
+			nlb := apisaws.LoadBalancerTypeNLB
+			nlbCluster := &extensionscontroller.Cluster{
+				CoreShoot: &gardencorev1alpha1.Shoot{
+					Spec: gardencorev1alpha1.ShootSpec{
+						Provider: gardencorev1alpha1.Provider{
+							ControlPlaneConfig: &gardencorev1alpha1.ProviderConfig{
+								RawExtension: runtime.RawExtension{
+									Raw: encode(&apisaws.ControlPlaneConfig{
+										LoadBalancer: &apisaws.LoadBalancerConfig{Type: &nlb},
+									}),
+								},
+							},
+						},
+					},
+				},
+			}
+
+			// Create valuesProvider
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetControlPlaneExposureChartValues method and check the result
+			values, err := vp.GetControlPlaneExposureChartValues(context.TODO(), cp, nlbCluster, checksums)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{"enabled": false}))
+		})
+	})
+
+	Describe("#GetMonitoringConfig", func() {
+		It("should return the default monitoring configuration", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
+
+			// Call GetMonitoringConfig method and check the result
+			monitoringConfig, err := vp.GetMonitoringConfig(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(monitoringConfig).To(Equal(controlplane.DefaultMonitoringConfig()))
+		})
 	})
 })
 
@@ -51,7 +51,12 @@ func (a *actuator) reconcile(ctx context.Context, infrastructure *extensionsv1al
 		return err
 	}
 
-	terraformConfig, err := generateTerraformInfraConfig(ctx, infrastructure, infrastructureConfig, providerSecret)
+	awsClient, err := client.NewClient(string(providerSecret.Data[aws.AccessKeyID]), string(providerSecret.Data[aws.SecretAccessKey]), infrastructure.Spec.Region)
+	if err != nil {
+		return err
+	}
+
+	terraformConfig, err := generateTerraformInfraConfig(ctx, infrastructure, infrastructureConfig, awsClient)
 	if err != nil {
 		return fmt.Errorf("failed to generate Terraform config: %+v", err)
 	}
@@ -91,13 +96,15 @@ func (a *actuator) reconcile(ctx context.Context, infrastructure *extensionsv1al
 	return a.updateProviderStatus(ctx, tf, infrastructure, infrastructureConfig)
 }
 
-func generateTerraformInfraConfig(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, infrastructureConfig *awsapi.InfrastructureConfig, providerSecret *corev1.Secret) (map[string]interface{}, error) {
+func generateTerraformInfraConfig(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, infrastructureConfig *awsapi.InfrastructureConfig, awsClient client.Interface) (map[string]interface{}, error) {
 	var (
 		dhcpDomainName    = "ec2.internal"
 		createVPC         = true
 		vpcID             = "${aws_vpc.vpc.id}"
 		vpcCIDR           = ""
+		vpcIPv6CIDR       = "${aws_vpc.vpc.ipv6_cidr_block}"
 		internetGatewayID = "${aws_internet_gateway.igw.id}"
+		dualStack         = isDualStack(infrastructureConfig)
 	)
 
 	if infrastructure.Spec.Region != "us-east-1" {
@@ -108,15 +115,22 @@ func generateTerraformInfraConfig(ctx context.Context, infrastructure *extension
 	case infrastructureConfig.Networks.VPC.ID != nil:
 		createVPC = false
 		vpcID = *infrastructureConfig.Networks.VPC.ID
-		awsClient, err := client.NewClient(string(providerSecret.Data[aws.AccessKeyID]), string(providerSecret.Data[aws.SecretAccessKey]), infrastructure.Spec.Region)
-		if err != nil {
-			return nil, err
-		}
 		igwID, err := awsClient.GetInternetGateway(ctx, vpcID)
 		if err != nil {
 			return nil, err
 		}
 		internetGatewayID = igwID
+
+		if dualStack {
+			ipv6CIDR, err := awsClient.GetVPCIPv6CIDRBlock(ctx, vpcID)
+			if err != nil {
+				return nil, err
+			}
+			if len(ipv6CIDR) == 0 {
+				return nil, fmt.Errorf("dual-stack networking requires an IPv6 CIDR block associated with vpc %s", vpcID)
+			}
+			vpcIPv6CIDR = ipv6CIDR
+		}
 	case infrastructureConfig.Networks.VPC.CIDR != nil:
 		vpcCIDR = string(*infrastructureConfig.Networks.VPC.CIDR)
 	}
@@ -138,10 +152,12 @@ func generateTerraformInfraConfig(ctx context.Context, infrastructure *extension
 		"create": map[string]interface{}{
 			"vpc": createVPC,
 		},
+		"dualStack":    dualStack,
 		"sshPublicKey": string(infrastructure.Spec.SSHPublicKey),
 		"vpc": map[string]interface{}{
 			"id":                vpcID,
 			"cidr":              vpcCIDR,
+			"ipv6CIDR":          vpcIPv6CIDR,
 			"dhcpDomainName":    dhcpDomainName,
 			"internetGatewayID": internetGatewayID,
 		},
@@ -149,8 +165,11 @@ func generateTerraformInfraConfig(ctx context.Context, infrastructure *extension
 		"zones":       zones,
 		"outputKeys": map[string]interface{}{
 			"vpcIdKey":                   aws.VPCIDKey,
+			"vpcIPv6CIDRKey":             aws.VPCIPv6CIDRKey,
 			"subnetsPublicPrefix":        aws.SubnetPublicPrefix,
 			"subnetsNodesPrefix":         aws.SubnetNodesPrefix,
+			"subnetsPublicIPv6Prefix":    aws.SubnetPublicIPv6Prefix,
+			"subnetsNodesIPv6Prefix":     aws.SubnetNodesIPv6Prefix,
 			"securityGroupsNodes":        aws.SecurityGroupsNodes,
 			"sshKeyName":                 aws.SSHKeyName,
 			"iamInstanceProfileNodes":    aws.IAMInstanceProfileNodes,
@@ -175,6 +194,15 @@ func (a *actuator) updateProviderStatus(ctx context.Context, tf *terraformer.Ter
 		outputVarKeys = append(outputVarKeys, fmt.Sprintf("%s%d", aws.SubnetPublicPrefix, zoneIndex))
 	}
 
+	dualStack := isDualStack(infrastructureConfig)
+	if dualStack {
+		outputVarKeys = append(outputVarKeys, aws.VPCIPv6CIDRKey)
+		for zoneIndex := range infrastructureConfig.Networks.Zones {
+			outputVarKeys = append(outputVarKeys, fmt.Sprintf("%s%d", aws.SubnetNodesIPv6Prefix, zoneIndex))
+			outputVarKeys = append(outputVarKeys, fmt.Sprintf("%s%d", aws.SubnetPublicIPv6Prefix, zoneIndex))
+		}
+	}
+
 	output, err := tf.GetStateOutputVariables(outputVarKeys...)
 	if err != nil {
 		return err
@@ -193,8 +221,9 @@ func (a *actuator) updateProviderStatus(ctx context.Context, tf *terraformer.Ter
 					Kind:       "InfrastructureStatus",
 				},
 				VPC: awsv1alpha1.VPCStatus{
-					ID:      output[aws.VPCIDKey],
-					Subnets: subnets,
+					ID:       output[aws.VPCIDKey],
+					IPv6CIDR: output[aws.VPCIPv6CIDRKey],
+					Subnets:  subnets,
 					SecurityGroups: []awsv1alpha1.SecurityGroup{
 						{
 							Purpose: awsapi.PurposeNodes,
@@ -229,13 +258,15 @@ func computeProviderStatusSubnets(infrastructure *awsapi.InfrastructureConfig, v
 	var subnetsToReturn []awsv1alpha1.Subnet
 
 	for key, value := range values {
-		var prefix, purpose string
+		var prefix, ipv6Prefix, purpose string
 		if strings.HasPrefix(key, aws.SubnetPublicPrefix) {
 			prefix = aws.SubnetPublicPrefix
+			ipv6Prefix = aws.SubnetPublicIPv6Prefix
 			purpose = awsapi.PurposePublic
 		}
 		if strings.HasPrefix(key, aws.SubnetNodesPrefix) {
 			prefix = aws.SubnetNodesPrefix
+			ipv6Prefix = aws.SubnetNodesIPv6Prefix
 			purpose = awsv1alpha1.PurposeNodes
 		}
 
@@ -248,11 +279,16 @@ func computeProviderStatusSubnets(infrastructure *awsapi.InfrastructureConfig, v
 			return nil, err
 		}
 		subnetsToReturn = append(subnetsToReturn, awsv1alpha1.Subnet{
-			ID:      value,
-			Purpose: purpose,
-			Zone:    infrastructure.Networks.Zones[zoneID].Name,
+			ID:       value,
+			Purpose:  purpose,
+			Zone:     infrastructure.Networks.Zones[zoneID].Name,
+			IPv6CIDR: values[fmt.Sprintf("%s%d", ipv6Prefix, zoneID)],
 		})
 	}
 
 	return subnetsToReturn, nil
 }
+
+func isDualStack(infrastructureConfig *awsapi.InfrastructureConfig) bool {
+	return infrastructureConfig.Networks.DualStack != nil && infrastructureConfig.Networks.DualStack.Enabled
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	awsapi "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	awsv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/v1alpha1"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws/client"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type fakeAWSClient struct {
+	client.Interface
+
+	vpcIPv6CIDR string
+}
+
+func (c *fakeAWSClient) GetInternetGateway(_ context.Context, vpcID string) (string, error) {
+	return fmt.Sprintf("igw-%s", vpcID), nil
+}
+
+func (c *fakeAWSClient) GetVPCIPv6CIDRBlock(_ context.Context, _ string) (string, error) {
+	return c.vpcIPv6CIDR, nil
+}
+
+var _ = Describe("Reconcile", func() {
+	var (
+		ctx       = context.TODO()
+		awsClient *fakeAWSClient
+		infra     *extensionsv1alpha1.Infrastructure
+		config    *awsapi.InfrastructureConfig
+
+		vpcID   = "vpc-1234"
+		vpcCIDR = "10.250.0.0/16"
+	)
+
+	BeforeEach(func() {
+		awsClient = &fakeAWSClient{}
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "infra"},
+			Spec:       extensionsv1alpha1.InfrastructureSpec{Region: "eu-west-1"},
+		}
+		config = &awsapi.InfrastructureConfig{
+			Networks: awsapi.Networks{
+				VPC: awsapi.VPC{CIDR: &vpcCIDR},
+				Zones: []awsapi.Zone{
+					{Name: "eu-west-1a", Workers: "10.250.0.0/19", Public: "10.250.96.0/22", Internal: "10.250.112.0/22"},
+				},
+			},
+		}
+	})
+
+	Describe("#generateTerraformInfraConfig", func() {
+		It("should not enable dual-stack by default", func() {
+			values, err := generateTerraformInfraConfig(ctx, infra, config, awsClient)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("dualStack", false))
+			Expect(values).To(HaveKeyWithValue("create", map[string]interface{}{"vpc": true}))
+		})
+
+		It("should use the IPv6 CIDR block generated for a new vpc", func() {
+			config.Networks.DualStack = &awsapi.DualStack{Enabled: true}
+
+			values, err := generateTerraformInfraConfig(ctx, infra, config, awsClient)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("dualStack", true))
+			Expect(values["vpc"]).To(HaveKeyWithValue("ipv6CIDR", "${aws_vpc.vpc.ipv6_cidr_block}"))
+		})
+
+		It("should use the IPv6 CIDR block associated with an existing vpc", func() {
+			config.Networks.VPC = awsapi.VPC{ID: &vpcID}
+			config.Networks.DualStack = &awsapi.DualStack{Enabled: true}
+			awsClient.vpcIPv6CIDR = "2a05:d018:1:2300::/56"
+
+			values, err := generateTerraformInfraConfig(ctx, infra, config, awsClient)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("create", map[string]interface{}{"vpc": false}))
+			Expect(values["vpc"]).To(HaveKeyWithValue("id", vpcID))
+			Expect(values["vpc"]).To(HaveKeyWithValue("internetGatewayID", "igw-"+vpcID))
+			Expect(values["vpc"]).To(HaveKeyWithValue("ipv6CIDR", "2a05:d018:1:2300::/56"))
+		})
+
+		It("should fail if an existing vpc has no IPv6 CIDR block", func() {
+			config.Networks.VPC = awsapi.VPC{ID: &vpcID}
+			config.Networks.DualStack = &awsapi.DualStack{Enabled: true}
+
+			_, err := generateTerraformInfraConfig(ctx, infra, config, awsClient)
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should not look up the IPv6 CIDR block of an existing vpc without dual-stack", func() {
+			config.Networks.VPC = awsapi.VPC{ID: &vpcID}
+
+			values, err := generateTerraformInfraConfig(ctx, infra, config, awsClient)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("dualStack", false))
+		})
+	})
+
+	Describe("#computeProviderStatusSubnets", func() {
+		It("should add the IPv6 CIDR blocks of dual-stack subnets", func() {
+			subnets, err := computeProviderStatusSubnets(config, map[string]string{
+				aws.SubnetNodesPrefix + "0":      "subnet-nodes",
+				aws.SubnetPublicPrefix + "0":     "subnet-public",
+				aws.SubnetNodesIPv6Prefix + "0":  "2a05:d018:1:2300::/64",
+				aws.SubnetPublicIPv6Prefix + "0": "2a05:d018:1:2302::/64",
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(subnets).To(ConsistOf(
+				awsv1alpha1.Subnet{ID: "subnet-nodes", Purpose: awsapi.PurposeNodes, Zone: "eu-west-1a", IPv6CIDR: "2a05:d018:1:2300::/64"},
+				awsv1alpha1.Subnet{ID: "subnet-public", Purpose: awsapi.PurposePublic, Zone: "eu-west-1a", IPv6CIDR: "2a05:d018:1:2302::/64"},
+			))
+		})
+	})
+})
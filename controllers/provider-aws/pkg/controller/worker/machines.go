@@ -134,18 +134,18 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				return err
 			}
 
+			networkInterface := map[string]interface{}{
+				"subnetID":         nodesSubnet.ID,
+				"securityGroupIDs": []string{nodesSecurityGroup.ID},
+			}
+
 			machineClassSpec := map[string]interface{}{
 				"ami":                ami,
 				"region":             w.worker.Spec.Region,
 				"machineType":        pool.MachineType,
 				"iamInstanceProfile": nodesInstanceProfile.Name,
 				"keyName":            infrastructureStatus.EC2.KeyName,
-				"networkInterfaces": []map[string]interface{}{
-					{
-						"subnetID":         nodesSubnet.ID,
-						"securityGroupIDs": []string{nodesSecurityGroup.ID},
-					},
-				},
+				"networkInterfaces":  []map[string]interface{}{networkInterface},
 				"tags": map[string]string{
 					fmt.Sprintf("kubernetes.io/cluster/%s", w.worker.Namespace): "1",
 					"kubernetes.io/role/node":                                   "1",
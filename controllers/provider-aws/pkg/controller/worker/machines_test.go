@@ -64,7 +64,7 @@ var _ = Describe("Machines", func() {
 	})
 
 	Context("workerDelegate", func() {
-		workerDelegate := NewWorkerDelegate(nil, nil, nil, nil, nil, "", nil, nil)
+		workerDelegate := NewWorkerDelegate(nil, nil, nil, nil, config.ControlPlaneComponents{}, nil, "", nil, nil)
 
 		Describe("#MachineClassKind", func() {
 			It("should return the correct kind of the machine class", func() {
@@ -288,7 +288,7 @@ var _ = Describe("Machines", func() {
 				_ = awsv1alpha1.AddToScheme(scheme)
 				decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
 
-				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToAMIMapping, config.ControlPlaneComponents{}, chartApplier, "", w, cluster)
 			})
 
 			It("should return the expected machine deployments", func() {
@@ -447,6 +447,35 @@ var _ = Describe("Machines", func() {
 				Expect(result).To(Equal(machineDeployments))
 			})
 
+			It("should not request IPv6 addresses for dual-stack subnets", func() {
+				infrastructureStatus := &apisaws.InfrastructureStatus{}
+				Expect(json.Unmarshal(w.Spec.InfrastructureProviderStatus.Raw, infrastructureStatus)).To(Succeed())
+				for i := range infrastructureStatus.VPC.Subnets {
+					infrastructureStatus.VPC.Subnets[i].IPv6CIDR = fmt.Sprintf("2600:1f18:47a:120%d::/64", i)
+				}
+				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: encode(infrastructureStatus)}
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToAMIMapping, config.ControlPlaneComponents{}, chartApplier, "", w, cluster)
+
+				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
+				chartApplier.
+					EXPECT().
+					ApplyChart(context.TODO(), filepath.Join(aws.InternalChartsPath, "machineclass"), namespace, "machineclass", gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, _, _, _ string, values, _ map[string]interface{}) error {
+						machineClasses := values["machineClasses"].([]map[string]interface{})
+						Expect(machineClasses).To(HaveLen(4))
+						for _, machineClass := range machineClasses {
+							for _, networkInterface := range machineClass["networkInterfaces"].([]map[string]interface{}) {
+								Expect(networkInterface).To(HaveLen(2))
+								Expect(networkInterface).To(HaveKey("subnetID"))
+								Expect(networkInterface).To(HaveKey("securityGroupIDs"))
+							}
+						}
+						return nil
+					})
+
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+			})
+
 			It("should fail because the secret cannot be read", func() {
 				c.EXPECT().
 					Get(context.TODO(), gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).
@@ -461,7 +490,7 @@ var _ = Describe("Machines", func() {
 				expectGetSecretCallToWork(c, awsAccessKeyID, awsSecretAccessKey)
 
 				cluster.CoreShoot.Spec.Kubernetes.Version = "invalid"
-				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToAMIMapping, config.ControlPlaneComponents{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -473,7 +502,7 @@ var _ = Describe("Machines", func() {
 
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{}
 
-				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToAMIMapping, config.ControlPlaneComponents{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -487,7 +516,7 @@ var _ = Describe("Machines", func() {
 					Raw: encode(&apisaws.InfrastructureStatus{}),
 				}
 
-				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToAMIMapping, config.ControlPlaneComponents{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -510,7 +539,7 @@ var _ = Describe("Machines", func() {
 					}),
 				}
 
-				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToAMIMapping, config.ControlPlaneComponents{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -522,7 +551,7 @@ var _ = Describe("Machines", func() {
 
 				w.Spec.Region = "another-region"
 
-				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToAMIMapping, config.ControlPlaneComponents{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -554,7 +583,7 @@ var _ = Describe("Machines", func() {
 					}),
 				}
 
-				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToAMIMapping, config.ControlPlaneComponents{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -566,7 +595,7 @@ var _ = Describe("Machines", func() {
 
 				w.Spec.Pools[0].Volume.Size = "not-decodeable"
 
-				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToAMIMapping, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImageToAMIMapping, config.ControlPlaneComponents{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -21,6 +21,7 @@ import (
 
 	"github.com/coreos/go-systemd/unit"
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane/genericmutator"
@@ -53,7 +54,7 @@ func (e *ensurer) InjectClient(client client.Client) error {
 }
 
 // EnsureKubeAPIServerDeployment ensures that the kube-apiserver deployment conforms to the provider requirements.
-func (e *ensurer) EnsureKubeAPIServerDeployment(ctx context.Context, dep *appsv1.Deployment) error {
+func (e *ensurer) EnsureKubeAPIServerDeployment(ctx context.Context, dep *appsv1.Deployment, cluster *extensionscontroller.Cluster) error {
 	template := &dep.Spec.Template
 	ps := &template.Spec
 	if c := extensionswebhook.ContainerWithName(ps.Containers, "kube-apiserver"); c != nil {
@@ -66,11 +67,16 @@ func (e *ensurer) EnsureKubeAPIServerDeployment(ctx context.Context, dep *appsv1
 }
 
 // EnsureKubeControllerManagerDeployment ensures that the kube-controller-manager deployment conforms to the provider requirements.
-func (e *ensurer) EnsureKubeControllerManagerDeployment(ctx context.Context, dep *appsv1.Deployment) error {
+func (e *ensurer) EnsureKubeControllerManagerDeployment(ctx context.Context, dep *appsv1.Deployment, cluster *extensionscontroller.Cluster) error {
 	template := &dep.Spec.Template
 	ps := &template.Spec
+	useCSI, err := aws.UseCSI(extensionscontroller.GetKubernetesVersion(cluster))
+	if err != nil {
+		return err
+	}
+
 	if c := extensionswebhook.ContainerWithName(ps.Containers, "kube-controller-manager"); c != nil {
-		ensureKubeControllerManagerCommandLineArgs(c)
+		ensureKubeControllerManagerCommandLineArgs(c, useCSI)
 		ensureEnvVars(c)
 		ensureVolumeMounts(c)
 	}
@@ -89,13 +95,31 @@ func ensureKubeAPIServerCommandLineArgs(c *corev1.Container) {
 		"PersistentVolumeLabel", ",")
 }
 
-func ensureKubeControllerManagerCommandLineArgs(c *corev1.Container) {
+func ensureKubeControllerManagerCommandLineArgs(c *corev1.Container, useCSI bool) {
 	c.Command = extensionswebhook.EnsureStringWithPrefix(c.Command, "--cloud-provider=", "external")
 	c.Command = extensionswebhook.EnsureStringWithPrefix(c.Command, "--cloud-config=",
 		"/etc/kubernetes/cloudprovider/cloudprovider.conf")
+
+	if useCSI {
+		// The in-tree volume plugin is switched off, volumes are handled by the AWS EBS CSI driver.
+		c.Command = extensionswebhook.EnsureNoStringWithPrefix(c.Command, "--external-cloud-volume-plugin=")
+		for _, featureGate := range csiMigrationFeatureGates {
+			c.Command = extensionswebhook.EnsureStringWithPrefixContains(c.Command, "--feature-gates=", featureGate+"=true", ",")
+		}
+		return
+	}
+
 	c.Command = extensionswebhook.EnsureStringWithPrefix(c.Command, "--external-cloud-volume-plugin=", "aws")
 }
 
+// csiMigrationFeatureGates are the feature gates that redirect all operations of the in-tree AWS EBS
+// volume plugin to the AWS EBS CSI driver and disable the in-tree plugin.
+var csiMigrationFeatureGates = []string{
+	"CSIMigration",
+	"CSIMigrationAWS",
+	"CSIMigrationAWSComplete",
+}
+
 func ensureKubeControllerManagerAnnotations(t *corev1.PodTemplateSpec) {
 	t.Labels = extensionswebhook.EnsureAnnotationOrLabel(t.Labels, v1alpha1constants.LabelNetworkPolicyToPublicNetworks, v1alpha1constants.LabelNetworkPolicyAllowed)
 	t.Labels = extensionswebhook.EnsureAnnotationOrLabel(t.Labels, v1alpha1constants.LabelNetworkPolicyToPrivateNetworks, v1alpha1constants.LabelNetworkPolicyAllowed)
@@ -179,7 +203,22 @@ func ensureKubeletCommandLineArgs(command []string) []string {
 }
 
 // EnsureKubeletConfiguration ensures that the kubelet configuration conforms to the provider requirements.
-func (e *ensurer) EnsureKubeletConfiguration(ctx context.Context, kubeletConfig *kubeletconfigv1beta1.KubeletConfiguration) error {
+func (e *ensurer) EnsureKubeletConfiguration(ctx context.Context, kubeletConfig *kubeletconfigv1beta1.KubeletConfiguration, cluster *extensionscontroller.Cluster) error {
+	useCSI, err := aws.UseCSI(extensionscontroller.GetKubernetesVersion(cluster))
+	if err != nil {
+		return err
+	}
+
+	if useCSI {
+		if kubeletConfig.FeatureGates == nil {
+			kubeletConfig.FeatureGates = make(map[string]bool)
+		}
+		for _, featureGate := range csiMigrationFeatureGates {
+			kubeletConfig.FeatureGates[featureGate] = true
+		}
+		return nil
+	}
+
 	// Make sure CSI-related feature gates are not enabled
 	// TODO Leaving these enabled shouldn't do any harm, perhaps remove this code when properly tested?
 	delete(kubeletConfig.FeatureGates, "VolumeSnapshotDataSource")
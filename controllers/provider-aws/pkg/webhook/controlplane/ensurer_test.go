@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
 	"github.com/gardener/gardener-extensions/pkg/util"
 	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
@@ -26,6 +27,7 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 
 	"github.com/coreos/go-systemd/unit"
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
@@ -52,6 +54,25 @@ var _ = Describe("Ensurer", func() {
 	var (
 		ctrl *gomock.Controller
 
+		cluster = &extensionscontroller.Cluster{
+			CoreShoot: &gardencorev1alpha1.Shoot{
+				Spec: gardencorev1alpha1.ShootSpec{
+					Kubernetes: gardencorev1alpha1.Kubernetes{
+						Version: "1.13.4",
+					},
+				},
+			},
+		}
+		csiCluster = &extensionscontroller.Cluster{
+			CoreShoot: &gardencorev1alpha1.Shoot{
+				Spec: gardencorev1alpha1.ShootSpec{
+					Kubernetes: gardencorev1alpha1.Kubernetes{
+						Version: "1.18.0",
+					},
+				},
+			},
+		}
+
 		secretKey = client.ObjectKey{Namespace: namespace, Name: v1alpha1constants.SecretNameCloudProvider}
 		secret    = &corev1.Secret{
 			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: v1alpha1constants.SecretNameCloudProvider},
@@ -114,7 +135,7 @@ var _ = Describe("Ensurer", func() {
 			Expect(err).To(Not(HaveOccurred()))
 
 			// Call EnsureKubeAPIServerDeployment method and check the result
-			err = ensurer.EnsureKubeAPIServerDeployment(context.TODO(), dep)
+			err = ensurer.EnsureKubeAPIServerDeployment(context.TODO(), dep, nil)
 			Expect(err).To(Not(HaveOccurred()))
 			checkKubeAPIServerDeployment(dep, annotations)
 		})
@@ -164,7 +185,7 @@ var _ = Describe("Ensurer", func() {
 			Expect(err).To(Not(HaveOccurred()))
 
 			// Call EnsureKubeAPIServerDeployment method and check the result
-			err = ensurer.EnsureKubeAPIServerDeployment(context.TODO(), dep)
+			err = ensurer.EnsureKubeAPIServerDeployment(context.TODO(), dep, nil)
 			Expect(err).To(Not(HaveOccurred()))
 			checkKubeAPIServerDeployment(dep, annotations)
 		})
@@ -200,7 +221,7 @@ var _ = Describe("Ensurer", func() {
 			Expect(err).To(Not(HaveOccurred()))
 
 			// Call EnsureKubeControllerManagerDeployment method and check the result
-			err = ensurer.EnsureKubeControllerManagerDeployment(context.TODO(), dep)
+			err = ensurer.EnsureKubeControllerManagerDeployment(context.TODO(), dep, cluster)
 			Expect(err).To(Not(HaveOccurred()))
 			checkKubeControllerManagerDeployment(dep, annotations, kubeControllerManagerLabels)
 		})
@@ -249,10 +270,53 @@ var _ = Describe("Ensurer", func() {
 			Expect(err).To(Not(HaveOccurred()))
 
 			// Call EnsureKubeControllerManagerDeployment method and check the result
-			err = ensurer.EnsureKubeControllerManagerDeployment(context.TODO(), dep)
+			err = ensurer.EnsureKubeControllerManagerDeployment(context.TODO(), dep, cluster)
 			Expect(err).To(Not(HaveOccurred()))
 			checkKubeControllerManagerDeployment(dep, annotations, kubeControllerManagerLabels)
 		})
+
+		It("should switch off the in-tree volume plugin if the CSI driver is used", func() {
+			var (
+				dep = &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: v1alpha1constants.DeploymentNameKubeControllerManager},
+					Spec: appsv1.DeploymentSpec{
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{
+									{
+										Name: "kube-controller-manager",
+										Command: []string{
+											"--external-cloud-volume-plugin=aws",
+											"--feature-gates=Foo=true",
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+			)
+
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), secretKey, &corev1.Secret{}).DoAndReturn(clientGet(secret))
+			client.EXPECT().Get(context.TODO(), cmKey, &corev1.ConfigMap{}).DoAndReturn(clientGet(cm))
+
+			// Create ensurer
+			ensurer := NewEnsurer(logger)
+			err := ensurer.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
+
+			// Call EnsureKubeControllerManagerDeployment method and check the result
+			err = ensurer.EnsureKubeControllerManagerDeployment(context.TODO(), dep, csiCluster)
+			Expect(err).To(Not(HaveOccurred()))
+
+			c := extensionswebhook.ContainerWithName(dep.Spec.Template.Spec.Containers, "kube-controller-manager")
+			Expect(c).To(Not(BeNil()))
+			Expect(c.Command).To(ContainElement("--cloud-provider=external"))
+			Expect(c.Command).To(ContainElement("--feature-gates=Foo=true,CSIMigration=true,CSIMigrationAWS=true,CSIMigrationAWSComplete=true"))
+			Expect(c.Command).NotTo(ContainElement(HavePrefix("--external-cloud-volume-plugin=")))
+		})
 	})
 
 	Describe("#EnsureAdditionalUnits", func() {
@@ -345,7 +409,34 @@ var _ = Describe("Ensurer", func() {
 
 			// Call EnsureKubeletConfiguration method and check the result
 			kubeletConfig := *oldKubeletConfig
-			err := ensurer.EnsureKubeletConfiguration(context.TODO(), &kubeletConfig)
+			err := ensurer.EnsureKubeletConfiguration(context.TODO(), &kubeletConfig, cluster)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(&kubeletConfig).To(Equal(newKubeletConfig))
+		})
+
+		It("should enable the CSI migration feature gates if the CSI driver is used", func() {
+			var (
+				oldKubeletConfig = &kubeletconfigv1beta1.KubeletConfiguration{
+					FeatureGates: map[string]bool{
+						"Foo": true,
+					},
+				}
+				newKubeletConfig = &kubeletconfigv1beta1.KubeletConfiguration{
+					FeatureGates: map[string]bool{
+						"Foo":                     true,
+						"CSIMigration":            true,
+						"CSIMigrationAWS":         true,
+						"CSIMigrationAWSComplete": true,
+					},
+				}
+			)
+
+			// Create ensurer
+			ensurer := NewEnsurer(logger)
+
+			// Call EnsureKubeletConfiguration method and check the result
+			kubeletConfig := *oldKubeletConfig
+			err := ensurer.EnsureKubeletConfiguration(context.TODO(), &kubeletConfig, csiCluster)
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(&kubeletConfig).To(Equal(newKubeletConfig))
 		})
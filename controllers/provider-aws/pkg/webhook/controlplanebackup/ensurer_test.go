@@ -16,11 +16,16 @@ package controlplanebackup
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"testing"
+	"time"
 
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/config"
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/aws"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupentry"
 	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
 	"github.com/gardener/gardener-extensions/pkg/util"
 	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
@@ -28,14 +33,17 @@ import (
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
@@ -55,6 +63,10 @@ var _ = Describe("Ensurer", func() {
 		var (
 			ctrl *gomock.Controller
 
+			scheme  = runtime.NewScheme()
+			_       = apisaws.AddToScheme(scheme)
+			decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
+
 			etcdBackup = &config.ETCDBackup{
 				Schedule: util.StringPtr("0 */24 * * *"),
 			}
@@ -86,8 +98,9 @@ var _ = Describe("Ensurer", func() {
 				},
 			}
 
-			secretKey = client.ObjectKey{Namespace: namespace, Name: aws.BackupSecretName}
-			secret    = &corev1.Secret{
+			secretKey      = client.ObjectKey{Namespace: namespace, Name: aws.BackupSecretName}
+			backupEntryKey = client.ObjectKey{Name: "shoot--test--sample--test-uid"}
+			secret         = &corev1.Secret{
 				ObjectMeta: metav1.ObjectMeta{Name: aws.BackupSecretName, Namespace: namespace},
 				Data:       map[string][]byte{"foo": []byte("bar")},
 			}
@@ -114,10 +127,12 @@ var _ = Describe("Ensurer", func() {
 
 			// Create mock client
 			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), &extensionsv1alpha1.ControlPlaneList{}, gomock.Any()).AnyTimes()
 			client.EXPECT().Get(context.TODO(), secretKey, &corev1.Secret{}).DoAndReturn(clientGet(secret))
+			client.EXPECT().Get(context.TODO(), backupEntryKey, &extensionsv1alpha1.BackupEntry{}).Return(apierrors.NewNotFound(extensionsv1alpha1.Resource("backupentries"), backupEntryKey.Name))
 
 			// Create ensurer
-			ensurer := NewEnsurer(etcdBackup, imageVector, logger)
+			ensurer := NewEnsurer(etcdBackup, imageVector, decoder, logger)
 			err := ensurer.(inject.Client).InjectClient(client)
 			Expect(err).To(Not(HaveOccurred()))
 
@@ -127,6 +142,77 @@ var _ = Describe("Ensurer", func() {
 			checkETCDMainStatefulSet(ss, annotations)
 		})
 
+		It("should restore etcd from the staged snapshots of the backupentry for etcd-main statefulset", func() {
+			var (
+				ss = &appsv1.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: v1alpha1constants.StatefulSetNameETCDMain},
+				}
+				be = &extensionsv1alpha1.BackupEntry{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        backupEntryKey.Name,
+						Annotations: map[string]string{backupentry.AnnotationStorePrefix: "restore-20191001120000"},
+					},
+				}
+			)
+
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), &extensionsv1alpha1.ControlPlaneList{}, gomock.Any()).AnyTimes()
+			client.EXPECT().Get(context.TODO(), secretKey, &corev1.Secret{}).DoAndReturn(clientGet(secret))
+			client.EXPECT().Get(context.TODO(), backupEntryKey, &extensionsv1alpha1.BackupEntry{}).DoAndReturn(clientGet(be))
+
+			// Create ensurer
+			ensurer := NewEnsurer(etcdBackup, imageVector, decoder, logger)
+			err := ensurer.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
+
+			// Call EnsureETCDStatefulSet method and check the result
+			err = ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
+			Expect(err).To(Not(HaveOccurred()))
+			c := extensionswebhook.ContainerWithName(ss.Spec.Template.Spec.Containers, "backup-restore")
+			Expect(c).NotTo(BeNil())
+			Expect(c.Command).To(ContainElement("--store-prefix=shoot--test--sample--test-uid/restore-20191001120000/etcd-main"))
+			Expect(ss.Spec.Template.Spec.InitContainers).To(BeEmpty())
+		})
+
+		It("should keep the current store prefix of etcd-main statefulset if the backupentry cannot be read", func() {
+			var (
+				ss = &appsv1.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: v1alpha1constants.StatefulSetNameETCDMain},
+					Spec: appsv1.StatefulSetSpec{
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{
+									{
+										Name:    "backup-restore",
+										Command: []string{"--store-prefix=shoot--test--sample--test-uid/restore-20191001120000/etcd-main"},
+									},
+								},
+							},
+						},
+					},
+				}
+			)
+
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), &extensionsv1alpha1.ControlPlaneList{}, gomock.Any()).AnyTimes()
+			client.EXPECT().Get(context.TODO(), secretKey, &corev1.Secret{}).DoAndReturn(clientGet(secret))
+			client.EXPECT().Get(context.TODO(), backupEntryKey, &extensionsv1alpha1.BackupEntry{}).Return(fmt.Errorf("fake error"))
+
+			// Create ensurer
+			ensurer := NewEnsurer(etcdBackup, imageVector, decoder, logger)
+			err := ensurer.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
+
+			// Call EnsureETCDStatefulSet method and check the result
+			err = ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
+			Expect(err).To(Not(HaveOccurred()))
+			c := extensionswebhook.ContainerWithName(ss.Spec.Template.Spec.Containers, "backup-restore")
+			Expect(c).NotTo(BeNil())
+			Expect(c.Command).To(ContainElement("--store-prefix=shoot--test--sample--test-uid/restore-20191001120000/etcd-main"))
+		})
+
 		It("should modify existing elements of etcd-main statefulset", func() {
 			var (
 				ss = &appsv1.StatefulSet{
@@ -147,10 +233,12 @@ var _ = Describe("Ensurer", func() {
 
 			// Create mock client
 			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), &extensionsv1alpha1.ControlPlaneList{}, gomock.Any()).AnyTimes()
 			client.EXPECT().Get(context.TODO(), secretKey, &corev1.Secret{}).DoAndReturn(clientGet(secret))
+			client.EXPECT().Get(context.TODO(), backupEntryKey, &extensionsv1alpha1.BackupEntry{}).Return(apierrors.NewNotFound(extensionsv1alpha1.Resource("backupentries"), backupEntryKey.Name))
 
 			// Create ensurer
-			ensurer := NewEnsurer(etcdBackup, imageVector, logger)
+			ensurer := NewEnsurer(etcdBackup, imageVector, decoder, logger)
 			err := ensurer.(inject.Client).InjectClient(client)
 			Expect(err).To(Not(HaveOccurred()))
 
@@ -168,9 +256,10 @@ var _ = Describe("Ensurer", func() {
 
 			// Create mock client
 			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), &extensionsv1alpha1.ControlPlaneList{}, gomock.Any()).AnyTimes()
 
 			// Create ensurer
-			ensurer := NewEnsurer(etcdBackup, imageVector, logger)
+			ensurer := NewEnsurer(etcdBackup, imageVector, decoder, logger)
 			err := ensurer.(inject.Client).InjectClient(client)
 			Expect(err).To(Not(HaveOccurred()))
 
@@ -189,10 +278,11 @@ var _ = Describe("Ensurer", func() {
 
 			// Create mock client
 			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), &extensionsv1alpha1.ControlPlaneList{}, gomock.Any()).AnyTimes()
 			//client.EXPECT().Get(context.TODO(), secretKey, &corev1.Secret{}).DoAndReturn(clientGet(secret))
 
 			// Create ensurer
-			ensurer := NewEnsurer(etcdBackup, imageVector, logger)
+			ensurer := NewEnsurer(etcdBackup, imageVector, decoder, logger)
 			err := ensurer.(inject.Client).InjectClient(client)
 			Expect(err).To(Not(HaveOccurred()))
 
@@ -215,11 +305,17 @@ var _ = Describe("Ensurer", func() {
 				}
 			)
 
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), &extensionsv1alpha1.ControlPlaneList{}, gomock.Any()).AnyTimes()
+
 			// Create ensurer
-			ensurer := NewEnsurer(etcdBackup, imageVector, logger)
+			ensurer := NewEnsurer(etcdBackup, imageVector, decoder, logger)
+			err := ensurer.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
 
 			// Call EnsureETCDStatefulSet method and check the result
-			err := ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
+			err = ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
 			Expect(err).To(Not(HaveOccurred()))
 			checkETCDEventsStatefulSet(ss)
 		})
@@ -242,14 +338,73 @@ var _ = Describe("Ensurer", func() {
 				}
 			)
 
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), &extensionsv1alpha1.ControlPlaneList{}, gomock.Any()).AnyTimes()
+
 			// Create ensurer
-			ensurer := NewEnsurer(etcdBackup, imageVector, logger)
+			ensurer := NewEnsurer(etcdBackup, imageVector, decoder, logger)
+			err := ensurer.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
 
 			// Call EnsureETCDStatefulSet method and check the result
-			err := ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
+			err = ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
 			Expect(err).To(Not(HaveOccurred()))
 			checkETCDEventsStatefulSet(ss)
 		})
+
+		It("should apply the backup-restore configuration of the controller and the shoot", func() {
+			var (
+				ss = &appsv1.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: v1alpha1constants.StatefulSetNameETCDEvents},
+				}
+				limitBased = controlplane.GarbageCollectionPolicyLimitBased
+				maxBackups = int32(3)
+				cp         = extensionsv1alpha1.ControlPlane{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "control-plane"},
+					Spec: extensionsv1alpha1.ControlPlaneSpec{
+						DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: aws.Type},
+						ProviderConfig: &runtime.RawExtension{
+							Raw: encode(&apisaws.ControlPlaneConfig{
+								ETCDBackup: &apisaws.ETCDBackupConfig{
+									GarbageCollectionPeriod: &metav1.Duration{Duration: time.Hour},
+									GarbageCollectionPolicy: &limitBased,
+									MaxBackups:              &maxBackups,
+								},
+							}),
+						},
+					},
+				}
+				etcdBackup = &config.ETCDBackup{
+					Schedule:                util.StringPtr("0 */24 * * *"),
+					DeltaSnapshotPeriod:     &metav1.Duration{Duration: time.Minute},
+					GarbageCollectionPeriod: &metav1.Duration{Duration: 2 * time.Hour},
+				}
+			)
+
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), &extensionsv1alpha1.ControlPlaneList{}, gomock.Any()).
+				DoAndReturn(func(_ context.Context, list *extensionsv1alpha1.ControlPlaneList, _ ...interface{}) error {
+					list.Items = []extensionsv1alpha1.ControlPlane{cp}
+					return nil
+				})
+
+			// Create ensurer
+			ensurer := NewEnsurer(etcdBackup, imageVector, decoder, logger)
+			err := ensurer.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
+
+			// Call EnsureETCDStatefulSet method and check the result
+			err = ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
+			Expect(err).To(Not(HaveOccurred()))
+			c := extensionswebhook.ContainerWithName(ss.Spec.Template.Spec.Containers, "backup-restore")
+			Expect(c).NotTo(BeNil())
+			Expect(c.Command).To(ContainElement("--delta-snapshot-period-seconds=60"))
+			Expect(c.Command).To(ContainElement("--garbage-collection-period-seconds=3600"))
+			Expect(c.Command).To(ContainElement("--garbage-collection-policy=LimitBased"))
+			Expect(c.Command).To(ContainElement("--max-backups=3"))
+		})
 	})
 })
 
@@ -297,21 +452,21 @@ func checkETCDMainStatefulSet(ss *appsv1.StatefulSet, annotations map[string]str
 
 	c := extensionswebhook.ContainerWithName(ss.Spec.Template.Spec.Containers, "backup-restore")
 	Expect(c).To(Equal(controlplane.GetBackupRestoreContainer(v1alpha1constants.StatefulSetNameETCDMain, controlplane.EtcdMainVolumeClaimTemplateName, "0 */24 * * *", aws.StorageProviderName, "shoot--test--sample--test-uid",
-		"test-repository:test-tag", nil, env, nil)))
+		"test-repository:test-tag", nil, nil, env, nil)))
 	Expect(ss.Spec.Template.Annotations).To(Equal(annotations))
 }
 
 func checkETCDMainStatefulSetWithoutBackup(ss *appsv1.StatefulSet, annotations map[string]string) {
 	c := extensionswebhook.ContainerWithName(ss.Spec.Template.Spec.Containers, "backup-restore")
 	Expect(c).To(Equal(controlplane.GetBackupRestoreContainer(v1alpha1constants.StatefulSetNameETCDMain, controlplane.EtcdMainVolumeClaimTemplateName, "0 */24 * * *", "", "",
-		"test-repository:test-tag", nil, nil, nil)))
+		"test-repository:test-tag", nil, nil, nil, nil)))
 	Expect(ss.Spec.Template.Annotations).To(BeNil())
 }
 
 func checkETCDEventsStatefulSet(ss *appsv1.StatefulSet) {
 	c := extensionswebhook.ContainerWithName(ss.Spec.Template.Spec.Containers, "backup-restore")
 	Expect(c).To(Equal(controlplane.GetBackupRestoreContainer(v1alpha1constants.StatefulSetNameETCDEvents, v1alpha1constants.StatefulSetNameETCDEvents, "0 */24 * * *", "", "",
-		"test-repository:test-tag", nil, nil, nil)))
+		"test-repository:test-tag", nil, nil, nil, nil)))
 }
 
 func clientGet(result runtime.Object) interface{} {
@@ -319,7 +474,14 @@ func clientGet(result runtime.Object) interface{} {
 		switch obj.(type) {
 		case *corev1.Secret:
 			*obj.(*corev1.Secret) = *result.(*corev1.Secret)
+		case *extensionsv1alpha1.BackupEntry:
+			*obj.(*extensionsv1alpha1.BackupEntry) = *result.(*extensionsv1alpha1.BackupEntry)
 		}
 		return nil
 	}
 }
+
+func encode(obj runtime.Object) []byte {
+	data, _ := json.Marshal(obj)
+	return data
+}
@@ -15,8 +15,16 @@
 package controlplaneexposure
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
 
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/helper"
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/config"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
@@ -24,15 +32,28 @@ import (
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane/genericmutator"
 
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	annotationLoadBalancerType                   = "service.beta.kubernetes.io/aws-load-balancer-type"
+	annotationLoadBalancerCrossZoneLoadBalancing = "service.beta.kubernetes.io/aws-load-balancer-cross-zone-load-balancing-enabled"
+	annotationLoadBalancerInternal               = "service.beta.kubernetes.io/aws-load-balancer-internal"
 )
 
 // NewEnsurer creates a new controlplaneexposure ensurer.
-func NewEnsurer(etcdStorage *config.ETCDStorage, logger logr.Logger) genericmutator.Ensurer {
+func NewEnsurer(etcdStorage *config.ETCDStorage, decoder runtime.Decoder, logger logr.Logger) genericmutator.Ensurer {
 	return &ensurer{
 		etcdStorage: etcdStorage,
+		decoder:     decoder,
+		lookupIP:    net.LookupIP,
 		logger:      logger.WithName("aws-controlplaneexposure-ensurer"),
 	}
 }
@@ -40,14 +61,49 @@ func NewEnsurer(etcdStorage *config.ETCDStorage, logger logr.Logger) genericmuta
 type ensurer struct {
 	genericmutator.NoopEnsurer
 	etcdStorage *config.ETCDStorage
+	decoder     runtime.Decoder
+	client      client.Client
+	lookupIP    func(host string) ([]net.IP, error)
 	logger      logr.Logger
 }
 
+// InjectClient injects the given client into the ensurer.
+func (e *ensurer) InjectClient(client client.Client) error {
+	e.client = client
+	return nil
+}
+
 // EnsureKubeAPIServerService ensures that the kube-apiserver service conforms to the provider requirements.
-func (e *ensurer) EnsureKubeAPIServerService(ctx context.Context, svc *corev1.Service) error {
+func (e *ensurer) EnsureKubeAPIServerService(ctx context.Context, svc *corev1.Service, cluster *extensionscontroller.Cluster) error {
+	cpConfig, err := helper.ControlPlaneConfigFromCluster(e.decoder, cluster)
+	if err != nil {
+		return err
+	}
+
+	lbType := helper.GetLoadBalancerType(cpConfig)
+	if err := e.checkLoadBalancerType(ctx, svc, lbType); err != nil {
+		return err
+	}
+
 	if svc.Annotations == nil {
 		svc.Annotations = make(map[string]string)
 	}
+
+	if lb := cpConfig.LoadBalancer; lb != nil {
+		if lb.CrossZoneLoadBalancing != nil {
+			svc.Annotations[annotationLoadBalancerCrossZoneLoadBalancing] = strconv.FormatBool(*lb.CrossZoneLoadBalancing)
+		}
+		if lb.Internal != nil && *lb.Internal {
+			svc.Annotations[annotationLoadBalancerInternal] = "true"
+		}
+	}
+
+	// Network load balancers pass the TLS connections through, hence the classic ELB settings don't apply.
+	if lbType == apisaws.LoadBalancerTypeNLB {
+		svc.Annotations[annotationLoadBalancerType] = string(apisaws.LoadBalancerTypeNLB)
+		return nil
+	}
+
 	svc.Annotations["service.beta.kubernetes.io/aws-load-balancer-connection-idle-timeout"] = "3600"
 	svc.Annotations["service.beta.kubernetes.io/aws-load-balancer-backend-protocol"] = "ssl"
 	svc.Annotations["service.beta.kubernetes.io/aws-load-balancer-ssl-ports"] = "443"
@@ -59,14 +115,103 @@ func (e *ensurer) EnsureKubeAPIServerService(ctx context.Context, svc *corev1.Se
 	return nil
 }
 
+// checkLoadBalancerType checks that the load balancer type of an already existing kube-apiserver service matches the
+// given one. The cloud provider doesn't replace the load balancer of a service if its type annotation changes, hence
+// switching between classic and network load balancers is not supported for existing services.
+func (e *ensurer) checkLoadBalancerType(ctx context.Context, svc *corev1.Service, lbType apisaws.LoadBalancerType) error {
+	existing := &corev1.Service{}
+	if err := e.client.Get(ctx, kutil.Key(svc.Namespace, svc.Name), existing); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrap(err, "could not get kube-apiserver service")
+	}
+
+	existingType := apisaws.LoadBalancerTypeClassic
+	if existing.Annotations[annotationLoadBalancerType] == string(apisaws.LoadBalancerTypeNLB) {
+		existingType = apisaws.LoadBalancerTypeNLB
+	}
+	if existingType != lbType {
+		return fmt.Errorf("cannot change the load balancer type of the existing kube-apiserver service from '%s' to '%s'", existingType, lbType)
+	}
+	return nil
+}
+
 // EnsureKubeAPIServerDeployment ensures that the kube-apiserver deployment conforms to the provider requirements.
-func (e *ensurer) EnsureKubeAPIServerDeployment(ctx context.Context, dep *appsv1.Deployment) error {
-	if c := extensionswebhook.ContainerWithName(dep.Spec.Template.Spec.Containers, "kube-apiserver"); c != nil {
-		c.Command = extensionswebhook.EnsureStringWithPrefix(c.Command, "--endpoint-reconciler-type=", "none")
+func (e *ensurer) EnsureKubeAPIServerDeployment(ctx context.Context, dep *appsv1.Deployment, cluster *extensionscontroller.Cluster) error {
+	cpConfig, err := helper.ControlPlaneConfigFromCluster(e.decoder, cluster)
+	if err != nil {
+		return err
+	}
+
+	c := extensionswebhook.ContainerWithName(dep.Spec.Template.Spec.Containers, "kube-apiserver")
+	if c == nil {
+		return nil
+	}
+
+	// The aws-lb-readvertiser maintains the endpoints of the kube-apiserver for classic load balancers whose IP
+	// addresses may change. Network load balancers have static IP addresses which can be advertised directly.
+	if helper.GetLoadBalancerType(cpConfig) == apisaws.LoadBalancerTypeNLB {
+		address, err := e.getLoadBalancerIP(ctx, dep.Namespace, getAdvertiseAddress(c.Command))
+		if err != nil {
+			return err
+		}
+
+		c.Command = extensionswebhook.EnsureNoStringWithPrefix(c.Command, "--endpoint-reconciler-type=")
+		c.Command = extensionswebhook.EnsureStringWithPrefix(c.Command, "--advertise-address=", address)
+		return nil
 	}
+
+	c.Command = extensionswebhook.EnsureStringWithPrefix(c.Command, "--endpoint-reconciler-type=", "none")
 	return nil
 }
 
+// getLoadBalancerIP returns an IPv4 address of the load balancer of the kube-apiserver service in the given namespace.
+// The addresses of network load balancers are resolved in random order, hence the currently advertised address is kept
+// as long as it still belongs to the load balancer, and otherwise the lowest address is chosen. This way, the
+// kube-apiserver deployment is not rolled out needlessly.
+func (e *ensurer) getLoadBalancerIP(ctx context.Context, namespace, currentAddress string) (string, error) {
+	address, err := kutil.GetLoadBalancerIngress(ctx, e.client, namespace, v1alpha1constants.DeploymentNameKubeAPIServer)
+	if err != nil {
+		return "", errors.Wrap(err, "could not get kube-apiserver service load balancer address")
+	}
+	if ip := net.ParseIP(address); ip != nil {
+		return address, nil
+	}
+
+	ips, err := e.lookupIP(address)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not resolve kube-apiserver service load balancer address '%s'", address)
+	}
+
+	var ipv4s []net.IP
+	for _, ip := range ips {
+		if ip4 := ip.To4(); ip4 != nil {
+			if ip4.String() == currentAddress {
+				return currentAddress, nil
+			}
+			ipv4s = append(ipv4s, ip4)
+		}
+	}
+	if len(ipv4s) == 0 {
+		return "", fmt.Errorf("kube-apiserver service load balancer address '%s' does not resolve to an IPv4 address", address)
+	}
+
+	sort.Slice(ipv4s, func(i, j int) bool {
+		return bytes.Compare(ipv4s[i], ipv4s[j]) < 0
+	})
+	return ipv4s[0].String(), nil
+}
+
+// getAdvertiseAddress returns the value of the --advertise-address flag in the given command, if any.
+func getAdvertiseAddress(command []string) string {
+	const prefix = "--advertise-address="
+	if i := extensionswebhook.StringWithPrefixIndex(command, prefix); i >= 0 {
+		return strings.TrimPrefix(command[i], prefix)
+	}
+	return ""
+}
+
 // EnsureETCDStatefulSet ensures that the etcd stateful sets conform to the provider requirements.
 func (e *ensurer) EnsureETCDStatefulSet(ctx context.Context, ss *appsv1.StatefulSet, cluster *extensionscontroller.Cluster) error {
 	e.ensureVolumeClaimTemplates(&ss.Spec, ss.Name)
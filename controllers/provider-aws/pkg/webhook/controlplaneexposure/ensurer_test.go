@@ -16,20 +16,38 @@ package controlplaneexposure
 
 import (
 	"context"
+	"encoding/json"
+	"net"
 	"testing"
 
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/install"
 	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/config"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
 	"github.com/gardener/gardener-extensions/pkg/util"
 	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane"
 
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+)
+
+const (
+	namespace = "test"
 )
 
 func TestController(t *testing.T) {
@@ -43,8 +61,49 @@ var _ = Describe("Ensurer", func() {
 			ClassName: util.StringPtr("gardener.cloud-fast"),
 			Capacity:  util.QuantityPtr(resource.MustParse("80Gi")),
 		}
+
+		ctrl    *gomock.Controller
+		decoder runtime.Decoder
+
+		nlb       = apisaws.LoadBalancerTypeNLB
+		crossZone = true
+		internal  = true
+
+		nlbCluster = clusterWithControlPlaneConfig(&apisaws.ControlPlaneConfig{
+			LoadBalancer: &apisaws.LoadBalancerConfig{
+				Type:                   &nlb,
+				CrossZoneLoadBalancing: &crossZone,
+				Internal:               &internal,
+			},
+		})
+
+		svcKey           = client.ObjectKey{Namespace: namespace, Name: v1alpha1constants.DeploymentNameKubeAPIServer}
+		kubeAPIServerKey = client.ObjectKey{Name: v1alpha1constants.DeploymentNameKubeAPIServer}
+		notFoundErr      = apierrors.NewNotFound(schema.GroupResource{Resource: "services"}, v1alpha1constants.DeploymentNameKubeAPIServer)
+		svc              = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: v1alpha1constants.DeploymentNameKubeAPIServer, Namespace: namespace},
+			Status: corev1.ServiceStatus{
+				LoadBalancer: corev1.LoadBalancerStatus{
+					Ingress: []corev1.LoadBalancerIngress{
+						{Hostname: "kube-apiserver.elb.amazonaws.com"},
+					},
+				},
+			},
+		}
 	)
 
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		scheme := runtime.NewScheme()
+		install.Install(scheme)
+		decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
 	Describe("#EnsureKubeAPIServerService", func() {
 		It("should add annotations to kube-apiserver service", func() {
 			var (
@@ -53,11 +112,17 @@ var _ = Describe("Ensurer", func() {
 				}
 			)
 
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), kubeAPIServerKey, &corev1.Service{}).Return(notFoundErr)
+
 			// Create ensurer
-			ensurer := NewEnsurer(etcdStorage, logger)
+			ensurer := NewEnsurer(etcdStorage, decoder, logger)
+			err := ensurer.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
 
 			// Call EnsureKubeAPIServerService method and check the result
-			err := ensurer.EnsureKubeAPIServerService(context.TODO(), svc)
+			err = ensurer.EnsureKubeAPIServerService(context.TODO(), svc, nil)
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(svc.Annotations).To(HaveKeyWithValue("service.beta.kubernetes.io/aws-load-balancer-connection-idle-timeout", "3600"))
 			Expect(svc.Annotations).To(HaveKeyWithValue("service.beta.kubernetes.io/aws-load-balancer-backend-protocol", "ssl"))
@@ -67,6 +132,68 @@ var _ = Describe("Ensurer", func() {
 			Expect(svc.Annotations).To(HaveKeyWithValue("service.beta.kubernetes.io/aws-load-balancer-healthcheck-healthy-threshold", "2"))
 			Expect(svc.Annotations).To(HaveKeyWithValue("service.beta.kubernetes.io/aws-load-balancer-healthcheck-unhealthy-threshold", "2"))
 			Expect(svc.Annotations).To(HaveKeyWithValue("service.beta.kubernetes.io/aws-load-balancer-ssl-negotiation-policy", "ELBSecurityPolicy-TLS-1-2-2017-01"))
+			Expect(svc.Annotations).NotTo(HaveKey("service.beta.kubernetes.io/aws-load-balancer-type"))
+		})
+
+		It("should add network load balancer annotations to kube-apiserver service", func() {
+			var (
+				svc = &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver"},
+				}
+			)
+
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), kubeAPIServerKey, &corev1.Service{}).Return(notFoundErr)
+
+			// Create ensurer
+			ensurer := NewEnsurer(etcdStorage, decoder, logger)
+			err := ensurer.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
+
+			// Call EnsureKubeAPIServerService method and check the result
+			err = ensurer.EnsureKubeAPIServerService(context.TODO(), svc, nlbCluster)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(svc.Annotations).To(Equal(map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-type":                              "nlb",
+				"service.beta.kubernetes.io/aws-load-balancer-cross-zone-load-balancing-enabled": "true",
+				"service.beta.kubernetes.io/aws-load-balancer-internal":                          "true",
+			}))
+		})
+
+		It("should fail to switch an existing classic load balancer to a network load balancer", func() {
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), svcKey, &corev1.Service{}).DoAndReturn(clientGet(svc))
+
+			// Create ensurer
+			ensurer := NewEnsurer(etcdStorage, decoder, logger)
+			err := ensurer.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
+
+			// Call EnsureKubeAPIServerService method and check the result
+			newSvc := svc.DeepCopy()
+			err = ensurer.EnsureKubeAPIServerService(context.TODO(), newSvc, nlbCluster)
+			Expect(err).To(HaveOccurred())
+			Expect(newSvc.Annotations).To(BeEmpty())
+		})
+
+		It("should fail to switch an existing network load balancer to a classic load balancer", func() {
+			nlbSvc := svc.DeepCopy()
+			nlbSvc.Annotations = map[string]string{"service.beta.kubernetes.io/aws-load-balancer-type": "nlb"}
+
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), svcKey, &corev1.Service{}).DoAndReturn(clientGet(nlbSvc))
+
+			// Create ensurer
+			ensurer := NewEnsurer(etcdStorage, decoder, logger)
+			err := ensurer.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
+
+			// Call EnsureKubeAPIServerService method and check the result
+			err = ensurer.EnsureKubeAPIServerService(context.TODO(), nlbSvc.DeepCopy(), nil)
+			Expect(err).To(HaveOccurred())
 		})
 	})
 
@@ -90,10 +217,10 @@ var _ = Describe("Ensurer", func() {
 			)
 
 			// Create ensurer
-			ensurer := NewEnsurer(etcdStorage, logger)
+			ensurer := NewEnsurer(etcdStorage, decoder, logger)
 
 			// Call EnsureKubeAPIServerDeployment method and check the result
-			err := ensurer.EnsureKubeAPIServerDeployment(context.TODO(), dep)
+			err := ensurer.EnsureKubeAPIServerDeployment(context.TODO(), dep, nil)
 			Expect(err).To(Not(HaveOccurred()))
 			checkKubeAPIServerDeployment(dep)
 		})
@@ -118,15 +245,120 @@ var _ = Describe("Ensurer", func() {
 			)
 
 			// Create ensurer
-			ensurer := NewEnsurer(etcdStorage, logger)
+			ensurer := NewEnsurer(etcdStorage, decoder, logger)
 
 			// Call EnsureKubeAPIServerDeployment method and check the result
-			err := ensurer.EnsureKubeAPIServerDeployment(context.TODO(), dep)
+			err := ensurer.EnsureKubeAPIServerDeployment(context.TODO(), dep, nil)
 			Expect(err).To(Not(HaveOccurred()))
 			checkKubeAPIServerDeployment(dep)
 		})
 	})
 
+	Describe("#EnsureKubeAPIServerDeployment with a network load balancer", func() {
+		var dep *appsv1.Deployment
+
+		BeforeEach(func() {
+			dep = &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: v1alpha1constants.DeploymentNameKubeAPIServer, Namespace: namespace},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name:    "kube-apiserver",
+									Command: []string{"--endpoint-reconciler-type=none"},
+								},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should advertise the resolved load balancer address", func() {
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), svcKey, &corev1.Service{}).DoAndReturn(clientGet(svc))
+
+			// Create ensurer
+			e := NewEnsurer(etcdStorage, decoder, logger)
+			e.(*ensurer).lookupIP = func(host string) ([]net.IP, error) {
+				Expect(host).To(Equal("kube-apiserver.elb.amazonaws.com"))
+				return []net.IP{net.ParseIP("::1"), net.ParseIP("1.2.3.4"), net.ParseIP("5.6.7.8")}, nil
+			}
+			err := e.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
+
+			// Call EnsureKubeAPIServerDeployment method and check the result
+			err = e.EnsureKubeAPIServerDeployment(context.TODO(), dep, nlbCluster)
+			Expect(err).To(Not(HaveOccurred()))
+			c := extensionswebhook.ContainerWithName(dep.Spec.Template.Spec.Containers, "kube-apiserver")
+			Expect(c).To(Not(BeNil()))
+			Expect(c.Command).To(ConsistOf("--advertise-address=1.2.3.4"))
+		})
+
+		It("should advertise the lowest resolved load balancer address", func() {
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), svcKey, &corev1.Service{}).DoAndReturn(clientGet(svc))
+
+			// Create ensurer
+			e := NewEnsurer(etcdStorage, decoder, logger)
+			e.(*ensurer).lookupIP = func(host string) ([]net.IP, error) {
+				return []net.IP{net.ParseIP("10.2.3.4"), net.ParseIP("5.6.7.8"), net.ParseIP("9.6.7.8")}, nil
+			}
+			err := e.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
+
+			// Call EnsureKubeAPIServerDeployment method and check the result
+			err = e.EnsureKubeAPIServerDeployment(context.TODO(), dep, nlbCluster)
+			Expect(err).To(Not(HaveOccurred()))
+			c := extensionswebhook.ContainerWithName(dep.Spec.Template.Spec.Containers, "kube-apiserver")
+			Expect(c).To(Not(BeNil()))
+			Expect(c.Command).To(ConsistOf("--advertise-address=5.6.7.8"))
+		})
+
+		It("should keep the advertised address if it still belongs to the load balancer", func() {
+			c := extensionswebhook.ContainerWithName(dep.Spec.Template.Spec.Containers, "kube-apiserver")
+			c.Command = []string{"--advertise-address=9.6.7.8"}
+
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), svcKey, &corev1.Service{}).DoAndReturn(clientGet(svc))
+
+			// Create ensurer
+			e := NewEnsurer(etcdStorage, decoder, logger)
+			e.(*ensurer).lookupIP = func(host string) ([]net.IP, error) {
+				return []net.IP{net.ParseIP("10.2.3.4"), net.ParseIP("5.6.7.8"), net.ParseIP("9.6.7.8")}, nil
+			}
+			err := e.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
+
+			// Call EnsureKubeAPIServerDeployment method and check the result
+			err = e.EnsureKubeAPIServerDeployment(context.TODO(), dep, nlbCluster)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(c.Command).To(ConsistOf("--advertise-address=9.6.7.8"))
+		})
+
+		It("should fail if the load balancer address does not resolve to an IPv4 address", func() {
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), svcKey, &corev1.Service{}).DoAndReturn(clientGet(svc))
+
+			// Create ensurer
+			e := NewEnsurer(etcdStorage, decoder, logger)
+			e.(*ensurer).lookupIP = func(host string) ([]net.IP, error) {
+				return []net.IP{net.ParseIP("::1")}, nil
+			}
+			err := e.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
+
+			// Call EnsureKubeAPIServerDeployment method and check the result
+			err = e.EnsureKubeAPIServerDeployment(context.TODO(), dep, nlbCluster)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 	Describe("#EnsureETCDStatefulSet", func() {
 		It("should add or modify elements to etcd-main statefulset", func() {
 			var (
@@ -136,7 +368,7 @@ var _ = Describe("Ensurer", func() {
 			)
 
 			// Create ensurer
-			ensurer := NewEnsurer(etcdStorage, logger)
+			ensurer := NewEnsurer(etcdStorage, decoder, logger)
 
 			// Call EnsureETCDStatefulSet method and check the result
 			err := ensurer.EnsureETCDStatefulSet(context.TODO(), ss, nil)
@@ -167,7 +399,7 @@ var _ = Describe("Ensurer", func() {
 			)
 
 			// Create ensurer
-			ensurer := NewEnsurer(etcdStorage, logger)
+			ensurer := NewEnsurer(etcdStorage, decoder, logger)
 
 			// Call EnsureETCDStatefulSet method and check the result
 			err := ensurer.EnsureETCDStatefulSet(context.TODO(), ss, nil)
@@ -183,7 +415,7 @@ var _ = Describe("Ensurer", func() {
 			)
 
 			// Create ensurer
-			ensurer := NewEnsurer(etcdStorage, logger)
+			ensurer := NewEnsurer(etcdStorage, decoder, logger)
 
 			// Call EnsureETCDStatefulSet method and check the result
 			err := ensurer.EnsureETCDStatefulSet(context.TODO(), ss, nil)
@@ -214,7 +446,7 @@ var _ = Describe("Ensurer", func() {
 			)
 
 			// Create ensurer
-			ensurer := NewEnsurer(etcdStorage, logger)
+			ensurer := NewEnsurer(etcdStorage, decoder, logger)
 
 			// Call EnsureETCDStatefulSet method and check the result
 			err := ensurer.EnsureETCDStatefulSet(context.TODO(), ss, nil)
@@ -241,3 +473,32 @@ func checkETCDEventsStatefulSet(ss *appsv1.StatefulSet) {
 	pvc := extensionswebhook.PVCWithName(ss.Spec.VolumeClaimTemplates, v1alpha1constants.StatefulSetNameETCDEvents)
 	Expect(pvc).To(Equal(controlplane.GetETCDVolumeClaimTemplate(v1alpha1constants.StatefulSetNameETCDEvents, nil, nil)))
 }
+
+func clusterWithControlPlaneConfig(cpConfig *apisaws.ControlPlaneConfig) *extensionscontroller.Cluster {
+	return &extensionscontroller.Cluster{
+		CoreShoot: &gardencorev1alpha1.Shoot{
+			Spec: gardencorev1alpha1.ShootSpec{
+				Provider: gardencorev1alpha1.Provider{
+					ControlPlaneConfig: &gardencorev1alpha1.ProviderConfig{
+						RawExtension: runtime.RawExtension{Raw: encode(cpConfig)},
+					},
+				},
+			},
+		},
+	}
+}
+
+func encode(obj runtime.Object) []byte {
+	data, _ := json.Marshal(obj)
+	return data
+}
+
+func clientGet(result runtime.Object) interface{} {
+	return func(ctx context.Context, key client.ObjectKey, obj runtime.Object) error {
+		switch obj.(type) {
+		case *corev1.Service:
+			*obj.(*corev1.Service) = *result.(*corev1.Service)
+		}
+		return nil
+	}
+}
@@ -19,7 +19,9 @@ import (
 	"github.com/gardener/gardener-extensions/pkg/webhook/shoot"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
 )
@@ -38,8 +40,11 @@ var logger = log.Log.WithName("aws-shoot-webhook")
 func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) (*extensionswebhook.Webhook, error) {
 	logger.Info("Adding webhook to manager")
 	return shoot.Add(mgr, shoot.AddArgs{
-		Types:   []runtime.Object{&corev1.ConfigMap{}},
-		Mutator: NewMutator(),
+		Types:                     []runtime.Object{&corev1.ConfigMap{}, &corev1.Service{}},
+		MutatorWithShootNamespace: NewMutator(serializer.NewCodecFactory(mgr.GetScheme()).UniversalDecoder()),
+		// Services of type LoadBalancer may be created in any namespace of the shoot. The failure policy stays Ignore,
+		// hence services created while the webhook is unavailable get the default classic load balancer.
+		NamespaceSelector: &metav1.LabelSelector{},
 	})
 }
 
@@ -23,23 +23,46 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
 )
 
+const nginxIngressControllerConfigMapName = "addons-nginx-ingress-controller"
+
 type mutator struct {
-	logger logr.Logger
+	client  client.Client
+	decoder runtime.Decoder
+	logger  logr.Logger
 }
 
 // NewMutator creates a new Mutator that mutates resources in the shoot cluster.
-func NewMutator() extensionswebhook.Mutator {
+func NewMutator(decoder runtime.Decoder) extensionswebhook.MutatorWithShootNamespace {
 	return &mutator{
-		logger: log.Log.WithName("shoot-mutator"),
+		decoder: decoder,
+		logger:  log.Log.WithName("shoot-mutator"),
+	}
+}
+
+func (m *mutator) InjectClient(client client.Client) error {
+	m.client = client
+	return nil
+}
+
+// Handles returns whether the given object is the nginx-ingress-controller configmap or a new service of type
+// LoadBalancer which does not select a load balancer type itself.
+func (m *mutator) Handles(obj runtime.Object) bool {
+	switch x := obj.(type) {
+	case *corev1.ConfigMap:
+		return x.Namespace == metav1.NamespaceSystem && x.Name == nginxIngressControllerConfigMapName
+	case *corev1.Service:
+		return needsDefaultLoadBalancerType(x)
 	}
+	return false
 }
 
-// Mutate mutates resources.
-func (m *mutator) Mutate(ctx context.Context, obj runtime.Object) error {
+func (m *mutator) Mutate(ctx context.Context, obj runtime.Object, shootNamespace string) error {
 	acc, err := meta.Accessor(obj)
 	if err != nil {
 		return errors.Wrapf(err, "could not create accessor during webhook")
@@ -49,13 +72,17 @@ func (m *mutator) Mutate(ctx context.Context, obj runtime.Object) error {
 		return nil
 	}
 
+	if !m.Handles(obj) {
+		return nil
+	}
+
 	switch x := obj.(type) {
 	case *corev1.ConfigMap:
-		switch x.Name {
-		case "addons-nginx-ingress-controller":
-			extensionswebhook.LogMutation(logger, x.Kind, x.Namespace, x.Name)
-			return m.mutateNginxIngressControllerConfigMap(ctx, x)
-		}
+		extensionswebhook.LogMutation(logger, x.Kind, x.Namespace, x.Name)
+		return m.mutateNginxIngressControllerConfigMap(ctx, x)
+	case *corev1.Service:
+		extensionswebhook.LogMutation(logger, x.Kind, x.Namespace, x.Name)
+		return m.mutateLoadBalancerService(ctx, x, shootNamespace)
 	}
 	return nil
 }
@@ -0,0 +1,67 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+
+	apisaws "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/helper"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	annotationLoadBalancerType          = "service.beta.kubernetes.io/aws-load-balancer-type"
+	annotationLoadBalancerProxyProtocol = "service.beta.kubernetes.io/aws-load-balancer-proxy-protocol"
+)
+
+// needsDefaultLoadBalancerType returns whether the given service is a new service of type LoadBalancer which neither
+// selects a load balancer type nor requires the proxy protocol, which is not supported by network load balancers.
+// The load balancer type of existing services is never changed as the cloud provider does not migrate load balancers.
+func needsDefaultLoadBalancerType(service *corev1.Service) bool {
+	if service.Spec.Type != corev1.ServiceTypeLoadBalancer || len(service.ResourceVersion) > 0 {
+		return false
+	}
+	if _, ok := service.Annotations[annotationLoadBalancerType]; ok {
+		return false
+	}
+	_, ok := service.Annotations[annotationLoadBalancerProxyProtocol]
+	return !ok
+}
+
+// mutateLoadBalancerService sets the load balancer type configured in the ControlPlaneConfig of the shoot in the given
+// namespace on the given service.
+func (m *mutator) mutateLoadBalancerService(ctx context.Context, service *corev1.Service, shootNamespace string) error {
+	cluster, err := extensionscontroller.GetCluster(ctx, m.client, shootNamespace)
+	if err != nil {
+		return errors.Wrapf(err, "could not get cluster for namespace '%s'", shootNamespace)
+	}
+
+	cpConfig, err := helper.ControlPlaneConfigFromCluster(m.decoder, cluster)
+	if err != nil {
+		return err
+	}
+
+	if helper.GetLoadBalancerType(cpConfig) == apisaws.LoadBalancerTypeNLB {
+		if service.Annotations == nil {
+			service.Annotations = make(map[string]string, 1)
+		}
+		service.Annotations[annotationLoadBalancerType] = string(apisaws.LoadBalancerTypeNLB)
+	}
+	return nil
+}
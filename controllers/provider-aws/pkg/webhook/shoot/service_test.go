@@ -0,0 +1,159 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/install"
+	awsv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-aws/pkg/apis/aws/v1alpha1"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/kubernetes/scheme"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const namespace = "shoot--foo--bar"
+
+var _ = Describe("Service", func() {
+	var (
+		ctx = context.TODO()
+
+		s       *runtime.Scheme
+		m       *mutator
+		service *corev1.Service
+	)
+
+	newMutator := func(lbType *awsv1alpha1.LoadBalancerType) *mutator {
+		cpConfig := &awsv1alpha1.ControlPlaneConfig{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: awsv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "ControlPlaneConfig",
+			},
+		}
+		if lbType != nil {
+			cpConfig.LoadBalancer = &awsv1alpha1.LoadBalancerConfig{Type: lbType}
+		}
+
+		cluster := &extensionsv1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: namespace},
+			Spec: extensionsv1alpha1.ClusterSpec{
+				CloudProfile: runtime.RawExtension{Raw: encode(&gardencorev1alpha1.CloudProfile{
+					TypeMeta: metav1.TypeMeta{APIVersion: gardencorev1alpha1.SchemeGroupVersion.String(), Kind: "CloudProfile"},
+				})},
+				Seed: runtime.RawExtension{Raw: encode(&gardencorev1alpha1.Seed{
+					TypeMeta: metav1.TypeMeta{APIVersion: gardencorev1alpha1.SchemeGroupVersion.String(), Kind: "Seed"},
+				})},
+				Shoot: runtime.RawExtension{Raw: encode(&gardencorev1alpha1.Shoot{
+					TypeMeta: metav1.TypeMeta{APIVersion: gardencorev1alpha1.SchemeGroupVersion.String(), Kind: "Shoot"},
+					Spec: gardencorev1alpha1.ShootSpec{
+						Provider: gardencorev1alpha1.Provider{
+							ControlPlaneConfig: &gardencorev1alpha1.ProviderConfig{
+								RawExtension: runtime.RawExtension{Raw: encode(cpConfig)},
+							},
+						},
+					},
+				})},
+			},
+		}
+
+		m := &mutator{decoder: serializer.NewCodecFactory(s).UniversalDecoder()}
+		Expect(m.InjectClient(fakeclient.NewFakeClientWithScheme(s, cluster))).To(Succeed())
+		return m
+	}
+
+	BeforeEach(func() {
+		s = runtime.NewScheme()
+		Expect(scheme.AddToScheme(s)).To(Succeed())
+		Expect(extensionsv1alpha1.AddToScheme(s)).To(Succeed())
+		Expect(install.AddToScheme(s)).To(Succeed())
+
+		nlb := awsv1alpha1.LoadBalancerTypeNLB
+		m = newMutator(&nlb)
+
+		service = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "ingress", Namespace: "default"},
+			Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+		}
+	})
+
+	Describe("#Handles", func() {
+		It("should handle new load balancer services without load balancer type", func() {
+			Expect(m.Handles(service)).To(BeTrue())
+		})
+
+		It("should not handle services of other types", func() {
+			service.Spec.Type = corev1.ServiceTypeClusterIP
+			Expect(m.Handles(service)).To(BeFalse())
+		})
+
+		It("should not handle existing services", func() {
+			service.ResourceVersion = "1"
+			Expect(m.Handles(service)).To(BeFalse())
+		})
+
+		It("should not handle services selecting a load balancer type or requiring the proxy protocol", func() {
+			service.Annotations = map[string]string{annotationLoadBalancerType: "elb"}
+			Expect(m.Handles(service)).To(BeFalse())
+
+			service.Annotations = map[string]string{annotationLoadBalancerProxyProtocol: "*"}
+			Expect(m.Handles(service)).To(BeFalse())
+		})
+
+		It("should only handle the nginx-ingress-controller configmap in the kube-system namespace", func() {
+			configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: nginxIngressControllerConfigMapName, Namespace: metav1.NamespaceSystem}}
+			Expect(m.Handles(configMap)).To(BeTrue())
+
+			configMap.Namespace = "default"
+			Expect(m.Handles(configMap)).To(BeFalse())
+		})
+	})
+
+	Describe("#Mutate", func() {
+		It("should default new load balancer services to network load balancers", func() {
+			Expect(m.Mutate(ctx, service, namespace)).To(Succeed())
+			Expect(service.Annotations).To(Equal(map[string]string{annotationLoadBalancerType: "nlb"}))
+		})
+
+		It("should not mutate services if classic load balancers are configured", func() {
+			m = newMutator(nil)
+			expected := service.DeepCopy()
+
+			Expect(m.Mutate(ctx, service, namespace)).To(Succeed())
+			Expect(service).To(Equal(expected))
+		})
+
+		It("should not mutate existing services", func() {
+			service.ResourceVersion = "1"
+			expected := service.DeepCopy()
+
+			Expect(m.Mutate(ctx, service, namespace)).To(Succeed())
+			Expect(service).To(Equal(expected))
+		})
+	})
+})
+
+func encode(obj runtime.Object) []byte {
+	data, _ := json.Marshal(obj)
+	return data
+}
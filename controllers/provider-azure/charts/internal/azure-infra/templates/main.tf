@@ -27,7 +27,7 @@ resource "azurerm_virtual_network" "vnet" {
 
 resource "azurerm_subnet" "workers" {
   name                      = "{{ required "clusterName is required" .Values.clusterName }}-nodes"
-  resource_group_name       = "{{ required "resourceGroup.name is required" .Values.resourceGroup.name }}"
+  resource_group_name       = "{{ required "resourceGroup.name is required" (default .Values.resourceGroup.name .Values.resourceGroup.vnet.resourceGroup) }}"
   virtual_network_name      = "{{ required "resourceGroup.vnet.name is required" .Values.resourceGroup.vnet.name }}"
   address_prefix            = "{{ required "networks.worker is required" .Values.networks.worker }}"
   service_endpoints         = [{{range $index, $serviceEndpoint := .Values.resourceGroup.subnet.serviceEndpoints}}{{if $index}},{{end}}"{{$serviceEndpoint}}"{{end}}]
@@ -55,6 +55,48 @@ resource "azurerm_subnet_network_security_group_association" "workers-sg-associa
   network_security_group_id = "${azurerm_network_security_group.workers.id}"
 }
 
+{{ if .Values.natGateway.enabled -}}
+#=====================================================================
+#= NAT Gateway
+#=====================================================================
+
+{{ range $index, $ipAddress := .Values.natGateway.ipAddresses -}}
+data "azurerm_public_ip" "nat-gateway-ip-{{ $index }}" {
+  name                = "{{ required "natGateway.ipAddresses[].name is required" $ipAddress.name }}"
+  resource_group_name = "{{ required "natGateway.ipAddresses[].resourceGroup is required" $ipAddress.resourceGroup }}"
+}
+
+{{ else -}}
+resource "azurerm_public_ip" "nat-gateway-ip" {
+  name                = "{{ required "clusterName is required" .Values.clusterName }}-nat-gateway-ip"
+  location            = "{{ required "azure.region is required" .Values.azure.region }}"
+  resource_group_name = "{{ required "resourceGroup.name is required" .Values.resourceGroup.name }}"
+  allocation_method   = "Static"
+  sku                 = "Standard"
+  {{- if .Values.natGateway.zone }}
+  zones               = ["{{ .Values.natGateway.zone }}"]
+  {{- end }}
+}
+
+{{ end -}}
+resource "azurerm_nat_gateway" "nat" {
+  name                    = "{{ required "clusterName is required" .Values.clusterName }}-nat-gateway"
+  location                = "{{ required "azure.region is required" .Values.azure.region }}"
+  resource_group_name     = "{{ required "resourceGroup.name is required" .Values.resourceGroup.name }}"
+  sku_name                = "Standard"
+  idle_timeout_in_minutes = {{ required "natGateway.idleConnectionTimeoutMinutes is required" .Values.natGateway.idleConnectionTimeoutMinutes }}
+  {{- if .Values.natGateway.zone }}
+  zones                   = ["{{ .Values.natGateway.zone }}"]
+  {{- end }}
+  public_ip_address_ids   = [{{ range $index, $ipAddress := .Values.natGateway.ipAddresses }}{{ if $index }}, {{ end }}"${data.azurerm_public_ip.nat-gateway-ip-{{ $index }}.id}"{{ else }}"${azurerm_public_ip.nat-gateway-ip.id}"{{ end }}]
+}
+
+resource "azurerm_subnet_nat_gateway_association" "nat-worker-subnet-association" {
+  subnet_id      = "${azurerm_subnet.workers.id}"
+  nat_gateway_id = "${azurerm_nat_gateway.nat.id}"
+}
+
+{{ end -}}
 {{ if .Values.create.availabilitySet -}}
 #=====================================================================
 #= Availability Set
@@ -81,6 +123,12 @@ output "{{ .Values.outputKeys.resourceGroupName }}" {
 output "{{ .Values.outputKeys.vnetName }}" {
   value = "{{ required "resourceGroup.vnet.name is required" .Values.resourceGroup.vnet.name }}"
 }
+{{- if .Values.resourceGroup.vnet.resourceGroup }}
+
+output "{{ .Values.outputKeys.vnetResourceGroup }}" {
+  value = "{{ .Values.resourceGroup.vnet.resourceGroup }}"
+}
+{{- end }}
 
 output "{{ .Values.outputKeys.subnetName }}" {
   value = "${azurerm_subnet.workers.name}"
@@ -102,4 +150,14 @@ output "{{ .Values.outputKeys.availabilitySetID }}" {
 output "{{ .Values.outputKeys.availabilitySetName }}" {
   value = "${azurerm_availability_set.workers.name}"
 }
+{{- end}}
+{{- if .Values.natGateway.enabled }}
+
+output "{{ .Values.outputKeys.natGatewayID }}" {
+  value = "${azurerm_nat_gateway.nat.id}"
+}
+
+output "{{ .Values.outputKeys.natGatewayPublicIPAddresses }}" {
+  value = "{{ range $index, $ipAddress := .Values.natGateway.ipAddresses }}{{ if $index }},{{ end }}${data.azurerm_public_ip.nat-gateway-ip-{{ $index }}.ip_address}{{ else }}${azurerm_public_ip.nat-gateway-ip.ip_address}{{ end }}"
+}
 {{- end}}
\ No newline at end of file
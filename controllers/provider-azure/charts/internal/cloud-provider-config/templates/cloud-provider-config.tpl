@@ -5,8 +5,20 @@ resourceGroup: "{{ .Values.resourceGroup }}"
 routeTableName: "{{ .Values.routeTableName }}"
 securityGroupName: "{{ .Values.securityGroupName }}"
 loadBalancerSku: "{{ .Values.loadBalancerSku }}"
+{{- if hasKey .Values "loadBalancerAllocatedOutboundPorts" }}
+loadBalancerAllocatedOutboundPorts: {{ .Values.loadBalancerAllocatedOutboundPorts }}
+{{- end }}
+{{- if hasKey .Values "loadBalancerIdleTimeoutInMinutes" }}
+loadBalancerIdleTimeoutInMinutes: {{ .Values.loadBalancerIdleTimeoutInMinutes }}
+{{- end }}
+{{- if hasKey .Values "excludeMasterFromStandardLB" }}
+excludeMasterFromStandardLB: {{ .Values.excludeMasterFromStandardLB }}
+{{- end }}
 subnetName: "{{ .Values.subnetName }}"
 vnetName: "{{ .Values.vnetName }}"
+{{- if hasKey .Values "vnetResourceGroup" }}
+vnetResourceGroup: "{{ .Values.vnetResourceGroup }}"
+{{- end }}
 {{- if hasKey .Values "availabilitySetName" }}
 primaryAvailabilitySetName: "{{ .Values.availabilitySetName }}"
 {{- end }}
@@ -0,0 +1,3 @@
+{{- define "csi-driver-controller.socket-dir" -}}
+/var/lib/csi/sockets/pluginproxy
+{{- end -}}
@@ -5,3 +5,19 @@ storage.k8s.io/v1
 storage.k8s.io/v1beta1
 {{- end -}}
 {{- end -}}
+
+{{- define "disk-provisioner" -}}
+{{- if .Values.useCSI -}}
+disk.csi.azure.com
+{{- else -}}
+kubernetes.io/azure-disk
+{{- end -}}
+{{- end -}}
+
+{{- define "file-provisioner" -}}
+{{- if .Values.useCSI -}}
+file.csi.azure.com
+{{- else -}}
+kubernetes.io/azure-file
+{{- end -}}
+{{- end -}}
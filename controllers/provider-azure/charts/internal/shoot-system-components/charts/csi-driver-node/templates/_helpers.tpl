@@ -0,0 +1,15 @@
+{{- define "csi-driver-node.disk.name" -}}
+disk.csi.azure.com
+{{- end -}}
+
+{{- define "csi-driver-node.disk.plugin-dir" -}}
+/var/lib/kubelet/plugins/{{ include "csi-driver-node.disk.name" . }}
+{{- end -}}
+
+{{- define "csi-driver-node.file.name" -}}
+file.csi.azure.com
+{{- end -}}
+
+{{- define "csi-driver-node.file.plugin-dir" -}}
+/var/lib/kubelet/plugins/{{ include "csi-driver-node.file.name" . }}
+{{- end -}}
@@ -60,6 +60,8 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 		backupEntryCtrlOpts = &controllercmd.ControllerOptions{
 			MaxConcurrentReconciles: 5,
 		}
+		backupEntryDeletionOpts       = &controllercmd.DeletionOptions{}
+		backupEntryCtrlOptsUnprefixed = controllercmd.NewOptionAggregator(backupEntryCtrlOpts, backupEntryDeletionOpts)
 
 		// options for the controlplane controller
 		controlPlaneCtrlOpts = &controllercmd.ControllerOptions{
@@ -95,7 +97,7 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			restOpts,
 			mgrOpts,
 			controllercmd.PrefixOption("backupbucket-", backupBucketCtrlOpts),
-			controllercmd.PrefixOption("backupentry-", backupEntryCtrlOpts),
+			controllercmd.PrefixOption("backupentry-", &backupEntryCtrlOptsUnprefixed),
 			controllercmd.PrefixOption("controlplane-", controlPlaneCtrlOpts),
 			controllercmd.PrefixOption("infrastructure-", infraCtrlOpts),
 			controllercmd.PrefixOption("worker-", &workerCtrlOptsUnprefixed),
@@ -136,10 +138,14 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			}
 
 			configFileOpts.Completed().ApplyMachineImages(&azureworker.DefaultAddOptions.MachineImages)
+			configFileOpts.Completed().ApplyControlPlaneComponents(&azurecontrolplane.DefaultAddOptions.ControlPlaneComponents)
+			configFileOpts.Completed().ApplyControlPlaneComponents(&azureworker.DefaultAddOptions.ControlPlaneComponents)
 			configFileOpts.Completed().ApplyETCDStorage(&azurecontrolplaneexposure.DefaultAddOptions.ETCDStorage)
 			configFileOpts.Completed().ApplyETCDBackup(&azurecontrolplanebackup.DefaultAddOptions.ETCDBackup)
+			configFileOpts.Completed().ApplyBackupBucketConfig(&azurebackupbucket.DefaultAddOptions.BackupBucketConfig)
 			backupBucketCtrlOpts.Completed().Apply(&azurebackupbucket.DefaultAddOptions.Controller)
 			backupEntryCtrlOpts.Completed().Apply(&azurebackupentry.DefaultAddOptions.Controller)
+			backupEntryDeletionOpts.Completed().Apply(&azurebackupentry.DefaultAddOptions.Deletion.GracePeriod, &azurebackupentry.DefaultAddOptions.Deletion.GarbageCollectionPeriod)
 			controlPlaneCtrlOpts.Completed().Apply(&azurecontrolplane.DefaultAddOptions.Controller)
 			infraCtrlOpts.Completed().Apply(&azureinfrastructure.DefaultAddOptions.Controller)
 			reconcileOpts.Completed().Apply(&azureinfrastructure.DefaultAddOptions.IgnoreOperationAnnotation)
@@ -0,0 +1,65 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackupBucketConfig contains configuration settings for the backup bucket.
+type BackupBucketConfig struct {
+	metav1.TypeMeta
+
+	// Immutability contains the time-based retention policy of the blob container.
+	Immutability *ImmutabilityConfig
+
+	// Encryption contains the encryption settings for the storage account of the bucket.
+	Encryption *EncryptionConfig
+
+	// Lifecycle contains the expiry rules for blobs in the bucket.
+	Lifecycle *LifecycleConfig
+}
+
+// ImmutabilityConfig contains the time-based retention policy of the blob container.
+type ImmutabilityConfig struct {
+	// Days is the number of days blobs cannot be deleted or modified after they were created.
+	Days int32
+
+	// Locked indicates whether the retention policy shall be locked. A locked retention policy cannot be removed
+	// and its retention period can only be extended. Deleting the backup bucket fails until
+	// the retention period of all blobs has expired.
+	Locked *bool
+}
+
+// EncryptionConfig contains the encryption settings for the storage account of the bucket. The key vault must grant
+// the managed identity of the storage account access to the key.
+type EncryptionConfig struct {
+	// KeyVaultURI is the URI of the key vault containing the encryption key.
+	KeyVaultURI string
+
+	// KeyName is the name of the encryption key.
+	KeyName string
+
+	// KeyVersion is the version of the encryption key. If not set, the current version of the key is used.
+	KeyVersion *string
+}
+
+// LifecycleConfig contains the expiry rules for blobs in the bucket.
+type LifecycleConfig struct {
+	// ExpirationDays is the number of days after the last modification after which blobs are deleted.
+	ExpirationDays int32
+}
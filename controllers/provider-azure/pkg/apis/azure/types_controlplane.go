@@ -15,6 +15,9 @@
 package azure
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -27,10 +30,100 @@ type ControlPlaneConfig struct {
 	// CloudControllerManager contains configuration settings for the cloud-controller-manager.
 	// +optional
 	CloudControllerManager *CloudControllerManagerConfig
+
+	// StorageClasses contains additional storage classes that shall be deployed into the shoot cluster.
+	// If the CSI driver is used, each of them is additionally deployed with the CSI provisioner under its name
+	// suffixed with "-csi", and the default annotation moves to these storage classes.
+	StorageClasses []StorageClass
+
+	// LoadBalancer contains configuration settings for the load balancers of the shoot cluster.
+	// +optional
+	LoadBalancer *LoadBalancerConfig
+
+	// ETCDBackup contains configuration settings for the etcd backup-restore sidecar.
+	ETCDBackup *ETCDBackupConfig
+}
+
+// LoadBalancerSKU is the SKU of the Azure load balancers of a shoot cluster.
+type LoadBalancerSKU string
+
+const (
+	// LoadBalancerSKUBasic is the basic Azure load balancer SKU.
+	LoadBalancerSKUBasic LoadBalancerSKU = "basic"
+	// LoadBalancerSKUStandard is the standard Azure load balancer SKU.
+	LoadBalancerSKUStandard LoadBalancerSKU = "standard"
+)
+
+// LoadBalancerConfig contains configuration settings for the load balancers of the shoot cluster.
+type LoadBalancerConfig struct {
+	// SKU is the SKU of the load balancers. It defaults to standard for new shoots and to the current SKU for
+	// existing shoots. Existing shoots with basic load balancers are only migrated to standard load balancers if
+	// this is set to standard and the migration is confirmed by an annotation on the shoot.
+	// +optional
+	SKU *LoadBalancerSKU
+	// AllocatedOutboundPorts is the number of outbound SNAT ports allocated per node. Only applicable
+	// to standard load balancers.
+	// +optional
+	AllocatedOutboundPorts *int32
+	// IdleTimeoutInMinutes is the idle timeout of outbound connections. Only applicable to standard
+	// load balancers.
+	// +optional
+	IdleTimeoutInMinutes *int32
+	// ExcludeMasterFromStandardLB indicates whether master nodes shall be excluded from the standard
+	// load balancer backend pools. Only applicable to standard load balancers.
+	// +optional
+	ExcludeMasterFromStandardLB *bool
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
 type CloudControllerManagerConfig struct {
 	// FeatureGates contains information about enabled feature gates.
 	FeatureGates map[string]bool
+
+	// ConcurrentServiceSyncs is the number of services that are allowed to sync concurrently. Defaults to 10.
+	ConcurrentServiceSyncs *int32
+
+	// NodeMonitorPeriod is the period for syncing the status of the nodes with the cloud provider.
+	NodeMonitorPeriod *metav1.Duration
+
+	// Verbosity is the log verbosity of the cloud-controller-manager. Defaults to 2.
+	Verbosity *int32
+
+	// RouteReconciliationPeriod is the period for reconciling the routes created for the nodes by the cloud provider.
+	RouteReconciliationPeriod *metav1.Duration
+}
+
+// StorageClass contains configuration for a storage class deployed into the shoot cluster.
+type StorageClass struct {
+	// Name is the name of the storage class.
+	Name string
+
+	// Type is the volume type provisioned by the storage class.
+	Type string
+
+	// ReclaimPolicy is the reclaim policy of the dynamically provisioned volumes. Defaults to Delete.
+	ReclaimPolicy *corev1.PersistentVolumeReclaimPolicy
+
+	// VolumeBindingMode indicates how volumes shall be provisioned and bound. Defaults to Immediate.
+	VolumeBindingMode *storagev1.VolumeBindingMode
+
+	// Default indicates whether the storage class shall be the default storage class of the shoot cluster.
+	Default *bool
+}
+
+// ETCDBackupConfig contains configuration settings for the etcd backup-restore sidecar.
+type ETCDBackupConfig struct {
+	// DeltaSnapshotPeriod is the period after which delta snapshots are taken. Defaults to 5m.
+	DeltaSnapshotPeriod *metav1.Duration
+	// DeltaSnapshotMemoryLimit is the size of accumulated events after which a delta snapshot is taken
+	// before the delta snapshot period has passed. Defaults to 100Mi.
+	DeltaSnapshotMemoryLimit *resource.Quantity
+	// GarbageCollectionPeriod is the period after which old snapshots are garbage collected. Defaults to 12h.
+	GarbageCollectionPeriod *metav1.Duration
+	// GarbageCollectionPolicy is the policy for garbage collecting old snapshots, either Exponential or LimitBased.
+	GarbageCollectionPolicy *string
+	// MaxBackups is the maximum number of full snapshots kept by the LimitBased garbage collection policy.
+	MaxBackups *int32
+	// Resources are the compute resources of the backup-restore sidecar.
+	Resources *corev1.ResourceRequirements
 }
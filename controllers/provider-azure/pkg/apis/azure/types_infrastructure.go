@@ -45,6 +45,29 @@ type NetworkConfig struct {
 	Workers string
 	// ServiceEndpoints is a list of Azure ServiceEndpoints which should be associated with the worker subnet.
 	ServiceEndpoints []string
+	// NatGateway contains the configuration for the NAT gateway of the worker subnet.
+	NatGateway *NatGatewayConfig
+}
+
+// NatGatewayConfig contains configuration for the NAT gateway and the attached resources.
+type NatGatewayConfig struct {
+	// Enabled is an indicator if a NAT gateway should be deployed.
+	Enabled bool
+	// IdleConnectionTimeoutMinutes specifies the idle connection timeout limit for the NAT gateway in minutes.
+	IdleConnectionTimeoutMinutes *int32
+	// Zone specifies the zone in which the NAT gateway and the created public IP should be deployed.
+	Zone *int32
+	// IPAddresses is a list of existing public IP addresses which should be attached to the NAT gateway.
+	// If empty, a public IP is created.
+	IPAddresses []PublicIPReference
+}
+
+// PublicIPReference contains information about an existing public IP address.
+type PublicIPReference struct {
+	// Name is the name of the public IP address.
+	Name string
+	// ResourceGroup is the name of the resource group of the public IP address.
+	ResourceGroup string
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -72,6 +95,16 @@ type NetworkStatus struct {
 	VNet VNetStatus
 	// Subnets are the subnets that have been created.
 	Subnets []Subnet
+	// NatGateway is the status of the NAT gateway of the worker subnet.
+	NatGateway *NatGatewayStatus
+}
+
+// NatGatewayStatus contains information about the NAT gateway.
+type NatGatewayStatus struct {
+	// ID is the id of the NAT gateway.
+	ID string
+	// PublicIPAddresses are the public IP addresses attached to the NAT gateway.
+	PublicIPAddresses []string
 }
 
 // Purpose is a purpose of a subnet.
@@ -122,6 +155,9 @@ type SecurityGroup struct {
 type VNet struct {
 	// Name is the VNet name.
 	Name *string
+	// ResourceGroup is the resource group of an existing VNet.
+	// It is not supported yet as the machine-controller-manager cannot attach machines to a VNet in another resource group.
+	ResourceGroup *string
 	// CIDR is the VNet CIDR
 	CIDR *string
 }
@@ -130,4 +166,6 @@ type VNet struct {
 type VNetStatus struct {
 	// Name is the VNet name.
 	Name string
+	// ResourceGroup is the resource group of the VNet if it differs from the resource group of the shoot.
+	ResourceGroup *string
 }
@@ -48,6 +48,33 @@ type NetworkConfig struct {
 	// ServiceEndpoints is a list of Azure ServiceEndpoints which should be associated with the worker subnet.
 	// +optional
 	ServiceEndpoints []string `json:"serviceEndpoints,omitempty"`
+	// NatGateway contains the configuration for the NAT gateway of the worker subnet.
+	// +optional
+	NatGateway *NatGatewayConfig `json:"natGateway,omitempty"`
+}
+
+// NatGatewayConfig contains configuration for the NAT gateway and the attached resources.
+type NatGatewayConfig struct {
+	// Enabled is an indicator if a NAT gateway should be deployed.
+	Enabled bool `json:"enabled"`
+	// IdleConnectionTimeoutMinutes specifies the idle connection timeout limit for the NAT gateway in minutes.
+	// +optional
+	IdleConnectionTimeoutMinutes *int32 `json:"idleConnectionTimeoutMinutes,omitempty"`
+	// Zone specifies the zone in which the NAT gateway and the created public IP should be deployed.
+	// +optional
+	Zone *int32 `json:"zone,omitempty"`
+	// IPAddresses is a list of existing public IP addresses which should be attached to the NAT gateway.
+	// If empty, a public IP is created.
+	// +optional
+	IPAddresses []PublicIPReference `json:"ipAddresses,omitempty"`
+}
+
+// PublicIPReference contains information about an existing public IP address.
+type PublicIPReference struct {
+	// Name is the name of the public IP address.
+	Name string `json:"name"`
+	// ResourceGroup is the name of the resource group of the public IP address.
+	ResourceGroup string `json:"resourceGroup"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -77,6 +104,18 @@ type NetworkStatus struct {
 
 	// Subnets are the subnets that have been created.
 	Subnets []Subnet `json:"subnets"`
+
+	// NatGateway is the status of the NAT gateway of the worker subnet.
+	// +optional
+	NatGateway *NatGatewayStatus `json:"natGateway,omitempty"`
+}
+
+// NatGatewayStatus contains information about the NAT gateway.
+type NatGatewayStatus struct {
+	// ID is the id of the NAT gateway.
+	ID string `json:"id"`
+	// PublicIPAddresses are the public IP addresses attached to the NAT gateway.
+	PublicIPAddresses []string `json:"publicIPAddresses"`
 }
 
 // Purpose is a purpose of a subnet.
@@ -128,6 +167,10 @@ type VNet struct {
 	// Name is the VNet name.
 	// +optional
 	Name *string `json:"name,omitempty"`
+	// ResourceGroup is the resource group of an existing VNet.
+	// It is not supported yet as the machine-controller-manager cannot attach machines to a VNet in another resource group.
+	// +optional
+	ResourceGroup *string `json:"resourceGroup,omitempty"`
 	// CIDR is the VNet CIDR
 	// +optional
 	CIDR *string `json:"cidr,omitempty"`
@@ -137,4 +180,7 @@ type VNet struct {
 type VNetStatus struct {
 	// Name is the VNet name.
 	Name string `json:"name"`
+	// ResourceGroup is the resource group of the VNet if it differs from the resource group of the shoot.
+	// +optional
+	ResourceGroup *string `json:"resourceGroup,omitempty"`
 }
@@ -24,6 +24,10 @@ import (
 	unsafe "unsafe"
 
 	azure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -45,6 +49,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BackupBucketConfig)(nil), (*azure.BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketConfig_To_azure_BackupBucketConfig(a.(*BackupBucketConfig), b.(*azure.BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.BackupBucketConfig)(nil), (*BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(a.(*azure.BackupBucketConfig), b.(*BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudControllerManagerConfig)(nil), (*azure.CloudControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudControllerManagerConfig_To_azure_CloudControllerManagerConfig(a.(*CloudControllerManagerConfig), b.(*azure.CloudControllerManagerConfig), scope)
 	}); err != nil {
@@ -85,6 +99,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ETCDBackupConfig)(nil), (*azure.ETCDBackupConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ETCDBackupConfig_To_azure_ETCDBackupConfig(a.(*ETCDBackupConfig), b.(*azure.ETCDBackupConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.ETCDBackupConfig)(nil), (*ETCDBackupConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_ETCDBackupConfig_To_v1alpha1_ETCDBackupConfig(a.(*azure.ETCDBackupConfig), b.(*ETCDBackupConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EncryptionConfig)(nil), (*azure.EncryptionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EncryptionConfig_To_azure_EncryptionConfig(a.(*EncryptionConfig), b.(*azure.EncryptionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.EncryptionConfig)(nil), (*EncryptionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_EncryptionConfig_To_v1alpha1_EncryptionConfig(a.(*azure.EncryptionConfig), b.(*EncryptionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ImmutabilityConfig)(nil), (*azure.ImmutabilityConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImmutabilityConfig_To_azure_ImmutabilityConfig(a.(*ImmutabilityConfig), b.(*azure.ImmutabilityConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.ImmutabilityConfig)(nil), (*ImmutabilityConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_ImmutabilityConfig_To_v1alpha1_ImmutabilityConfig(a.(*azure.ImmutabilityConfig), b.(*ImmutabilityConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InfrastructureConfig)(nil), (*azure.InfrastructureConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InfrastructureConfig_To_azure_InfrastructureConfig(a.(*InfrastructureConfig), b.(*azure.InfrastructureConfig), scope)
 	}); err != nil {
@@ -105,6 +149,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*LifecycleConfig)(nil), (*azure.LifecycleConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LifecycleConfig_To_azure_LifecycleConfig(a.(*LifecycleConfig), b.(*azure.LifecycleConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.LifecycleConfig)(nil), (*LifecycleConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_LifecycleConfig_To_v1alpha1_LifecycleConfig(a.(*azure.LifecycleConfig), b.(*LifecycleConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerConfig)(nil), (*azure.LoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerConfig_To_azure_LoadBalancerConfig(a.(*LoadBalancerConfig), b.(*azure.LoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.LoadBalancerConfig)(nil), (*LoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(a.(*azure.LoadBalancerConfig), b.(*LoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineImage)(nil), (*azure.MachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachineImage_To_azure_MachineImage(a.(*MachineImage), b.(*azure.MachineImage), scope)
 	}); err != nil {
@@ -135,6 +199,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NatGatewayConfig)(nil), (*azure.NatGatewayConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NatGatewayConfig_To_azure_NatGatewayConfig(a.(*NatGatewayConfig), b.(*azure.NatGatewayConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.NatGatewayConfig)(nil), (*NatGatewayConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_NatGatewayConfig_To_v1alpha1_NatGatewayConfig(a.(*azure.NatGatewayConfig), b.(*NatGatewayConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NatGatewayStatus)(nil), (*azure.NatGatewayStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NatGatewayStatus_To_azure_NatGatewayStatus(a.(*NatGatewayStatus), b.(*azure.NatGatewayStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.NatGatewayStatus)(nil), (*NatGatewayStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_NatGatewayStatus_To_v1alpha1_NatGatewayStatus(a.(*azure.NatGatewayStatus), b.(*NatGatewayStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NetworkConfig)(nil), (*azure.NetworkConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NetworkConfig_To_azure_NetworkConfig(a.(*NetworkConfig), b.(*azure.NetworkConfig), scope)
 	}); err != nil {
@@ -155,6 +239,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PublicIPReference)(nil), (*azure.PublicIPReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PublicIPReference_To_azure_PublicIPReference(a.(*PublicIPReference), b.(*azure.PublicIPReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.PublicIPReference)(nil), (*PublicIPReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_PublicIPReference_To_v1alpha1_PublicIPReference(a.(*azure.PublicIPReference), b.(*PublicIPReference), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ResourceGroup)(nil), (*azure.ResourceGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ResourceGroup_To_azure_ResourceGroup(a.(*ResourceGroup), b.(*azure.ResourceGroup), scope)
 	}); err != nil {
@@ -185,6 +279,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*StorageClass)(nil), (*azure.StorageClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageClass_To_azure_StorageClass(a.(*StorageClass), b.(*azure.StorageClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.StorageClass)(nil), (*StorageClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_StorageClass_To_v1alpha1_StorageClass(a.(*azure.StorageClass), b.(*StorageClass), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Subnet)(nil), (*azure.Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Subnet_To_azure_Subnet(a.(*Subnet), b.(*azure.Subnet), scope)
 	}); err != nil {
@@ -252,8 +356,36 @@ func Convert_azure_AvailabilitySet_To_v1alpha1_AvailabilitySet(in *azure.Availab
 	return autoConvert_azure_AvailabilitySet_To_v1alpha1_AvailabilitySet(in, out, s)
 }
 
+func autoConvert_v1alpha1_BackupBucketConfig_To_azure_BackupBucketConfig(in *BackupBucketConfig, out *azure.BackupBucketConfig, s conversion.Scope) error {
+	out.Immutability = (*azure.ImmutabilityConfig)(unsafe.Pointer(in.Immutability))
+	out.Encryption = (*azure.EncryptionConfig)(unsafe.Pointer(in.Encryption))
+	out.Lifecycle = (*azure.LifecycleConfig)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketConfig_To_azure_BackupBucketConfig is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketConfig_To_azure_BackupBucketConfig(in *BackupBucketConfig, out *azure.BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketConfig_To_azure_BackupBucketConfig(in, out, s)
+}
+
+func autoConvert_azure_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *azure.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	out.Immutability = (*ImmutabilityConfig)(unsafe.Pointer(in.Immutability))
+	out.Encryption = (*EncryptionConfig)(unsafe.Pointer(in.Encryption))
+	out.Lifecycle = (*LifecycleConfig)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_azure_BackupBucketConfig_To_v1alpha1_BackupBucketConfig is an autogenerated conversion function.
+func Convert_azure_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *azure.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_azure_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudControllerManagerConfig_To_azure_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *azure.CloudControllerManagerConfig, s conversion.Scope) error {
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.ConcurrentServiceSyncs = (*int32)(unsafe.Pointer(in.ConcurrentServiceSyncs))
+	out.NodeMonitorPeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorPeriod))
+	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
+	out.RouteReconciliationPeriod = (*v1.Duration)(unsafe.Pointer(in.RouteReconciliationPeriod))
 	return nil
 }
 
@@ -264,6 +396,10 @@ func Convert_v1alpha1_CloudControllerManagerConfig_To_azure_CloudControllerManag
 
 func autoConvert_azure_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in *azure.CloudControllerManagerConfig, out *CloudControllerManagerConfig, s conversion.Scope) error {
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.ConcurrentServiceSyncs = (*int32)(unsafe.Pointer(in.ConcurrentServiceSyncs))
+	out.NodeMonitorPeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorPeriod))
+	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
+	out.RouteReconciliationPeriod = (*v1.Duration)(unsafe.Pointer(in.RouteReconciliationPeriod))
 	return nil
 }
 
@@ -298,6 +434,9 @@ func Convert_azure_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *azure.C
 
 func autoConvert_v1alpha1_ControlPlaneConfig_To_azure_ControlPlaneConfig(in *ControlPlaneConfig, out *azure.ControlPlaneConfig, s conversion.Scope) error {
 	out.CloudControllerManager = (*azure.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.StorageClasses = *(*[]azure.StorageClass)(unsafe.Pointer(&in.StorageClasses))
+	out.LoadBalancer = (*azure.LoadBalancerConfig)(unsafe.Pointer(in.LoadBalancer))
+	out.ETCDBackup = (*azure.ETCDBackupConfig)(unsafe.Pointer(in.ETCDBackup))
 	return nil
 }
 
@@ -308,6 +447,9 @@ func Convert_v1alpha1_ControlPlaneConfig_To_azure_ControlPlaneConfig(in *Control
 
 func autoConvert_azure_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *azure.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
 	out.CloudControllerManager = (*CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.StorageClasses = *(*[]StorageClass)(unsafe.Pointer(&in.StorageClasses))
+	out.LoadBalancer = (*LoadBalancerConfig)(unsafe.Pointer(in.LoadBalancer))
+	out.ETCDBackup = (*ETCDBackupConfig)(unsafe.Pointer(in.ETCDBackup))
 	return nil
 }
 
@@ -338,6 +480,82 @@ func Convert_azure_DomainCount_To_v1alpha1_DomainCount(in *azure.DomainCount, ou
 	return autoConvert_azure_DomainCount_To_v1alpha1_DomainCount(in, out, s)
 }
 
+func autoConvert_v1alpha1_ETCDBackupConfig_To_azure_ETCDBackupConfig(in *ETCDBackupConfig, out *azure.ETCDBackupConfig, s conversion.Scope) error {
+	out.DeltaSnapshotPeriod = (*v1.Duration)(unsafe.Pointer(in.DeltaSnapshotPeriod))
+	out.DeltaSnapshotMemoryLimit = (*resource.Quantity)(unsafe.Pointer(in.DeltaSnapshotMemoryLimit))
+	out.GarbageCollectionPeriod = (*v1.Duration)(unsafe.Pointer(in.GarbageCollectionPeriod))
+	out.GarbageCollectionPolicy = (*string)(unsafe.Pointer(in.GarbageCollectionPolicy))
+	out.MaxBackups = (*int32)(unsafe.Pointer(in.MaxBackups))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	return nil
+}
+
+// Convert_v1alpha1_ETCDBackupConfig_To_azure_ETCDBackupConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ETCDBackupConfig_To_azure_ETCDBackupConfig(in *ETCDBackupConfig, out *azure.ETCDBackupConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ETCDBackupConfig_To_azure_ETCDBackupConfig(in, out, s)
+}
+
+func autoConvert_azure_ETCDBackupConfig_To_v1alpha1_ETCDBackupConfig(in *azure.ETCDBackupConfig, out *ETCDBackupConfig, s conversion.Scope) error {
+	out.DeltaSnapshotPeriod = (*v1.Duration)(unsafe.Pointer(in.DeltaSnapshotPeriod))
+	out.DeltaSnapshotMemoryLimit = (*resource.Quantity)(unsafe.Pointer(in.DeltaSnapshotMemoryLimit))
+	out.GarbageCollectionPeriod = (*v1.Duration)(unsafe.Pointer(in.GarbageCollectionPeriod))
+	out.GarbageCollectionPolicy = (*string)(unsafe.Pointer(in.GarbageCollectionPolicy))
+	out.MaxBackups = (*int32)(unsafe.Pointer(in.MaxBackups))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	return nil
+}
+
+// Convert_azure_ETCDBackupConfig_To_v1alpha1_ETCDBackupConfig is an autogenerated conversion function.
+func Convert_azure_ETCDBackupConfig_To_v1alpha1_ETCDBackupConfig(in *azure.ETCDBackupConfig, out *ETCDBackupConfig, s conversion.Scope) error {
+	return autoConvert_azure_ETCDBackupConfig_To_v1alpha1_ETCDBackupConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_EncryptionConfig_To_azure_EncryptionConfig(in *EncryptionConfig, out *azure.EncryptionConfig, s conversion.Scope) error {
+	out.KeyVaultURI = in.KeyVaultURI
+	out.KeyName = in.KeyName
+	out.KeyVersion = (*string)(unsafe.Pointer(in.KeyVersion))
+	return nil
+}
+
+// Convert_v1alpha1_EncryptionConfig_To_azure_EncryptionConfig is an autogenerated conversion function.
+func Convert_v1alpha1_EncryptionConfig_To_azure_EncryptionConfig(in *EncryptionConfig, out *azure.EncryptionConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EncryptionConfig_To_azure_EncryptionConfig(in, out, s)
+}
+
+func autoConvert_azure_EncryptionConfig_To_v1alpha1_EncryptionConfig(in *azure.EncryptionConfig, out *EncryptionConfig, s conversion.Scope) error {
+	out.KeyVaultURI = in.KeyVaultURI
+	out.KeyName = in.KeyName
+	out.KeyVersion = (*string)(unsafe.Pointer(in.KeyVersion))
+	return nil
+}
+
+// Convert_azure_EncryptionConfig_To_v1alpha1_EncryptionConfig is an autogenerated conversion function.
+func Convert_azure_EncryptionConfig_To_v1alpha1_EncryptionConfig(in *azure.EncryptionConfig, out *EncryptionConfig, s conversion.Scope) error {
+	return autoConvert_azure_EncryptionConfig_To_v1alpha1_EncryptionConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_ImmutabilityConfig_To_azure_ImmutabilityConfig(in *ImmutabilityConfig, out *azure.ImmutabilityConfig, s conversion.Scope) error {
+	out.Days = in.Days
+	out.Locked = (*bool)(unsafe.Pointer(in.Locked))
+	return nil
+}
+
+// Convert_v1alpha1_ImmutabilityConfig_To_azure_ImmutabilityConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ImmutabilityConfig_To_azure_ImmutabilityConfig(in *ImmutabilityConfig, out *azure.ImmutabilityConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImmutabilityConfig_To_azure_ImmutabilityConfig(in, out, s)
+}
+
+func autoConvert_azure_ImmutabilityConfig_To_v1alpha1_ImmutabilityConfig(in *azure.ImmutabilityConfig, out *ImmutabilityConfig, s conversion.Scope) error {
+	out.Days = in.Days
+	out.Locked = (*bool)(unsafe.Pointer(in.Locked))
+	return nil
+}
+
+// Convert_azure_ImmutabilityConfig_To_v1alpha1_ImmutabilityConfig is an autogenerated conversion function.
+func Convert_azure_ImmutabilityConfig_To_v1alpha1_ImmutabilityConfig(in *azure.ImmutabilityConfig, out *ImmutabilityConfig, s conversion.Scope) error {
+	return autoConvert_azure_ImmutabilityConfig_To_v1alpha1_ImmutabilityConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_InfrastructureConfig_To_azure_InfrastructureConfig(in *InfrastructureConfig, out *azure.InfrastructureConfig, s conversion.Scope) error {
 	out.ResourceGroup = (*azure.ResourceGroup)(unsafe.Pointer(in.ResourceGroup))
 	if err := Convert_v1alpha1_NetworkConfig_To_azure_NetworkConfig(&in.Networks, &out.Networks, s); err != nil {
@@ -404,6 +622,52 @@ func Convert_azure_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *azu
 	return autoConvert_azure_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_LifecycleConfig_To_azure_LifecycleConfig(in *LifecycleConfig, out *azure.LifecycleConfig, s conversion.Scope) error {
+	out.ExpirationDays = in.ExpirationDays
+	return nil
+}
+
+// Convert_v1alpha1_LifecycleConfig_To_azure_LifecycleConfig is an autogenerated conversion function.
+func Convert_v1alpha1_LifecycleConfig_To_azure_LifecycleConfig(in *LifecycleConfig, out *azure.LifecycleConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LifecycleConfig_To_azure_LifecycleConfig(in, out, s)
+}
+
+func autoConvert_azure_LifecycleConfig_To_v1alpha1_LifecycleConfig(in *azure.LifecycleConfig, out *LifecycleConfig, s conversion.Scope) error {
+	out.ExpirationDays = in.ExpirationDays
+	return nil
+}
+
+// Convert_azure_LifecycleConfig_To_v1alpha1_LifecycleConfig is an autogenerated conversion function.
+func Convert_azure_LifecycleConfig_To_v1alpha1_LifecycleConfig(in *azure.LifecycleConfig, out *LifecycleConfig, s conversion.Scope) error {
+	return autoConvert_azure_LifecycleConfig_To_v1alpha1_LifecycleConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_LoadBalancerConfig_To_azure_LoadBalancerConfig(in *LoadBalancerConfig, out *azure.LoadBalancerConfig, s conversion.Scope) error {
+	out.SKU = (*azure.LoadBalancerSKU)(unsafe.Pointer(in.SKU))
+	out.AllocatedOutboundPorts = (*int32)(unsafe.Pointer(in.AllocatedOutboundPorts))
+	out.IdleTimeoutInMinutes = (*int32)(unsafe.Pointer(in.IdleTimeoutInMinutes))
+	out.ExcludeMasterFromStandardLB = (*bool)(unsafe.Pointer(in.ExcludeMasterFromStandardLB))
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancerConfig_To_azure_LoadBalancerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_LoadBalancerConfig_To_azure_LoadBalancerConfig(in *LoadBalancerConfig, out *azure.LoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoadBalancerConfig_To_azure_LoadBalancerConfig(in, out, s)
+}
+
+func autoConvert_azure_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in *azure.LoadBalancerConfig, out *LoadBalancerConfig, s conversion.Scope) error {
+	out.SKU = (*LoadBalancerSKU)(unsafe.Pointer(in.SKU))
+	out.AllocatedOutboundPorts = (*int32)(unsafe.Pointer(in.AllocatedOutboundPorts))
+	out.IdleTimeoutInMinutes = (*int32)(unsafe.Pointer(in.IdleTimeoutInMinutes))
+	out.ExcludeMasterFromStandardLB = (*bool)(unsafe.Pointer(in.ExcludeMasterFromStandardLB))
+	return nil
+}
+
+// Convert_azure_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig is an autogenerated conversion function.
+func Convert_azure_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in *azure.LoadBalancerConfig, out *LoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_azure_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_MachineImage_To_azure_MachineImage(in *MachineImage, out *azure.MachineImage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Version = in.Version
@@ -478,12 +742,61 @@ func Convert_azure_MachineImages_To_v1alpha1_MachineImages(in *azure.MachineImag
 	return autoConvert_azure_MachineImages_To_v1alpha1_MachineImages(in, out, s)
 }
 
+func autoConvert_v1alpha1_NatGatewayConfig_To_azure_NatGatewayConfig(in *NatGatewayConfig, out *azure.NatGatewayConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.IdleConnectionTimeoutMinutes = (*int32)(unsafe.Pointer(in.IdleConnectionTimeoutMinutes))
+	out.Zone = (*int32)(unsafe.Pointer(in.Zone))
+	out.IPAddresses = *(*[]azure.PublicIPReference)(unsafe.Pointer(&in.IPAddresses))
+	return nil
+}
+
+// Convert_v1alpha1_NatGatewayConfig_To_azure_NatGatewayConfig is an autogenerated conversion function.
+func Convert_v1alpha1_NatGatewayConfig_To_azure_NatGatewayConfig(in *NatGatewayConfig, out *azure.NatGatewayConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NatGatewayConfig_To_azure_NatGatewayConfig(in, out, s)
+}
+
+func autoConvert_azure_NatGatewayConfig_To_v1alpha1_NatGatewayConfig(in *azure.NatGatewayConfig, out *NatGatewayConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.IdleConnectionTimeoutMinutes = (*int32)(unsafe.Pointer(in.IdleConnectionTimeoutMinutes))
+	out.Zone = (*int32)(unsafe.Pointer(in.Zone))
+	out.IPAddresses = *(*[]PublicIPReference)(unsafe.Pointer(&in.IPAddresses))
+	return nil
+}
+
+// Convert_azure_NatGatewayConfig_To_v1alpha1_NatGatewayConfig is an autogenerated conversion function.
+func Convert_azure_NatGatewayConfig_To_v1alpha1_NatGatewayConfig(in *azure.NatGatewayConfig, out *NatGatewayConfig, s conversion.Scope) error {
+	return autoConvert_azure_NatGatewayConfig_To_v1alpha1_NatGatewayConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_NatGatewayStatus_To_azure_NatGatewayStatus(in *NatGatewayStatus, out *azure.NatGatewayStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.PublicIPAddresses = *(*[]string)(unsafe.Pointer(&in.PublicIPAddresses))
+	return nil
+}
+
+// Convert_v1alpha1_NatGatewayStatus_To_azure_NatGatewayStatus is an autogenerated conversion function.
+func Convert_v1alpha1_NatGatewayStatus_To_azure_NatGatewayStatus(in *NatGatewayStatus, out *azure.NatGatewayStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NatGatewayStatus_To_azure_NatGatewayStatus(in, out, s)
+}
+
+func autoConvert_azure_NatGatewayStatus_To_v1alpha1_NatGatewayStatus(in *azure.NatGatewayStatus, out *NatGatewayStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.PublicIPAddresses = *(*[]string)(unsafe.Pointer(&in.PublicIPAddresses))
+	return nil
+}
+
+// Convert_azure_NatGatewayStatus_To_v1alpha1_NatGatewayStatus is an autogenerated conversion function.
+func Convert_azure_NatGatewayStatus_To_v1alpha1_NatGatewayStatus(in *azure.NatGatewayStatus, out *NatGatewayStatus, s conversion.Scope) error {
+	return autoConvert_azure_NatGatewayStatus_To_v1alpha1_NatGatewayStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_NetworkConfig_To_azure_NetworkConfig(in *NetworkConfig, out *azure.NetworkConfig, s conversion.Scope) error {
 	if err := Convert_v1alpha1_VNet_To_azure_VNet(&in.VNet, &out.VNet, s); err != nil {
 		return err
 	}
 	out.Workers = in.Workers
 	out.ServiceEndpoints = *(*[]string)(unsafe.Pointer(&in.ServiceEndpoints))
+	out.NatGateway = (*azure.NatGatewayConfig)(unsafe.Pointer(in.NatGateway))
 	return nil
 }
 
@@ -498,6 +811,7 @@ func autoConvert_azure_NetworkConfig_To_v1alpha1_NetworkConfig(in *azure.Network
 	}
 	out.Workers = in.Workers
 	out.ServiceEndpoints = *(*[]string)(unsafe.Pointer(&in.ServiceEndpoints))
+	out.NatGateway = (*NatGatewayConfig)(unsafe.Pointer(in.NatGateway))
 	return nil
 }
 
@@ -511,6 +825,7 @@ func autoConvert_v1alpha1_NetworkStatus_To_azure_NetworkStatus(in *NetworkStatus
 		return err
 	}
 	out.Subnets = *(*[]azure.Subnet)(unsafe.Pointer(&in.Subnets))
+	out.NatGateway = (*azure.NatGatewayStatus)(unsafe.Pointer(in.NatGateway))
 	return nil
 }
 
@@ -524,6 +839,7 @@ func autoConvert_azure_NetworkStatus_To_v1alpha1_NetworkStatus(in *azure.Network
 		return err
 	}
 	out.Subnets = *(*[]Subnet)(unsafe.Pointer(&in.Subnets))
+	out.NatGateway = (*NatGatewayStatus)(unsafe.Pointer(in.NatGateway))
 	return nil
 }
 
@@ -532,6 +848,28 @@ func Convert_azure_NetworkStatus_To_v1alpha1_NetworkStatus(in *azure.NetworkStat
 	return autoConvert_azure_NetworkStatus_To_v1alpha1_NetworkStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_PublicIPReference_To_azure_PublicIPReference(in *PublicIPReference, out *azure.PublicIPReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceGroup = in.ResourceGroup
+	return nil
+}
+
+// Convert_v1alpha1_PublicIPReference_To_azure_PublicIPReference is an autogenerated conversion function.
+func Convert_v1alpha1_PublicIPReference_To_azure_PublicIPReference(in *PublicIPReference, out *azure.PublicIPReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PublicIPReference_To_azure_PublicIPReference(in, out, s)
+}
+
+func autoConvert_azure_PublicIPReference_To_v1alpha1_PublicIPReference(in *azure.PublicIPReference, out *PublicIPReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceGroup = in.ResourceGroup
+	return nil
+}
+
+// Convert_azure_PublicIPReference_To_v1alpha1_PublicIPReference is an autogenerated conversion function.
+func Convert_azure_PublicIPReference_To_v1alpha1_PublicIPReference(in *azure.PublicIPReference, out *PublicIPReference, s conversion.Scope) error {
+	return autoConvert_azure_PublicIPReference_To_v1alpha1_PublicIPReference(in, out, s)
+}
+
 func autoConvert_v1alpha1_ResourceGroup_To_azure_ResourceGroup(in *ResourceGroup, out *azure.ResourceGroup, s conversion.Scope) error {
 	out.Name = in.Name
 	return nil
@@ -596,6 +934,34 @@ func Convert_azure_SecurityGroup_To_v1alpha1_SecurityGroup(in *azure.SecurityGro
 	return autoConvert_azure_SecurityGroup_To_v1alpha1_SecurityGroup(in, out, s)
 }
 
+func autoConvert_v1alpha1_StorageClass_To_azure_StorageClass(in *StorageClass, out *azure.StorageClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	out.ReclaimPolicy = (*corev1.PersistentVolumeReclaimPolicy)(unsafe.Pointer(in.ReclaimPolicy))
+	out.VolumeBindingMode = (*storagev1.VolumeBindingMode)(unsafe.Pointer(in.VolumeBindingMode))
+	out.Default = (*bool)(unsafe.Pointer(in.Default))
+	return nil
+}
+
+// Convert_v1alpha1_StorageClass_To_azure_StorageClass is an autogenerated conversion function.
+func Convert_v1alpha1_StorageClass_To_azure_StorageClass(in *StorageClass, out *azure.StorageClass, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageClass_To_azure_StorageClass(in, out, s)
+}
+
+func autoConvert_azure_StorageClass_To_v1alpha1_StorageClass(in *azure.StorageClass, out *StorageClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	out.ReclaimPolicy = (*corev1.PersistentVolumeReclaimPolicy)(unsafe.Pointer(in.ReclaimPolicy))
+	out.VolumeBindingMode = (*storagev1.VolumeBindingMode)(unsafe.Pointer(in.VolumeBindingMode))
+	out.Default = (*bool)(unsafe.Pointer(in.Default))
+	return nil
+}
+
+// Convert_azure_StorageClass_To_v1alpha1_StorageClass is an autogenerated conversion function.
+func Convert_azure_StorageClass_To_v1alpha1_StorageClass(in *azure.StorageClass, out *StorageClass, s conversion.Scope) error {
+	return autoConvert_azure_StorageClass_To_v1alpha1_StorageClass(in, out, s)
+}
+
 func autoConvert_v1alpha1_Subnet_To_azure_Subnet(in *Subnet, out *azure.Subnet, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Purpose = azure.Purpose(in.Purpose)
@@ -620,6 +986,7 @@ func Convert_azure_Subnet_To_v1alpha1_Subnet(in *azure.Subnet, out *Subnet, s co
 
 func autoConvert_v1alpha1_VNet_To_azure_VNet(in *VNet, out *azure.VNet, s conversion.Scope) error {
 	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
 	out.CIDR = (*string)(unsafe.Pointer(in.CIDR))
 	return nil
 }
@@ -631,6 +998,7 @@ func Convert_v1alpha1_VNet_To_azure_VNet(in *VNet, out *azure.VNet, s conversion
 
 func autoConvert_azure_VNet_To_v1alpha1_VNet(in *azure.VNet, out *VNet, s conversion.Scope) error {
 	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
 	out.CIDR = (*string)(unsafe.Pointer(in.CIDR))
 	return nil
 }
@@ -642,6 +1010,7 @@ func Convert_azure_VNet_To_v1alpha1_VNet(in *azure.VNet, out *VNet, s conversion
 
 func autoConvert_v1alpha1_VNetStatus_To_azure_VNetStatus(in *VNetStatus, out *azure.VNetStatus, s conversion.Scope) error {
 	out.Name = in.Name
+	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
 	return nil
 }
 
@@ -652,6 +1021,7 @@ func Convert_v1alpha1_VNetStatus_To_azure_VNetStatus(in *VNetStatus, out *azure.
 
 func autoConvert_azure_VNetStatus_To_v1alpha1_VNetStatus(in *azure.VNetStatus, out *VNetStatus, s conversion.Scope) error {
 	out.Name = in.Name
+	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
 	return nil
 }
 
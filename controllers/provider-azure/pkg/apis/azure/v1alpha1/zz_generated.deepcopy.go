@@ -21,6 +21,9 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -40,6 +43,46 @@ func (in *AvailabilitySet) DeepCopy() *AvailabilitySet {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupBucketConfig) DeepCopyInto(out *BackupBucketConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Immutability != nil {
+		in, out := &in.Immutability, &out.Immutability
+		*out = new(ImmutabilityConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(EncryptionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = new(LifecycleConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupBucketConfig.
+func (in *BackupBucketConfig) DeepCopy() *BackupBucketConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupBucketConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupBucketConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudControllerManagerConfig) DeepCopyInto(out *CloudControllerManagerConfig) {
 	*out = *in
@@ -50,6 +93,26 @@ func (in *CloudControllerManagerConfig) DeepCopyInto(out *CloudControllerManager
 			(*out)[key] = val
 		}
 	}
+	if in.ConcurrentServiceSyncs != nil {
+		in, out := &in.ConcurrentServiceSyncs, &out.ConcurrentServiceSyncs
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NodeMonitorPeriod != nil {
+		in, out := &in.NodeMonitorPeriod, &out.NodeMonitorPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Verbosity != nil {
+		in, out := &in.Verbosity, &out.Verbosity
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RouteReconciliationPeriod != nil {
+		in, out := &in.RouteReconciliationPeriod, &out.RouteReconciliationPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -114,6 +177,23 @@ func (in *ControlPlaneConfig) DeepCopyInto(out *ControlPlaneConfig) {
 		*out = new(CloudControllerManagerConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StorageClasses != nil {
+		in, out := &in.StorageClasses, &out.StorageClasses
+		*out = make([]StorageClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(LoadBalancerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ETCDBackup != nil {
+		in, out := &in.ETCDBackup, &out.ETCDBackup
+		*out = new(ETCDBackupConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -151,6 +231,94 @@ func (in *DomainCount) DeepCopy() *DomainCount {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ETCDBackupConfig) DeepCopyInto(out *ETCDBackupConfig) {
+	*out = *in
+	if in.DeltaSnapshotPeriod != nil {
+		in, out := &in.DeltaSnapshotPeriod, &out.DeltaSnapshotPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DeltaSnapshotMemoryLimit != nil {
+		in, out := &in.DeltaSnapshotMemoryLimit, &out.DeltaSnapshotMemoryLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.GarbageCollectionPeriod != nil {
+		in, out := &in.GarbageCollectionPeriod, &out.GarbageCollectionPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.GarbageCollectionPolicy != nil {
+		in, out := &in.GarbageCollectionPolicy, &out.GarbageCollectionPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxBackups != nil {
+		in, out := &in.MaxBackups, &out.MaxBackups
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ETCDBackupConfig.
+func (in *ETCDBackupConfig) DeepCopy() *ETCDBackupConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ETCDBackupConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionConfig) DeepCopyInto(out *EncryptionConfig) {
+	*out = *in
+	if in.KeyVersion != nil {
+		in, out := &in.KeyVersion, &out.KeyVersion
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionConfig.
+func (in *EncryptionConfig) DeepCopy() *EncryptionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImmutabilityConfig) DeepCopyInto(out *ImmutabilityConfig) {
+	*out = *in
+	if in.Locked != nil {
+		in, out := &in.Locked, &out.Locked
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImmutabilityConfig.
+func (in *ImmutabilityConfig) DeepCopy() *ImmutabilityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ImmutabilityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InfrastructureConfig) DeepCopyInto(out *InfrastructureConfig) {
 	*out = *in
@@ -224,6 +392,58 @@ func (in *InfrastructureStatus) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecycleConfig) DeepCopyInto(out *LifecycleConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleConfig.
+func (in *LifecycleConfig) DeepCopy() *LifecycleConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecycleConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerConfig) DeepCopyInto(out *LoadBalancerConfig) {
+	*out = *in
+	if in.SKU != nil {
+		in, out := &in.SKU, &out.SKU
+		*out = new(LoadBalancerSKU)
+		**out = **in
+	}
+	if in.AllocatedOutboundPorts != nil {
+		in, out := &in.AllocatedOutboundPorts, &out.AllocatedOutboundPorts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.IdleTimeoutInMinutes != nil {
+		in, out := &in.IdleTimeoutInMinutes, &out.IdleTimeoutInMinutes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ExcludeMasterFromStandardLB != nil {
+		in, out := &in.ExcludeMasterFromStandardLB, &out.ExcludeMasterFromStandardLB
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerConfig.
+func (in *LoadBalancerConfig) DeepCopy() *LoadBalancerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineImage) DeepCopyInto(out *MachineImage) {
 	*out = *in
@@ -282,6 +502,58 @@ func (in *MachineImages) DeepCopy() *MachineImages {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NatGatewayConfig) DeepCopyInto(out *NatGatewayConfig) {
+	*out = *in
+	if in.IdleConnectionTimeoutMinutes != nil {
+		in, out := &in.IdleConnectionTimeoutMinutes, &out.IdleConnectionTimeoutMinutes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(int32)
+		**out = **in
+	}
+	if in.IPAddresses != nil {
+		in, out := &in.IPAddresses, &out.IPAddresses
+		*out = make([]PublicIPReference, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatGatewayConfig.
+func (in *NatGatewayConfig) DeepCopy() *NatGatewayConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NatGatewayConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NatGatewayStatus) DeepCopyInto(out *NatGatewayStatus) {
+	*out = *in
+	if in.PublicIPAddresses != nil {
+		in, out := &in.PublicIPAddresses, &out.PublicIPAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatGatewayStatus.
+func (in *NatGatewayStatus) DeepCopy() *NatGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NatGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
 	*out = *in
@@ -291,6 +563,11 @@ func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.NatGateway != nil {
+		in, out := &in.NatGateway, &out.NatGateway
+		*out = new(NatGatewayConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -307,12 +584,17 @@ func (in *NetworkConfig) DeepCopy() *NetworkConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
 	*out = *in
-	out.VNet = in.VNet
+	in.VNet.DeepCopyInto(&out.VNet)
 	if in.Subnets != nil {
 		in, out := &in.Subnets, &out.Subnets
 		*out = make([]Subnet, len(*in))
 		copy(*out, *in)
 	}
+	if in.NatGateway != nil {
+		in, out := &in.NatGateway, &out.NatGateway
+		*out = new(NatGatewayStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -326,6 +608,22 @@ func (in *NetworkStatus) DeepCopy() *NetworkStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicIPReference) DeepCopyInto(out *PublicIPReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicIPReference.
+func (in *PublicIPReference) DeepCopy() *PublicIPReference {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicIPReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceGroup) DeepCopyInto(out *ResourceGroup) {
 	*out = *in
@@ -374,6 +672,37 @@ func (in *SecurityGroup) DeepCopy() *SecurityGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageClass) DeepCopyInto(out *StorageClass) {
+	*out = *in
+	if in.ReclaimPolicy != nil {
+		in, out := &in.ReclaimPolicy, &out.ReclaimPolicy
+		*out = new(corev1.PersistentVolumeReclaimPolicy)
+		**out = **in
+	}
+	if in.VolumeBindingMode != nil {
+		in, out := &in.VolumeBindingMode, &out.VolumeBindingMode
+		*out = new(storagev1.VolumeBindingMode)
+		**out = **in
+	}
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageClass.
+func (in *StorageClass) DeepCopy() *StorageClass {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Subnet) DeepCopyInto(out *Subnet) {
 	*out = *in
@@ -398,6 +727,11 @@ func (in *VNet) DeepCopyInto(out *VNet) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ResourceGroup != nil {
+		in, out := &in.ResourceGroup, &out.ResourceGroup
+		*out = new(string)
+		**out = **in
+	}
 	if in.CIDR != nil {
 		in, out := &in.CIDR, &out.CIDR
 		*out = new(string)
@@ -419,6 +753,11 @@ func (in *VNet) DeepCopy() *VNet {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VNetStatus) DeepCopyInto(out *VNetStatus) {
 	*out = *in
+	if in.ResourceGroup != nil {
+		in, out := &in.ResourceGroup, &out.ResourceGroup
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -0,0 +1,62 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"net/url"
+
+	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// maxImmutabilityDays is the maximum retention period of an immutability policy supported by Azure.
+const maxImmutabilityDays = 146000
+
+// ValidateBackupBucketConfig validates a BackupBucketConfig object.
+func ValidateBackupBucketConfig(backupBucketConfig *apisazure.BackupBucketConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if immutability := backupBucketConfig.Immutability; immutability != nil && (immutability.Days <= 0 || immutability.Days > maxImmutabilityDays) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("immutability", "days"), immutability.Days, "must be greater than 0 and not greater than 146000"))
+	}
+
+	if encryption := backupBucketConfig.Encryption; encryption != nil {
+		encryptionPath := field.NewPath("encryption")
+
+		if u, err := url.Parse(encryption.KeyVaultURI); err != nil || u.Scheme != "https" || len(u.Host) == 0 {
+			allErrs = append(allErrs, field.Invalid(encryptionPath.Child("keyVaultURI"), encryption.KeyVaultURI, "must be a valid https URL"))
+		}
+		if len(encryption.KeyName) == 0 {
+			allErrs = append(allErrs, field.Required(encryptionPath.Child("keyName"), "must provide the name of the encryption key"))
+		}
+		if encryption.KeyVersion != nil && len(*encryption.KeyVersion) == 0 {
+			allErrs = append(allErrs, field.Invalid(encryptionPath.Child("keyVersion"), *encryption.KeyVersion, "must not be empty"))
+		}
+	}
+
+	if lifecycle := backupBucketConfig.Lifecycle; lifecycle != nil {
+		expirationDaysPath := field.NewPath("lifecycle", "expirationDays")
+
+		if lifecycle.ExpirationDays <= 0 {
+			allErrs = append(allErrs, field.Invalid(expirationDaysPath, lifecycle.ExpirationDays, "must be greater than 0"))
+		}
+		if immutability := backupBucketConfig.Immutability; immutability != nil && lifecycle.ExpirationDays < immutability.Days {
+			allErrs = append(allErrs, field.Invalid(expirationDaysPath, lifecycle.ExpirationDays, "must not be less than the immutability days"))
+		}
+	}
+
+	return allErrs
+}
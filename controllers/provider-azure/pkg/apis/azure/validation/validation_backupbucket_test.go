@@ -0,0 +1,91 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+	. "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("BackupBucketConfig validation", func() {
+	var backupBucketConfig *apisazure.BackupBucketConfig
+
+	BeforeEach(func() {
+		backupBucketConfig = &apisazure.BackupBucketConfig{}
+	})
+
+	Describe("#ValidateBackupBucketConfig", func() {
+		It("should allow an empty configuration", func() {
+			Expect(ValidateBackupBucketConfig(backupBucketConfig)).To(BeEmpty())
+		})
+
+		It("should allow a valid configuration", func() {
+			var (
+				locked     = true
+				keyVersion = "0123456789abcdef"
+			)
+			backupBucketConfig.Immutability = &apisazure.ImmutabilityConfig{
+				Days:   14,
+				Locked: &locked,
+			}
+			backupBucketConfig.Encryption = &apisazure.EncryptionConfig{
+				KeyVaultURI: "https://my-vault.vault.azure.net",
+				KeyName:     "my-key",
+				KeyVersion:  &keyVersion,
+			}
+			backupBucketConfig.Lifecycle = &apisazure.LifecycleConfig{ExpirationDays: 30}
+
+			Expect(ValidateBackupBucketConfig(backupBucketConfig)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid configuration", func() {
+			keyVersion := ""
+			backupBucketConfig.Immutability = &apisazure.ImmutabilityConfig{Days: 146001}
+			backupBucketConfig.Encryption = &apisazure.EncryptionConfig{
+				KeyVaultURI: "http://my-vault.vault.azure.net",
+				KeyVersion:  &keyVersion,
+			}
+			backupBucketConfig.Lifecycle = &apisazure.LifecycleConfig{ExpirationDays: 30}
+
+			Expect(ValidateBackupBucketConfig(backupBucketConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("immutability.days"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("encryption.keyVaultURI"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("encryption.keyName"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("encryption.keyVersion"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("lifecycle.expirationDays"),
+				})),
+			))
+		})
+	})
+})
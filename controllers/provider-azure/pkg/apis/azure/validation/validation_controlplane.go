@@ -0,0 +1,152 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+
+	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/helper"
+	extensionsvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	// maxCloudControllerManagerVerbosity is the highest log verbosity supported by the cloud-controller-manager.
+	maxCloudControllerManagerVerbosity = 10
+
+	// csiStorageClassNameSuffix is the suffix of the names of the storage classes which use the CSI provisioner.
+	csiStorageClassNameSuffix = "-csi"
+)
+
+var (
+	supportedStorageClassTypes = sets.NewString("Standard_LRS", "StandardSSD_LRS", "Premium_LRS")
+	defaultStorageClassNames   = sets.NewString("default", "managed-standard-hdd", "managed-premium-ssd", "files")
+	supportedLoadBalancerSKUs  = sets.NewString(string(apisazure.LoadBalancerSKUBasic), string(apisazure.LoadBalancerSKUStandard))
+)
+
+// ValidateControlPlaneConfig validates a ControlPlaneConfig object.
+func ValidateControlPlaneConfig(controlPlaneConfig *apisazure.ControlPlaneConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if controlPlaneConfig.CloudControllerManager != nil {
+		allErrs = append(allErrs, validateCloudControllerManager(controlPlaneConfig.CloudControllerManager, field.NewPath("cloudControllerManager"))...)
+	}
+	allErrs = append(allErrs, validateStorageClasses(controlPlaneConfig.StorageClasses, field.NewPath("storageClasses"))...)
+
+	if controlPlaneConfig.ETCDBackup != nil {
+		allErrs = append(allErrs, controlplane.ValidateBackupRestoreConfig(helper.GetBackupRestoreConfig(controlPlaneConfig.ETCDBackup), field.NewPath("etcdBackup"))...)
+	}
+
+	if controlPlaneConfig.LoadBalancer != nil {
+		allErrs = append(allErrs, validateLoadBalancer(controlPlaneConfig.LoadBalancer, field.NewPath("loadBalancer"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateControlPlaneConfigUpdate validates a ControlPlaneConfig object update.
+func ValidateControlPlaneConfigUpdate(oldConfig, newConfig *apisazure.ControlPlaneConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// Load balancers can only be migrated from basic to standard.
+	if getLoadBalancerSKU(oldConfig) == apisazure.LoadBalancerSKUStandard && getLoadBalancerSKU(newConfig) == apisazure.LoadBalancerSKUBasic {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("loadBalancer", "sku"), "load balancers cannot be migrated from standard to basic"))
+	}
+
+	return allErrs
+}
+
+func validateLoadBalancer(lb *apisazure.LoadBalancerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if lb.SKU != nil && !supportedLoadBalancerSKUs.Has(string(*lb.SKU)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("sku"), *lb.SKU, supportedLoadBalancerSKUs.List()))
+	}
+
+	if ports := lb.AllocatedOutboundPorts; ports != nil && (*ports < 0 || *ports > 64000 || *ports%8 != 0) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("allocatedOutboundPorts"), *ports, "must be a multiple of 8 between 0 and 64000"))
+	}
+	if timeout := lb.IdleTimeoutInMinutes; timeout != nil && (*timeout < 4 || *timeout > 120) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("idleTimeoutInMinutes"), *timeout, "must be between 4 and 120"))
+	}
+
+	if lb.SKU != nil && *lb.SKU == apisazure.LoadBalancerSKUBasic {
+		if lb.AllocatedOutboundPorts != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("allocatedOutboundPorts"), "may only be set for standard load balancers"))
+		}
+		if lb.IdleTimeoutInMinutes != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("idleTimeoutInMinutes"), "may only be set for standard load balancers"))
+		}
+		if lb.ExcludeMasterFromStandardLB != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("excludeMasterFromStandardLB"), "may only be set for standard load balancers"))
+		}
+	}
+
+	return allErrs
+}
+
+func getLoadBalancerSKU(controlPlaneConfig *apisazure.ControlPlaneConfig) apisazure.LoadBalancerSKU {
+	if controlPlaneConfig.LoadBalancer != nil && controlPlaneConfig.LoadBalancer.SKU != nil {
+		return *controlPlaneConfig.LoadBalancer.SKU
+	}
+	return apisazure.LoadBalancerSKUStandard
+}
+
+func validateCloudControllerManager(ccm *apisazure.CloudControllerManagerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if ccm.ConcurrentServiceSyncs != nil && *ccm.ConcurrentServiceSyncs < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("concurrentServiceSyncs"), *ccm.ConcurrentServiceSyncs, "must be at least 1"))
+	}
+	if ccm.NodeMonitorPeriod != nil && ccm.NodeMonitorPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("nodeMonitorPeriod"), ccm.NodeMonitorPeriod.Duration.String(), "must be greater than 0"))
+	}
+	if ccm.RouteReconciliationPeriod != nil && ccm.RouteReconciliationPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("routeReconciliationPeriod"), ccm.RouteReconciliationPeriod.Duration.String(), "must be greater than 0"))
+	}
+	if ccm.Verbosity != nil && (*ccm.Verbosity < 0 || *ccm.Verbosity > maxCloudControllerManagerVerbosity) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("verbosity"), *ccm.Verbosity, fmt.Sprintf("must be between 0 and %d", maxCloudControllerManagerVerbosity)))
+	}
+
+	return allErrs
+}
+
+func validateStorageClasses(storageClasses []apisazure.StorageClass, fldPath *field.Path) field.ErrorList {
+	genericStorageClasses := make([]extensionsvalidation.StorageClass, 0, len(storageClasses))
+	for _, sc := range storageClasses {
+		genericStorageClasses = append(genericStorageClasses, extensionsvalidation.StorageClass{
+			Name:              sc.Name,
+			ReclaimPolicy:     sc.ReclaimPolicy,
+			VolumeBindingMode: sc.VolumeBindingMode,
+			Default:           sc.Default,
+		})
+	}
+
+	allErrs := extensionsvalidation.ValidateStorageClasses(genericStorageClasses, defaultStorageClassNames, []string{csiStorageClassNameSuffix}, fldPath)
+
+	for i, sc := range storageClasses {
+		idxPath := fldPath.Index(i)
+
+		if !supportedStorageClassTypes.Has(sc.Type) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("type"), sc.Type, supportedStorageClassTypes.List()))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"strings"
+
+	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const serviceEndpointPrefix = "Microsoft."
+
+// ValidateInfrastructureConfig validates a InfrastructureConfig object.
+func ValidateInfrastructureConfig(infra *apisazure.InfrastructureConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	networksPath := field.NewPath("networks")
+	if len(infra.Networks.Workers) == 0 {
+		allErrs = append(allErrs, field.Required(networksPath.Child("workers"), "must specify the network range for the worker network"))
+	}
+
+	if infra.Networks.VNet.ResourceGroup != nil {
+		allErrs = append(allErrs, field.Forbidden(networksPath.Child("vnet", "resourceGroup"), "vnets in other resource groups are not supported by the machine-controller-manager yet"))
+	}
+
+	allErrs = append(allErrs, validateServiceEndpoints(infra.Networks.ServiceEndpoints, networksPath.Child("serviceEndpoints"))...)
+
+	if natGateway := infra.Networks.NatGateway; natGateway != nil {
+		allErrs = append(allErrs, validateNatGatewayConfig(natGateway, infra.Zoned, networksPath.Child("natGateway"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateInfrastructureConfigAgainstControlPlaneConfig validates the given InfrastructureConfig against the given
+// ControlPlaneConfig of the same shoot.
+func ValidateInfrastructureConfigAgainstControlPlaneConfig(infra *apisazure.InfrastructureConfig, controlPlaneConfig *apisazure.ControlPlaneConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if natGateway := infra.Networks.NatGateway; natGateway != nil && natGateway.Enabled && getLoadBalancerSKU(controlPlaneConfig) != apisazure.LoadBalancerSKUStandard {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("networks", "natGateway", "enabled"), "a NAT gateway can only be used together with standard load balancers"))
+	}
+
+	return allErrs
+}
+
+func validateServiceEndpoints(serviceEndpoints []string, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		seen    = sets.NewString()
+	)
+
+	for i, serviceEndpoint := range serviceEndpoints {
+		idxPath := fldPath.Index(i)
+
+		if len(serviceEndpoint) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath, "must provide a service endpoint"))
+			continue
+		}
+		if !strings.HasPrefix(serviceEndpoint, serviceEndpointPrefix) || len(serviceEndpoint) == len(serviceEndpointPrefix) {
+			allErrs = append(allErrs, field.Invalid(idxPath, serviceEndpoint, "please use the format `Microsoft.<Service>` for the service endpoint"))
+		}
+		if seen.Has(serviceEndpoint) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, serviceEndpoint))
+		}
+		seen.Insert(serviceEndpoint)
+	}
+
+	return allErrs
+}
+
+func validateNatGatewayConfig(natGateway *apisazure.NatGatewayConfig, zoned bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !natGateway.Enabled {
+		return allErrs
+	}
+
+	if timeout := natGateway.IdleConnectionTimeoutMinutes; timeout != nil && (*timeout < 4 || *timeout > 120) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("idleConnectionTimeoutMinutes"), *timeout, "must be between 4 and 120"))
+	}
+
+	if zone := natGateway.Zone; zone != nil {
+		if !zoned {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("zone"), "a zone can only be specified for zoned clusters"))
+		} else if *zone <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("zone"), *zone, "must be greater than 0"))
+		}
+	}
+
+	for i, ipAddress := range natGateway.IPAddresses {
+		idxPath := fldPath.Child("ipAddresses").Index(i)
+
+		if len(ipAddress.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		}
+		if len(ipAddress.ResourceGroup) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("resourceGroup"), "must provide a resource group"))
+		}
+	}
+
+	return allErrs
+}
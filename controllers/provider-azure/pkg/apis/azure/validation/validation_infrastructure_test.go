@@ -0,0 +1,201 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+	. "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("InfrastructureConfig validation", func() {
+	var infrastructureConfig *apisazure.InfrastructureConfig
+
+	BeforeEach(func() {
+		infrastructureConfig = &apisazure.InfrastructureConfig{
+			Networks: apisazure.NetworkConfig{
+				Workers:          "10.250.0.0/19",
+				ServiceEndpoints: []string{"Microsoft.Storage"},
+			},
+			Zoned: true,
+		}
+	})
+
+	Describe("#ValidateInfrastructureConfig", func() {
+		It("should allow a valid configuration", func() {
+			Expect(ValidateInfrastructureConfig(infrastructureConfig)).To(BeEmpty())
+		})
+
+		It("should forbid an empty workers range", func() {
+			infrastructureConfig.Networks.Workers = ""
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("networks.workers"),
+			}))))
+		})
+
+		It("should forbid a vnet in another resource group", func() {
+			var (
+				name          = "vnet"
+				resourceGroup = "vnet-rg"
+			)
+			infrastructureConfig.Networks.VNet.Name = &name
+			infrastructureConfig.Networks.VNet.ResourceGroup = &resourceGroup
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.vnet.resourceGroup"),
+			}))))
+		})
+
+		Context("service endpoints", func() {
+			It("should forbid empty, malformed and duplicate service endpoints", func() {
+				infrastructureConfig.Networks.ServiceEndpoints = []string{"", "Storage", "Microsoft.", "Microsoft.Sql", "Microsoft.Sql"}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("networks.serviceEndpoints[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.serviceEndpoints[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.serviceEndpoints[2]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("networks.serviceEndpoints[4]"),
+					})),
+				))
+			})
+		})
+
+		Context("NAT gateway", func() {
+			var (
+				timeout int32
+				zone    int32
+			)
+
+			BeforeEach(func() {
+				timeout, zone = 10, 1
+				infrastructureConfig.Networks.NatGateway = &apisazure.NatGatewayConfig{
+					Enabled:                      true,
+					IdleConnectionTimeoutMinutes: &timeout,
+					Zone:                         &zone,
+					IPAddresses: []apisazure.PublicIPReference{
+						{Name: "ip", ResourceGroup: "ip-rg"},
+					},
+				}
+			})
+
+			It("should allow a valid NAT gateway configuration", func() {
+				Expect(ValidateInfrastructureConfig(infrastructureConfig)).To(BeEmpty())
+			})
+
+			It("should not validate a disabled NAT gateway", func() {
+				timeout = 1
+				infrastructureConfig.Networks.NatGateway.Enabled = false
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig)).To(BeEmpty())
+			})
+
+			It("should forbid an idle connection timeout out of range", func() {
+				timeout = 121
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.natGateway.idleConnectionTimeoutMinutes"),
+				}))))
+			})
+
+			It("should forbid a zone for non zoned clusters", func() {
+				infrastructureConfig.Zoned = false
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.natGateway.zone"),
+				}))))
+			})
+
+			It("should forbid incomplete public ip references", func() {
+				infrastructureConfig.Networks.NatGateway.IPAddresses = []apisazure.PublicIPReference{{}}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("networks.natGateway.ipAddresses[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("networks.natGateway.ipAddresses[0].resourceGroup"),
+					})),
+				))
+			})
+		})
+	})
+
+	Describe("#ValidateInfrastructureConfigAgainstControlPlaneConfig", func() {
+		var controlPlaneConfig *apisazure.ControlPlaneConfig
+
+		BeforeEach(func() {
+			infrastructureConfig.Networks.NatGateway = &apisazure.NatGatewayConfig{Enabled: true}
+			controlPlaneConfig = &apisazure.ControlPlaneConfig{}
+		})
+
+		It("should allow a NAT gateway with standard load balancers", func() {
+			Expect(ValidateInfrastructureConfigAgainstControlPlaneConfig(infrastructureConfig, controlPlaneConfig)).To(BeEmpty())
+		})
+
+		It("should allow basic load balancers without a NAT gateway", func() {
+			sku := apisazure.LoadBalancerSKUBasic
+			controlPlaneConfig.LoadBalancer = &apisazure.LoadBalancerConfig{SKU: &sku}
+			infrastructureConfig.Networks.NatGateway.Enabled = false
+
+			Expect(ValidateInfrastructureConfigAgainstControlPlaneConfig(infrastructureConfig, controlPlaneConfig)).To(BeEmpty())
+		})
+
+		It("should forbid a NAT gateway with basic load balancers", func() {
+			sku := apisazure.LoadBalancerSKUBasic
+			controlPlaneConfig.LoadBalancer = &apisazure.LoadBalancerConfig{SKU: &sku}
+
+			errorList := ValidateInfrastructureConfigAgainstControlPlaneConfig(infrastructureConfig, controlPlaneConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.natGateway.enabled"),
+			}))))
+		})
+	})
+})
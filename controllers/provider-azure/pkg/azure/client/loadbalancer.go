@@ -0,0 +1,60 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	"github.com/Azure/go-autorest/autorest/azure/auth"
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// loadBalancerResourceFilter selects the load balancers among the resources of a resource group.
+const loadBalancerResourceFilter = "resourceType eq 'Microsoft.Network/loadBalancers'"
+
+// ListLoadBalancersFromSubscriptionSecretRef lists the names of the load balancers with the given SKU in the resource
+// group <resourceGroupName> using the subscription details from the secret reference.
+func ListLoadBalancersFromSubscriptionSecretRef(ctx context.Context, c client.Client, secretRef *corev1.SecretReference, resourceGroupName, sku string) ([]string, error) {
+	clientAuth, err := internal.GetClientAuthData(ctx, c, *secretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	resourcesClient := resources.NewClient(clientAuth.SubscriptionID)
+	clientCredConfig := auth.NewClientCredentialsConfig(clientAuth.ClientID, clientAuth.ClientSecret, clientAuth.TenantID)
+	authorizer, err := clientCredConfig.Authorizer()
+	if err != nil {
+		return nil, err
+	}
+	resourcesClient.Authorizer = authorizer
+
+	var names []string
+	for page, err := resourcesClient.ListByResourceGroup(ctx, resourceGroupName, loadBalancerResourceFilter, "", nil); page.NotDone(); err = page.NextWithContext(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		for _, resource := range page.Values() {
+			if resource.Name != nil && resource.Sku != nil && resource.Sku.Name != nil && strings.EqualFold(*resource.Sku.Name, sku) {
+				names = append(names, *resource.Name)
+			}
+		}
+	}
+	return names, nil
+}
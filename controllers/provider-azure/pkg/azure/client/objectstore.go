@@ -0,0 +1,44 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
+)
+
+// objectStore is an objectstore.ObjectStore for the blob containers of a storage account.
+type objectStore struct {
+	Storage
+}
+
+// NewObjectStore creates a new objectstore.ObjectStore for the blob containers of the storage account of the given
+// storage client. Buckets correspond to blob containers.
+func NewObjectStore(storage Storage) objectstore.ObjectStore {
+	return &objectStore{
+		Storage: storage,
+	}
+}
+
+// CreateBucketIfNotExists creates the blob container with name <container>. If it already exists, no error is returned.
+func (s *objectStore) CreateBucketIfNotExists(ctx context.Context, container string) error {
+	return s.CreateContainerIfNotExists(ctx, container)
+}
+
+// DeleteBucketIfExists deletes the blob container with name <container>. If it does not exist, no error is returned.
+func (s *objectStore) DeleteBucketIfExists(ctx context.Context, container string) error {
+	return s.DeleteContainerIfExists(ctx, container)
+}
@@ -17,7 +17,9 @@ package client
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/url"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
@@ -26,11 +28,22 @@ import (
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
 
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	// lifecyclePolicyRuleName is the name of the lifecycle management policy rule managed for backup buckets.
+	lifecyclePolicyRuleName = "gardener-backup-expiry"
+
+	// blobUploadBufferSize is the size of the buffers used for uploading blobs.
+	blobUploadBufferSize = 4 * 1024 * 1024
+	// blobUploadMaxBuffers is the maximum number of buffers used concurrently for uploading blobs.
+	blobUploadMaxBuffers = 4
+)
+
 // NewStorageClientAuthFromSubscriptionSecretRef retrieves the azure storage client auth from specified by the secret reference.
 func NewStorageClientAuthFromSubscriptionSecretRef(ctx context.Context, c client.Client, secretRef *corev1.SecretReference, resourceGroupName, accountName, region string) (*StorageAuth, error) {
 	// Reference : https://github.com/Azure-Samples/azure-sdk-for-go-samples/blob/master/storage/account.go
@@ -104,6 +117,151 @@ func DeleteResourceGroupFromSubscriptionSecretRef(ctx context.Context, c client.
 	return err
 }
 
+// NewStorageAccountClientFromSubscriptionSecretRef creates a client for managing the settings of the storage account
+// <accountName> in <resourceGroupName> using the subscription details from the secret reference.
+func NewStorageAccountClientFromSubscriptionSecretRef(ctx context.Context, c client.Client, secretRef *corev1.SecretReference, resourceGroupName, accountName string) (*StorageAccountClient, error) {
+	clientAuth, err := internal.GetClientAuthData(ctx, c, *secretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	clientCredConfig := auth.NewClientCredentialsConfig(clientAuth.ClientID, clientAuth.ClientSecret, clientAuth.TenantID)
+	authorizer, err := clientCredConfig.Authorizer()
+	if err != nil {
+		return nil, err
+	}
+
+	accountsClient := storage.NewAccountsClient(clientAuth.SubscriptionID)
+	accountsClient.Authorizer = authorizer
+	blobContainersClient := storage.NewBlobContainersClient(clientAuth.SubscriptionID)
+	blobContainersClient.Authorizer = authorizer
+	managementPoliciesClient := storage.NewManagementPoliciesClient(clientAuth.SubscriptionID)
+	managementPoliciesClient.Authorizer = authorizer
+
+	return &StorageAccountClient{
+		accounts:           accountsClient,
+		blobContainers:     blobContainersClient,
+		managementPolicies: managementPoliciesClient,
+		resourceGroupName:  resourceGroupName,
+		accountName:        accountName,
+	}, nil
+}
+
+// UpdateContainerImmutabilityPolicy sets the retention period of the immutability policy of <container> to <days>
+// and locks the policy if <locked> is true. The retention period of a locked policy can only be extended.
+func (c *StorageAccountClient) UpdateContainerImmutabilityPolicy(ctx context.Context, container string, days int32, locked bool) error {
+	policy, err := c.blobContainers.GetImmutabilityPolicy(ctx, c.resourceGroupName, c.accountName, container, "")
+	if err != nil {
+		return err
+	}
+
+	var (
+		currentDays   int32
+		currentLocked bool
+	)
+	if policy.ImmutabilityPolicyProperty != nil {
+		if policy.ImmutabilityPeriodSinceCreationInDays != nil {
+			currentDays = *policy.ImmutabilityPeriodSinceCreationInDays
+		}
+		currentLocked = policy.State == storage.Locked
+	}
+
+	if currentDays != days {
+		parameters := &storage.ImmutabilityPolicy{
+			ImmutabilityPolicyProperty: &storage.ImmutabilityPolicyProperty{ImmutabilityPeriodSinceCreationInDays: &days},
+		}
+
+		switch {
+		case !currentLocked:
+			policy, err = c.blobContainers.CreateOrUpdateImmutabilityPolicy(ctx, c.resourceGroupName, c.accountName, container, parameters, etag(policy))
+		case days > currentDays:
+			policy, err = c.blobContainers.ExtendImmutabilityPolicy(ctx, c.resourceGroupName, c.accountName, container, etag(policy), parameters)
+		default:
+			return fmt.Errorf("retention period of the locked immutability policy of container %s cannot be reduced from %d to %d days", container, currentDays, days)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if locked && !currentLocked {
+		_, err = c.blobContainers.LockImmutabilityPolicy(ctx, c.resourceGroupName, c.accountName, container, etag(policy))
+	}
+	return err
+}
+
+// UpdateEncryption configures the storage account to encrypt its data with the key <keyName> of the key vault
+// <keyVaultURI>. If <keyVersion> is nil, the current version of the key is used. The storage account gets a
+// system-assigned managed identity that must be granted access to the key.
+func (c *StorageAccountClient) UpdateEncryption(ctx context.Context, keyVaultURI, keyName string, keyVersion *string) error {
+	var (
+		identityType = "SystemAssigned"
+		enabled      = true
+	)
+	if keyVersion == nil {
+		keyVersion = new(string)
+	}
+
+	_, err := c.accounts.Update(ctx, c.resourceGroupName, c.accountName, storage.AccountUpdateParameters{
+		Identity: &storage.Identity{Type: &identityType},
+		AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+			Encryption: &storage.Encryption{
+				Services: &storage.EncryptionServices{
+					Blob: &storage.EncryptionService{Enabled: &enabled},
+				},
+				KeySource: storage.MicrosoftKeyvault,
+				KeyVaultProperties: &storage.KeyVaultProperties{
+					KeyVaultURI: &keyVaultURI,
+					KeyName:     &keyName,
+					KeyVersion:  keyVersion,
+				},
+			},
+		},
+	})
+	return err
+}
+
+// UpdateLifecyclePolicy sets the lifecycle management policy of the storage account to delete blobs
+// <expirationDays> after their last modification.
+func (c *StorageAccountClient) UpdateLifecyclePolicy(ctx context.Context, expirationDays int32) error {
+	var (
+		enabled               = true
+		ruleName              = lifecyclePolicyRuleName
+		ruleType              = "Lifecycle"
+		daysAfterModification = float64(expirationDays)
+	)
+
+	_, err := c.managementPolicies.CreateOrUpdate(ctx, c.resourceGroupName, c.accountName, storage.ManagementPolicy{
+		ManagementPolicyProperties: &storage.ManagementPolicyProperties{
+			Policy: &storage.ManagementPolicySchema{
+				Rules: &[]storage.ManagementPolicyRule{
+					{
+						Enabled: &enabled,
+						Name:    &ruleName,
+						Type:    &ruleType,
+						Definition: &storage.ManagementPolicyDefinition{
+							Actions: &storage.ManagementPolicyAction{
+								BaseBlob: &storage.ManagementPolicyBaseBlob{
+									Delete: &storage.DateAfterModification{DaysAfterModificationGreaterThan: &daysAfterModification},
+								},
+							},
+							Filters: &storage.ManagementPolicyFilter{BlobTypes: &[]string{"blockBlob"}},
+						},
+					},
+				},
+			},
+		},
+	})
+	return err
+}
+
+func etag(policy storage.ImmutabilityPolicy) string {
+	if policy.Etag == nil {
+		return ""
+	}
+	return *policy.Etag
+}
+
 // NewStorageClientFromSecretRef retrieves the azure client from specified by the secret reference.
 func NewStorageClientFromSecretRef(ctx context.Context, c client.Client, secretRef *corev1.SecretReference) (*StorageClient, error) {
 	secret, err := extensionscontroller.GetSecretByReference(ctx, c, secretRef)
@@ -119,6 +277,16 @@ func NewStorageClientFromSecretRef(ctx context.Context, c client.Client, secretR
 	return NewStorageClientFromStorageAuth(storageAuth)
 }
 
+// NewObjectStoreFromSecretRef creates a new object store for the blob containers of the storage account specified by
+// the secret reference. The region is ignored.
+func NewObjectStoreFromSecretRef(ctx context.Context, c client.Client, secretRef corev1.SecretReference, _ string) (objectstore.ObjectStore, error) {
+	storageClient, err := NewStorageClientFromSecretRef(ctx, c, &secretRef)
+	if err != nil {
+		return nil, err
+	}
+	return NewObjectStore(storageClient), nil
+}
+
 // ReadStorageClientAuthDataFromSecret reads the storage client auth details from the given secret.
 func ReadStorageClientAuthDataFromSecret(secret *corev1.Secret) (*StorageAuth, error) {
 	storageAccount, ok := secret.Data[azure.StorageAccount]
@@ -190,6 +358,53 @@ func (c *StorageClient) DeleteObjectsWithPrefix(ctx context.Context, container,
 	return nil
 }
 
+// ListObjectsWithPrefix returns the names of the blob objects with the specific <prefix> in <container> together with
+// their last modification time.
+func (c *StorageClient) ListObjectsWithPrefix(ctx context.Context, container, prefix string) (map[string]time.Time, error) {
+	containerURL := c.serviceURL.NewContainerURL(container)
+	opts := azblob.ListBlobsSegmentOptions{
+		Prefix: prefix,
+	}
+	objects := make(map[string]time.Time)
+	for marker := (azblob.Marker{}); marker.NotDone(); {
+		listBlob, err := containerURL.ListBlobsFlatSegment(ctx, marker, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the blobs, error: %v", err)
+		}
+		marker = listBlob.NextMarker
+
+		for _, blob := range listBlob.Segment.BlobItems {
+			objects[blob.Name] = blob.Properties.LastModified
+		}
+	}
+	return objects, nil
+}
+
+// GetObject returns the contents of the blob object with name <key> in <container>.
+func (c *StorageClient) GetObject(ctx context.Context, container, key string) (io.ReadCloser, error) {
+	blobURL := c.serviceURL.NewContainerURL(container).NewBlobURL(key)
+	resp, err := blobURL.Download(ctx, 0, azblob.CountToEnd, azblob.BlobAccessConditions{}, false)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body(azblob.RetryReaderOptions{}), nil
+}
+
+// PutObject stores the contents of <body> as the blob object with name <key> in <container>.
+func (c *StorageClient) PutObject(ctx context.Context, container, key string, body io.ReadSeeker) error {
+	blockBlobURL := c.serviceURL.NewContainerURL(container).NewBlockBlobURL(key)
+	_, err := azblob.UploadStreamToBlockBlob(ctx, body, blockBlobURL, azblob.UploadStreamToBlockBlobOptions{
+		BufferSize: blobUploadBufferSize,
+		MaxBuffers: blobUploadMaxBuffers,
+	})
+	return err
+}
+
+// DeleteObject deletes the blob object with name <key> from <container>. If it does not exist, no error is returned.
+func (c *StorageClient) DeleteObject(ctx context.Context, container, key string) error {
+	return c.deleteBlobIfExists(ctx, container, key)
+}
+
 // deleteBlobIfExists deletes the azure blob with name <blobName> from <container>. If it does not exist,
 // no error is returned.
 func (c *StorageClient) deleteBlobIfExists(ctx context.Context, container, blobName string) error {
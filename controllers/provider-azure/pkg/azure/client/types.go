@@ -16,7 +16,10 @@ package client
 
 import (
 	"context"
+	"io"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
 	"github.com/Azure/azure-storage-blob-go/azblob"
 )
 
@@ -34,9 +37,23 @@ type StorageClient struct {
 	serviceURL azblob.ServiceURL
 }
 
+// StorageAccountClient represents a Azure client for managing the settings of a storage account.
+type StorageAccountClient struct {
+	accounts           storage.AccountsClient
+	blobContainers     storage.BlobContainersClient
+	managementPolicies storage.ManagementPoliciesClient
+
+	resourceGroupName string
+	accountName       string
+}
+
 // Storage represents a Azure storage client.
 type Storage interface {
 	DeleteObjectsWithPrefix(ctx context.Context, container, prefix string) error
+	DeleteObject(ctx context.Context, container, key string) error
+	ListObjectsWithPrefix(ctx context.Context, container, prefix string) (map[string]time.Time, error)
+	GetObject(ctx context.Context, container, key string) (io.ReadCloser, error)
+	PutObject(ctx context.Context, container, key string, body io.ReadSeeker) error
 	CreateContainerIfNotExists(ctx context.Context, container string) error
 	DeleteContainerIfExists(ctx context.Context, container string) error
 }
@@ -14,7 +14,11 @@
 
 package azure
 
-import "path/filepath"
+import (
+	"path/filepath"
+
+	"github.com/gardener/gardener/pkg/utils"
+)
 
 const (
 	// Name is the name of the Azure provider.
@@ -33,6 +37,20 @@ const (
 	MachineControllerManagerName = "machine-controller-manager"
 	// HyperkubeImageName is the name of the hyperkube image
 	HyperkubeImageName = "hyperkube"
+	// CSIDriverDiskImageName is the name of the Azure Disk CSI driver image.
+	CSIDriverDiskImageName = "csi-driver-azure-disk"
+	// CSIDriverFileImageName is the name of the Azure File CSI driver image.
+	CSIDriverFileImageName = "csi-driver-azure-file"
+	// CSIProvisionerImageName is the name of the CSI provisioner image.
+	CSIProvisionerImageName = "csi-provisioner"
+	// CSIAttacherImageName is the name of the CSI attacher image.
+	CSIAttacherImageName = "csi-attacher"
+	// CSIResizerImageName is the name of the CSI resizer image.
+	CSIResizerImageName = "csi-resizer"
+	// CSINodeDriverRegistrarImageName is the name of the CSI node driver registrar image.
+	CSINodeDriverRegistrarImageName = "csi-node-driver-registrar"
+	// CSILivenessProbeImageName is the name of the CSI liveness probe image.
+	CSILivenessProbeImageName = "csi-liveness-probe"
 
 	// SubscriptionIDKey is the key for the subscription ID
 	SubscriptionIDKey = "subscriptionID"
@@ -56,6 +74,11 @@ const (
 	// TODO In the future, the bucket name should come from a BackupBucket resource (see https://github.com/gardener/gardener/blob/master/docs/proposals/02-backupinfra.md)
 	BucketName = "bucketName"
 
+	// AnnotationConfirmLoadBalancerMigration is the annotation on the Shoot which confirms the migration of its load
+	// balancers from the basic to the standard SKU. All services of type LoadBalancer are deleted and recreated during
+	// the migration, hence their public IP addresses change.
+	AnnotationConfirmLoadBalancerMigration = "azure.provider.extensions.gardener.cloud/confirm-loadbalancer-migration"
+
 	// CloudProviderConfigName is the name of the configmap containing the cloud provider config.
 	CloudProviderConfigName = "cloud-provider-config"
 	// CloudProviderKubeletConfigName is the name of the configmap containing the cloud provider config for the shoot nodes.
@@ -68,6 +91,14 @@ const (
 	MachineControllerManagerVpaName = "machine-controller-manager-vpa"
 	// MachineControllerManagerMonitoringConfigName is the name of the ConfigMap containing monitoring stack configurations for machine-controller-manager.
 	MachineControllerManagerMonitoringConfigName = "machine-controller-manager-monitoring-config"
+
+	// CSIDriverDiskName is the name of the Azure Disk CSI driver.
+	CSIDriverDiskName = "disk.csi.azure.com"
+	// CSIDriverFileName is the name of the Azure File CSI driver.
+	CSIDriverFileName = "file.csi.azure.com"
+	// CSIMinimumKubernetesVersion is the minimum Kubernetes version for which the Azure Disk and Azure File CSI
+	// drivers are used instead of the in-tree volume plugins.
+	CSIMinimumKubernetesVersion = "1.19"
 )
 
 var (
@@ -76,3 +107,9 @@ var (
 	// InternalChartsPath is the path to the internal charts
 	InternalChartsPath = filepath.Join(ChartsPath, "internal")
 )
+
+// UseCSI returns true if the Azure Disk and Azure File CSI drivers shall be used instead of the in-tree volume
+// plugins for the given Kubernetes version.
+func UseCSI(kubernetesVersion string) (bool, error) {
+	return utils.CompareVersions(kubernetesVersion, ">=", CSIMinimumKubernetesVersion)
+}
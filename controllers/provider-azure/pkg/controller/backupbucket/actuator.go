@@ -18,31 +18,36 @@ import (
 	"context"
 	"fmt"
 
+	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/validation"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure"
 	azureclient "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure/client"
 	extensioncontroller "github.com/gardener/gardener-extensions/pkg/controller"
-	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket/genericactuator"
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
 )
 
 type actuator struct {
-	backupbucket.Actuator
-	client client.Client
-	logger logr.Logger
+	bbConfig *apisazure.BackupBucketConfig
+	client   client.Client
+	logger   logr.Logger
 }
 
-func newActuator() backupbucket.Actuator {
+func newActuator(bbConfig *apisazure.BackupBucketConfig) genericactuator.BackupBucketDelegate {
 	return &actuator{
-		logger: log.Log.WithName("azure-backupbucket-actuator"),
+		bbConfig: bbConfig,
+		logger:   logger,
 	}
 }
 
@@ -51,25 +56,29 @@ func (a *actuator) InjectClient(client client.Client) error {
 	return nil
 }
 
-func (a *actuator) Reconcile(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+func (a *actuator) GetObjectStore(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) (objectstore.ObjectStore, error) {
 	azureClient, err := a.getAzureClient(ctx, bb)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	return azureClient.CreateContainerIfNotExists(ctx, bb.Name)
+	return azureclient.NewObjectStore(azureClient), nil
 }
 
-func (a *actuator) Delete(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
-	azureClient, err := a.getAzureClient(ctx, bb)
-	if err != nil {
-		return err
+func (a *actuator) ReconcileBucketConfig(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+	if a.bbConfig.Immutability == nil && a.bbConfig.Encryption == nil && a.bbConfig.Lifecycle == nil {
+		return nil
 	}
 
-	if err := azureClient.DeleteContainerIfExists(ctx, bb.Name); err != nil {
+	storageAccountClient, err := azureclient.NewStorageAccountClientFromSubscriptionSecretRef(ctx, a.client, &bb.Spec.SecretRef, bb.Name, getStorageAccountName(bb))
+	if err != nil {
 		return err
 	}
 
+	return reconcileBucketConfig(ctx, storageAccountClient, bb.Name, a.bbConfig)
+}
+
+// FinalizeBackupBucket deletes the resource group of the storage account and the generated secret of the BackupBucket.
+func (a *actuator) FinalizeBackupBucket(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
 	return a.deleteGenerateBackupBucketSecret(ctx, bb)
 }
 
@@ -77,9 +86,7 @@ func (a *actuator) getAzureClient(ctx context.Context, bb *extensionsv1alpha1.Ba
 	if bb.Status.GeneratedSecretRef != nil {
 		return azureclient.NewStorageClientFromSecretRef(ctx, a.client, bb.Status.GeneratedSecretRef)
 	}
-	backupBucketNameSha := utils.ComputeSHA1Hex([]byte(bb.Name))
-	storageAccountName := fmt.Sprintf("bkp%s", backupBucketNameSha[:15])
-	storageAuth, err := azureclient.NewStorageClientAuthFromSubscriptionSecretRef(ctx, a.client, &bb.Spec.SecretRef, bb.Name, storageAccountName, bb.Spec.Region)
+	storageAuth, err := azureclient.NewStorageClientAuthFromSubscriptionSecretRef(ctx, a.client, &bb.Spec.SecretRef, bb.Name, getStorageAccountName(bb), bb.Spec.Region)
 	if err != nil {
 		return nil, err
 	}
@@ -113,6 +120,56 @@ func (a *actuator) getAzureClient(ctx context.Context, bb *extensionsv1alpha1.Ba
 	return azureclient.NewStorageClientFromStorageAuth(storageAuth)
 }
 
+// decodeBackupBucketConfig decodes and validates the given BackupBucketConfig of the controller configuration.
+func decodeBackupBucketConfig(decoder runtime.Decoder, backupBucketConfig *runtime.RawExtension) (*apisazure.BackupBucketConfig, error) {
+	bbConfig := &apisazure.BackupBucketConfig{}
+	if backupBucketConfig == nil {
+		return bbConfig, nil
+	}
+
+	if _, _, err := decoder.Decode(backupBucketConfig.Raw, nil, bbConfig); err != nil {
+		return nil, errors.Wrap(err, "could not decode the backup bucket configuration")
+	}
+	if errs := validation.ValidateBackupBucketConfig(bbConfig); len(errs) > 0 {
+		return nil, errors.Wrap(errs.ToAggregate(), "invalid backup bucket configuration")
+	}
+
+	return bbConfig, nil
+}
+
+// reconcileBucketConfig applies the settings of the given BackupBucketConfig to the container and its storage account.
+// The settings are applied on every reconciliation to revert any changes that were made outside of Gardener. Settings
+// that are not configured are left untouched.
+func reconcileBucketConfig(ctx context.Context, storageAccountClient *azureclient.StorageAccountClient, container string, bbConfig *apisazure.BackupBucketConfig) error {
+	if immutability := bbConfig.Immutability; immutability != nil {
+		locked := immutability.Locked != nil && *immutability.Locked
+
+		if err := storageAccountClient.UpdateContainerImmutabilityPolicy(ctx, container, immutability.Days, locked); err != nil {
+			return errors.Wrapf(err, "could not update immutability policy of container '%s'", container)
+		}
+	}
+
+	if encryption := bbConfig.Encryption; encryption != nil {
+		if err := storageAccountClient.UpdateEncryption(ctx, encryption.KeyVaultURI, encryption.KeyName, encryption.KeyVersion); err != nil {
+			return errors.Wrapf(err, "could not update encryption of the storage account of container '%s'", container)
+		}
+	}
+
+	if lifecycle := bbConfig.Lifecycle; lifecycle != nil {
+		if err := storageAccountClient.UpdateLifecyclePolicy(ctx, lifecycle.ExpirationDays); err != nil {
+			return errors.Wrapf(err, "could not update lifecycle policy of the storage account of container '%s'", container)
+		}
+	}
+
+	return nil
+}
+
+// getStorageAccountName returns the name of the storage account that is created for the given BackupBucket.
+func getStorageAccountName(bb *extensionsv1alpha1.BackupBucket) string {
+	backupBucketNameSha := utils.ComputeSHA1Hex([]byte(bb.Name))
+	return fmt.Sprintf("bkp%s", backupBucketNameSha[:15])
+}
+
 func generateGeneratedBackupBucketSecretName(backupBucketName string) string {
 	return fmt.Sprintf("generated-bucket-%s", backupBucketName)
 }
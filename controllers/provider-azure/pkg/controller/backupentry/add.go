@@ -16,6 +16,7 @@ package backupentry
 
 import (
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure"
+	azureclient "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure/client"
 	"github.com/gardener/gardener-extensions/pkg/controller/backupentry"
 	"github.com/gardener/gardener-extensions/pkg/controller/backupentry/genericactuator"
 
@@ -26,7 +27,9 @@ import (
 
 var (
 	// DefaultAddOptions are the default AddOptions for AddToManager.
-	DefaultAddOptions = AddOptions{}
+	DefaultAddOptions = AddOptions{
+		SnapshotCheck: backupentry.DefaultSnapshotCheckConfig(),
+	}
 
 	logger = log.Log.WithName("azure-backupentry-actuator")
 )
@@ -37,6 +40,10 @@ type AddOptions struct {
 	Controller controller.Options
 	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
 	IgnoreOperationAnnotation bool
+	// SnapshotCheck contains the settings of the etcd snapshot check. If it is nil, snapshots are not checked.
+	SnapshotCheck *backupentry.SnapshotCheckConfig
+	// Deletion contains the settings for deferring the deletion of the backups of deleted backupentries.
+	Deletion backupentry.DeletionConfig
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
@@ -46,6 +53,10 @@ func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
 		Actuator:          genericactuator.NewActuator(newActuator(), logger),
 		ControllerOptions: opts.Controller,
 		Predicates:        backupentry.DefaultPredicates(azure.Type, opts.IgnoreOperationAnnotation),
+		Type:              azure.Type,
+		SnapshotCheck:     opts.SnapshotCheck,
+		ObjectStore:       azureclient.NewObjectStoreFromSecretRef,
+		Deletion:          opts.Deletion,
 	})
 }
 
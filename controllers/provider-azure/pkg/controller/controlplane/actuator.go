@@ -0,0 +1,489 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure"
+	azureclient "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure/client"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/controlplane"
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+	"github.com/gardener/gardener-extensions/pkg/util"
+
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+)
+
+const (
+	// loadBalancerMigrationSecretName is the name of the secret in the shoot namespace of the seed that holds the
+	// backup of the load balancer services while the load balancers of a shoot are migrated to the standard SKU.
+	loadBalancerMigrationSecretName = "loadbalancer-migration-backup"
+	loadBalancerMigrationSecretKey  = "services"
+	// loadBalancerMigrationResourceManagerReplicasKey is the key in the backup secret that holds the number of replicas
+	// of the gardener-resource-manager before it was paused for the migration.
+	loadBalancerMigrationResourceManagerReplicasKey = "resourceManagerReplicas"
+
+	loadBalancerMigrationRequeueInterval = 30 * time.Second
+)
+
+// NewActuator creates a new Actuator that migrates the load balancers of existing shoot clusters from the basic
+// to the standard SKU if requested and confirmed, and delegates everything else to the given Actuator.
+func NewActuator(a controlplane.Actuator, logger logr.Logger) controlplane.Actuator {
+	return &actuator{
+		Actuator: a,
+		logger:   logger.WithName("azure-controlplane-actuator"),
+	}
+}
+
+type actuator struct {
+	controlplane.Actuator
+	client  client.Client
+	decoder runtime.Decoder
+	logger  logr.Logger
+}
+
+// InjectFunc enables injecting Kubernetes dependencies into the delegate actuator.
+func (a *actuator) InjectFunc(f inject.Func) error {
+	return f(a.Actuator)
+}
+
+// InjectScheme injects the given scheme into the actuator.
+func (a *actuator) InjectScheme(scheme *runtime.Scheme) error {
+	a.decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
+	return nil
+}
+
+// InjectClient injects the given client into the actuator.
+func (a *actuator) InjectClient(client client.Client) error {
+	a.client = client
+	return nil
+}
+
+// Reconcile reconciles the given controlplane and cluster. Before delegating it migrates the load balancers
+// of the shoot to the standard SKU if needed, afterwards it restores the load balancer services of a finished migration.
+func (a *actuator) Reconcile(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) (bool, error) {
+	// Decode providerConfig
+	cpConfig := &apisazure.ControlPlaneConfig{}
+	if cp.Spec.ProviderConfig != nil {
+		if _, _, err := a.decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, cpConfig); err != nil {
+			return false, errors.Wrapf(err, "could not decode providerConfig of controlplane '%s'", util.ObjectName(cp))
+		}
+	}
+
+	// The shoot API server is not available while the cluster is hibernated.
+	hibernated := extensionscontroller.IsHibernated(cluster)
+
+	if !hibernated {
+		if err := a.migrateLoadBalancers(ctx, cp, cpConfig, cluster); err != nil {
+			return false, err
+		}
+	}
+
+	requeue, err := a.Actuator.Reconcile(ctx, cp, cluster)
+	if err != nil || hibernated {
+		return requeue, err
+	}
+
+	if err := a.restoreLoadBalancerServices(ctx, cp.Namespace); err != nil {
+		return false, err
+	}
+	return requeue, nil
+}
+
+// migrateLoadBalancers migrates the load balancers of the shoot in the given namespace from the basic to the standard
+// SKU if the standard SKU is explicitly requested by the given ControlPlaneConfig and the migration is confirmed by
+// the shoot annotation azure.AnnotationConfirmLoadBalancerMigration. It backs up all services of type LoadBalancer in
+// the shoot, pauses the gardener-resource-manager so that it does not recreate the services it manages, deletes the
+// services, waits until the basic load balancers are gone in Azure, and finally switches the cloud-provider-config to
+// the standard SKU. The services are restored by restoreLoadBalancerServices once the cloud-controller-manager has been
+// rolled out with the new configuration.
+func (a *actuator) migrateLoadBalancers(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, cpConfig *apisazure.ControlPlaneConfig, cluster *extensionscontroller.Cluster) error {
+	namespace := cp.Namespace
+	if !isLoadBalancerMigrationRequested(cpConfig) {
+		return nil
+	}
+	sku, found, err := getCurrentLoadBalancerSKU(ctx, a.client, namespace)
+	if err != nil {
+		return err
+	}
+	if !found || sku == apisazure.LoadBalancerSKUStandard {
+		return nil
+	}
+	if !isLoadBalancerMigrationConfirmed(cluster) {
+		a.logger.Info("Keeping basic load balancer SKU as the migration to standard SKU has not been confirmed", "namespace", namespace, "annotation", azure.AnnotationConfirmLoadBalancerMigration)
+		return nil
+	}
+
+	if cp.Spec.InfrastructureProviderStatus == nil {
+		return fmt.Errorf("infrastructureProviderStatus of controlplane '%s' is not set", util.ObjectName(cp))
+	}
+	infraStatus := &apisazure.InfrastructureStatus{}
+	if _, _, err := a.decoder.Decode(cp.Spec.InfrastructureProviderStatus.Raw, nil, infraStatus); err != nil {
+		return errors.Wrapf(err, "could not decode infrastructureProviderStatus of controlplane '%s'", util.ObjectName(cp))
+	}
+
+	_, shootClient, err := util.NewClientForShoot(ctx, a.client, namespace, client.Options{})
+	if err != nil {
+		return errors.Wrap(err, "could not create shoot client")
+	}
+
+	listBasicLoadBalancers := func(ctx context.Context) ([]string, error) {
+		return azureclient.ListLoadBalancersFromSubscriptionSecretRef(ctx, a.client, &cp.Spec.SecretRef, infraStatus.ResourceGroup.Name, string(apisazure.LoadBalancerSKUBasic))
+	}
+
+	a.logger.Info("Migrating load balancers from basic to standard SKU", "namespace", namespace)
+	return migrateLoadBalancers(ctx, a.client, shootClient, namespace, listBasicLoadBalancers)
+}
+
+func migrateLoadBalancers(ctx context.Context, c, shootClient client.Client, namespace string, listBasicLoadBalancers func(context.Context) ([]string, error)) error {
+	// Back up the load balancer services unless this has already been done in a previous reconciliation.
+	backup := &corev1.Secret{}
+	if err := c.Get(ctx, kutil.Key(namespace, loadBalancerMigrationSecretName), backup); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "could not get secret '%s/%s'", namespace, loadBalancerMigrationSecretName)
+		}
+
+		services, err := listLoadBalancerServices(ctx, shootClient)
+		if err != nil {
+			return err
+		}
+		if err := checkLoadBalancerIPs(services); err != nil {
+			return err
+		}
+		resourceManagerReplicas, err := getResourceManagerReplicas(ctx, c, namespace)
+		if err != nil {
+			return err
+		}
+		if backup, err = newLoadBalancerMigrationSecret(namespace, services, resourceManagerReplicas); err != nil {
+			return err
+		}
+		if err := c.Create(ctx, backup); err != nil {
+			return errors.Wrapf(err, "could not create secret '%s/%s'", namespace, loadBalancerMigrationSecretName)
+		}
+	}
+
+	services, err := getBackedUpServices(backup)
+	if err != nil {
+		return err
+	}
+
+	// The gardener-resource-manager would immediately recreate the services it manages (e.g. vpn-shoot), hence it is
+	// paused until the services are restored. This is repeated in every reconciliation as the gardenlet may have
+	// scaled it up again in the meantime.
+	if err := scaleResourceManager(ctx, c, namespace, 0); err != nil {
+		return err
+	}
+
+	// Delete the load balancer services, the cloud-controller-manager then deletes the basic Azure load balancer.
+	for _, service := range services {
+		if err := shootClient.Delete(ctx, service.DeepCopy()); client.IgnoreNotFound(err) != nil {
+			return errors.Wrapf(err, "could not delete service '%s'", util.ObjectName(&service))
+		}
+	}
+
+	remaining, err := listLoadBalancerServices(ctx, shootClient)
+	if err != nil {
+		return err
+	}
+	if len(remaining) > 0 {
+		return &controllererror.RequeueAfterError{
+			Cause:        fmt.Errorf("waiting for %d load balancer services to be deleted", len(remaining)),
+			RequeueAfter: loadBalancerMigrationRequeueInterval,
+		}
+	}
+
+	// Only as of Kubernetes 1.16 services of type LoadBalancer carry a finalizer that is removed after the cloud load
+	// balancer has been cleaned up, hence Azure is asked directly whether the basic load balancers are gone.
+	loadBalancers, err := listBasicLoadBalancers(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not list basic load balancers")
+	}
+	if len(loadBalancers) > 0 {
+		return &controllererror.RequeueAfterError{
+			Cause:        fmt.Errorf("waiting for basic load balancers %s to be deleted", strings.Join(loadBalancers, ", ")),
+			RequeueAfter: loadBalancerMigrationRequeueInterval,
+		}
+	}
+
+	// Switch the existing cloud-provider-config to the standard SKU. The config chart is rendered with the SKU found there.
+	cm := &corev1.ConfigMap{}
+	if err := c.Get(ctx, kutil.Key(namespace, cloudProviderConfigMapName), cm); err != nil {
+		return errors.Wrapf(err, "could not get configmap '%s/%s'", namespace, cloudProviderConfigMapName)
+	}
+	cm.Data[cloudProviderConfigMapKey] = setLoadBalancerSku(cm.Data[cloudProviderConfigMapKey], apisazure.LoadBalancerSKUStandard)
+	if err := c.Update(ctx, cm); err != nil {
+		return errors.Wrapf(err, "could not update configmap '%s/%s'", namespace, cloudProviderConfigMapName)
+	}
+	return nil
+}
+
+// restoreLoadBalancerServices recreates the load balancer services backed up by migrateLoadBalancers once the
+// cloud-controller-manager uses the standard SKU and resumes the gardener-resource-manager afterwards.
+func (a *actuator) restoreLoadBalancerServices(ctx context.Context, namespace string) error {
+	backup := &corev1.Secret{}
+	if err := a.client.Get(ctx, kutil.Key(namespace, loadBalancerMigrationSecretName), backup); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "could not get secret '%s/%s'", namespace, loadBalancerMigrationSecretName)
+	}
+
+	_, shootClient, err := util.NewClientForShoot(ctx, a.client, namespace, client.Options{})
+	if err != nil {
+		return errors.Wrap(err, "could not create shoot client")
+	}
+
+	a.logger.Info("Restoring load balancer services after migration to standard SKU", "namespace", namespace)
+	return restoreLoadBalancerServices(ctx, a.client, shootClient, namespace, backup)
+}
+
+func restoreLoadBalancerServices(ctx context.Context, c, shootClient client.Client, namespace string, backup *corev1.Secret) error {
+	sku, _, err := getCurrentLoadBalancerSKU(ctx, c, namespace)
+	if err != nil {
+		return err
+	}
+	if sku != apisazure.LoadBalancerSKUStandard {
+		return nil
+	}
+
+	// Services must not be recreated before the cloud-controller-manager runs with the new configuration,
+	// otherwise it would create basic load balancers again.
+	deployment := &appsv1.Deployment{}
+	if err := c.Get(ctx, kutil.Key(namespace, cloudControllerManagerDeploymentName), deployment); err != nil {
+		return errors.Wrapf(err, "could not get deployment '%s/%s'", namespace, cloudControllerManagerDeploymentName)
+	}
+	if !isDeploymentRolledOut(deployment) {
+		return &controllererror.RequeueAfterError{
+			Cause:        fmt.Errorf("waiting for deployment '%s' to be rolled out", cloudControllerManagerDeploymentName),
+			RequeueAfter: loadBalancerMigrationRequeueInterval,
+		}
+	}
+
+	services, err := getBackedUpServices(backup)
+	if err != nil {
+		return err
+	}
+	for _, service := range services {
+		if err := shootClient.Create(ctx, service.DeepCopy()); err != nil && !apierrors.IsAlreadyExists(err) {
+			return errors.Wrapf(err, "could not recreate service '%s'", util.ObjectName(&service))
+		}
+	}
+
+	if err := scaleResourceManager(ctx, c, namespace, getBackedUpResourceManagerReplicas(backup)); err != nil {
+		return err
+	}
+
+	return client.IgnoreNotFound(c.Delete(ctx, backup))
+}
+
+// isLoadBalancerMigrationRequested returns true if the standard SKU is explicitly requested in the given ControlPlaneConfig.
+func isLoadBalancerMigrationRequested(cpConfig *apisazure.ControlPlaneConfig) bool {
+	return cpConfig.LoadBalancer != nil && cpConfig.LoadBalancer.SKU != nil && *cpConfig.LoadBalancer.SKU == apisazure.LoadBalancerSKUStandard
+}
+
+// isLoadBalancerMigrationConfirmed returns true if the shoot of the given cluster confirms the load balancer migration.
+func isLoadBalancerMigrationConfirmed(cluster *extensionscontroller.Cluster) bool {
+	var annotations map[string]string
+	if cluster.Shoot != nil {
+		annotations = cluster.Shoot.Annotations
+	} else if cluster.CoreShoot != nil {
+		annotations = cluster.CoreShoot.Annotations
+	}
+	return annotations[azure.AnnotationConfirmLoadBalancerMigration] == "true"
+}
+
+// checkLoadBalancerIPs returns an error if one of the given services requests a specific load balancer IP address.
+// Public IP addresses of the basic SKU cannot be used by standard load balancers, hence such services could not be
+// recreated after the migration.
+func checkLoadBalancerIPs(services []corev1.Service) error {
+	var names []string
+	for _, service := range services {
+		if len(service.Spec.LoadBalancerIP) > 0 {
+			names = append(names, util.ObjectName(&service))
+		}
+	}
+	if len(names) > 0 {
+		return fmt.Errorf("cannot migrate load balancers to standard SKU as services %s request a specific load balancer IP address, remove '.spec.loadBalancerIP' from them first", strings.Join(names, ", "))
+	}
+	return nil
+}
+
+// listLoadBalancerServices returns all services of type LoadBalancer in the shoot.
+func listLoadBalancerServices(ctx context.Context, shootClient client.Client) ([]corev1.Service, error) {
+	serviceList := &corev1.ServiceList{}
+	if err := shootClient.List(ctx, serviceList); err != nil {
+		return nil, errors.Wrap(err, "could not list services")
+	}
+
+	var services []corev1.Service
+	for _, service := range serviceList.Items {
+		if service.Spec.Type == corev1.ServiceTypeLoadBalancer {
+			services = append(services, service)
+		}
+	}
+	return services, nil
+}
+
+// getResourceManagerReplicas returns the number of replicas of the gardener-resource-manager in the given namespace.
+func getResourceManagerReplicas(ctx context.Context, c client.Client, namespace string) (int32, error) {
+	deployment := &appsv1.Deployment{}
+	if err := c.Get(ctx, kutil.Key(namespace, v1alpha1constants.DeploymentNameGardenerResourceManager), deployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, errors.Wrapf(err, "could not get deployment '%s/%s'", namespace, v1alpha1constants.DeploymentNameGardenerResourceManager)
+	}
+	if deployment.Spec.Replicas == nil {
+		return 1, nil
+	}
+	return *deployment.Spec.Replicas, nil
+}
+
+// scaleResourceManager scales the gardener-resource-manager in the given namespace to the given number of replicas
+// if it exists.
+func scaleResourceManager(ctx context.Context, c client.Client, namespace string, replicas int32) error {
+	deployment := &appsv1.Deployment{}
+	if err := c.Get(ctx, kutil.Key(namespace, v1alpha1constants.DeploymentNameGardenerResourceManager), deployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "could not get deployment '%s/%s'", namespace, v1alpha1constants.DeploymentNameGardenerResourceManager)
+	}
+	if deployment.Spec.Replicas != nil && *deployment.Spec.Replicas == replicas {
+		return nil
+	}
+
+	deployment.Spec.Replicas = &replicas
+	if err := c.Update(ctx, deployment); err != nil {
+		return errors.Wrapf(err, "could not scale deployment '%s/%s'", namespace, v1alpha1constants.DeploymentNameGardenerResourceManager)
+	}
+	return nil
+}
+
+// newLoadBalancerMigrationSecret returns a secret containing the given services, stripped of all fields
+// that prevent them from being recreated, and the number of replicas of the gardener-resource-manager.
+func newLoadBalancerMigrationSecret(namespace string, services []corev1.Service, resourceManagerReplicas int32) (*corev1.Secret, error) {
+	backup := make([]corev1.Service, 0, len(services))
+	for _, service := range services {
+		backup = append(backup, corev1.Service{
+			TypeMeta: service.TypeMeta,
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        service.Name,
+				Namespace:   service.Namespace,
+				Labels:      service.Labels,
+				Annotations: service.Annotations,
+			},
+			Spec: *sanitizeServiceSpec(&service.Spec),
+		})
+	}
+
+	data, err := json.Marshal(backup)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal load balancer services")
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      loadBalancerMigrationSecretName,
+			Namespace: namespace,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			loadBalancerMigrationSecretKey:                  data,
+			loadBalancerMigrationResourceManagerReplicasKey: []byte(strconv.Itoa(int(resourceManagerReplicas))),
+		},
+	}, nil
+}
+
+func sanitizeServiceSpec(spec *corev1.ServiceSpec) *corev1.ServiceSpec {
+	out := spec.DeepCopy()
+	out.ClusterIP = ""
+	out.HealthCheckNodePort = 0
+	for i := range out.Ports {
+		out.Ports[i].NodePort = 0
+	}
+	return out
+}
+
+// getBackedUpServices returns the services stored in the given load balancer migration secret.
+func getBackedUpServices(backup *corev1.Secret) ([]corev1.Service, error) {
+	var services []corev1.Service
+	if err := json.Unmarshal(backup.Data[loadBalancerMigrationSecretKey], &services); err != nil {
+		return nil, errors.Wrapf(err, "could not unmarshal load balancer services from secret '%s'", util.ObjectName(backup))
+	}
+	return services, nil
+}
+
+// getBackedUpResourceManagerReplicas returns the number of replicas of the gardener-resource-manager stored in the
+// given load balancer migration secret. It defaults to one replica.
+func getBackedUpResourceManagerReplicas(backup *corev1.Secret) int32 {
+	replicas, err := strconv.Atoi(string(backup.Data[loadBalancerMigrationResourceManagerReplicasKey]))
+	if err != nil {
+		return 1
+	}
+	return int32(replicas)
+}
+
+// setLoadBalancerSku sets the load balancer SKU in the given cloud provider configuration.
+func setLoadBalancerSku(data string, sku apisazure.LoadBalancerSKU) string {
+	var (
+		lines = strings.Split(data, "\n")
+		entry = fmt.Sprintf("%s: %q", loadBalancerSkuKey, sku)
+	)
+
+	for i, line := range lines {
+		if strings.HasPrefix(line, loadBalancerSkuKey+":") {
+			lines[i] = entry
+			return strings.Join(lines, "\n")
+		}
+	}
+
+	if len(data) > 0 && !strings.HasSuffix(data, "\n") {
+		data += "\n"
+	}
+	return data + entry + "\n"
+}
+
+// isDeploymentRolledOut returns true if all replicas of the given deployment have been updated and are available.
+func isDeploymentRolledOut(deployment *appsv1.Deployment) bool {
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+
+	return deployment.Status.ObservedGeneration >= deployment.Generation &&
+		deployment.Status.UpdatedReplicas == replicas &&
+		deployment.Status.Replicas == replicas &&
+		deployment.Status.AvailableReplicas == replicas
+}
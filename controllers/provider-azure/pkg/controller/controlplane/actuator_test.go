@@ -0,0 +1,249 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+
+	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+var _ = Describe("Actuator", func() {
+	var (
+		ctx = context.TODO()
+
+		seedClient  client.Client
+		shootClient client.Client
+
+		lbService      *corev1.Service
+		clusterService *corev1.Service
+		cloudConfig    *corev1.ConfigMap
+		ccmDeployment  *appsv1.Deployment
+		grmDeployment  *appsv1.Deployment
+
+		grmReplicas    = int32(2)
+		pausedReplicas = int32(0)
+
+		noBasicLoadBalancers = func(context.Context) ([]string, error) { return nil, nil }
+	)
+
+	BeforeEach(func() {
+		lbService = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "ingress",
+				Namespace:   "default",
+				Annotations: map[string]string{"foo": "bar"},
+			},
+			Spec: corev1.ServiceSpec{
+				Type:      corev1.ServiceTypeLoadBalancer,
+				ClusterIP: "100.64.0.10",
+				Ports:     []corev1.ServicePort{{Name: "https", Port: 443, NodePort: 30443}},
+			},
+		}
+		clusterService = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubernetes", Namespace: "default"},
+			Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP},
+		}
+		cloudConfig = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: cloudProviderConfigMapName, Namespace: namespace},
+			Data: map[string]string{
+				cloudProviderConfigMapKey: "cloud: AZUREPUBLICCLOUD\nloadBalancerSku: \"basic\"\nsubnetName: \"nodes\"\n",
+			},
+		}
+		ccmDeployment = &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: cloudControllerManagerDeploymentName, Namespace: namespace},
+		}
+		grmDeployment = &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: v1alpha1constants.DeploymentNameGardenerResourceManager, Namespace: namespace},
+			Spec:       appsv1.DeploymentSpec{Replicas: &grmReplicas},
+		}
+	})
+
+	Describe("#migrateLoadBalancers", func() {
+		BeforeEach(func() {
+			seedClient = fakeclient.NewFakeClientWithScheme(scheme.Scheme, cloudConfig, grmDeployment)
+			shootClient = fakeclient.NewFakeClientWithScheme(scheme.Scheme, lbService, clusterService)
+		})
+
+		It("should back up and delete the load balancer services and switch to the standard SKU", func() {
+			Expect(migrateLoadBalancers(ctx, seedClient, shootClient, namespace, noBasicLoadBalancers)).To(Succeed())
+
+			backup := &corev1.Secret{}
+			Expect(seedClient.Get(ctx, kutil.Key(namespace, loadBalancerMigrationSecretName), backup)).To(Succeed())
+			services, err := getBackedUpServices(backup)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(services).To(HaveLen(1))
+			Expect(services[0].Name).To(Equal("ingress"))
+			Expect(services[0].Annotations).To(Equal(map[string]string{"foo": "bar"}))
+			Expect(services[0].Spec.ClusterIP).To(BeEmpty())
+			Expect(services[0].Spec.Ports[0].NodePort).To(BeZero())
+			Expect(getBackedUpResourceManagerReplicas(backup)).To(Equal(int32(2)))
+
+			deployment := &appsv1.Deployment{}
+			Expect(seedClient.Get(ctx, kutil.Key(namespace, v1alpha1constants.DeploymentNameGardenerResourceManager), deployment)).To(Succeed())
+			Expect(deployment.Spec.Replicas).To(PointTo(BeZero()))
+
+			err = shootClient.Get(ctx, kutil.Key("default", "ingress"), &corev1.Service{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			Expect(shootClient.Get(ctx, kutil.Key("default", "kubernetes"), &corev1.Service{})).To(Succeed())
+
+			sku, found, err := getCurrentLoadBalancerSKU(ctx, seedClient, namespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(sku).To(Equal(apisazure.LoadBalancerSKUStandard))
+		})
+
+		It("should wait until the basic load balancers have been deleted in Azure", func() {
+			basicLoadBalancers := func(context.Context) ([]string, error) { return []string{"shoot--foo--bar"}, nil }
+
+			err := migrateLoadBalancers(ctx, seedClient, shootClient, namespace, basicLoadBalancers)
+			Expect(err).To(BeAssignableToTypeOf(&controllererror.RequeueAfterError{}))
+
+			err = shootClient.Get(ctx, kutil.Key("default", "ingress"), &corev1.Service{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+			sku, _, err := getCurrentLoadBalancerSKU(ctx, seedClient, namespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sku).To(Equal(apisazure.LoadBalancerSKUBasic))
+		})
+
+		It("should fail without deleting services if a service requests a specific load balancer IP", func() {
+			lbService.Spec.LoadBalancerIP = "20.30.40.50"
+			shootClient = fakeclient.NewFakeClientWithScheme(scheme.Scheme, lbService, clusterService)
+
+			Expect(migrateLoadBalancers(ctx, seedClient, shootClient, namespace, noBasicLoadBalancers)).NotTo(Succeed())
+
+			Expect(shootClient.Get(ctx, kutil.Key("default", "ingress"), &corev1.Service{})).To(Succeed())
+			err := seedClient.Get(ctx, kutil.Key(namespace, loadBalancerMigrationSecretName), &corev1.Secret{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+			sku, _, err := getCurrentLoadBalancerSKU(ctx, seedClient, namespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sku).To(Equal(apisazure.LoadBalancerSKUBasic))
+		})
+	})
+
+	Describe("#actuator.migrateLoadBalancers", func() {
+		var (
+			a       *actuator
+			cp      *extensionsv1alpha1.ControlPlane
+			cluster *extensionscontroller.Cluster
+
+			standard = apisazure.LoadBalancerSKUStandard
+		)
+
+		BeforeEach(func() {
+			seedClient = fakeclient.NewFakeClientWithScheme(scheme.Scheme, cloudConfig)
+			a = &actuator{client: seedClient, logger: log.Log.WithName("test")}
+			cp = &extensionsv1alpha1.ControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "control-plane", Namespace: namespace}}
+			cluster = &extensionscontroller.Cluster{CoreShoot: &gardencorev1alpha1.Shoot{}}
+		})
+
+		It("should keep the basic SKU if the standard SKU is not explicitly requested", func() {
+			cluster.CoreShoot.Annotations = map[string]string{azure.AnnotationConfirmLoadBalancerMigration: "true"}
+
+			Expect(a.migrateLoadBalancers(ctx, cp, &apisazure.ControlPlaneConfig{}, cluster)).To(Succeed())
+
+			sku, _, err := getCurrentLoadBalancerSKU(ctx, seedClient, namespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sku).To(Equal(apisazure.LoadBalancerSKUBasic))
+		})
+
+		It("should keep the basic SKU if the migration is not confirmed", func() {
+			cpConfig := &apisazure.ControlPlaneConfig{LoadBalancer: &apisazure.LoadBalancerConfig{SKU: &standard}}
+
+			Expect(a.migrateLoadBalancers(ctx, cp, cpConfig, cluster)).To(Succeed())
+
+			sku, _, err := getCurrentLoadBalancerSKU(ctx, seedClient, namespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sku).To(Equal(apisazure.LoadBalancerSKUBasic))
+		})
+	})
+
+	Describe("#restoreLoadBalancerServices", func() {
+		var backup *corev1.Secret
+
+		BeforeEach(func() {
+			var err error
+			backup, err = newLoadBalancerMigrationSecret(namespace, []corev1.Service{*lbService}, 2)
+			Expect(err).NotTo(HaveOccurred())
+
+			cloudConfig.Data[cloudProviderConfigMapKey] = setLoadBalancerSku(cloudConfig.Data[cloudProviderConfigMapKey], apisazure.LoadBalancerSKUStandard)
+			shootClient = fakeclient.NewFakeClientWithScheme(scheme.Scheme)
+		})
+
+		It("should wait until the cloud-controller-manager has been rolled out", func() {
+			ccmDeployment.Status.UpdatedReplicas = 1
+			ccmDeployment.Status.Replicas = 2
+			seedClient = fakeclient.NewFakeClientWithScheme(scheme.Scheme, cloudConfig, ccmDeployment, backup)
+
+			err := restoreLoadBalancerServices(ctx, seedClient, shootClient, namespace, backup)
+			Expect(err).To(BeAssignableToTypeOf(&controllererror.RequeueAfterError{}))
+
+			err = shootClient.Get(ctx, kutil.Key("default", "ingress"), &corev1.Service{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should recreate the services and delete the backup", func() {
+			ccmDeployment.Status.UpdatedReplicas = 1
+			ccmDeployment.Status.Replicas = 1
+			ccmDeployment.Status.AvailableReplicas = 1
+			grmDeployment.Spec.Replicas = &pausedReplicas
+			seedClient = fakeclient.NewFakeClientWithScheme(scheme.Scheme, cloudConfig, ccmDeployment, grmDeployment, backup)
+
+			Expect(restoreLoadBalancerServices(ctx, seedClient, shootClient, namespace, backup)).To(Succeed())
+
+			service := &corev1.Service{}
+			Expect(shootClient.Get(ctx, kutil.Key("default", "ingress"), service)).To(Succeed())
+			Expect(service.Spec.Type).To(Equal(corev1.ServiceTypeLoadBalancer))
+
+			deployment := &appsv1.Deployment{}
+			Expect(seedClient.Get(ctx, kutil.Key(namespace, v1alpha1constants.DeploymentNameGardenerResourceManager), deployment)).To(Succeed())
+			Expect(deployment.Spec.Replicas).To(PointTo(Equal(int32(2))))
+
+			err := seedClient.Get(ctx, kutil.Key(namespace, loadBalancerMigrationSecretName), &corev1.Secret{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	DescribeTable("#setLoadBalancerSku",
+		func(data, expected string) {
+			Expect(setLoadBalancerSku(data, apisazure.LoadBalancerSKUStandard)).To(Equal(expected))
+		},
+		Entry("replace existing entry", "a: 1\nloadBalancerSku: \"basic\"\nb: 2\n", "a: 1\nloadBalancerSku: \"standard\"\nb: 2\n"),
+		Entry("append missing entry", "a: 1\n", "a: 1\nloadBalancerSku: \"standard\"\n"),
+		Entry("append missing entry without trailing newline", "a: 1", "a: 1\nloadBalancerSku: \"standard\"\n"),
+	)
+})
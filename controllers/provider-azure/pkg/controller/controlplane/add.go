@@ -15,6 +15,7 @@
 package controlplane
 
 import (
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/config"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal/imagevector"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
@@ -39,15 +40,17 @@ type AddOptions struct {
 	Controller controller.Options
 	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
 	IgnoreOperationAnnotation bool
+	// ControlPlaneComponents are the vertical pod autoscaling and high availability settings of the control plane components.
+	ControlPlaneComponents config.ControlPlaneComponents
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
 	return controlplane.Add(mgr, controlplane.AddArgs{
-		Actuator: genericactuator.NewActuator(azure.Name, controlPlaneSecrets, nil, configChart, ccmChart, ccmShootChart,
-			storageClassChart, nil, NewValuesProvider(logger), extensionscontroller.ChartRendererFactoryFunc(util.NewChartRendererForShoot),
-			imagevector.ImageVector(), azure.CloudProviderConfigName, nil, mgr.GetWebhookServer().Port, logger),
+		Actuator: NewActuator(genericactuator.NewActuator(azure.Name, controlPlaneSecrets, nil, configChart, controlPlaneChart, controlPlaneShootChart,
+			storageClassChart, nil, NewValuesProvider(opts.ControlPlaneComponents, logger), extensionscontroller.ChartRendererFactoryFunc(util.NewChartRendererForShoot),
+			imagevector.ImageVector(), azure.CloudProviderConfigName, nil, mgr.GetWebhookServer().Port, logger), logger),
 		ControllerOptions: opts.Controller,
 		Predicates:        controlplane.DefaultPredicates(azure.Type, opts.IgnoreOperationAnnotation),
 	})
@@ -21,6 +21,8 @@ import (
 
 	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
 	azureapihelper "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/helper"
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/config"
+	confighelper "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/config/helper"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
@@ -31,13 +33,16 @@ import (
 
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/chart"
 	"github.com/gardener/gardener/pkg/utils/secrets"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1beta1 "k8s.io/api/storage/v1beta1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
@@ -47,10 +52,16 @@ import (
 
 // Object names
 const (
-	cloudControllerManagerDeploymentName = "cloud-controller-manager"
-	cloudControllerManagerServerName     = "cloud-controller-manager-server"
-	cloudProviderConfigMapName           = "cloud-provider-config"
-	cloudProviderConfigMapKey            = "cloudprovider.conf"
+	cloudControllerManagerDeploymentName  = "cloud-controller-manager"
+	cloudControllerManagerServerName      = "cloud-controller-manager-server"
+	cloudProviderConfigMapName            = "cloud-provider-config"
+	cloudProviderConfigMapKey             = "cloudprovider.conf"
+	loadBalancerSkuKey                    = "loadBalancerSku"
+	csiDriverControllerDiskDeploymentName = "csi-driver-controller-disk"
+	csiDriverControllerFileDeploymentName = "csi-driver-controller-file"
+	csiProvisionerName                    = "csi-provisioner"
+	csiAttacherName                       = "csi-attacher"
+	csiResizerName                        = "csi-resizer"
 )
 
 var controlPlaneSecrets = &secrets.Secrets{
@@ -85,6 +96,45 @@ var controlPlaneSecrets = &secrets.Secrets{
 					SigningCA:  cas[v1alpha1constants.SecretNameCACluster],
 				},
 			},
+			&secrets.ControlPlaneSecretConfig{
+				CertificateSecretConfig: &secrets.CertificateSecretConfig{
+					Name:         csiProvisionerName,
+					CommonName:   "system:csi-provisioner",
+					Organization: []string{user.SystemPrivilegedGroup},
+					CertType:     secrets.ClientCert,
+					SigningCA:    cas[v1alpha1constants.SecretNameCACluster],
+				},
+				KubeConfigRequest: &secrets.KubeConfigRequest{
+					ClusterName:  clusterName,
+					APIServerURL: v1alpha1constants.DeploymentNameKubeAPIServer,
+				},
+			},
+			&secrets.ControlPlaneSecretConfig{
+				CertificateSecretConfig: &secrets.CertificateSecretConfig{
+					Name:         csiAttacherName,
+					CommonName:   "system:csi-attacher",
+					Organization: []string{user.SystemPrivilegedGroup},
+					CertType:     secrets.ClientCert,
+					SigningCA:    cas[v1alpha1constants.SecretNameCACluster],
+				},
+				KubeConfigRequest: &secrets.KubeConfigRequest{
+					ClusterName:  clusterName,
+					APIServerURL: v1alpha1constants.DeploymentNameKubeAPIServer,
+				},
+			},
+			&secrets.ControlPlaneSecretConfig{
+				CertificateSecretConfig: &secrets.CertificateSecretConfig{
+					Name:         csiResizerName,
+					CommonName:   "system:csi-resizer",
+					Organization: []string{user.SystemPrivilegedGroup},
+					CertType:     secrets.ClientCert,
+					SigningCA:    cas[v1alpha1constants.SecretNameCACluster],
+				},
+				KubeConfigRequest: &secrets.KubeConfigRequest{
+					ClusterName:  clusterName,
+					APIServerURL: v1alpha1constants.DeploymentNameKubeAPIServer,
+				},
+			},
 		}
 	},
 }
@@ -104,23 +154,81 @@ var configChart = &chart.Chart{
 	},
 }
 
-var ccmChart = &chart.Chart{
-	Name:   "cloud-controller-manager",
-	Path:   filepath.Join(internal.InternalChartsPath, "cloud-controller-manager"),
-	Images: []string{azure.HyperkubeImageName},
-	Objects: []*chart.Object{
-		{Type: &corev1.Service{}, Name: "cloud-controller-manager"},
-		{Type: &appsv1.Deployment{}, Name: "cloud-controller-manager"},
-		{Type: &corev1.ConfigMap{}, Name: "cloud-controller-manager-monitoring-config"},
+var controlPlaneChart = &chart.Chart{
+	Name: "seed-controlplane",
+	Path: filepath.Join(internal.InternalChartsPath, "seed-controlplane"),
+	SubCharts: []*chart.Chart{
+		{
+			Name:   "cloud-controller-manager",
+			Images: []string{azure.HyperkubeImageName},
+			Objects: []*chart.Object{
+				{Type: &corev1.Service{}, Name: "cloud-controller-manager"},
+				{Type: &appsv1.Deployment{}, Name: "cloud-controller-manager"},
+				{Type: extensionscontroller.GetVerticalPodAutoscalerObject(), Name: "cloud-controller-manager-vpa"},
+				{Type: &policyv1beta1.PodDisruptionBudget{}, Name: "cloud-controller-manager"},
+				{Type: &corev1.ConfigMap{}, Name: "cloud-controller-manager-monitoring-config"},
+			},
+		},
+		{
+			Name: "csi-driver-controller",
+			Images: []string{
+				azure.CSIDriverDiskImageName,
+				azure.CSIDriverFileImageName,
+				azure.CSIProvisionerImageName,
+				azure.CSIAttacherImageName,
+				azure.CSIResizerImageName,
+				azure.CSILivenessProbeImageName,
+			},
+			Objects: []*chart.Object{
+				{Type: &appsv1.Deployment{}, Name: csiDriverControllerDiskDeploymentName},
+				{Type: extensionscontroller.GetVerticalPodAutoscalerObject(), Name: csiDriverControllerDiskDeploymentName + "-vpa"},
+				{Type: &policyv1beta1.PodDisruptionBudget{}, Name: csiDriverControllerDiskDeploymentName},
+				{Type: &appsv1.Deployment{}, Name: csiDriverControllerFileDeploymentName},
+				{Type: extensionscontroller.GetVerticalPodAutoscalerObject(), Name: csiDriverControllerFileDeploymentName + "-vpa"},
+				{Type: &policyv1beta1.PodDisruptionBudget{}, Name: csiDriverControllerFileDeploymentName},
+			},
+		},
 	},
 }
 
-var ccmShootChart = &chart.Chart{
-	Name: "cloud-controller-manager-shoot",
-	Path: filepath.Join(internal.InternalChartsPath, "cloud-controller-manager-shoot"),
-	Objects: []*chart.Object{
-		{Type: &rbacv1.ClusterRole{}, Name: "system:controller:cloud-node-controller"},
-		{Type: &rbacv1.ClusterRoleBinding{}, Name: "system:controller:cloud-node-controller"},
+var controlPlaneShootChart = &chart.Chart{
+	Name: "shoot-system-components",
+	Path: filepath.Join(internal.InternalChartsPath, "shoot-system-components"),
+	SubCharts: []*chart.Chart{
+		{
+			Name: "cloud-controller-manager",
+			Objects: []*chart.Object{
+				{Type: &rbacv1.ClusterRole{}, Name: "system:controller:cloud-node-controller"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "system:controller:cloud-node-controller"},
+			},
+		},
+		{
+			Name: "csi-driver-node",
+			Images: []string{
+				azure.CSIDriverDiskImageName,
+				azure.CSIDriverFileImageName,
+				azure.CSINodeDriverRegistrarImageName,
+				azure.CSILivenessProbeImageName,
+			},
+			Objects: []*chart.Object{
+				{Type: &appsv1.DaemonSet{}, Name: "csi-driver-node-disk"},
+				{Type: &appsv1.DaemonSet{}, Name: "csi-driver-node-file"},
+				{Type: &storagev1beta1.CSIDriver{}, Name: azure.CSIDriverDiskName},
+				{Type: &storagev1beta1.CSIDriver{}, Name: azure.CSIDriverFileName},
+				{Type: &corev1.ServiceAccount{}, Name: "csi-driver-node"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:psp:kube-system:csi-driver-node"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:psp:csi-driver-node"},
+				{Type: &policyv1beta1.PodSecurityPolicy{}, Name: "gardener.kube-system.csi-driver-node"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:kube-system:csi-provisioner"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:csi-provisioner"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:kube-system:csi-attacher"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:csi-attacher"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:kube-system:csi-resizer"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:csi-resizer"},
+				{Type: &rbacv1.Role{}, Name: "csi-leader-election"},
+				{Type: &rbacv1.RoleBinding{}, Name: "csi-leader-election"},
+			},
+		},
 	},
 }
 
@@ -130,18 +238,20 @@ var storageClassChart = &chart.Chart{
 }
 
 // NewValuesProvider creates a new ValuesProvider for the generic actuator.
-func NewValuesProvider(logger logr.Logger) genericactuator.ValuesProvider {
+func NewValuesProvider(controlPlaneComponents config.ControlPlaneComponents, logger logr.Logger) genericactuator.ValuesProvider {
 	return &valuesProvider{
-		logger: logger.WithName("azure-values-provider"),
+		controlPlaneComponents: controlPlaneComponents,
+		logger:                 logger.WithName("azure-values-provider"),
 	}
 }
 
 // valuesProvider is a ValuesProvider that provides azure-specific values for the 2 charts applied by the generic actuator.
 type valuesProvider struct {
 	genericactuator.NoopValuesProvider
-	decoder runtime.Decoder
-	client  client.Client
-	logger  logr.Logger
+	controlPlaneComponents config.ControlPlaneComponents
+	decoder                runtime.Decoder
+	client                 client.Client
+	logger                 logr.Logger
 }
 
 // InjectScheme injects the given scheme into the valuesProvider.
@@ -181,13 +291,13 @@ func (vp *valuesProvider) GetConfigChartValues(
 	}
 
 	// Determine which kind of LoadBalancer should be configured in the cloud-provider-config.
-	loadBalancerType, err := determineLoadBalancerType(ctx, vp.client, cp.Namespace)
+	loadBalancerType, err := determineLoadBalancerType(ctx, vp.client, cp.Namespace, cpConfig)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not determine which type of loadbalancer should be used")
 	}
 
 	// Get config chart values
-	return getConfigChartValues(infraStatus, cp, cluster, auth, loadBalancerType)
+	return getConfigChartValues(cpConfig, infraStatus, cp, cluster, auth, loadBalancerType)
 }
 
 // GetControlPlaneChartValues returns the values for the control plane chart applied by the generic actuator.
@@ -204,23 +314,68 @@ func (vp *valuesProvider) GetControlPlaneChartValues(
 		return nil, errors.Wrapf(err, "could not decode providerConfig of controlplane '%s'", util.ObjectName(cp))
 	}
 
-	// Get CCM chart values
-	return getCCMChartValues(cpConfig, cp, cluster, checksums, scaledDown)
+	// Get control plane chart values
+	values, err := getControlPlaneChartValues(cpConfig, cp, cluster, checksums, scaledDown, confighelper.GetControlPlaneComponentReplicas(vp.controlPlaneComponents))
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply the vertical pod autoscaling and high availability settings to all control plane components
+	componentValues := confighelper.GetControlPlaneComponentChartValues(vp.controlPlaneComponents)
+	for _, subChart := range controlPlaneChart.SubCharts {
+		if subChartValues, ok := values[subChart.Name].(map[string]interface{}); ok {
+			values[subChart.Name] = utils.MergeMaps(subChartValues, componentValues)
+		}
+	}
+
+	return values, nil
+}
+
+// GetControlPlaneShootChartValues returns the values for the control plane shoot chart applied by the generic actuator.
+func (vp *valuesProvider) GetControlPlaneShootChartValues(
+	ctx context.Context,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+) (map[string]interface{}, error) {
+	// Get control plane shoot chart values
+	return getControlPlaneShootChartValues(cluster)
+}
+
+// GetStorageClassesChartValues returns the values for the storage classes chart applied by the generic actuator.
+func (vp *valuesProvider) GetStorageClassesChartValues(
+	ctx context.Context,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+) (map[string]interface{}, error) {
+	// Decode providerConfig
+	cpConfig := &apisazure.ControlPlaneConfig{}
+	if _, _, err := vp.decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, cpConfig); err != nil {
+		return nil, errors.Wrapf(err, "could not decode providerConfig of controlplane '%s'", util.ObjectName(cp))
+	}
+
+	// Get storage classes chart values
+	return getStorageClassesChartValues(cpConfig, cluster)
 }
 
 // getConfigChartValues collects and returns the configuration chart values.
 func getConfigChartValues(
+	cpConfig *apisazure.ControlPlaneConfig,
 	infraStatus *apisazure.InfrastructureStatus,
 	cp *extensionsv1alpha1.ControlPlane,
 	cluster *extensionscontroller.Cluster,
 	ca *internal.ClientAuth,
-	loadBalancerType string,
+	loadBalancerType apisazure.LoadBalancerSKU,
 ) (map[string]interface{}, error) {
 	subnetName, routeTableName, securityGroupName, err := getInfraNames(infraStatus)
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not determine subnet, availability set, route table or security group name from infrastructureStatus of controlplane '%s'", util.ObjectName(cp))
 	}
 
+	// NAT gateways cannot be used together with basic load balancers.
+	if infraStatus.Networks.NatGateway != nil && loadBalancerType != apisazure.LoadBalancerSKUStandard {
+		return nil, errors.Errorf("NAT gateway of controlplane '%s' requires standard load balancers, but %s load balancers are used", util.ObjectName(cp), loadBalancerType)
+	}
+
 	// Collect config chart values.
 	values := map[string]interface{}{
 		"kubernetesVersion": extensionscontroller.GetKubernetesVersion(cluster),
@@ -233,10 +388,28 @@ func getConfigChartValues(
 		"subnetName":        subnetName,
 		"routeTableName":    routeTableName,
 		"securityGroupName": securityGroupName,
-		"loadBalancerSku":   loadBalancerType,
+		"loadBalancerSku":   string(loadBalancerType),
 		"region":            cp.Spec.Region,
 	}
 
+	// Add the resource group of the VNet if it differs from the one of the shoot.
+	if infraStatus.Networks.VNet.ResourceGroup != nil {
+		values["vnetResourceGroup"] = *infraStatus.Networks.VNet.ResourceGroup
+	}
+
+	// Add the outbound settings of standard load balancers.
+	if lb := cpConfig.LoadBalancer; lb != nil && loadBalancerType == apisazure.LoadBalancerSKUStandard {
+		if lb.AllocatedOutboundPorts != nil {
+			values["loadBalancerAllocatedOutboundPorts"] = *lb.AllocatedOutboundPorts
+		}
+		if lb.IdleTimeoutInMinutes != nil {
+			values["loadBalancerIdleTimeoutInMinutes"] = *lb.IdleTimeoutInMinutes
+		}
+		if lb.ExcludeMasterFromStandardLB != nil {
+			values["excludeMasterFromStandardLB"] = *lb.ExcludeMasterFromStandardLB
+		}
+	}
+
 	// Add AvailabilitySet config if the cluster is not zoned.
 	if !infraStatus.Zoned {
 		nodesAvailabilitySet, err := azureapihelper.FindAvailabilitySetByPurpose(infraStatus.AvailabilitySets, apisazure.PurposeNodes)
@@ -249,6 +422,31 @@ func getConfigChartValues(
 	return values, nil
 }
 
+// getControlPlaneChartValues collects and returns the control plane chart values.
+func getControlPlaneChartValues(
+	cpConfig *apisazure.ControlPlaneConfig,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+	checksums map[string]string,
+	scaledDown bool,
+	replicas int,
+) (map[string]interface{}, error) {
+	ccm, err := getCCMChartValues(cpConfig, cp, cluster, checksums, scaledDown, replicas)
+	if err != nil {
+		return nil, err
+	}
+
+	csi, err := getCSIControllerChartValues(cluster, checksums, scaledDown, replicas)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"cloud-controller-manager": ccm,
+		"csi-driver-controller":    csi,
+	}, nil
+}
+
 // getCCMChartValues collects and returns the CCM chart values.
 func getCCMChartValues(
 	cpConfig *apisazure.ControlPlaneConfig,
@@ -256,9 +454,10 @@ func getCCMChartValues(
 	cluster *extensionscontroller.Cluster,
 	checksums map[string]string,
 	scaledDown bool,
+	replicas int,
 ) (map[string]interface{}, error) {
 	values := map[string]interface{}{
-		"replicas":          extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
+		"replicas":          extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, replicas),
 		"clusterName":       cp.Namespace,
 		"kubernetesVersion": extensionscontroller.GetKubernetesVersion(cluster),
 		"podNetwork":        extensionscontroller.GetPodNetwork(cluster),
@@ -270,13 +469,131 @@ func getCCMChartValues(
 		},
 	}
 
-	if cpConfig.CloudControllerManager != nil {
-		values["featureGates"] = cpConfig.CloudControllerManager.FeatureGates
+	if ccmConfig := cpConfig.CloudControllerManager; ccmConfig != nil {
+		values["featureGates"] = ccmConfig.FeatureGates
+		if ccmConfig.ConcurrentServiceSyncs != nil {
+			values["concurrentServiceSyncs"] = *ccmConfig.ConcurrentServiceSyncs
+		}
+		if ccmConfig.NodeMonitorPeriod != nil {
+			values["nodeMonitorPeriod"] = ccmConfig.NodeMonitorPeriod.Duration.String()
+		}
+		if ccmConfig.RouteReconciliationPeriod != nil {
+			values["routeReconciliationPeriod"] = ccmConfig.RouteReconciliationPeriod.Duration.String()
+		}
+		if ccmConfig.Verbosity != nil {
+			values["verbosity"] = *ccmConfig.Verbosity
+		}
 	}
 
 	return values, nil
 }
 
+// getCSIControllerChartValues collects and returns the CSI controller chart values.
+func getCSIControllerChartValues(
+	cluster *extensionscontroller.Cluster,
+	checksums map[string]string,
+	scaledDown bool,
+	replicas int,
+) (map[string]interface{}, error) {
+	useCSI, err := useCSI(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	if !useCSI {
+		return map[string]interface{}{"enabled": false}, nil
+	}
+
+	return map[string]interface{}{
+		"enabled":  true,
+		"replicas": extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, replicas),
+		"podAnnotations": map[string]interface{}{
+			"checksum/secret-" + csiProvisionerName:               checksums[csiProvisionerName],
+			"checksum/secret-" + csiAttacherName:                  checksums[csiAttacherName],
+			"checksum/secret-" + csiResizerName:                   checksums[csiResizerName],
+			"checksum/configmap-" + azure.CloudProviderConfigName: checksums[azure.CloudProviderConfigName],
+		},
+	}, nil
+}
+
+// getControlPlaneShootChartValues collects and returns the control plane shoot chart values.
+func getControlPlaneShootChartValues(cluster *extensionscontroller.Cluster) (map[string]interface{}, error) {
+	useCSI, err := useCSI(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"csi-driver-node": map[string]interface{}{
+			"enabled": useCSI,
+		},
+	}, nil
+}
+
+// getStorageClassesChartValues collects and returns the storage classes chart values.
+func getStorageClassesChartValues(
+	cpConfig *apisazure.ControlPlaneConfig,
+	cluster *extensionscontroller.Cluster,
+) (map[string]interface{}, error) {
+	useCSI, err := useCSI(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	storageClasses, customDefaultStorageClass := getStorageClasses(cpConfig.StorageClasses)
+
+	return map[string]interface{}{
+		"useCSI":                    useCSI,
+		"storageClasses":            storageClasses,
+		"customDefaultStorageClass": customDefaultStorageClass,
+	}, nil
+}
+
+// getStorageClasses collects and returns the chart values for the given additional storage classes. It also
+// returns whether one of them shall be the default storage class of the shoot cluster.
+func getStorageClasses(storageClasses []apisazure.StorageClass) ([]map[string]interface{}, bool) {
+	var (
+		values     = make([]map[string]interface{}, 0, len(storageClasses))
+		hasDefault bool
+	)
+
+	for _, sc := range storageClasses {
+		parameters := map[string]interface{}{
+			"storageaccounttype": sc.Type,
+			"kind":               "managed",
+		}
+
+		isDefault := sc.Default != nil && *sc.Default
+		if isDefault {
+			hasDefault = true
+		}
+
+		value := map[string]interface{}{
+			"name":       sc.Name,
+			"default":    isDefault,
+			"parameters": parameters,
+		}
+		if sc.ReclaimPolicy != nil {
+			value["reclaimPolicy"] = string(*sc.ReclaimPolicy)
+		}
+		if sc.VolumeBindingMode != nil {
+			value["volumeBindingMode"] = string(*sc.VolumeBindingMode)
+		}
+		values = append(values, value)
+	}
+
+	return values, hasDefault
+}
+
+// useCSI returns true if the Azure Disk and Azure File CSI drivers shall be used for the given cluster.
+func useCSI(cluster *extensionscontroller.Cluster) (bool, error) {
+	useCSI, err := azure.UseCSI(extensionscontroller.GetKubernetesVersion(cluster))
+	if err != nil {
+		return false, errors.Wrapf(err, "could not determine whether the CSI driver shall be used")
+	}
+	return useCSI, nil
+}
+
 // getInfraNames determines the subnet, availability set, route table and security group names from the given infrastructure status.
 func getInfraNames(infraStatus *apisazure.InfrastructureStatus) (string, string, string, error) {
 	nodesSubnet, err := azureapihelper.FindSubnetByPurpose(infraStatus.Networks.Subnets, apisazure.PurposeNodes)
@@ -295,29 +612,53 @@ func getInfraNames(infraStatus *apisazure.InfrastructureStatus) (string, string,
 	return nodesSubnet.Name, nodesRouteTable.Name, nodesSecurityGroup.Name, nil
 }
 
-func determineLoadBalancerType(ctx context.Context, c client.Client, namespace string) (string, error) {
+// determineLoadBalancerType determines which kind of load balancer shall be configured in the cloud-provider-config.
+// New clusters use the SKU requested in the given ControlPlaneConfig (standard by default), existing clusters keep
+// their current SKU. Existing clusters are migrated from basic to standard by the actuator before the config chart
+// is applied, see migrateLoadBalancers.
+func determineLoadBalancerType(ctx context.Context, c client.Client, namespace string, cpConfig *apisazure.ControlPlaneConfig) (apisazure.LoadBalancerSKU, error) {
+	sku, found, err := getCurrentLoadBalancerSKU(ctx, c, namespace)
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		return getDesiredLoadBalancerSKU(cpConfig), nil
+	}
+	return sku, nil
+}
+
+// getDesiredLoadBalancerSKU returns the load balancer SKU requested in the given ControlPlaneConfig, defaulting to standard.
+func getDesiredLoadBalancerSKU(cpConfig *apisazure.ControlPlaneConfig) apisazure.LoadBalancerSKU {
+	if cpConfig.LoadBalancer != nil && cpConfig.LoadBalancer.SKU != nil {
+		return *cpConfig.LoadBalancer.SKU
+	}
+	return apisazure.LoadBalancerSKUStandard
+}
+
+// getCurrentLoadBalancerSKU returns the load balancer SKU configured in the existing cloud-provider-config configmap.
+// It also returns whether such a configuration was found.
+func getCurrentLoadBalancerSKU(ctx context.Context, c client.Client, namespace string) (apisazure.LoadBalancerSKU, bool, error) {
 	var (
 		cm    = &corev1.ConfigMap{}
 		cmRef = kutil.Key(namespace, cloudProviderConfigMapName)
 	)
 	// Check if a cloud-provider-config configmap already exists.
-	// If this is not the case it can assume this is a new cluster and use standard LoadBalancers.
+	// If this is not the case it can assume this is a new cluster.
 	if err := c.Get(ctx, cmRef, cm); err != nil {
 		if apierrors.IsNotFound(err) {
-			return "standard", nil
+			return "", false, nil
 		}
-		return "", errors.Wrapf(err, "could not fetch existing %s configmap", cloudProviderConfigMapName)
+		return "", false, errors.Wrapf(err, "could not fetch existing %s configmap", cloudProviderConfigMapName)
 	}
 	data, ok := cm.Data[cloudProviderConfigMapKey]
 	if !ok {
-		return "standard", nil
+		return "", false, nil
 	}
 	// If the cloud-provider-config does not contain a LoadBalancer type configuration
-	// then it choose the basic LoadBalancers as they were the former default and
-	// there is no automatic migration path implemented.
+	// then it choose the basic LoadBalancers as they were the former default.
 	// Anyways it writes the usedLoadBalancer type now explictly to the cloud-privider-config.
-	if !strings.Contains(data, "loadBalancerSku") || strings.Contains(data, "loadBalancerSku: \"basic\"") {
-		return "basic", nil
+	if !strings.Contains(data, loadBalancerSkuKey) || strings.Contains(data, loadBalancerSkuKey+`: "basic"`) {
+		return apisazure.LoadBalancerSKUBasic, true, nil
 	}
-	return "standard", nil
+	return apisazure.LoadBalancerSKUStandard, true, nil
 }
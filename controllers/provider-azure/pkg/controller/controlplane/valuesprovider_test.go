@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 
 	apisazure "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/config"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
@@ -30,6 +31,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -414,6 +416,18 @@ var _ = Describe("ValuesProvider", func() {
 				},
 			},
 		}
+		csiCluster = &extensionscontroller.Cluster{
+			CoreShoot: &gardencorev1alpha1.Shoot{
+				Spec: gardencorev1alpha1.ShootSpec{
+					Networking: gardencorev1alpha1.Networking{
+						Pods: &cidr,
+					},
+					Kubernetes: gardencorev1alpha1.Kubernetes{
+						Version: "1.19.0",
+					},
+				},
+			},
+		}
 
 		cpSecretKey = client.ObjectKey{Namespace: namespace, Name: v1alpha1constants.SecretNameCloudProvider}
 		cpSecret    = &corev1.Secret{
@@ -445,6 +459,9 @@ var _ = Describe("ValuesProvider", func() {
 			azure.CloudProviderConfigName:             "08a7bc7fe8f59b055f173145e211760a83f02cf89635cef26ebb351378635606",
 			"cloud-controller-manager":                "3d791b164a808638da9a8df03924be2a41e34cd664e42231c00fe369e3588272",
 			"cloud-controller-manager-server":         "6dff2a2e6f14444b66d8e4a351c049f7e89ee24ba3eaab95dbec40ba6bdebb52",
+			csiProvisionerName:                        "65b1dac6b50673535cff480564c2e5c71077ed19b1b6e0e2291207225bdf77d4",
+			csiAttacherName:                           "2da58ad61c401a2af779a909d22fb42eed93a1524cbfdab974ceedb413fcb914",
+			csiResizerName:                            "a77e663ba1af340fb3dd7f6f8a1be47c7aa9e658198695480641e6b934c0b9ed",
 		}
 
 		configNonZonedClusterChartValues = map[string]interface{}{
@@ -478,19 +495,40 @@ var _ = Describe("ValuesProvider", func() {
 			"kubernetesVersion": "1.13.4",
 		}
 
-		ccmChartValues = map[string]interface{}{
-			"replicas":          1,
-			"clusterName":       namespace,
-			"kubernetesVersion": "1.13.4",
-			"podNetwork":        cidr,
-			"podAnnotations": map[string]interface{}{
-				"checksum/secret-cloud-controller-manager":        "3d791b164a808638da9a8df03924be2a41e34cd664e42231c00fe369e3588272",
-				"checksum/secret-cloud-controller-manager-server": "6dff2a2e6f14444b66d8e4a351c049f7e89ee24ba3eaab95dbec40ba6bdebb52",
-				"checksum/secret-cloudprovider":                   "8bafb35ff1ac60275d62e1cbd495aceb511fb354f74a20f7d06ecb48b3a68432",
-				"checksum/configmap-cloud-provider-config":        "08a7bc7fe8f59b055f173145e211760a83f02cf89635cef26ebb351378635606",
+		vpaChartValues = map[string]interface{}{
+			"enabled": true,
+			"updatePolicy": map[string]interface{}{
+				"updateMode": "Auto",
 			},
-			"featureGates": map[string]bool{
-				"CustomResourceValidation": true,
+		}
+		disabledChartValues = map[string]interface{}{
+			"enabled": false,
+		}
+
+		controlPlaneChartValues = map[string]interface{}{
+			"cloud-controller-manager": map[string]interface{}{
+				"replicas":          1,
+				"clusterName":       namespace,
+				"kubernetesVersion": "1.13.4",
+				"podNetwork":        cidr,
+				"podAnnotations": map[string]interface{}{
+					"checksum/secret-cloud-controller-manager":        "3d791b164a808638da9a8df03924be2a41e34cd664e42231c00fe369e3588272",
+					"checksum/secret-cloud-controller-manager-server": "6dff2a2e6f14444b66d8e4a351c049f7e89ee24ba3eaab95dbec40ba6bdebb52",
+					"checksum/secret-cloudprovider":                   "8bafb35ff1ac60275d62e1cbd495aceb511fb354f74a20f7d06ecb48b3a68432",
+					"checksum/configmap-cloud-provider-config":        "08a7bc7fe8f59b055f173145e211760a83f02cf89635cef26ebb351378635606",
+				},
+				"featureGates": map[string]bool{
+					"CustomResourceValidation": true,
+				},
+				"vpa":                 vpaChartValues,
+				"podDisruptionBudget": disabledChartValues,
+				"podAntiAffinity":     disabledChartValues,
+			},
+			"csi-driver-controller": map[string]interface{}{
+				"enabled":             false,
+				"vpa":                 vpaChartValues,
+				"podDisruptionBudget": disabledChartValues,
+				"podAntiAffinity":     disabledChartValues,
 			},
 		}
 
@@ -513,7 +551,7 @@ var _ = Describe("ValuesProvider", func() {
 			client.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
 
 			// Create valuesProvider
-			vp := NewValuesProvider(logger)
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
 			err := vp.(inject.Scheme).InjectScheme(scheme)
 			Expect(err).NotTo(HaveOccurred())
 			err = vp.(inject.Client).InjectClient(client)
@@ -534,7 +572,7 @@ var _ = Describe("ValuesProvider", func() {
 		client.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
 
 		// Create valuesProvider
-		vp := NewValuesProvider(logger)
+		vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
 		err := vp.(inject.Scheme).InjectScheme(scheme)
 		Expect(err).NotTo(HaveOccurred())
 		err = vp.(inject.Client).InjectClient(client)
@@ -547,6 +585,73 @@ var _ = Describe("ValuesProvider", func() {
 		Expect(values).To(Equal(configZonedClusterChartValues))
 	})
 
+	It("should return correct config chart values for standard load balancer outbound settings", func() {
+		var (
+			allocatedOutboundPorts int32 = 1024
+			idleTimeoutInMinutes   int32 = 30
+			excludeMaster                = true
+		)
+		cpWithLoadBalancer := cpZoned.DeepCopy()
+		cpWithLoadBalancer.Spec.ProviderConfig = &runtime.RawExtension{
+			Raw: encode(&apisazure.ControlPlaneConfig{
+				LoadBalancer: &apisazure.LoadBalancerConfig{
+					AllocatedOutboundPorts:      &allocatedOutboundPorts,
+					IdleTimeoutInMinutes:        &idleTimeoutInMinutes,
+					ExcludeMasterFromStandardLB: &excludeMaster,
+				},
+			}),
+		}
+
+		// Create mock client
+		client := mockclient.NewMockClient(ctrl)
+		client.EXPECT().Get(context.TODO(), cloudProviderConfigKey, &corev1.ConfigMap{}).DoAndReturn(clientGet(cloudProviderConfigMap))
+		client.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+
+		// Create valuesProvider
+		vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
+		err := vp.(inject.Scheme).InjectScheme(scheme)
+		Expect(err).NotTo(HaveOccurred())
+		err = vp.(inject.Client).InjectClient(client)
+		Expect(err).NotTo(HaveOccurred())
+
+		// Call GetConfigChartValues method and check the result
+		values, err := vp.GetConfigChartValues(context.TODO(), cpWithLoadBalancer, cluster)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(values).To(HaveKeyWithValue("loadBalancerAllocatedOutboundPorts", allocatedOutboundPorts))
+		Expect(values).To(HaveKeyWithValue("loadBalancerIdleTimeoutInMinutes", idleTimeoutInMinutes))
+		Expect(values).To(HaveKeyWithValue("excludeMasterFromStandardLB", true))
+	})
+
+	It("should return error if a NAT gateway is used with basic load balancers", func() {
+		infraStatus := &apisazure.InfrastructureStatus{}
+		Expect(json.Unmarshal(cp.Spec.InfrastructureProviderStatus.Raw, infraStatus)).To(Succeed())
+		infraStatus.Networks.NatGateway = &apisazure.NatGatewayStatus{ID: "nat-gateway-id"}
+
+		cpWithNatGateway := cp.DeepCopy()
+		cpWithNatGateway.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: encode(infraStatus)}
+
+		cm := cloudProviderConfigMap.DeepCopy()
+		cm.Data[cloudProviderConfigMapKey] = "loadBalancerSku: \"basic\""
+
+		// Create mock client
+		client := mockclient.NewMockClient(ctrl)
+		client.EXPECT().Get(context.TODO(), cloudProviderConfigKey, &corev1.ConfigMap{}).DoAndReturn(clientGet(cm))
+		client.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+
+		// Create valuesProvider
+		vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
+		err := vp.(inject.Scheme).InjectScheme(scheme)
+		Expect(err).NotTo(HaveOccurred())
+		err = vp.(inject.Client).InjectClient(client)
+		Expect(err).NotTo(HaveOccurred())
+
+		// Call GetConfigChartValues method and check the result
+		_, err = vp.GetConfigChartValues(context.TODO(), cpWithNatGateway, cluster)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("requires standard load balancers"))
+	})
+
 	Describe("#GetConfigChartValuesNoSubnet", func() {
 		It("should return error, missing subnet", func() {
 			// Create mock client
@@ -555,7 +660,7 @@ var _ = Describe("ValuesProvider", func() {
 			client.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
 
 			// Create valuesProvider
-			vp := NewValuesProvider(logger)
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
 			err := vp.(inject.Scheme).InjectScheme(scheme)
 			Expect(err).NotTo(HaveOccurred())
 			err = vp.(inject.Client).InjectClient(client)
@@ -576,7 +681,7 @@ var _ = Describe("ValuesProvider", func() {
 			client.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
 
 			// Create valuesProvider
-			vp := NewValuesProvider(logger)
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
 			err := vp.(inject.Scheme).InjectScheme(scheme)
 			Expect(err).NotTo(HaveOccurred())
 			err = vp.(inject.Client).InjectClient(client)
@@ -597,7 +702,7 @@ var _ = Describe("ValuesProvider", func() {
 			client.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
 
 			// Create valuesProvider
-			vp := NewValuesProvider(logger)
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
 			err := vp.(inject.Scheme).InjectScheme(scheme)
 			Expect(err).NotTo(HaveOccurred())
 			err = vp.(inject.Client).InjectClient(client)
@@ -618,7 +723,7 @@ var _ = Describe("ValuesProvider", func() {
 			client.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
 
 			// Create valuesProvider
-			vp := NewValuesProvider(logger)
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
 			err := vp.(inject.Scheme).InjectScheme(scheme)
 			Expect(err).NotTo(HaveOccurred())
 			err = vp.(inject.Client).InjectClient(client)
@@ -634,14 +739,112 @@ var _ = Describe("ValuesProvider", func() {
 	Describe("#GetControlPlaneChartValues", func() {
 		It("should return correct control plane chart values", func() {
 			// Create valuesProvider
-			vp := NewValuesProvider(logger)
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
 			err := vp.(inject.Scheme).InjectScheme(scheme)
 			Expect(err).NotTo(HaveOccurred())
 
 			// Call GetControlPlaneChartValues method and check the result
 			values, err := vp.GetControlPlaneChartValues(context.TODO(), cp, cluster, checksums, false)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(values).To(Equal(ccmChartValues))
+			Expect(values).To(Equal(controlPlaneChartValues))
+		})
+
+		It("should return correct control plane chart values if the CSI driver is used", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetControlPlaneChartValues method and check the result
+			values, err := vp.GetControlPlaneChartValues(context.TODO(), cp, csiCluster, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("csi-driver-controller", map[string]interface{}{
+				"enabled":  true,
+				"replicas": 1,
+				"podAnnotations": map[string]interface{}{
+					"checksum/secret-csi-provisioner":          "65b1dac6b50673535cff480564c2e5c71077ed19b1b6e0e2291207225bdf77d4",
+					"checksum/secret-csi-attacher":             "2da58ad61c401a2af779a909d22fb42eed93a1524cbfdab974ceedb413fcb914",
+					"checksum/secret-csi-resizer":              "a77e663ba1af340fb3dd7f6f8a1be47c7aa9e658198695480641e6b934c0b9ed",
+					"checksum/configmap-cloud-provider-config": "08a7bc7fe8f59b055f173145e211760a83f02cf89635cef26ebb351378635606",
+				},
+				"vpa":                 vpaChartValues,
+				"podDisruptionBudget": disabledChartValues,
+				"podAntiAffinity":     disabledChartValues,
+			}))
+		})
+	})
+
+	Describe("#GetControlPlaneShootChartValues", func() {
+		It("should return correct control plane shoot chart values", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
+
+			// Call GetControlPlaneShootChartValues method and check the result
+			values, err := vp.GetControlPlaneShootChartValues(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"csi-driver-node": map[string]interface{}{"enabled": false},
+			}))
+
+			values, err = vp.GetControlPlaneShootChartValues(context.TODO(), cp, csiCluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"csi-driver-node": map[string]interface{}{"enabled": true},
+			}))
+		})
+	})
+
+	Describe("#GetStorageClassesChartValues", func() {
+		It("should return correct storage classes chart values", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetStorageClassesChartValues method and check the result
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{"useCSI": false, "storageClasses": []map[string]interface{}{}, "customDefaultStorageClass": false}))
+
+			values, err = vp.GetStorageClassesChartValues(context.TODO(), cp, csiCluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{"useCSI": true, "storageClasses": []map[string]interface{}{}, "customDefaultStorageClass": false}))
+		})
+
+		It("should return correct storage classes chart values for additional storage classes", func() {
+			var (
+				retain    = corev1.PersistentVolumeReclaimRetain
+				isDefault = true
+			)
+			cpWithStorageClasses := cp.DeepCopy()
+			cpWithStorageClasses.Spec.ProviderConfig = &runtime.RawExtension{
+				Raw: encode(&apisazure.ControlPlaneConfig{
+					StorageClasses: []apisazure.StorageClass{
+						{Name: "fast", Type: "Premium_LRS", ReclaimPolicy: &retain, Default: &isDefault},
+					},
+				}),
+			}
+
+			// Create valuesProvider
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetStorageClassesChartValues method and check the result
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), cpWithStorageClasses, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"useCSI": false,
+				"storageClasses": []map[string]interface{}{
+					{
+						"name":          "fast",
+						"default":       true,
+						"reclaimPolicy": "Retain",
+						"parameters":    map[string]interface{}{"storageaccounttype": "Premium_LRS", "kind": "managed"},
+					},
+				},
+				"customDefaultStorageClass": true,
+			}))
 		})
 	})
 
@@ -651,9 +854,9 @@ var _ = Describe("ValuesProvider", func() {
 			c.EXPECT().Get(context.TODO(), cloudProviderConfigKey, &corev1.ConfigMap{}).DoAndReturn(clientGet(cloudProviderConfigMap))
 
 			vc := client.Client(c)
-			lbType, err := determineLoadBalancerType(context.TODO(), vc, namespace)
+			lbType, err := determineLoadBalancerType(context.TODO(), vc, namespace, &apisazure.ControlPlaneConfig{})
 			Expect(err).NotTo(HaveOccurred())
-			Expect(lbType).To(Equal("standard"))
+			Expect(lbType).To(Equal(apisazure.LoadBalancerSKUStandard))
 		})
 
 		It("should use standard load balancer, as cloud-provider-config is empty", func() {
@@ -669,9 +872,9 @@ var _ = Describe("ValuesProvider", func() {
 			c.EXPECT().Get(context.TODO(), cloudProviderConfigKey, &corev1.ConfigMap{}).DoAndReturn(clientGet(cm))
 
 			vc := client.Client(c)
-			lbType, err := determineLoadBalancerType(context.TODO(), vc, namespace)
+			lbType, err := determineLoadBalancerType(context.TODO(), vc, namespace, &apisazure.ControlPlaneConfig{})
 			Expect(err).NotTo(HaveOccurred())
-			Expect(lbType).To(Equal("standard"))
+			Expect(lbType).To(Equal(apisazure.LoadBalancerSKUStandard))
 		})
 
 		It("should use basic load balancer, as no lb config in cloud-provider-config", func() {
@@ -689,9 +892,9 @@ var _ = Describe("ValuesProvider", func() {
 			c.EXPECT().Get(context.TODO(), cloudProviderConfigKey, &corev1.ConfigMap{}).DoAndReturn(clientGet(cm))
 
 			vc := client.Client(c)
-			lbType, err := determineLoadBalancerType(context.TODO(), vc, namespace)
+			lbType, err := determineLoadBalancerType(context.TODO(), vc, namespace, &apisazure.ControlPlaneConfig{})
 			Expect(err).NotTo(HaveOccurred())
-			Expect(lbType).To(Equal("basic"))
+			Expect(lbType).To(Equal(apisazure.LoadBalancerSKUBasic))
 		})
 
 		It("should use basic load balancer, as configured in cloud-provider-config", func() {
@@ -709,9 +912,46 @@ var _ = Describe("ValuesProvider", func() {
 			c.EXPECT().Get(context.TODO(), cloudProviderConfigKey, &corev1.ConfigMap{}).DoAndReturn(clientGet(cm))
 
 			vc := client.Client(c)
-			lbType, err := determineLoadBalancerType(context.TODO(), vc, namespace)
+			lbType, err := determineLoadBalancerType(context.TODO(), vc, namespace, &apisazure.ControlPlaneConfig{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lbType).To(Equal(apisazure.LoadBalancerSKUBasic))
+		})
+
+		It("should use the configured load balancer SKU for new clusters", func() {
+			basic := apisazure.LoadBalancerSKUBasic
+
+			c := mockclient.NewMockClient(ctrl)
+			c.EXPECT().Get(context.TODO(), cloudProviderConfigKey, &corev1.ConfigMap{}).Return(apierrors.NewNotFound(corev1.Resource("configmaps"), cloudProviderConfigMapName))
+
+			vc := client.Client(c)
+			lbType, err := determineLoadBalancerType(context.TODO(), vc, namespace, &apisazure.ControlPlaneConfig{
+				LoadBalancer: &apisazure.LoadBalancerConfig{SKU: &basic},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lbType).To(Equal(apisazure.LoadBalancerSKUBasic))
+		})
+
+		It("should keep the load balancer SKU of existing clusters", func() {
+			standard := apisazure.LoadBalancerSKUStandard
+			var cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      cloudProviderConfigMapName,
+					Namespace: namespace,
+				},
+				Data: map[string]string{
+					cloudProviderConfigMapKey: "loadBalancerSku: \"basic\"",
+				},
+			}
+
+			c := mockclient.NewMockClient(ctrl)
+			c.EXPECT().Get(context.TODO(), cloudProviderConfigKey, &corev1.ConfigMap{}).DoAndReturn(clientGet(cm))
+
+			vc := client.Client(c)
+			lbType, err := determineLoadBalancerType(context.TODO(), vc, namespace, &apisazure.ControlPlaneConfig{
+				LoadBalancer: &apisazure.LoadBalancerConfig{SKU: &standard},
+			})
 			Expect(err).NotTo(HaveOccurred())
-			Expect(lbType).To(Equal("basic"))
+			Expect(lbType).To(Equal(apisazure.LoadBalancerSKUBasic))
 		})
 	})
 })
@@ -17,6 +17,7 @@ package infrastructure
 import (
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	azurev1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/v1alpha1"
 	azurev1alpha1helper "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/azure/v1alpha1/helper"
@@ -39,6 +40,8 @@ const (
 	TerraformerOutputKeyResourceGroupName = "resourceGroupName"
 	// TerraformerOutputKeyVNetName is the key for the vnetName output
 	TerraformerOutputKeyVNetName = "vnetName"
+	// TerraformerOutputKeyVNetResourceGroup is the key for the vnetResourceGroup output
+	TerraformerOutputKeyVNetResourceGroup = "vnetResourceGroup"
 	// TerraformerOutputKeySubnetName is the key for the subnetName output
 	TerraformerOutputKeySubnetName = "subnetName"
 	// TerraformerOutputKeyAvailabilitySetID is the key for the availabilitySetID output
@@ -49,6 +52,13 @@ const (
 	TerraformerOutputKeyRouteTableName = "routeTableName"
 	// TerraformerOutputKeySecurityGroupName is the key for the securityGroupName output
 	TerraformerOutputKeySecurityGroupName = "securityGroupName"
+	// TerraformerOutputKeyNatGatewayID is the key for the natGatewayID output
+	TerraformerOutputKeyNatGatewayID = "natGatewayID"
+	// TerraformerOutputKeyNatGatewayPublicIPAddresses is the key for the natGatewayPublicIPAddresses output
+	TerraformerOutputKeyNatGatewayPublicIPAddresses = "natGatewayPublicIPAddresses"
+
+	// DefaultNatGatewayIdleConnectionTimeoutMinutes is the default idle connection timeout of the NAT gateway.
+	DefaultNatGatewayIdleConnectionTimeoutMinutes int32 = 4
 )
 
 var (
@@ -112,6 +122,25 @@ func ComputeTerraformerChartValues(infra *extensionsv1alpha1.Infrastructure, cli
 		vnetCIDR = *config.Networks.VNet.CIDR
 	}
 
+	vnet := map[string]interface{}{
+		"name": vnetName,
+		"cidr": vnetCIDR,
+	}
+	// The existing VNet might live in a different resource group than the one of the shoot.
+	if config.Networks.VNet.ResourceGroup != nil {
+		vnet["resourceGroup"] = *config.Networks.VNet.ResourceGroup
+		outputKeys["vnetResourceGroup"] = TerraformerOutputKeyVNetResourceGroup
+	}
+
+	natGateway := map[string]interface{}{
+		"enabled": false,
+	}
+	if isNatGatewayEnabled(config) {
+		natGateway = computeNatGatewayValues(config.Networks.NatGateway)
+		outputKeys["natGatewayID"] = TerraformerOutputKeyNatGatewayID
+		outputKeys["natGatewayPublicIPAddresses"] = TerraformerOutputKeyNatGatewayPublicIPAddresses
+	}
+
 	// If the cluster is zoned, then we don't need to create an AvailabilitySet.
 	if !config.Zoned {
 		createAvailabilitySet = true
@@ -159,10 +188,7 @@ func ComputeTerraformerChartValues(infra *extensionsv1alpha1.Infrastructure, cli
 		},
 		"resourceGroup": map[string]interface{}{
 			"name": resourceGroupName,
-			"vnet": map[string]interface{}{
-				"name": vnetName,
-				"cidr": vnetCIDR,
-			},
+			"vnet": vnet,
 			"subnet": map[string]interface{}{
 				"serviceEndpoints": config.Networks.ServiceEndpoints,
 			},
@@ -171,10 +197,42 @@ func ComputeTerraformerChartValues(infra *extensionsv1alpha1.Infrastructure, cli
 		"networks": map[string]interface{}{
 			"worker": config.Networks.Workers,
 		},
+		"natGateway": natGateway,
 		"outputKeys": outputKeys,
 	}, nil
 }
 
+func isNatGatewayEnabled(config *azurev1alpha1.InfrastructureConfig) bool {
+	return config.Networks.NatGateway != nil && config.Networks.NatGateway.Enabled
+}
+
+func computeNatGatewayValues(natGateway *azurev1alpha1.NatGatewayConfig) map[string]interface{} {
+	var (
+		idleConnectionTimeoutMinutes = DefaultNatGatewayIdleConnectionTimeoutMinutes
+		ipAddresses                  = make([]map[string]interface{}, 0, len(natGateway.IPAddresses))
+	)
+
+	if natGateway.IdleConnectionTimeoutMinutes != nil {
+		idleConnectionTimeoutMinutes = *natGateway.IdleConnectionTimeoutMinutes
+	}
+	for _, ipAddress := range natGateway.IPAddresses {
+		ipAddresses = append(ipAddresses, map[string]interface{}{
+			"name":          ipAddress.Name,
+			"resourceGroup": ipAddress.ResourceGroup,
+		})
+	}
+
+	values := map[string]interface{}{
+		"enabled":                      true,
+		"idleConnectionTimeoutMinutes": idleConnectionTimeoutMinutes,
+		"ipAddresses":                  ipAddresses,
+	}
+	if natGateway.Zone != nil {
+		values["zone"] = *natGateway.Zone
+	}
+	return values
+}
+
 // RenderTerraformerChart renders the azure-infra chart with the given values.
 func RenderTerraformerChart(renderer chartrenderer.Interface, infra *extensionsv1alpha1.Infrastructure, clientAuth *internal.ClientAuth,
 	config *azurev1alpha1.InfrastructureConfig, cluster *controller.Cluster) (*TerraformFiles, error) {
@@ -206,6 +264,8 @@ type TerraformFiles struct {
 type TerraformState struct {
 	// VPCName is the name of the VNet created for an infrastructure.
 	VNetName string
+	// VNetResourceGroup is the name of the resource group of the VNet if it differs from ResourceGroupName.
+	VNetResourceGroup string
 	// ResourceGroupName is the name of the resource group.
 	ResourceGroupName string
 	// AvailabilitySetID is the ID for the created availability set.
@@ -218,6 +278,10 @@ type TerraformState struct {
 	RouteTableName string
 	// SecurityGroupName is the name of the security group.
 	SecurityGroupName string
+	// NatGatewayID is the id of the NAT gateway.
+	NatGatewayID string
+	// NatGatewayPublicIPAddresses are the public IP addresses attached to the NAT gateway.
+	NatGatewayPublicIPAddresses []string
 }
 
 // ExtractTerraformState extracts the TerraformState from the given Terraformer.
@@ -233,6 +297,12 @@ func ExtractTerraformState(tf *terraformer.Terraformer, config *azurev1alpha1.In
 	if !config.Zoned {
 		outputKeys = append(outputKeys, TerraformerOutputKeyAvailabilitySetID, TerraformerOutputKeyAvailabilitySetName)
 	}
+	if config.Networks.VNet.ResourceGroup != nil {
+		outputKeys = append(outputKeys, TerraformerOutputKeyVNetResourceGroup)
+	}
+	if isNatGatewayEnabled(config) {
+		outputKeys = append(outputKeys, TerraformerOutputKeyNatGatewayID, TerraformerOutputKeyNatGatewayPublicIPAddresses)
+	}
 
 	vars, err := tf.GetStateOutputVariables(outputKeys...)
 	if err != nil {
@@ -251,6 +321,13 @@ func ExtractTerraformState(tf *terraformer.Terraformer, config *azurev1alpha1.In
 		tfState.AvailabilitySetID = vars[TerraformerOutputKeyAvailabilitySetID]
 		tfState.AvailabilitySetName = vars[TerraformerOutputKeyAvailabilitySetName]
 	}
+	if config.Networks.VNet.ResourceGroup != nil {
+		tfState.VNetResourceGroup = vars[TerraformerOutputKeyVNetResourceGroup]
+	}
+	if isNatGatewayEnabled(config) {
+		tfState.NatGatewayID = vars[TerraformerOutputKeyNatGatewayID]
+		tfState.NatGatewayPublicIPAddresses = splitNonEmpty(vars[TerraformerOutputKeyNatGatewayPublicIPAddresses])
+	}
 	return &tfState, nil
 }
 
@@ -293,9 +370,31 @@ func StatusFromTerraformState(state *TerraformState) *azurev1alpha1.Infrastructu
 		})
 	}
 
+	if state.VNetResourceGroup != "" {
+		vnetResourceGroup := state.VNetResourceGroup
+		tfState.Networks.VNet.ResourceGroup = &vnetResourceGroup
+	}
+
+	if state.NatGatewayID != "" {
+		tfState.Networks.NatGateway = &azurev1alpha1.NatGatewayStatus{
+			ID:                state.NatGatewayID,
+			PublicIPAddresses: state.NatGatewayPublicIPAddresses,
+		}
+	}
+
 	return &tfState
 }
 
+func splitNonEmpty(value string) []string {
+	var out []string
+	for _, part := range strings.Split(value, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
+
 // ComputeStatus computes the status based on the Terraformer and the given InfrastructureConfig.
 func ComputeStatus(tf *terraformer.Terraformer, config *azurev1alpha1.InfrastructureConfig) (*azurev1alpha1.InfrastructureStatus, error) {
 	state, err := ExtractTerraformState(tf, config)
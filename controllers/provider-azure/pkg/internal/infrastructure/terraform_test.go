@@ -151,6 +151,9 @@ var _ = Describe("Terraform", func() {
 				"networks": map[string]interface{}{
 					"worker": config.Networks.Workers,
 				},
+				"natGateway": map[string]interface{}{
+					"enabled": false,
+				},
 				"outputKeys": map[string]interface{}{
 					"resourceGroupName": TerraformerOutputKeyResourceGroupName,
 					"vnetName":          TerraformerOutputKeyVNetName,
@@ -194,6 +197,9 @@ var _ = Describe("Terraform", func() {
 				"networks": map[string]interface{}{
 					"worker": config.Networks.Workers,
 				},
+				"natGateway": map[string]interface{}{
+					"enabled": false,
+				},
 				"outputKeys": map[string]interface{}{
 					"resourceGroupName":   TerraformerOutputKeyResourceGroupName,
 					"vnetName":            TerraformerOutputKeyVNetName,
@@ -206,6 +212,56 @@ var _ = Describe("Terraform", func() {
 			}
 			Expect(values).To(BeEquivalentTo(expectedValues))
 		})
+
+		It("should correctly compute the terraformer chart values for a vnet in a foreign resource group and a NAT gateway", func() {
+			var (
+				vnetResourceGroup       = "vnet-rg"
+				timeout           int32 = 20
+				zone              int32 = 2
+			)
+			config.Networks.VNet.ResourceGroup = &vnetResourceGroup
+			config.Networks.NatGateway = &azurev1alpha1.NatGatewayConfig{
+				Enabled:                      true,
+				IdleConnectionTimeoutMinutes: &timeout,
+				Zone:                         &zone,
+				IPAddresses: []azurev1alpha1.PublicIPReference{
+					{Name: "ip", ResourceGroup: "ip-rg"},
+				},
+			}
+
+			values, err := ComputeTerraformerChartValues(infra, clientAuth, config, cluster)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(values["resourceGroup"]).To(HaveKeyWithValue("vnet", map[string]interface{}{
+				"name":          *config.Networks.VNet.Name,
+				"cidr":          config.Networks.Workers,
+				"resourceGroup": vnetResourceGroup,
+			}))
+			Expect(values["natGateway"]).To(Equal(map[string]interface{}{
+				"enabled":                      true,
+				"idleConnectionTimeoutMinutes": timeout,
+				"zone":                         zone,
+				"ipAddresses": []map[string]interface{}{
+					{"name": "ip", "resourceGroup": "ip-rg"},
+				},
+			}))
+			Expect(values["outputKeys"]).To(And(
+				HaveKeyWithValue("vnetResourceGroup", TerraformerOutputKeyVNetResourceGroup),
+				HaveKeyWithValue("natGatewayID", TerraformerOutputKeyNatGatewayID),
+				HaveKeyWithValue("natGatewayPublicIPAddresses", TerraformerOutputKeyNatGatewayPublicIPAddresses),
+			))
+		})
+
+		It("should default the idle connection timeout of the NAT gateway", func() {
+			config.Networks.NatGateway = &azurev1alpha1.NatGatewayConfig{Enabled: true}
+
+			values, err := ComputeTerraformerChartValues(infra, clientAuth, config, cluster)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(values["natGateway"]).To(Equal(map[string]interface{}{
+				"enabled":                      true,
+				"idleConnectionTimeoutMinutes": DefaultNatGatewayIdleConnectionTimeoutMinutes,
+				"ipAddresses":                  []map[string]interface{}{},
+			}))
+		})
 	})
 
 	Describe("#StatusFromTerraformState", func() {
@@ -294,5 +350,21 @@ var _ = Describe("Terraform", func() {
 			}))
 		})
 
+		It("should correctly compute the status for a vnet in a foreign resource group and a NAT gateway", func() {
+			state.VNetResourceGroup = "vnet-rg"
+			state.NatGatewayID = "nat-id"
+			state.NatGatewayPublicIPAddresses = []string{"1.2.3.4", "5.6.7.8"}
+
+			status := StatusFromTerraformState(state)
+			Expect(status.Networks.VNet).To(Equal(azurev1alpha1.VNetStatus{
+				Name:          vnetName,
+				ResourceGroup: &state.VNetResourceGroup,
+			}))
+			Expect(status.Networks.NatGateway).To(Equal(&azurev1alpha1.NatGatewayStatus{
+				ID:                "nat-id",
+				PublicIPAddresses: []string{"1.2.3.4", "5.6.7.8"},
+			}))
+		})
+
 	})
 })
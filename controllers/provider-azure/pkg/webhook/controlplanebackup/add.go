@@ -16,14 +16,18 @@ package controlplanebackup
 
 import (
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/config"
+	confighelper "github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/apis/config/helper"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/azure"
 	"github.com/gardener/gardener-extensions/controllers/provider-azure/pkg/internal/imagevector"
 	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane/genericmutator"
 
+	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
 )
@@ -44,11 +48,14 @@ var logger = log.Log.WithName("azure-controlplanebackup-webhook")
 // AddToManagerWithOptions creates a webhook with the given options and adds it to the manager.
 func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) (*extensionswebhook.Webhook, error) {
 	logger.Info("Adding webhook to manager")
+	if errs := controlplane.ValidateBackupRestoreConfig(confighelper.GetBackupRestoreConfig(&opts.ETCDBackup), field.NewPath("etcd", "backup")); len(errs) > 0 {
+		return nil, errors.Wrap(errs.ToAggregate(), "invalid etcd backup configuration")
+	}
 	return controlplane.Add(mgr, controlplane.AddArgs{
 		Kind:     controlplane.KindBackup,
 		Provider: azure.Type,
 		Types:    []runtime.Object{&appsv1.StatefulSet{}},
-		Mutator:  genericmutator.NewMutator(NewEnsurer(&opts.ETCDBackup, imagevector.ImageVector(), logger), nil, nil, nil, logger),
+		Mutator:  genericmutator.NewMutator(NewEnsurer(&opts.ETCDBackup, imagevector.ImageVector(), serializer.NewCodecFactory(mgr.GetScheme()).UniversalDecoder(), logger), nil, nil, nil, logger),
 	})
 }
 
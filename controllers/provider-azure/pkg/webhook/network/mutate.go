@@ -24,7 +24,11 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
-func mutateNetworkConfig(network *extensionsv1alpha1.Network) error {
+// vxlanVethMTU is the MTU for pod interfaces when using the VXLAN backend. Azure VMs have an MTU of 1500 and VXLAN
+// encapsulation requires 50 bytes.
+const vxlanVethMTU int32 = 1450
+
+func mutateNetworkConfig(network, oldNetwork *extensionsv1alpha1.Network) error {
 	extensionswebhook.LogMutation(logger, "Network", network.Namespace, network.Name)
 
 	var (
@@ -46,10 +50,45 @@ func mutateNetworkConfig(network *extensionsv1alpha1.Network) error {
 		}
 	}
 
-	networkConfig.Backend = calicov1alpha1.None
+	// Azure does not support BGP nor IPIP, hence only VXLAN encapsulation or no overlay network at all can be used.
+	if networkConfig.Backend != calicov1alpha1.VXLan {
+		networkConfig.Backend = calicov1alpha1.None
+	}
+	// VXLAN encapsulation cannot be enabled or disabled once the default IPv4 pool has been created, hence the backend
+	// of an existing network is kept.
+	if oldNetwork != nil {
+		backend, err := getBackend(oldNetwork)
+		if err != nil {
+			return err
+		}
+		networkConfig.Backend = backend
+	}
+	ipipNever := calicov1alpha1.IPIPNever
+	networkConfig.IPIP = &ipipNever
+	if networkConfig.Backend == calicov1alpha1.VXLan && networkConfig.VethMTU == nil {
+		vethMTU := vxlanVethMTU
+		networkConfig.VethMTU = &vethMTU
+	}
+
 	network.Spec.ProviderConfig = &runtime.RawExtension{
 		Object: networkConfig,
 	}
 
 	return nil
 }
+
+// getBackend returns the backend the given network has been mutated to. It is the VXLAN backend if set, and no
+// backend otherwise.
+func getBackend(network *extensionsv1alpha1.Network) (calicov1alpha1.Backend, error) {
+	if network.Spec.ProviderConfig == nil {
+		return calicov1alpha1.None, nil
+	}
+	networkConfig, err := controller.CalicoNetworkConfigFromNetworkResource(network)
+	if err != nil {
+		return "", err
+	}
+	if networkConfig.Backend == calicov1alpha1.VXLan {
+		return calicov1alpha1.VXLan, nil
+	}
+	return calicov1alpha1.None, nil
+}
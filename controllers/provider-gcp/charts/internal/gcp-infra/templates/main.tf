@@ -29,6 +29,25 @@ resource "google_compute_subnetwork" "subnetwork-nodes" {
   ip_cidr_range = "{{ required "networks.worker is required" .Values.networks.worker }}"
   network       = "{{ required "vpc.name is required" .Values.vpc.name }}"
   region        = "{{ required "google.region is required" .Values.google.region }}"
+{{- if .Values.dualStack }}
+
+  stack_type       = "IPV4_IPV6"
+  ipv6_access_type = "EXTERNAL"
+{{- end }}
+{{- if .Values.networks.flowLogs }}
+
+  log_config {
+{{- if .Values.networks.flowLogs.aggregationInterval }}
+    aggregation_interval = "{{ .Values.networks.flowLogs.aggregationInterval }}"
+{{- end }}
+{{- if hasKey .Values.networks.flowLogs "flowSampling" }}
+    flow_sampling        = {{ .Values.networks.flowLogs.flowSampling }}
+{{- end }}
+{{- if .Values.networks.flowLogs.metadata }}
+    metadata             = "{{ .Values.networks.flowLogs.metadata }}"
+{{- end }}
+  }
+{{- end }}
 }
 
 {{ if .Values.networks.internal -}}
@@ -37,8 +56,82 @@ resource "google_compute_subnetwork" "subnetwork-internal" {
   ip_cidr_range = "{{ required "networks.internal is required" .Values.networks.internal }}"
   network       = "{{ required "vpc.name is required" .Values.vpc.name }}"
   region        = "{{ required "google.region is required" .Values.google.region }}"
+{{- if .Values.dualStack }}
+
+  stack_type       = "IPV4_IPV6"
+  ipv6_access_type = "EXTERNAL"
+{{- end }}
+{{- if .Values.networks.flowLogs }}
+
+  log_config {
+{{- if .Values.networks.flowLogs.aggregationInterval }}
+    aggregation_interval = "{{ .Values.networks.flowLogs.aggregationInterval }}"
+{{- end }}
+{{- if hasKey .Values.networks.flowLogs "flowSampling" }}
+    flow_sampling        = {{ .Values.networks.flowLogs.flowSampling }}
+{{- end }}
+{{- if .Values.networks.flowLogs.metadata }}
+    metadata             = "{{ .Values.networks.flowLogs.metadata }}"
+{{- end }}
+  }
+{{- end }}
 }
 {{- end}}
+
+{{ if .Values.networks.cloudNAT -}}
+//=====================================================================
+//= Cloud NAT
+//=====================================================================
+
+resource "google_compute_router" "router" {
+  name    = "{{ required "clusterName is required" .Values.clusterName }}-cloud-router"
+  region  = "{{ required "google.region is required" .Values.google.region }}"
+  network = "{{ required "vpc.name is required" .Values.vpc.name }}"
+}
+{{- range $index, $natIPName := .Values.networks.cloudNAT.natIPNames }}
+
+data "google_compute_address" "nat_ip_{{ $index }}" {
+  name   = "{{ $natIPName }}"
+  region = "{{ required "google.region is required" $.Values.google.region }}"
+}
+{{- end }}
+
+resource "google_compute_router_nat" "nat" {
+  name                               = "{{ required "clusterName is required" .Values.clusterName }}-cloud-nat"
+  router                             = "${google_compute_router.router.name}"
+  region                             = "{{ required "google.region is required" .Values.google.region }}"
+{{- if .Values.networks.cloudNAT.natIPNames }}
+  nat_ip_allocate_option             = "MANUAL_ONLY"
+  nat_ips                            = [
+{{- range $index, $natIPName := .Values.networks.cloudNAT.natIPNames }}
+    "${data.google_compute_address.nat_ip_{{ $index }}.self_link}",
+{{- end }}
+  ]
+{{- else }}
+  nat_ip_allocate_option             = "AUTO_ONLY"
+{{- end }}
+  source_subnetwork_ip_ranges_to_nat = "LIST_OF_SUBNETWORKS"
+  min_ports_per_vm                   = {{ required "networks.cloudNAT.minPortsPerVM is required" .Values.networks.cloudNAT.minPortsPerVM }}
+{{- if .Values.networks.cloudNAT.icmpIdleTimeoutSec }}
+  icmp_idle_timeout_sec              = {{ .Values.networks.cloudNAT.icmpIdleTimeoutSec }}
+{{- end }}
+{{- if .Values.networks.cloudNAT.tcpEstablishedIdleTimeoutSec }}
+  tcp_established_idle_timeout_sec   = {{ .Values.networks.cloudNAT.tcpEstablishedIdleTimeoutSec }}
+{{- end }}
+{{- if .Values.networks.cloudNAT.tcpTransitoryIdleTimeoutSec }}
+  tcp_transitory_idle_timeout_sec    = {{ .Values.networks.cloudNAT.tcpTransitoryIdleTimeoutSec }}
+{{- end }}
+{{- if .Values.networks.cloudNAT.udpIdleTimeoutSec }}
+  udp_idle_timeout_sec               = {{ .Values.networks.cloudNAT.udpIdleTimeoutSec }}
+{{- end }}
+
+  subnetwork {
+    name                    = "${google_compute_subnetwork.subnetwork-nodes.self_link}"
+    source_ip_ranges_to_nat = ["ALL_IP_RANGES"]
+  }
+}
+{{- end }}
+
 //=====================================================================
 //= Firewall
 //=====================================================================
@@ -102,6 +195,66 @@ resource "google_compute_firewall" "rule-allow-health-checks" {
     ports    = ["30000-32767"]
   }
 }
+{{- if .Values.dualStack }}
+
+// Allow IPv6 traffic within the node and internal subnets.
+resource "google_compute_firewall" "rule-allow-internal-access-ipv6" {
+  name          = "{{ required "clusterName is required" .Values.clusterName }}-allow-internal-access-ipv6"
+  network       = "{{ required "vpc.name is required" .Values.vpc.name }}"
+  source_ranges = [
+    "${google_compute_subnetwork.subnetwork-nodes.external_ipv6_prefix}",
+{{- if .Values.networks.internal }}
+    "${google_compute_subnetwork.subnetwork-internal.external_ipv6_prefix}",
+{{- end }}
+  ]
+
+  allow {
+    protocol = "58" // ICMPv6
+  }
+
+  allow {
+    protocol = "tcp"
+    ports    = ["1-65535"]
+  }
+
+  allow {
+    protocol = "udp"
+    ports    = ["1-65535"]
+  }
+}
+
+resource "google_compute_firewall" "rule-allow-external-access-ipv6" {
+  name          = "{{ required "clusterName is required" .Values.clusterName }}-allow-external-access-ipv6"
+  network       = "{{ required "vpc.name is required" .Values.vpc.name }}"
+  source_ranges = ["::/0"]
+
+  allow {
+    protocol = "tcp"
+    ports    = ["80", "443"] // Allow ingress
+  }
+}
+
+// Required to allow Google to perform IPv6 health checks on our instances.
+// https://cloud.google.com/load-balancing/docs/health-check-concepts#ip-ranges
+resource "google_compute_firewall" "rule-allow-health-checks-ipv6" {
+  name          = "{{ required "clusterName is required" .Values.clusterName }}-allow-health-checks-ipv6"
+  network       = "{{ required "vpc.name is required" .Values.vpc.name }}"
+  source_ranges = [
+    "2600:2d00:1:b029::/64",
+    "2600:2d00:1:1::/64",
+  ]
+
+  allow {
+    protocol = "tcp"
+    ports    = ["30000-32767"]
+  }
+
+  allow {
+    protocol = "udp"
+    ports    = ["30000-32767"]
+  }
+}
+{{- end }}
 
 // We have introduced new output variables. However, they are not applied for
 // existing clusters as Terraform won't detect a diff when we run `terraform plan`.
@@ -133,3 +286,23 @@ output "{{ .Values.outputKeys.subnetInternal }}" {
   value = "${google_compute_subnetwork.subnetwork-internal.name}"
 }
 {{- end}}
+{{- if .Values.dualStack }}
+
+output "{{ .Values.outputKeys.subnetNodesIPv6 }}" {
+  value = "${google_compute_subnetwork.subnetwork-nodes.external_ipv6_prefix}"
+}
+{{- if .Values.networks.internal }}
+
+output "{{ .Values.outputKeys.subnetInternalIPv6 }}" {
+  value = "${google_compute_subnetwork.subnetwork-internal.external_ipv6_prefix}"
+}
+{{- end}}
+{{- end}}
+{{- if .Values.networks.cloudNAT }}
+{{- range $index, $natIPName := .Values.networks.cloudNAT.natIPNames }}
+
+output "{{ $.Values.outputKeys.natIPPrefix }}{{ $index }}" {
+  value = "${data.google_compute_address.nat_ip_{{ $index }}.address}"
+}
+{{- end }}
+{{- end }}
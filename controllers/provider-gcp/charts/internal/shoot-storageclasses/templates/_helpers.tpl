@@ -5,3 +5,11 @@ storage.k8s.io/v1
 storage.k8s.io/v1beta1
 {{- end -}}
 {{- end -}}
+
+{{- define "provisioner" -}}
+{{- if .Values.useCSI -}}
+pd.csi.storage.gke.io
+{{- else -}}
+kubernetes.io/gce-pd
+{{- end -}}
+{{- end -}}
@@ -60,6 +60,8 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 		backupEntryCtrlOpts = &controllercmd.ControllerOptions{
 			MaxConcurrentReconciles: 5,
 		}
+		backupEntryDeletionOpts       = &controllercmd.DeletionOptions{}
+		backupEntryCtrlOptsUnprefixed = controllercmd.NewOptionAggregator(backupEntryCtrlOpts, backupEntryDeletionOpts)
 
 		// options for the controlplane controller
 		controlPlaneCtrlOpts = &controllercmd.ControllerOptions{
@@ -95,7 +97,7 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			restOpts,
 			mgrOpts,
 			controllercmd.PrefixOption("backupbucket-", backupBucketCtrlOpts),
-			controllercmd.PrefixOption("backupentry-", backupEntryCtrlOpts),
+			controllercmd.PrefixOption("backupentry-", &backupEntryCtrlOptsUnprefixed),
 			controllercmd.PrefixOption("controlplane-", controlPlaneCtrlOpts),
 			controllercmd.PrefixOption("infrastructure-", infraCtrlOpts),
 			controllercmd.PrefixOption("worker-", &workerCtrlOptsUnprefixed),
@@ -136,10 +138,14 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			}
 
 			configFileOpts.Completed().ApplyMachineImages(&gcpworker.DefaultAddOptions.MachineImages)
+			configFileOpts.Completed().ApplyControlPlaneComponents(&gcpcontrolplane.DefaultAddOptions.ControlPlaneComponents)
+			configFileOpts.Completed().ApplyControlPlaneComponents(&gcpworker.DefaultAddOptions.ControlPlaneComponents)
 			configFileOpts.Completed().ApplyETCDStorage(&gcpcontrolplaneexposure.DefaultAddOptions.ETCDStorage)
 			configFileOpts.Completed().ApplyETCDBackup(&gcpcontrolplanebackup.DefaultAddOptions.ETCDBackup)
+			configFileOpts.Completed().ApplyBackupBucketConfig(&gcpbackupbucket.DefaultAddOptions.BackupBucketConfig)
 			backupBucketCtrlOpts.Completed().Apply(&gcpbackupbucket.DefaultAddOptions.Controller)
 			backupEntryCtrlOpts.Completed().Apply(&gcpbackupentry.DefaultAddOptions.Controller)
+			backupEntryDeletionOpts.Completed().Apply(&gcpbackupentry.DefaultAddOptions.Deletion.GracePeriod, &gcpbackupentry.DefaultAddOptions.Deletion.GarbageCollectionPeriod)
 			controlPlaneCtrlOpts.Completed().Apply(&gcpcontrolplane.DefaultAddOptions.Controller)
 			infraCtrlOpts.Completed().Apply(&gcpinfrastructure.DefaultAddOptions.Controller)
 			reconcileOpts.Completed().Apply(&gcpinfrastructure.DefaultAddOptions.IgnoreOperationAnnotation)
@@ -18,6 +18,7 @@ import (
 	"fmt"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane"
 )
 
 // FindSubnetByPurpose takes a list of subnets and tries to find the first entry
@@ -43,3 +44,18 @@ func FindMachineImage(machineImages []gcp.MachineImage, name, version string) (*
 	}
 	return nil, fmt.Errorf("no machine image with name %q, version %q found", name, version)
 }
+
+// GetBackupRestoreConfig returns the backup-restore configuration for the given etcd backup configuration of a shoot.
+func GetBackupRestoreConfig(etcdBackup *gcp.ETCDBackupConfig) *controlplane.BackupRestoreConfig {
+	if etcdBackup == nil {
+		return nil
+	}
+	return &controlplane.BackupRestoreConfig{
+		DeltaSnapshotPeriod:      etcdBackup.DeltaSnapshotPeriod,
+		DeltaSnapshotMemoryLimit: etcdBackup.DeltaSnapshotMemoryLimit,
+		GarbageCollectionPeriod:  etcdBackup.GarbageCollectionPeriod,
+		GarbageCollectionPolicy:  etcdBackup.GarbageCollectionPolicy,
+		MaxBackups:               etcdBackup.MaxBackups,
+		Resources:                etcdBackup.Resources,
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackupBucketConfig contains configuration settings for the backup bucket.
+type BackupBucketConfig struct {
+	metav1.TypeMeta
+
+	// Versioning indicates whether object versioning shall be enabled for the bucket.
+	Versioning *bool
+
+	// Retention contains the retention policy of the bucket. It cannot be combined with versioning.
+	Retention *RetentionConfig
+
+	// Encryption contains the default encryption settings for the bucket.
+	Encryption *EncryptionConfig
+
+	// Lifecycle contains the expiry rules for objects in the bucket.
+	Lifecycle *LifecycleConfig
+}
+
+// RetentionConfig contains the retention policy of the bucket.
+type RetentionConfig struct {
+	// Days is the number of days objects cannot be deleted or replaced after they were created.
+	Days int32
+
+	// Locked indicates whether the retention policy shall be locked. A locked retention policy cannot be removed
+	// and its retention period cannot be reduced anymore. Deleting the backup bucket fails until
+	// the retention period of all objects has expired.
+	Locked *bool
+}
+
+// EncryptionConfig contains the default encryption settings for the bucket.
+type EncryptionConfig struct {
+	// KMSKeyName is the name of the Cloud KMS key used for encrypting the objects.
+	KMSKeyName string
+}
+
+// LifecycleConfig contains the expiry rules for objects in the bucket.
+type LifecycleConfig struct {
+	// ExpirationDays is the number of days after which objects are deleted.
+	ExpirationDays *int32
+
+	// NumNewerVersions is the number of newer versions after which noncurrent object versions are deleted.
+	NumNewerVersions *int32
+}
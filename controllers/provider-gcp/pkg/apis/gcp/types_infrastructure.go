@@ -36,6 +36,56 @@ type NetworkConfig struct {
 	Internal *string
 	// Workers is the worker subnet range to create (used for the VMs).
 	Worker string
+	// DualStack contains the IPv6 settings of the subnets.
+	DualStack *DualStack
+	// CloudNAT contains the configuration of the Cloud NAT which is used by the nodes for egress traffic.
+	// If it is not set, no Cloud NAT is created.
+	CloudNAT *CloudNAT
+	// FlowLogs contains the VPC flow log configuration for the worker and internal subnets.
+	FlowLogs *FlowLogs
+}
+
+// DualStack contains the IPv6 settings of the subnets.
+type DualStack struct {
+	// Enabled indicates whether the subnets are created with IPv4 and IPv6 stack, and whether
+	// IPv6 firewall rules are created. Machines do not get IPv6 addresses yet as the machine-controller-manager does
+	// not support them.
+	Enabled bool
+}
+
+// CloudNAT contains the configuration of the Cloud NAT.
+type CloudNAT struct {
+	// MinPortsPerVM is the minimum number of ports allocated to a VM in the NAT config.
+	// The default value is 2048 ports.
+	MinPortsPerVM *int32
+	// NatIPNames is a list of names of user provided external (premium) IP addresses which are used by the Cloud NAT.
+	// If none are given, the IP addresses are allocated automatically.
+	NatIPNames []NatIPName
+	// IcmpIdleTimeoutSec is the timeout (in seconds) for ICMP connections.
+	IcmpIdleTimeoutSec *int32
+	// TCPEstablishedIdleTimeoutSec is the timeout (in seconds) for established TCP connections.
+	TCPEstablishedIdleTimeoutSec *int32
+	// TCPTransitoryIdleTimeoutSec is the timeout (in seconds) for transitory TCP connections.
+	TCPTransitoryIdleTimeoutSec *int32
+	// UDPIdleTimeoutSec is the timeout (in seconds) for UDP connections.
+	UDPIdleTimeoutSec *int32
+}
+
+// NatIPName is the name of a user provided external IP address.
+type NatIPName struct {
+	// Name of the external IP address.
+	Name string
+}
+
+// FlowLogs contains the configuration of the VPC flow logs.
+type FlowLogs struct {
+	// AggregationInterval is the interval for collecting flow logs, e.g. INTERVAL_5_SEC.
+	AggregationInterval *string
+	// FlowSampling is the sampling rate of the VPC flow logs within the subnetwork, between 0.0 and 1.0.
+	FlowSampling *float32
+	// Metadata configures whether metadata fields should be added to the reported VPC flow logs,
+	// e.g. INCLUDE_ALL_METADATA.
+	Metadata *string
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -58,6 +108,15 @@ type NetworkStatus struct {
 
 	// Subnets are the subnets that have been created.
 	Subnets []Subnet
+
+	// NatIPs is a list of the external IP addresses used by the Cloud NAT.
+	NatIPs []NatIP
+}
+
+// NatIP is an external IP address used by the Cloud NAT.
+type NatIP struct {
+	// IP is the external IP address.
+	IP string
 }
 
 // SubnetPurpose is a purpose of a subnet.
@@ -76,6 +135,8 @@ type Subnet struct {
 	Name string
 	// Purpose is the purpose for which the subnet was created.
 	Purpose SubnetPurpose
+	// IPv6CIDR is the IPv6 range of the subnet (only set for dual-stack infrastructures).
+	IPv6CIDR string
 }
 
 // VPC contains information about the VPC and some related resources.
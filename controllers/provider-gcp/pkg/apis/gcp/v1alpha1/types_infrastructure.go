@@ -38,6 +38,68 @@ type NetworkConfig struct {
 	Internal *string `json:"internal,omitempty"`
 	// Workers is the worker subnet range to create (used for the VMs).
 	Worker string `json:"worker"`
+	// DualStack contains the IPv6 settings of the subnets.
+	// +optional
+	DualStack *DualStack `json:"dualStack,omitempty"`
+	// CloudNAT contains the configuration of the Cloud NAT which is used by the nodes for egress traffic.
+	// If it is not set, no Cloud NAT is created.
+	// +optional
+	CloudNAT *CloudNAT `json:"cloudNAT,omitempty"`
+	// FlowLogs contains the VPC flow log configuration for the worker and internal subnets.
+	// +optional
+	FlowLogs *FlowLogs `json:"flowLogs,omitempty"`
+}
+
+// DualStack contains the IPv6 settings of the subnets.
+type DualStack struct {
+	// Enabled indicates whether the subnets are created with IPv4 and IPv6 stack, and whether
+	// IPv6 firewall rules are created. Machines do not get IPv6 addresses yet as the machine-controller-manager does
+	// not support them.
+	Enabled bool `json:"enabled"`
+}
+
+// CloudNAT contains the configuration of the Cloud NAT.
+type CloudNAT struct {
+	// MinPortsPerVM is the minimum number of ports allocated to a VM in the NAT config.
+	// The default value is 2048 ports.
+	// +optional
+	MinPortsPerVM *int32 `json:"minPortsPerVM,omitempty"`
+	// NatIPNames is a list of names of user provided external (premium) IP addresses which are used by the Cloud NAT.
+	// If none are given, the IP addresses are allocated automatically.
+	// +optional
+	NatIPNames []NatIPName `json:"natIPNames,omitempty"`
+	// IcmpIdleTimeoutSec is the timeout (in seconds) for ICMP connections.
+	// +optional
+	IcmpIdleTimeoutSec *int32 `json:"icmpIdleTimeoutSec,omitempty"`
+	// TCPEstablishedIdleTimeoutSec is the timeout (in seconds) for established TCP connections.
+	// +optional
+	TCPEstablishedIdleTimeoutSec *int32 `json:"tcpEstablishedIdleTimeoutSec,omitempty"`
+	// TCPTransitoryIdleTimeoutSec is the timeout (in seconds) for transitory TCP connections.
+	// +optional
+	TCPTransitoryIdleTimeoutSec *int32 `json:"tcpTransitoryIdleTimeoutSec,omitempty"`
+	// UDPIdleTimeoutSec is the timeout (in seconds) for UDP connections.
+	// +optional
+	UDPIdleTimeoutSec *int32 `json:"udpIdleTimeoutSec,omitempty"`
+}
+
+// NatIPName is the name of a user provided external IP address.
+type NatIPName struct {
+	// Name of the external IP address.
+	Name string `json:"name"`
+}
+
+// FlowLogs contains the configuration of the VPC flow logs.
+type FlowLogs struct {
+	// AggregationInterval is the interval for collecting flow logs, e.g. INTERVAL_5_SEC.
+	// +optional
+	AggregationInterval *string `json:"aggregationInterval,omitempty"`
+	// FlowSampling is the sampling rate of the VPC flow logs within the subnetwork, between 0.0 and 1.0.
+	// +optional
+	FlowSampling *float32 `json:"flowSampling,omitempty"`
+	// Metadata configures whether metadata fields should be added to the reported VPC flow logs,
+	// e.g. INCLUDE_ALL_METADATA.
+	// +optional
+	Metadata *string `json:"metadata,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -60,6 +122,16 @@ type NetworkStatus struct {
 
 	// Subnets are the subnets that have been created.
 	Subnets []Subnet `json:"subnets"`
+
+	// NatIPs is a list of the external IP addresses used by the Cloud NAT.
+	// +optional
+	NatIPs []NatIP `json:"natIPs,omitempty"`
+}
+
+// NatIP is an external IP address used by the Cloud NAT.
+type NatIP struct {
+	// IP is the external IP address.
+	IP string `json:"ip"`
 }
 
 // SubnetPurpose is a purpose of a subnet.
@@ -78,6 +150,9 @@ type Subnet struct {
 	Name string `json:"name"`
 	// Purpose is the purpose for which the subnet was created.
 	Purpose SubnetPurpose `json:"purpose"`
+	// IPv6CIDR is the IPv6 range of the subnet (only set for dual-stack infrastructures).
+	// +optional
+	IPv6CIDR string `json:"ipv6CIDR,omitempty"`
 }
 
 // VPC contains information about the VPC and some related resources.
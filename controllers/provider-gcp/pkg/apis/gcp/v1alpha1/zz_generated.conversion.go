@@ -24,6 +24,10 @@ import (
 	unsafe "unsafe"
 
 	gcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -35,6 +39,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*BackupBucketConfig)(nil), (*gcp.BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketConfig_To_gcp_BackupBucketConfig(a.(*BackupBucketConfig), b.(*gcp.BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.BackupBucketConfig)(nil), (*BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(a.(*gcp.BackupBucketConfig), b.(*BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudControllerManagerConfig)(nil), (*gcp.CloudControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudControllerManagerConfig_To_gcp_CloudControllerManagerConfig(a.(*CloudControllerManagerConfig), b.(*gcp.CloudControllerManagerConfig), scope)
 	}); err != nil {
@@ -45,6 +59,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CloudNAT)(nil), (*gcp.CloudNAT)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloudNAT_To_gcp_CloudNAT(a.(*CloudNAT), b.(*gcp.CloudNAT), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.CloudNAT)(nil), (*CloudNAT)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_CloudNAT_To_v1alpha1_CloudNAT(a.(*gcp.CloudNAT), b.(*CloudNAT), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudProfileConfig)(nil), (*gcp.CloudProfileConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudProfileConfig_To_gcp_CloudProfileConfig(a.(*CloudProfileConfig), b.(*gcp.CloudProfileConfig), scope)
 	}); err != nil {
@@ -65,6 +89,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DualStack)(nil), (*gcp.DualStack)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DualStack_To_gcp_DualStack(a.(*DualStack), b.(*gcp.DualStack), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.DualStack)(nil), (*DualStack)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_DualStack_To_v1alpha1_DualStack(a.(*gcp.DualStack), b.(*DualStack), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ETCDBackupConfig)(nil), (*gcp.ETCDBackupConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ETCDBackupConfig_To_gcp_ETCDBackupConfig(a.(*ETCDBackupConfig), b.(*gcp.ETCDBackupConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.ETCDBackupConfig)(nil), (*ETCDBackupConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_ETCDBackupConfig_To_v1alpha1_ETCDBackupConfig(a.(*gcp.ETCDBackupConfig), b.(*ETCDBackupConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EncryptionConfig)(nil), (*gcp.EncryptionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EncryptionConfig_To_gcp_EncryptionConfig(a.(*EncryptionConfig), b.(*gcp.EncryptionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.EncryptionConfig)(nil), (*EncryptionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_EncryptionConfig_To_v1alpha1_EncryptionConfig(a.(*gcp.EncryptionConfig), b.(*EncryptionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FlowLogs)(nil), (*gcp.FlowLogs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FlowLogs_To_gcp_FlowLogs(a.(*FlowLogs), b.(*gcp.FlowLogs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.FlowLogs)(nil), (*FlowLogs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_FlowLogs_To_v1alpha1_FlowLogs(a.(*gcp.FlowLogs), b.(*FlowLogs), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InfrastructureConfig)(nil), (*gcp.InfrastructureConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InfrastructureConfig_To_gcp_InfrastructureConfig(a.(*InfrastructureConfig), b.(*gcp.InfrastructureConfig), scope)
 	}); err != nil {
@@ -85,6 +149,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*LifecycleConfig)(nil), (*gcp.LifecycleConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LifecycleConfig_To_gcp_LifecycleConfig(a.(*LifecycleConfig), b.(*gcp.LifecycleConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.LifecycleConfig)(nil), (*LifecycleConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_LifecycleConfig_To_v1alpha1_LifecycleConfig(a.(*gcp.LifecycleConfig), b.(*LifecycleConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineImage)(nil), (*gcp.MachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachineImage_To_gcp_MachineImage(a.(*MachineImage), b.(*gcp.MachineImage), scope)
 	}); err != nil {
@@ -115,6 +189,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NatIP)(nil), (*gcp.NatIP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NatIP_To_gcp_NatIP(a.(*NatIP), b.(*gcp.NatIP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.NatIP)(nil), (*NatIP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_NatIP_To_v1alpha1_NatIP(a.(*gcp.NatIP), b.(*NatIP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NatIPName)(nil), (*gcp.NatIPName)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NatIPName_To_gcp_NatIPName(a.(*NatIPName), b.(*gcp.NatIPName), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.NatIPName)(nil), (*NatIPName)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_NatIPName_To_v1alpha1_NatIPName(a.(*gcp.NatIPName), b.(*NatIPName), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NetworkConfig)(nil), (*gcp.NetworkConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NetworkConfig_To_gcp_NetworkConfig(a.(*NetworkConfig), b.(*gcp.NetworkConfig), scope)
 	}); err != nil {
@@ -135,6 +229,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RetentionConfig)(nil), (*gcp.RetentionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RetentionConfig_To_gcp_RetentionConfig(a.(*RetentionConfig), b.(*gcp.RetentionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.RetentionConfig)(nil), (*RetentionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_RetentionConfig_To_v1alpha1_RetentionConfig(a.(*gcp.RetentionConfig), b.(*RetentionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StorageClass)(nil), (*gcp.StorageClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageClass_To_gcp_StorageClass(a.(*StorageClass), b.(*gcp.StorageClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.StorageClass)(nil), (*StorageClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_StorageClass_To_v1alpha1_StorageClass(a.(*gcp.StorageClass), b.(*StorageClass), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Subnet)(nil), (*gcp.Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Subnet_To_gcp_Subnet(a.(*Subnet), b.(*gcp.Subnet), scope)
 	}); err != nil {
@@ -168,8 +282,39 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha1_BackupBucketConfig_To_gcp_BackupBucketConfig(in *BackupBucketConfig, out *gcp.BackupBucketConfig, s conversion.Scope) error {
+	out.Versioning = (*bool)(unsafe.Pointer(in.Versioning))
+	out.Retention = (*gcp.RetentionConfig)(unsafe.Pointer(in.Retention))
+	out.Encryption = (*gcp.EncryptionConfig)(unsafe.Pointer(in.Encryption))
+	out.Lifecycle = (*gcp.LifecycleConfig)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketConfig_To_gcp_BackupBucketConfig is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketConfig_To_gcp_BackupBucketConfig(in *BackupBucketConfig, out *gcp.BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketConfig_To_gcp_BackupBucketConfig(in, out, s)
+}
+
+func autoConvert_gcp_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *gcp.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	out.Versioning = (*bool)(unsafe.Pointer(in.Versioning))
+	out.Retention = (*RetentionConfig)(unsafe.Pointer(in.Retention))
+	out.Encryption = (*EncryptionConfig)(unsafe.Pointer(in.Encryption))
+	out.Lifecycle = (*LifecycleConfig)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_gcp_BackupBucketConfig_To_v1alpha1_BackupBucketConfig is an autogenerated conversion function.
+func Convert_gcp_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *gcp.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_gcp_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudControllerManagerConfig_To_gcp_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *gcp.CloudControllerManagerConfig, s conversion.Scope) error {
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.ConcurrentServiceSyncs = (*int32)(unsafe.Pointer(in.ConcurrentServiceSyncs))
+	out.NodeMonitorPeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorPeriod))
+	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
+	out.RouteReconciliationPeriod = (*v1.Duration)(unsafe.Pointer(in.RouteReconciliationPeriod))
+	out.ConfigureCloudRoutes = (*bool)(unsafe.Pointer(in.ConfigureCloudRoutes))
 	return nil
 }
 
@@ -180,6 +325,11 @@ func Convert_v1alpha1_CloudControllerManagerConfig_To_gcp_CloudControllerManager
 
 func autoConvert_gcp_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in *gcp.CloudControllerManagerConfig, out *CloudControllerManagerConfig, s conversion.Scope) error {
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.ConcurrentServiceSyncs = (*int32)(unsafe.Pointer(in.ConcurrentServiceSyncs))
+	out.NodeMonitorPeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorPeriod))
+	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
+	out.RouteReconciliationPeriod = (*v1.Duration)(unsafe.Pointer(in.RouteReconciliationPeriod))
+	out.ConfigureCloudRoutes = (*bool)(unsafe.Pointer(in.ConfigureCloudRoutes))
 	return nil
 }
 
@@ -188,6 +338,36 @@ func Convert_gcp_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManager
 	return autoConvert_gcp_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_CloudNAT_To_gcp_CloudNAT(in *CloudNAT, out *gcp.CloudNAT, s conversion.Scope) error {
+	out.MinPortsPerVM = (*int32)(unsafe.Pointer(in.MinPortsPerVM))
+	out.NatIPNames = *(*[]gcp.NatIPName)(unsafe.Pointer(&in.NatIPNames))
+	out.IcmpIdleTimeoutSec = (*int32)(unsafe.Pointer(in.IcmpIdleTimeoutSec))
+	out.TCPEstablishedIdleTimeoutSec = (*int32)(unsafe.Pointer(in.TCPEstablishedIdleTimeoutSec))
+	out.TCPTransitoryIdleTimeoutSec = (*int32)(unsafe.Pointer(in.TCPTransitoryIdleTimeoutSec))
+	out.UDPIdleTimeoutSec = (*int32)(unsafe.Pointer(in.UDPIdleTimeoutSec))
+	return nil
+}
+
+// Convert_v1alpha1_CloudNAT_To_gcp_CloudNAT is an autogenerated conversion function.
+func Convert_v1alpha1_CloudNAT_To_gcp_CloudNAT(in *CloudNAT, out *gcp.CloudNAT, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudNAT_To_gcp_CloudNAT(in, out, s)
+}
+
+func autoConvert_gcp_CloudNAT_To_v1alpha1_CloudNAT(in *gcp.CloudNAT, out *CloudNAT, s conversion.Scope) error {
+	out.MinPortsPerVM = (*int32)(unsafe.Pointer(in.MinPortsPerVM))
+	out.NatIPNames = *(*[]NatIPName)(unsafe.Pointer(&in.NatIPNames))
+	out.IcmpIdleTimeoutSec = (*int32)(unsafe.Pointer(in.IcmpIdleTimeoutSec))
+	out.TCPEstablishedIdleTimeoutSec = (*int32)(unsafe.Pointer(in.TCPEstablishedIdleTimeoutSec))
+	out.TCPTransitoryIdleTimeoutSec = (*int32)(unsafe.Pointer(in.TCPTransitoryIdleTimeoutSec))
+	out.UDPIdleTimeoutSec = (*int32)(unsafe.Pointer(in.UDPIdleTimeoutSec))
+	return nil
+}
+
+// Convert_gcp_CloudNAT_To_v1alpha1_CloudNAT is an autogenerated conversion function.
+func Convert_gcp_CloudNAT_To_v1alpha1_CloudNAT(in *gcp.CloudNAT, out *CloudNAT, s conversion.Scope) error {
+	return autoConvert_gcp_CloudNAT_To_v1alpha1_CloudNAT(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudProfileConfig_To_gcp_CloudProfileConfig(in *CloudProfileConfig, out *gcp.CloudProfileConfig, s conversion.Scope) error {
 	out.MachineImages = *(*[]gcp.MachineImages)(unsafe.Pointer(&in.MachineImages))
 	return nil
@@ -211,6 +391,8 @@ func Convert_gcp_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *gcp.Cloud
 func autoConvert_v1alpha1_ControlPlaneConfig_To_gcp_ControlPlaneConfig(in *ControlPlaneConfig, out *gcp.ControlPlaneConfig, s conversion.Scope) error {
 	out.Zone = in.Zone
 	out.CloudControllerManager = (*gcp.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.StorageClasses = *(*[]gcp.StorageClass)(unsafe.Pointer(&in.StorageClasses))
+	out.ETCDBackup = (*gcp.ETCDBackupConfig)(unsafe.Pointer(in.ETCDBackup))
 	return nil
 }
 
@@ -222,6 +404,8 @@ func Convert_v1alpha1_ControlPlaneConfig_To_gcp_ControlPlaneConfig(in *ControlPl
 func autoConvert_gcp_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *gcp.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
 	out.Zone = in.Zone
 	out.CloudControllerManager = (*CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.StorageClasses = *(*[]StorageClass)(unsafe.Pointer(&in.StorageClasses))
+	out.ETCDBackup = (*ETCDBackupConfig)(unsafe.Pointer(in.ETCDBackup))
 	return nil
 }
 
@@ -230,6 +414,100 @@ func Convert_gcp_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *gcp.Contr
 	return autoConvert_gcp_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_DualStack_To_gcp_DualStack(in *DualStack, out *gcp.DualStack, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_DualStack_To_gcp_DualStack is an autogenerated conversion function.
+func Convert_v1alpha1_DualStack_To_gcp_DualStack(in *DualStack, out *gcp.DualStack, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DualStack_To_gcp_DualStack(in, out, s)
+}
+
+func autoConvert_gcp_DualStack_To_v1alpha1_DualStack(in *gcp.DualStack, out *DualStack, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_gcp_DualStack_To_v1alpha1_DualStack is an autogenerated conversion function.
+func Convert_gcp_DualStack_To_v1alpha1_DualStack(in *gcp.DualStack, out *DualStack, s conversion.Scope) error {
+	return autoConvert_gcp_DualStack_To_v1alpha1_DualStack(in, out, s)
+}
+
+func autoConvert_v1alpha1_ETCDBackupConfig_To_gcp_ETCDBackupConfig(in *ETCDBackupConfig, out *gcp.ETCDBackupConfig, s conversion.Scope) error {
+	out.DeltaSnapshotPeriod = (*v1.Duration)(unsafe.Pointer(in.DeltaSnapshotPeriod))
+	out.DeltaSnapshotMemoryLimit = (*resource.Quantity)(unsafe.Pointer(in.DeltaSnapshotMemoryLimit))
+	out.GarbageCollectionPeriod = (*v1.Duration)(unsafe.Pointer(in.GarbageCollectionPeriod))
+	out.GarbageCollectionPolicy = (*string)(unsafe.Pointer(in.GarbageCollectionPolicy))
+	out.MaxBackups = (*int32)(unsafe.Pointer(in.MaxBackups))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	return nil
+}
+
+// Convert_v1alpha1_ETCDBackupConfig_To_gcp_ETCDBackupConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ETCDBackupConfig_To_gcp_ETCDBackupConfig(in *ETCDBackupConfig, out *gcp.ETCDBackupConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ETCDBackupConfig_To_gcp_ETCDBackupConfig(in, out, s)
+}
+
+func autoConvert_gcp_ETCDBackupConfig_To_v1alpha1_ETCDBackupConfig(in *gcp.ETCDBackupConfig, out *ETCDBackupConfig, s conversion.Scope) error {
+	out.DeltaSnapshotPeriod = (*v1.Duration)(unsafe.Pointer(in.DeltaSnapshotPeriod))
+	out.DeltaSnapshotMemoryLimit = (*resource.Quantity)(unsafe.Pointer(in.DeltaSnapshotMemoryLimit))
+	out.GarbageCollectionPeriod = (*v1.Duration)(unsafe.Pointer(in.GarbageCollectionPeriod))
+	out.GarbageCollectionPolicy = (*string)(unsafe.Pointer(in.GarbageCollectionPolicy))
+	out.MaxBackups = (*int32)(unsafe.Pointer(in.MaxBackups))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	return nil
+}
+
+// Convert_gcp_ETCDBackupConfig_To_v1alpha1_ETCDBackupConfig is an autogenerated conversion function.
+func Convert_gcp_ETCDBackupConfig_To_v1alpha1_ETCDBackupConfig(in *gcp.ETCDBackupConfig, out *ETCDBackupConfig, s conversion.Scope) error {
+	return autoConvert_gcp_ETCDBackupConfig_To_v1alpha1_ETCDBackupConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_EncryptionConfig_To_gcp_EncryptionConfig(in *EncryptionConfig, out *gcp.EncryptionConfig, s conversion.Scope) error {
+	out.KMSKeyName = in.KMSKeyName
+	return nil
+}
+
+// Convert_v1alpha1_EncryptionConfig_To_gcp_EncryptionConfig is an autogenerated conversion function.
+func Convert_v1alpha1_EncryptionConfig_To_gcp_EncryptionConfig(in *EncryptionConfig, out *gcp.EncryptionConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EncryptionConfig_To_gcp_EncryptionConfig(in, out, s)
+}
+
+func autoConvert_gcp_EncryptionConfig_To_v1alpha1_EncryptionConfig(in *gcp.EncryptionConfig, out *EncryptionConfig, s conversion.Scope) error {
+	out.KMSKeyName = in.KMSKeyName
+	return nil
+}
+
+// Convert_gcp_EncryptionConfig_To_v1alpha1_EncryptionConfig is an autogenerated conversion function.
+func Convert_gcp_EncryptionConfig_To_v1alpha1_EncryptionConfig(in *gcp.EncryptionConfig, out *EncryptionConfig, s conversion.Scope) error {
+	return autoConvert_gcp_EncryptionConfig_To_v1alpha1_EncryptionConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_FlowLogs_To_gcp_FlowLogs(in *FlowLogs, out *gcp.FlowLogs, s conversion.Scope) error {
+	out.AggregationInterval = (*string)(unsafe.Pointer(in.AggregationInterval))
+	out.FlowSampling = (*float32)(unsafe.Pointer(in.FlowSampling))
+	out.Metadata = (*string)(unsafe.Pointer(in.Metadata))
+	return nil
+}
+
+// Convert_v1alpha1_FlowLogs_To_gcp_FlowLogs is an autogenerated conversion function.
+func Convert_v1alpha1_FlowLogs_To_gcp_FlowLogs(in *FlowLogs, out *gcp.FlowLogs, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FlowLogs_To_gcp_FlowLogs(in, out, s)
+}
+
+func autoConvert_gcp_FlowLogs_To_v1alpha1_FlowLogs(in *gcp.FlowLogs, out *FlowLogs, s conversion.Scope) error {
+	out.AggregationInterval = (*string)(unsafe.Pointer(in.AggregationInterval))
+	out.FlowSampling = (*float32)(unsafe.Pointer(in.FlowSampling))
+	out.Metadata = (*string)(unsafe.Pointer(in.Metadata))
+	return nil
+}
+
+// Convert_gcp_FlowLogs_To_v1alpha1_FlowLogs is an autogenerated conversion function.
+func Convert_gcp_FlowLogs_To_v1alpha1_FlowLogs(in *gcp.FlowLogs, out *FlowLogs, s conversion.Scope) error {
+	return autoConvert_gcp_FlowLogs_To_v1alpha1_FlowLogs(in, out, s)
+}
+
 func autoConvert_v1alpha1_InfrastructureConfig_To_gcp_InfrastructureConfig(in *InfrastructureConfig, out *gcp.InfrastructureConfig, s conversion.Scope) error {
 	if err := Convert_v1alpha1_NetworkConfig_To_gcp_NetworkConfig(&in.Networks, &out.Networks, s); err != nil {
 		return err
@@ -280,6 +558,28 @@ func Convert_gcp_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *gcp.I
 	return autoConvert_gcp_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_LifecycleConfig_To_gcp_LifecycleConfig(in *LifecycleConfig, out *gcp.LifecycleConfig, s conversion.Scope) error {
+	out.ExpirationDays = (*int32)(unsafe.Pointer(in.ExpirationDays))
+	out.NumNewerVersions = (*int32)(unsafe.Pointer(in.NumNewerVersions))
+	return nil
+}
+
+// Convert_v1alpha1_LifecycleConfig_To_gcp_LifecycleConfig is an autogenerated conversion function.
+func Convert_v1alpha1_LifecycleConfig_To_gcp_LifecycleConfig(in *LifecycleConfig, out *gcp.LifecycleConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LifecycleConfig_To_gcp_LifecycleConfig(in, out, s)
+}
+
+func autoConvert_gcp_LifecycleConfig_To_v1alpha1_LifecycleConfig(in *gcp.LifecycleConfig, out *LifecycleConfig, s conversion.Scope) error {
+	out.ExpirationDays = (*int32)(unsafe.Pointer(in.ExpirationDays))
+	out.NumNewerVersions = (*int32)(unsafe.Pointer(in.NumNewerVersions))
+	return nil
+}
+
+// Convert_gcp_LifecycleConfig_To_v1alpha1_LifecycleConfig is an autogenerated conversion function.
+func Convert_gcp_LifecycleConfig_To_v1alpha1_LifecycleConfig(in *gcp.LifecycleConfig, out *LifecycleConfig, s conversion.Scope) error {
+	return autoConvert_gcp_LifecycleConfig_To_v1alpha1_LifecycleConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_MachineImage_To_gcp_MachineImage(in *MachineImage, out *gcp.MachineImage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Version = in.Version
@@ -348,10 +648,53 @@ func Convert_gcp_MachineImages_To_v1alpha1_MachineImages(in *gcp.MachineImages,
 	return autoConvert_gcp_MachineImages_To_v1alpha1_MachineImages(in, out, s)
 }
 
+func autoConvert_v1alpha1_NatIP_To_gcp_NatIP(in *NatIP, out *gcp.NatIP, s conversion.Scope) error {
+	out.IP = in.IP
+	return nil
+}
+
+// Convert_v1alpha1_NatIP_To_gcp_NatIP is an autogenerated conversion function.
+func Convert_v1alpha1_NatIP_To_gcp_NatIP(in *NatIP, out *gcp.NatIP, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NatIP_To_gcp_NatIP(in, out, s)
+}
+
+func autoConvert_gcp_NatIP_To_v1alpha1_NatIP(in *gcp.NatIP, out *NatIP, s conversion.Scope) error {
+	out.IP = in.IP
+	return nil
+}
+
+// Convert_gcp_NatIP_To_v1alpha1_NatIP is an autogenerated conversion function.
+func Convert_gcp_NatIP_To_v1alpha1_NatIP(in *gcp.NatIP, out *NatIP, s conversion.Scope) error {
+	return autoConvert_gcp_NatIP_To_v1alpha1_NatIP(in, out, s)
+}
+
+func autoConvert_v1alpha1_NatIPName_To_gcp_NatIPName(in *NatIPName, out *gcp.NatIPName, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_NatIPName_To_gcp_NatIPName is an autogenerated conversion function.
+func Convert_v1alpha1_NatIPName_To_gcp_NatIPName(in *NatIPName, out *gcp.NatIPName, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NatIPName_To_gcp_NatIPName(in, out, s)
+}
+
+func autoConvert_gcp_NatIPName_To_v1alpha1_NatIPName(in *gcp.NatIPName, out *NatIPName, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_gcp_NatIPName_To_v1alpha1_NatIPName is an autogenerated conversion function.
+func Convert_gcp_NatIPName_To_v1alpha1_NatIPName(in *gcp.NatIPName, out *NatIPName, s conversion.Scope) error {
+	return autoConvert_gcp_NatIPName_To_v1alpha1_NatIPName(in, out, s)
+}
+
 func autoConvert_v1alpha1_NetworkConfig_To_gcp_NetworkConfig(in *NetworkConfig, out *gcp.NetworkConfig, s conversion.Scope) error {
 	out.VPC = (*gcp.VPC)(unsafe.Pointer(in.VPC))
 	out.Internal = (*string)(unsafe.Pointer(in.Internal))
 	out.Worker = in.Worker
+	out.DualStack = (*gcp.DualStack)(unsafe.Pointer(in.DualStack))
+	out.CloudNAT = (*gcp.CloudNAT)(unsafe.Pointer(in.CloudNAT))
+	out.FlowLogs = (*gcp.FlowLogs)(unsafe.Pointer(in.FlowLogs))
 	return nil
 }
 
@@ -364,6 +707,9 @@ func autoConvert_gcp_NetworkConfig_To_v1alpha1_NetworkConfig(in *gcp.NetworkConf
 	out.VPC = (*VPC)(unsafe.Pointer(in.VPC))
 	out.Internal = (*string)(unsafe.Pointer(in.Internal))
 	out.Worker = in.Worker
+	out.DualStack = (*DualStack)(unsafe.Pointer(in.DualStack))
+	out.CloudNAT = (*CloudNAT)(unsafe.Pointer(in.CloudNAT))
+	out.FlowLogs = (*FlowLogs)(unsafe.Pointer(in.FlowLogs))
 	return nil
 }
 
@@ -377,6 +723,7 @@ func autoConvert_v1alpha1_NetworkStatus_To_gcp_NetworkStatus(in *NetworkStatus,
 		return err
 	}
 	out.Subnets = *(*[]gcp.Subnet)(unsafe.Pointer(&in.Subnets))
+	out.NatIPs = *(*[]gcp.NatIP)(unsafe.Pointer(&in.NatIPs))
 	return nil
 }
 
@@ -390,6 +737,7 @@ func autoConvert_gcp_NetworkStatus_To_v1alpha1_NetworkStatus(in *gcp.NetworkStat
 		return err
 	}
 	out.Subnets = *(*[]Subnet)(unsafe.Pointer(&in.Subnets))
+	out.NatIPs = *(*[]NatIP)(unsafe.Pointer(&in.NatIPs))
 	return nil
 }
 
@@ -398,9 +746,60 @@ func Convert_gcp_NetworkStatus_To_v1alpha1_NetworkStatus(in *gcp.NetworkStatus,
 	return autoConvert_gcp_NetworkStatus_To_v1alpha1_NetworkStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_RetentionConfig_To_gcp_RetentionConfig(in *RetentionConfig, out *gcp.RetentionConfig, s conversion.Scope) error {
+	out.Days = in.Days
+	out.Locked = (*bool)(unsafe.Pointer(in.Locked))
+	return nil
+}
+
+// Convert_v1alpha1_RetentionConfig_To_gcp_RetentionConfig is an autogenerated conversion function.
+func Convert_v1alpha1_RetentionConfig_To_gcp_RetentionConfig(in *RetentionConfig, out *gcp.RetentionConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RetentionConfig_To_gcp_RetentionConfig(in, out, s)
+}
+
+func autoConvert_gcp_RetentionConfig_To_v1alpha1_RetentionConfig(in *gcp.RetentionConfig, out *RetentionConfig, s conversion.Scope) error {
+	out.Days = in.Days
+	out.Locked = (*bool)(unsafe.Pointer(in.Locked))
+	return nil
+}
+
+// Convert_gcp_RetentionConfig_To_v1alpha1_RetentionConfig is an autogenerated conversion function.
+func Convert_gcp_RetentionConfig_To_v1alpha1_RetentionConfig(in *gcp.RetentionConfig, out *RetentionConfig, s conversion.Scope) error {
+	return autoConvert_gcp_RetentionConfig_To_v1alpha1_RetentionConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_StorageClass_To_gcp_StorageClass(in *StorageClass, out *gcp.StorageClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	out.ReclaimPolicy = (*corev1.PersistentVolumeReclaimPolicy)(unsafe.Pointer(in.ReclaimPolicy))
+	out.VolumeBindingMode = (*storagev1.VolumeBindingMode)(unsafe.Pointer(in.VolumeBindingMode))
+	out.Default = (*bool)(unsafe.Pointer(in.Default))
+	return nil
+}
+
+// Convert_v1alpha1_StorageClass_To_gcp_StorageClass is an autogenerated conversion function.
+func Convert_v1alpha1_StorageClass_To_gcp_StorageClass(in *StorageClass, out *gcp.StorageClass, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageClass_To_gcp_StorageClass(in, out, s)
+}
+
+func autoConvert_gcp_StorageClass_To_v1alpha1_StorageClass(in *gcp.StorageClass, out *StorageClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	out.ReclaimPolicy = (*corev1.PersistentVolumeReclaimPolicy)(unsafe.Pointer(in.ReclaimPolicy))
+	out.VolumeBindingMode = (*storagev1.VolumeBindingMode)(unsafe.Pointer(in.VolumeBindingMode))
+	out.Default = (*bool)(unsafe.Pointer(in.Default))
+	return nil
+}
+
+// Convert_gcp_StorageClass_To_v1alpha1_StorageClass is an autogenerated conversion function.
+func Convert_gcp_StorageClass_To_v1alpha1_StorageClass(in *gcp.StorageClass, out *StorageClass, s conversion.Scope) error {
+	return autoConvert_gcp_StorageClass_To_v1alpha1_StorageClass(in, out, s)
+}
+
 func autoConvert_v1alpha1_Subnet_To_gcp_Subnet(in *Subnet, out *gcp.Subnet, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Purpose = gcp.SubnetPurpose(in.Purpose)
+	out.IPv6CIDR = in.IPv6CIDR
 	return nil
 }
 
@@ -412,6 +811,7 @@ func Convert_v1alpha1_Subnet_To_gcp_Subnet(in *Subnet, out *gcp.Subnet, s conver
 func autoConvert_gcp_Subnet_To_v1alpha1_Subnet(in *gcp.Subnet, out *Subnet, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Purpose = SubnetPurpose(in.Purpose)
+	out.IPv6CIDR = in.IPv6CIDR
 	return nil
 }
 
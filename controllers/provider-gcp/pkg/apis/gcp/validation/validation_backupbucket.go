@@ -0,0 +1,68 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"regexp"
+
+	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var kmsKeyNameRegex = regexp.MustCompile(`^projects/[^/]+/locations/[^/]+/keyRings/[^/]+/cryptoKeys/[^/]+$`)
+
+// ValidateBackupBucketConfig validates a BackupBucketConfig object.
+func ValidateBackupBucketConfig(backupBucketConfig *apisgcp.BackupBucketConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if retention := backupBucketConfig.Retention; retention != nil {
+		if retention.Days <= 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("retention", "days"), retention.Days, "must be greater than 0"))
+		}
+		// GCS rejects retention policies on buckets with object versioning enabled.
+		if backupBucketConfig.Versioning != nil && *backupBucketConfig.Versioning {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("retention"), "cannot be combined with versioning"))
+		}
+	}
+
+	if encryption := backupBucketConfig.Encryption; encryption != nil && !kmsKeyNameRegex.MatchString(encryption.KMSKeyName) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("encryption", "kmsKeyName"), encryption.KMSKeyName, "must have the format 'projects/<project>/locations/<location>/keyRings/<key-ring>/cryptoKeys/<key>'"))
+	}
+
+	if lifecycle := backupBucketConfig.Lifecycle; lifecycle != nil {
+		lifecyclePath := field.NewPath("lifecycle")
+
+		if lifecycle.ExpirationDays == nil && lifecycle.NumNewerVersions == nil {
+			allErrs = append(allErrs, field.Required(lifecyclePath, "must provide expirationDays or numNewerVersions"))
+		}
+		if lifecycle.ExpirationDays != nil && *lifecycle.ExpirationDays <= 0 {
+			allErrs = append(allErrs, field.Invalid(lifecyclePath.Child("expirationDays"), *lifecycle.ExpirationDays, "must be greater than 0"))
+		}
+		if lifecycle.NumNewerVersions != nil {
+			if *lifecycle.NumNewerVersions <= 0 {
+				allErrs = append(allErrs, field.Invalid(lifecyclePath.Child("numNewerVersions"), *lifecycle.NumNewerVersions, "must be greater than 0"))
+			}
+			if backupBucketConfig.Versioning == nil || !*backupBucketConfig.Versioning {
+				allErrs = append(allErrs, field.Forbidden(lifecyclePath.Child("numNewerVersions"), "requires versioning to be enabled"))
+			}
+		}
+		if retention := backupBucketConfig.Retention; retention != nil && lifecycle.ExpirationDays != nil && *lifecycle.ExpirationDays < retention.Days {
+			allErrs = append(allErrs, field.Invalid(lifecyclePath.Child("expirationDays"), *lifecycle.ExpirationDays, "must not be less than the retention days"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+	. "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("BackupBucketConfig validation", func() {
+	var backupBucketConfig *apisgcp.BackupBucketConfig
+
+	BeforeEach(func() {
+		backupBucketConfig = &apisgcp.BackupBucketConfig{}
+	})
+
+	Describe("#ValidateBackupBucketConfig", func() {
+		It("should allow an empty configuration", func() {
+			Expect(ValidateBackupBucketConfig(backupBucketConfig)).To(BeEmpty())
+		})
+
+		It("should allow a valid configuration with retention", func() {
+			var (
+				locked         = true
+				expirationDays = int32(30)
+			)
+			backupBucketConfig.Retention = &apisgcp.RetentionConfig{
+				Days:   14,
+				Locked: &locked,
+			}
+			backupBucketConfig.Encryption = &apisgcp.EncryptionConfig{
+				KMSKeyName: "projects/foo/locations/europe-west1/keyRings/bar/cryptoKeys/baz",
+			}
+			backupBucketConfig.Lifecycle = &apisgcp.LifecycleConfig{
+				ExpirationDays: &expirationDays,
+			}
+
+			Expect(ValidateBackupBucketConfig(backupBucketConfig)).To(BeEmpty())
+		})
+
+		It("should allow a valid configuration with versioning", func() {
+			var (
+				versioning       = true
+				expirationDays   = int32(30)
+				numNewerVersions = int32(3)
+			)
+			backupBucketConfig.Versioning = &versioning
+			backupBucketConfig.Lifecycle = &apisgcp.LifecycleConfig{
+				ExpirationDays:   &expirationDays,
+				NumNewerVersions: &numNewerVersions,
+			}
+
+			Expect(ValidateBackupBucketConfig(backupBucketConfig)).To(BeEmpty())
+		})
+
+		It("should forbid combining versioning and retention", func() {
+			versioning := true
+			backupBucketConfig.Versioning = &versioning
+			backupBucketConfig.Retention = &apisgcp.RetentionConfig{Days: 14}
+
+			Expect(ValidateBackupBucketConfig(backupBucketConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("retention"),
+				})),
+			))
+		})
+
+		It("should forbid an empty lifecycle configuration", func() {
+			backupBucketConfig.Lifecycle = &apisgcp.LifecycleConfig{}
+
+			Expect(ValidateBackupBucketConfig(backupBucketConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("lifecycle"),
+				})),
+			))
+		})
+
+		It("should forbid an invalid configuration", func() {
+			var (
+				expirationDays   = int32(7)
+				numNewerVersions = int32(0)
+			)
+			backupBucketConfig.Retention = &apisgcp.RetentionConfig{Days: 14}
+			backupBucketConfig.Encryption = &apisgcp.EncryptionConfig{KMSKeyName: "baz"}
+			backupBucketConfig.Lifecycle = &apisgcp.LifecycleConfig{
+				ExpirationDays:   &expirationDays,
+				NumNewerVersions: &numNewerVersions,
+			}
+
+			Expect(ValidateBackupBucketConfig(backupBucketConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("encryption.kmsKeyName"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("lifecycle.numNewerVersions"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("lifecycle.numNewerVersions"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("lifecycle.expirationDays"),
+				})),
+			))
+		})
+	})
+})
@@ -0,0 +1,212 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	"time"
+
+	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+	. "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("ControlPlaneConfig validation", func() {
+	var controlPlaneConfig *apisgcp.ControlPlaneConfig
+
+	BeforeEach(func() {
+		controlPlaneConfig = &apisgcp.ControlPlaneConfig{}
+	})
+
+	Describe("#ValidateControlPlaneConfig", func() {
+		It("should allow a configuration without storage classes", func() {
+			Expect(ValidateControlPlaneConfig(controlPlaneConfig)).To(BeEmpty())
+		})
+
+		It("should allow valid cloud-controller-manager settings", func() {
+			var (
+				syncs     int32 = 5
+				verbosity int32 = 4
+			)
+			controlPlaneConfig.CloudControllerManager = &apisgcp.CloudControllerManagerConfig{
+				ConcurrentServiceSyncs:    &syncs,
+				NodeMonitorPeriod:         &metav1.Duration{Duration: 5 * time.Second},
+				RouteReconciliationPeriod: &metav1.Duration{Duration: 10 * time.Second},
+				Verbosity:                 &verbosity,
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlaneConfig)).To(BeEmpty())
+		})
+
+		It("should forbid invalid cloud-controller-manager settings", func() {
+			var (
+				zero       int32
+				tooVerbose int32 = 11
+			)
+			controlPlaneConfig.CloudControllerManager = &apisgcp.CloudControllerManagerConfig{
+				ConcurrentServiceSyncs:    &zero,
+				NodeMonitorPeriod:         &metav1.Duration{Duration: -time.Second},
+				RouteReconciliationPeriod: &metav1.Duration{},
+				Verbosity:                 &tooVerbose,
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlaneConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.concurrentServiceSyncs"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.nodeMonitorPeriod"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.routeReconciliationPeriod"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.verbosity"),
+				})),
+			))
+		})
+
+		It("should allow a valid etcd backup configuration", func() {
+			var (
+				memoryLimit = resource.MustParse("50Mi")
+				limitBased  = "LimitBased"
+				maxBackups  = int32(5)
+			)
+			controlPlaneConfig.ETCDBackup = &apisgcp.ETCDBackupConfig{
+				DeltaSnapshotPeriod:      &metav1.Duration{Duration: time.Minute},
+				DeltaSnapshotMemoryLimit: &memoryLimit,
+				GarbageCollectionPeriod:  &metav1.Duration{Duration: time.Hour},
+				GarbageCollectionPolicy:  &limitBased,
+				MaxBackups:               &maxBackups,
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlaneConfig)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid etcd backup configuration", func() {
+			var (
+				memoryLimit = resource.MustParse("0")
+				policy      = "Linear"
+				maxBackups  = int32(5)
+			)
+			controlPlaneConfig.ETCDBackup = &apisgcp.ETCDBackupConfig{
+				DeltaSnapshotPeriod:      &metav1.Duration{Duration: time.Millisecond},
+				DeltaSnapshotMemoryLimit: &memoryLimit,
+				GarbageCollectionPeriod:  &metav1.Duration{},
+				GarbageCollectionPolicy:  &policy,
+				MaxBackups:               &maxBackups,
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlaneConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("etcdBackup.deltaSnapshotPeriod"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("etcdBackup.deltaSnapshotMemoryLimit"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("etcdBackup.garbageCollectionPeriod"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("etcdBackup.garbageCollectionPolicy"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("etcdBackup.maxBackups"),
+				})),
+			))
+		})
+
+		It("should forbid a route reconciliation period if cloud routes are not configured", func() {
+			configureCloudRoutes := false
+			controlPlaneConfig.CloudControllerManager = &apisgcp.CloudControllerManagerConfig{
+				ConfigureCloudRoutes:      &configureCloudRoutes,
+				RouteReconciliationPeriod: &metav1.Duration{Duration: 10 * time.Second},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlaneConfig)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("cloudControllerManager.routeReconciliationPeriod"),
+				})),
+			))
+		})
+
+		It("should allow valid storage classes", func() {
+			var (
+				retain          = corev1.PersistentVolumeReclaimRetain
+				waitForConsumer = storagev1.VolumeBindingWaitForFirstConsumer
+				isDefault       = true
+			)
+			controlPlaneConfig.StorageClasses = []apisgcp.StorageClass{
+				{
+					Name:              "fast",
+					Type:              "pd-ssd",
+					ReclaimPolicy:     &retain,
+					VolumeBindingMode: &waitForConsumer,
+					Default:           &isDefault,
+				},
+				{Name: "slow", Type: "pd-standard"},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlaneConfig)).To(BeEmpty())
+		})
+
+		It("should forbid the names of the storage classes deployed by default and their CSI variants", func() {
+			controlPlaneConfig.StorageClasses = []apisgcp.StorageClass{
+				{Name: "default", Type: "pd-standard"},
+				{Name: "default-csi", Type: "pd-standard"},
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlaneConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("storageClasses[0].name"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("storageClasses[1].name"),
+			}))))
+		})
+
+		It("should forbid unsupported types", func() {
+			controlPlaneConfig.StorageClasses = []apisgcp.StorageClass{
+				{Name: "fast", Type: "foo"},
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlaneConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("storageClasses[0].type"),
+			}))))
+		})
+	})
+})
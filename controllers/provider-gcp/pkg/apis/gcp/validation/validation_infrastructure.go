@@ -0,0 +1,80 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var (
+	supportedFlowLogsAggregationIntervals = sets.NewString("INTERVAL_5_SEC", "INTERVAL_30_SEC", "INTERVAL_1_MIN", "INTERVAL_5_MIN", "INTERVAL_10_MIN", "INTERVAL_15_MIN")
+	supportedFlowLogsMetadata             = sets.NewString("INCLUDE_ALL_METADATA", "EXCLUDE_ALL_METADATA")
+)
+
+// ValidateInfrastructureConfig validates a InfrastructureConfig object.
+func ValidateInfrastructureConfig(infra *apisgcp.InfrastructureConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	networksPath := field.NewPath("networks")
+	if len(infra.Networks.Worker) == 0 {
+		allErrs = append(allErrs, field.Required(networksPath.Child("worker"), "must specify the network range for the worker network"))
+	}
+
+	if cloudNAT := infra.Networks.CloudNAT; cloudNAT != nil {
+		cloudNATPath := networksPath.Child("cloudNAT")
+
+		if cloudNAT.MinPortsPerVM != nil && (*cloudNAT.MinPortsPerVM < 2 || *cloudNAT.MinPortsPerVM > 65536) {
+			allErrs = append(allErrs, field.Invalid(cloudNATPath.Child("minPortsPerVM"), *cloudNAT.MinPortsPerVM, "must be between 2 and 65536"))
+		}
+		for i, natIPName := range cloudNAT.NatIPNames {
+			if len(natIPName.Name) == 0 {
+				allErrs = append(allErrs, field.Required(cloudNATPath.Child("natIPNames").Index(i).Child("name"), "must provide a name"))
+			}
+		}
+		allErrs = append(allErrs, validatePositiveTimeout(cloudNAT.IcmpIdleTimeoutSec, cloudNATPath.Child("icmpIdleTimeoutSec"))...)
+		allErrs = append(allErrs, validatePositiveTimeout(cloudNAT.TCPEstablishedIdleTimeoutSec, cloudNATPath.Child("tcpEstablishedIdleTimeoutSec"))...)
+		allErrs = append(allErrs, validatePositiveTimeout(cloudNAT.TCPTransitoryIdleTimeoutSec, cloudNATPath.Child("tcpTransitoryIdleTimeoutSec"))...)
+		allErrs = append(allErrs, validatePositiveTimeout(cloudNAT.UDPIdleTimeoutSec, cloudNATPath.Child("udpIdleTimeoutSec"))...)
+	}
+
+	if flowLogs := infra.Networks.FlowLogs; flowLogs != nil {
+		flowLogsPath := networksPath.Child("flowLogs")
+
+		if flowLogs.AggregationInterval != nil && !supportedFlowLogsAggregationIntervals.Has(*flowLogs.AggregationInterval) {
+			allErrs = append(allErrs, field.NotSupported(flowLogsPath.Child("aggregationInterval"), *flowLogs.AggregationInterval, supportedFlowLogsAggregationIntervals.List()))
+		}
+		if flowLogs.FlowSampling != nil && (*flowLogs.FlowSampling < 0 || *flowLogs.FlowSampling > 1) {
+			allErrs = append(allErrs, field.Invalid(flowLogsPath.Child("flowSampling"), *flowLogs.FlowSampling, "must be between 0.0 and 1.0"))
+		}
+		if flowLogs.Metadata != nil && !supportedFlowLogsMetadata.Has(*flowLogs.Metadata) {
+			allErrs = append(allErrs, field.NotSupported(flowLogsPath.Child("metadata"), *flowLogs.Metadata, supportedFlowLogsMetadata.List()))
+		}
+	}
+
+	return allErrs
+}
+
+func validatePositiveTimeout(timeout *int32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if timeout != nil && *timeout <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, *timeout, "must be greater than 0"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+	. "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("InfrastructureConfig validation", func() {
+	var infrastructureConfig *apisgcp.InfrastructureConfig
+
+	BeforeEach(func() {
+		infrastructureConfig = &apisgcp.InfrastructureConfig{
+			Networks: apisgcp.NetworkConfig{
+				Worker: "10.250.0.0/19",
+			},
+		}
+	})
+
+	Describe("#ValidateInfrastructureConfig", func() {
+		It("should allow a minimal configuration", func() {
+			Expect(ValidateInfrastructureConfig(infrastructureConfig)).To(BeEmpty())
+		})
+
+		It("should forbid an empty worker network", func() {
+			infrastructureConfig.Networks.Worker = ""
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("networks.worker"),
+			}))))
+		})
+
+		Context("cloud NAT", func() {
+			It("should allow a valid configuration", func() {
+				var (
+					minPortsPerVM int32 = 4096
+					timeout       int32 = 30
+				)
+				infrastructureConfig.Networks.CloudNAT = &apisgcp.CloudNAT{
+					MinPortsPerVM:     &minPortsPerVM,
+					NatIPNames:        []apisgcp.NatIPName{{Name: "manual-nat-ip"}},
+					UDPIdleTimeoutSec: &timeout,
+				}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig)).To(BeEmpty())
+			})
+
+			It("should forbid invalid settings", func() {
+				var (
+					minPortsPerVM int32 = 1
+					timeout       int32 = 0
+				)
+				infrastructureConfig.Networks.CloudNAT = &apisgcp.CloudNAT{
+					MinPortsPerVM:      &minPortsPerVM,
+					NatIPNames:         []apisgcp.NatIPName{{}},
+					IcmpIdleTimeoutSec: &timeout,
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.cloudNAT.minPortsPerVM"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.cloudNAT.natIPNames[0].name"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.cloudNAT.icmpIdleTimeoutSec"),
+				}))))
+			})
+		})
+
+		Context("flow logs", func() {
+			It("should allow a valid configuration", func() {
+				var (
+					aggregationInterval         = "INTERVAL_5_SEC"
+					flowSampling        float32 = 0.5
+					metadata                    = "INCLUDE_ALL_METADATA"
+				)
+				infrastructureConfig.Networks.FlowLogs = &apisgcp.FlowLogs{
+					AggregationInterval: &aggregationInterval,
+					FlowSampling:        &flowSampling,
+					Metadata:            &metadata,
+				}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig)).To(BeEmpty())
+			})
+
+			It("should forbid invalid settings", func() {
+				var (
+					aggregationInterval         = "INTERVAL_2_SEC"
+					flowSampling        float32 = 1.5
+					metadata                    = "SOME_METADATA"
+				)
+				infrastructureConfig.Networks.FlowLogs = &apisgcp.FlowLogs{
+					AggregationInterval: &aggregationInterval,
+					FlowSampling:        &flowSampling,
+					Metadata:            &metadata,
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.flowLogs.aggregationInterval"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.flowLogs.flowSampling"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.flowLogs.metadata"),
+				}))))
+			})
+		})
+	})
+})
@@ -16,25 +16,31 @@ package backupbucket
 
 import (
 	"context"
+	"time"
 
+	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/validation"
 	gcpclient "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/gcp/client"
-	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket/genericactuator"
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
 
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
 )
 
 type actuator struct {
-	backupbucket.Actuator
-	client client.Client
-	logger logr.Logger
+	bbConfig *apisgcp.BackupBucketConfig
+	client   client.Client
+	logger   logr.Logger
 }
 
-func newActuator() backupbucket.Actuator {
+func newActuator(bbConfig *apisgcp.BackupBucketConfig) genericactuator.BackupBucketDelegate {
 	return &actuator{
-		logger: log.Log.WithName("gcp-backupbucket-actuator"),
+		bbConfig: bbConfig,
+		logger:   logger,
 	}
 }
 
@@ -43,20 +49,74 @@ func (a *actuator) InjectClient(client client.Client) error {
 	return nil
 }
 
-func (a *actuator) Reconcile(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+func (a *actuator) GetObjectStore(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) (objectstore.ObjectStore, error) {
+	return gcpclient.NewObjectStoreFromSecretRef(ctx, a.client, bb.Spec.SecretRef, bb.Spec.Region)
+}
+
+func (a *actuator) ReconcileBucketConfig(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
 	storageClient, err := gcpclient.NewStorageClientFromSecretRef(ctx, a.client, bb.Spec.SecretRef)
 	if err != nil {
 		return err
 	}
 
-	return storageClient.CreateBucketIfNotExists(ctx, bb.Name, bb.Spec.Region)
+	return reconcileBucketConfig(ctx, storageClient, bb.Name, a.bbConfig)
 }
 
-func (a *actuator) Delete(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
-	storageClient, err := gcpclient.NewStorageClientFromSecretRef(ctx, a.client, bb.Spec.SecretRef)
-	if err != nil {
-		return err
+// decodeBackupBucketConfig decodes and validates the given BackupBucketConfig of the controller configuration.
+func decodeBackupBucketConfig(decoder runtime.Decoder, backupBucketConfig *runtime.RawExtension) (*apisgcp.BackupBucketConfig, error) {
+	bbConfig := &apisgcp.BackupBucketConfig{}
+	if backupBucketConfig == nil {
+		return bbConfig, nil
+	}
+
+	if _, _, err := decoder.Decode(backupBucketConfig.Raw, nil, bbConfig); err != nil {
+		return nil, errors.Wrap(err, "could not decode the backup bucket configuration")
+	}
+	if errs := validation.ValidateBackupBucketConfig(bbConfig); len(errs) > 0 {
+		return nil, errors.Wrap(errs.ToAggregate(), "invalid backup bucket configuration")
+	}
+
+	return bbConfig, nil
+}
+
+// reconcileBucketConfig applies the settings of the given BackupBucketConfig to the bucket. The settings are applied
+// on every reconciliation to revert any changes that were made to the bucket outside of Gardener. Settings that are
+// not configured are left untouched.
+func reconcileBucketConfig(ctx context.Context, storageClient gcpclient.StorageClient, bucketName string, bbConfig *apisgcp.BackupBucketConfig) error {
+	if bbConfig.Versioning != nil {
+		if err := storageClient.UpdateBucketVersioning(ctx, bucketName, *bbConfig.Versioning); err != nil {
+			return errors.Wrapf(err, "could not update versioning of bucket '%s'", bucketName)
+		}
 	}
 
-	return storageClient.DeleteBucketIfExists(ctx, bb.Name)
+	if retention := bbConfig.Retention; retention != nil {
+		retentionPeriod := time.Duration(retention.Days) * 24 * time.Hour
+		locked := retention.Locked != nil && *retention.Locked
+
+		if err := storageClient.UpdateBucketRetentionPolicy(ctx, bucketName, retentionPeriod, locked); err != nil {
+			return errors.Wrapf(err, "could not update retention policy of bucket '%s'", bucketName)
+		}
+	}
+
+	if encryption := bbConfig.Encryption; encryption != nil {
+		if err := storageClient.UpdateBucketEncryption(ctx, bucketName, encryption.KMSKeyName); err != nil {
+			return errors.Wrapf(err, "could not update encryption of bucket '%s'", bucketName)
+		}
+	}
+
+	if lifecycle := bbConfig.Lifecycle; lifecycle != nil {
+		if err := storageClient.UpdateBucketLifecycle(ctx, bucketName, toInt64Ptr(lifecycle.ExpirationDays), toInt64Ptr(lifecycle.NumNewerVersions)); err != nil {
+			return errors.Wrapf(err, "could not update lifecycle of bucket '%s'", bucketName)
+		}
+	}
+
+	return nil
+}
+
+func toInt64Ptr(i *int32) *int64 {
+	if i == nil {
+		return nil
+	}
+	v := int64(*i)
+	return &v
 }
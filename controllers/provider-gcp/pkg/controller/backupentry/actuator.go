@@ -17,6 +17,7 @@ package backupentry
 import (
 	"context"
 	"fmt"
+	"time"
 
 	gcpclient "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/gcp/client"
 	"github.com/gardener/gardener-extensions/pkg/controller/backupentry/genericactuator"
@@ -54,3 +55,12 @@ func (a *actuator) Delete(ctx context.Context, be *extensionsv1alpha1.BackupEntr
 
 	return storageClient.DeleteObjectsWithPrefix(ctx, be.Spec.BucketName, fmt.Sprintf("%s/", be.Name))
 }
+
+func (a *actuator) ListObjects(ctx context.Context, be *extensionsv1alpha1.BackupEntry, prefix string) (map[string]time.Time, error) {
+	storageClient, err := gcpclient.NewStorageClientFromSecretRef(ctx, a.client, be.Spec.SecretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	return storageClient.ListObjectsWithPrefix(ctx, be.Spec.BucketName, fmt.Sprintf("%s/%s", be.Name, prefix))
+}
@@ -18,6 +18,8 @@ import (
 	"context"
 	"path/filepath"
 
+	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/config"
+	confighelper "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/config/helper"
 	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/gcp"
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal"
@@ -29,13 +31,16 @@ import (
 
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/chart"
 	"github.com/gardener/gardener/pkg/utils/secrets"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1beta1 "k8s.io/api/storage/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apiserver/pkg/authentication/user"
@@ -46,6 +51,10 @@ import (
 const (
 	cloudControllerManagerDeploymentName = "cloud-controller-manager"
 	cloudControllerManagerServerName     = "cloud-controller-manager-server"
+	csiDriverControllerDeploymentName    = "csi-driver-controller"
+	csiProvisionerName                   = "csi-provisioner"
+	csiAttacherName                      = "csi-attacher"
+	csiResizerName                       = "csi-resizer"
 )
 
 var controlPlaneSecrets = &secrets.Secrets{
@@ -80,6 +89,45 @@ var controlPlaneSecrets = &secrets.Secrets{
 					SigningCA:  cas[v1alpha1constants.SecretNameCACluster],
 				},
 			},
+			&secrets.ControlPlaneSecretConfig{
+				CertificateSecretConfig: &secrets.CertificateSecretConfig{
+					Name:         csiProvisionerName,
+					CommonName:   "system:csi-provisioner",
+					Organization: []string{user.SystemPrivilegedGroup},
+					CertType:     secrets.ClientCert,
+					SigningCA:    cas[v1alpha1constants.SecretNameCACluster],
+				},
+				KubeConfigRequest: &secrets.KubeConfigRequest{
+					ClusterName:  clusterName,
+					APIServerURL: v1alpha1constants.DeploymentNameKubeAPIServer,
+				},
+			},
+			&secrets.ControlPlaneSecretConfig{
+				CertificateSecretConfig: &secrets.CertificateSecretConfig{
+					Name:         csiAttacherName,
+					CommonName:   "system:csi-attacher",
+					Organization: []string{user.SystemPrivilegedGroup},
+					CertType:     secrets.ClientCert,
+					SigningCA:    cas[v1alpha1constants.SecretNameCACluster],
+				},
+				KubeConfigRequest: &secrets.KubeConfigRequest{
+					ClusterName:  clusterName,
+					APIServerURL: v1alpha1constants.DeploymentNameKubeAPIServer,
+				},
+			},
+			&secrets.ControlPlaneSecretConfig{
+				CertificateSecretConfig: &secrets.CertificateSecretConfig{
+					Name:         csiResizerName,
+					CommonName:   "system:csi-resizer",
+					Organization: []string{user.SystemPrivilegedGroup},
+					CertType:     secrets.ClientCert,
+					SigningCA:    cas[v1alpha1constants.SecretNameCACluster],
+				},
+				KubeConfigRequest: &secrets.KubeConfigRequest{
+					ClusterName:  clusterName,
+					APIServerURL: v1alpha1constants.DeploymentNameKubeAPIServer,
+				},
+			},
 		}
 	},
 }
@@ -95,23 +143,74 @@ var configChart = &chart.Chart{
 	},
 }
 
-var ccmChart = &chart.Chart{
-	Name:   "cloud-controller-manager",
-	Path:   filepath.Join(internal.InternalChartsPath, "cloud-controller-manager"),
-	Images: []string{gcp.HyperkubeImageName},
-	Objects: []*chart.Object{
-		{Type: &corev1.Service{}, Name: "cloud-controller-manager"},
-		{Type: &appsv1.Deployment{}, Name: "cloud-controller-manager"},
-		{Type: &corev1.ConfigMap{}, Name: "cloud-controller-manager-monitoring-config"},
+var controlPlaneChart = &chart.Chart{
+	Name: "seed-controlplane",
+	Path: filepath.Join(internal.InternalChartsPath, "seed-controlplane"),
+	SubCharts: []*chart.Chart{
+		{
+			Name:   "cloud-controller-manager",
+			Images: []string{gcp.HyperkubeImageName},
+			Objects: []*chart.Object{
+				{Type: &corev1.Service{}, Name: "cloud-controller-manager"},
+				{Type: &appsv1.Deployment{}, Name: "cloud-controller-manager"},
+				{Type: extensionscontroller.GetVerticalPodAutoscalerObject(), Name: "cloud-controller-manager-vpa"},
+				{Type: &policyv1beta1.PodDisruptionBudget{}, Name: "cloud-controller-manager"},
+				{Type: &corev1.ConfigMap{}, Name: "cloud-controller-manager-monitoring-config"},
+			},
+		},
+		{
+			Name: "csi-driver-controller",
+			Images: []string{
+				gcp.CSIDriverImageName,
+				gcp.CSIProvisionerImageName,
+				gcp.CSIAttacherImageName,
+				gcp.CSIResizerImageName,
+				gcp.CSILivenessProbeImageName,
+			},
+			Objects: []*chart.Object{
+				{Type: &appsv1.Deployment{}, Name: csiDriverControllerDeploymentName},
+				{Type: extensionscontroller.GetVerticalPodAutoscalerObject(), Name: csiDriverControllerDeploymentName + "-vpa"},
+				{Type: &policyv1beta1.PodDisruptionBudget{}, Name: csiDriverControllerDeploymentName},
+			},
+		},
 	},
 }
 
-var ccmShootChart = &chart.Chart{
-	Name: "cloud-controller-manager-shoot",
-	Path: filepath.Join(internal.InternalChartsPath, "cloud-controller-manager-shoot"),
-	Objects: []*chart.Object{
-		{Type: &rbacv1.ClusterRole{}, Name: "system:controller:cloud-node-controller"},
-		{Type: &rbacv1.ClusterRoleBinding{}, Name: "system:controller:cloud-node-controller"},
+var controlPlaneShootChart = &chart.Chart{
+	Name: "shoot-system-components",
+	Path: filepath.Join(internal.InternalChartsPath, "shoot-system-components"),
+	SubCharts: []*chart.Chart{
+		{
+			Name: "cloud-controller-manager",
+			Objects: []*chart.Object{
+				{Type: &rbacv1.ClusterRole{}, Name: "system:controller:cloud-node-controller"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "system:controller:cloud-node-controller"},
+			},
+		},
+		{
+			Name: "csi-driver-node",
+			Images: []string{
+				gcp.CSIDriverImageName,
+				gcp.CSINodeDriverRegistrarImageName,
+				gcp.CSILivenessProbeImageName,
+			},
+			Objects: []*chart.Object{
+				{Type: &appsv1.DaemonSet{}, Name: "csi-driver-node"},
+				{Type: &storagev1beta1.CSIDriver{}, Name: gcp.CSIDriverName},
+				{Type: &corev1.ServiceAccount{}, Name: "csi-driver-node"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:psp:kube-system:csi-driver-node"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:psp:csi-driver-node"},
+				{Type: &policyv1beta1.PodSecurityPolicy{}, Name: "gardener.kube-system.csi-driver-node"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:kube-system:csi-provisioner"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:csi-provisioner"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:kube-system:csi-attacher"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:csi-attacher"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:kube-system:csi-resizer"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:csi-resizer"},
+				{Type: &rbacv1.Role{}, Name: "csi-leader-election"},
+				{Type: &rbacv1.RoleBinding{}, Name: "csi-leader-election"},
+			},
+		},
 	},
 }
 
@@ -121,18 +220,20 @@ var storageClassChart = &chart.Chart{
 }
 
 // NewValuesProvider creates a new ValuesProvider for the generic actuator.
-func NewValuesProvider(logger logr.Logger) genericactuator.ValuesProvider {
+func NewValuesProvider(controlPlaneComponents config.ControlPlaneComponents, logger logr.Logger) genericactuator.ValuesProvider {
 	return &valuesProvider{
-		logger: logger.WithName("gcp-values-provider"),
+		controlPlaneComponents: controlPlaneComponents,
+		logger:                 logger.WithName("gcp-values-provider"),
 	}
 }
 
 // valuesProvider is a ValuesProvider that provides AWS-specific values for the 2 charts applied by the generic actuator.
 type valuesProvider struct {
 	genericactuator.NoopValuesProvider
-	decoder runtime.Decoder
-	client  client.Client
-	logger  logr.Logger
+	controlPlaneComponents config.ControlPlaneComponents
+	decoder                runtime.Decoder
+	client                 client.Client
+	logger                 logr.Logger
 }
 
 // InjectScheme injects the given scheme into the valuesProvider.
@@ -189,8 +290,47 @@ func (vp *valuesProvider) GetControlPlaneChartValues(
 		return nil, errors.Wrapf(err, "could not decode providerConfig of controlplane '%s'", util.ObjectName(cp))
 	}
 
-	// Get CCM chart values
-	return getCCMChartValues(cpConfig, cp, cluster, checksums, scaledDown)
+	// Get control plane chart values
+	values, err := getControlPlaneChartValues(cpConfig, cp, cluster, checksums, scaledDown, confighelper.GetControlPlaneComponentReplicas(vp.controlPlaneComponents))
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply the vertical pod autoscaling and high availability settings to all control plane components
+	componentValues := confighelper.GetControlPlaneComponentChartValues(vp.controlPlaneComponents)
+	for _, subChart := range controlPlaneChart.SubCharts {
+		if subChartValues, ok := values[subChart.Name].(map[string]interface{}); ok {
+			values[subChart.Name] = utils.MergeMaps(subChartValues, componentValues)
+		}
+	}
+
+	return values, nil
+}
+
+// GetControlPlaneShootChartValues returns the values for the control plane shoot chart applied by the generic actuator.
+func (vp *valuesProvider) GetControlPlaneShootChartValues(
+	ctx context.Context,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+) (map[string]interface{}, error) {
+	// Get control plane shoot chart values
+	return getControlPlaneShootChartValues(cluster)
+}
+
+// GetStorageClassesChartValues returns the values for the storage classes chart applied by the generic actuator.
+func (vp *valuesProvider) GetStorageClassesChartValues(
+	ctx context.Context,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+) (map[string]interface{}, error) {
+	// Decode providerConfig
+	cpConfig := &apisgcp.ControlPlaneConfig{}
+	if _, _, err := vp.decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, cpConfig); err != nil {
+		return nil, errors.Wrapf(err, "could not decode providerConfig of controlplane '%s'", util.ObjectName(cp))
+	}
+
+	// Get storage classes chart values
+	return getStorageClassesChartValues(cpConfig, cluster)
 }
 
 // getConfigChartValues collects and returns the configuration chart values.
@@ -204,12 +344,39 @@ func getConfigChartValues(
 	networkName, subNetworkName := getNetworkNames(infraStatus, cp)
 
 	// Collect config chart values
-	return map[string]interface{}{
+	values := map[string]interface{}{
 		"projectID":      serviceAccount.ProjectID,
 		"networkName":    networkName,
 		"subNetworkName": subNetworkName,
 		"zone":           cpConfig.Zone,
 		"nodeTags":       cp.Namespace,
+	}
+
+	return values, nil
+}
+
+// getControlPlaneChartValues collects and returns the control plane chart values.
+func getControlPlaneChartValues(
+	cpConfig *apisgcp.ControlPlaneConfig,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+	checksums map[string]string,
+	scaledDown bool,
+	replicas int,
+) (map[string]interface{}, error) {
+	ccm, err := getCCMChartValues(cpConfig, cp, cluster, checksums, scaledDown, replicas)
+	if err != nil {
+		return nil, err
+	}
+
+	csi, err := getCSIControllerChartValues(cluster, checksums, scaledDown, replicas)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"cloud-controller-manager": ccm,
+		"csi-driver-controller":    csi,
 	}, nil
 }
 
@@ -220,9 +387,10 @@ func getCCMChartValues(
 	cluster *extensionscontroller.Cluster,
 	checksums map[string]string,
 	scaledDown bool,
+	replicas int,
 ) (map[string]interface{}, error) {
 	values := map[string]interface{}{
-		"replicas":          extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
+		"replicas":          extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, replicas),
 		"clusterName":       cp.Namespace,
 		"kubernetesVersion": extensionscontroller.GetKubernetesVersion(cluster),
 		"podNetwork":        extensionscontroller.GetPodNetwork(cluster),
@@ -234,13 +402,133 @@ func getCCMChartValues(
 		},
 	}
 
-	if cpConfig.CloudControllerManager != nil {
-		values["featureGates"] = cpConfig.CloudControllerManager.FeatureGates
+	if ccmConfig := cpConfig.CloudControllerManager; ccmConfig != nil {
+		values["featureGates"] = ccmConfig.FeatureGates
+		if ccmConfig.ConcurrentServiceSyncs != nil {
+			values["concurrentServiceSyncs"] = *ccmConfig.ConcurrentServiceSyncs
+		}
+		if ccmConfig.NodeMonitorPeriod != nil {
+			values["nodeMonitorPeriod"] = ccmConfig.NodeMonitorPeriod.Duration.String()
+		}
+		if ccmConfig.RouteReconciliationPeriod != nil {
+			values["routeReconciliationPeriod"] = ccmConfig.RouteReconciliationPeriod.Duration.String()
+		}
+		if ccmConfig.ConfigureCloudRoutes != nil {
+			values["configureCloudRoutes"] = *ccmConfig.ConfigureCloudRoutes
+		}
+		if ccmConfig.Verbosity != nil {
+			values["verbosity"] = *ccmConfig.Verbosity
+		}
 	}
 
 	return values, nil
 }
 
+// getCSIControllerChartValues collects and returns the CSI controller chart values.
+func getCSIControllerChartValues(
+	cluster *extensionscontroller.Cluster,
+	checksums map[string]string,
+	scaledDown bool,
+	replicas int,
+) (map[string]interface{}, error) {
+	useCSI, err := useCSI(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	if !useCSI {
+		return map[string]interface{}{"enabled": false}, nil
+	}
+
+	return map[string]interface{}{
+		"enabled":  true,
+		"replicas": extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, replicas),
+		"podAnnotations": map[string]interface{}{
+			"checksum/secret-" + csiProvisionerName:                        checksums[csiProvisionerName],
+			"checksum/secret-" + csiAttacherName:                           checksums[csiAttacherName],
+			"checksum/secret-" + csiResizerName:                            checksums[csiResizerName],
+			"checksum/secret-" + v1alpha1constants.SecretNameCloudProvider: checksums[v1alpha1constants.SecretNameCloudProvider],
+		},
+	}, nil
+}
+
+// getControlPlaneShootChartValues collects and returns the control plane shoot chart values.
+func getControlPlaneShootChartValues(cluster *extensionscontroller.Cluster) (map[string]interface{}, error) {
+	useCSI, err := useCSI(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"csi-driver-node": map[string]interface{}{
+			"enabled": useCSI,
+		},
+	}, nil
+}
+
+// getStorageClassesChartValues collects and returns the storage classes chart values.
+func getStorageClassesChartValues(
+	cpConfig *apisgcp.ControlPlaneConfig,
+	cluster *extensionscontroller.Cluster,
+) (map[string]interface{}, error) {
+	useCSI, err := useCSI(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	storageClasses, customDefaultStorageClass := getStorageClasses(cpConfig.StorageClasses)
+
+	return map[string]interface{}{
+		"useCSI":                    useCSI,
+		"storageClasses":            storageClasses,
+		"customDefaultStorageClass": customDefaultStorageClass,
+	}, nil
+}
+
+// getStorageClasses collects and returns the chart values for the given additional storage classes. It also
+// returns whether one of them shall be the default storage class of the shoot cluster.
+func getStorageClasses(storageClasses []apisgcp.StorageClass) ([]map[string]interface{}, bool) {
+	var (
+		values     = make([]map[string]interface{}, 0, len(storageClasses))
+		hasDefault bool
+	)
+
+	for _, sc := range storageClasses {
+		parameters := map[string]interface{}{
+			"type": sc.Type,
+		}
+
+		isDefault := sc.Default != nil && *sc.Default
+		if isDefault {
+			hasDefault = true
+		}
+
+		value := map[string]interface{}{
+			"name":       sc.Name,
+			"default":    isDefault,
+			"parameters": parameters,
+		}
+		if sc.ReclaimPolicy != nil {
+			value["reclaimPolicy"] = string(*sc.ReclaimPolicy)
+		}
+		if sc.VolumeBindingMode != nil {
+			value["volumeBindingMode"] = string(*sc.VolumeBindingMode)
+		}
+		values = append(values, value)
+	}
+
+	return values, hasDefault
+}
+
+// useCSI returns true if the GCP Compute Persistent Disk CSI driver shall be used for the given cluster.
+func useCSI(cluster *extensionscontroller.Cluster) (bool, error) {
+	useCSI, err := gcp.UseCSI(extensionscontroller.GetKubernetesVersion(cluster))
+	if err != nil {
+		return false, errors.Wrapf(err, "could not determine whether the CSI driver shall be used")
+	}
+	return useCSI, nil
+}
+
 // getNetworkNames determines the network and sub-network names from the given infrastructure status and controlplane.
 func getNetworkNames(
 	infraStatus *apisgcp.InfrastructureStatus,
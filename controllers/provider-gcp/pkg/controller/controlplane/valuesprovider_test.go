@@ -17,7 +17,9 @@ package controlplane
 import (
 	"context"
 	"encoding/json"
+	"time"
 
+	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/config"
 	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/gcp"
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal"
@@ -50,6 +52,8 @@ var _ = Describe("ValuesProvider", func() {
 		scheme = runtime.NewScheme()
 		_      = apisgcp.AddToScheme(scheme)
 
+		configureCloudRoutes = false
+
 		cp = &extensionsv1alpha1.ControlPlane{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "control-plane",
@@ -67,6 +71,8 @@ var _ = Describe("ValuesProvider", func() {
 							FeatureGates: map[string]bool{
 								"CustomResourceValidation": true,
 							},
+							RouteReconciliationPeriod: &metav1.Duration{Duration: 30 * time.Second},
+							ConfigureCloudRoutes:      &configureCloudRoutes,
 						},
 					}),
 				},
@@ -101,6 +107,18 @@ var _ = Describe("ValuesProvider", func() {
 				},
 			},
 		}
+		csiCluster = &extensionscontroller.Cluster{
+			CoreShoot: &gardencorev1alpha1.Shoot{
+				Spec: gardencorev1alpha1.ShootSpec{
+					Networking: gardencorev1alpha1.Networking{
+						Pods: &cidr,
+					},
+					Kubernetes: gardencorev1alpha1.Kubernetes{
+						Version: "1.18.0",
+					},
+				},
+			},
+		}
 
 		cpSecretKey = client.ObjectKey{Namespace: namespace, Name: v1alpha1constants.SecretNameCloudProvider}
 		cpSecret    = &corev1.Secret{
@@ -119,6 +137,9 @@ var _ = Describe("ValuesProvider", func() {
 			internal.CloudProviderConfigName:          "08a7bc7fe8f59b055f173145e211760a83f02cf89635cef26ebb351378635606",
 			"cloud-controller-manager":                "3d791b164a808638da9a8df03924be2a41e34cd664e42231c00fe369e3588272",
 			"cloud-controller-manager-server":         "6dff2a2e6f14444b66d8e4a351c049f7e89ee24ba3eaab95dbec40ba6bdebb52",
+			csiProvisionerName:                        "65b1dac6b50673535cff480564c2e5c71077ed19b1b6e0e2291207225bdf77d4",
+			csiAttacherName:                           "2da58ad61c401a2af779a909d22fb42eed93a1524cbfdab974ceedb413fcb914",
+			csiResizerName:                            "a77e663ba1af340fb3dd7f6f8a1be47c7aa9e658198695480641e6b934c0b9ed",
 		}
 
 		configChartValues = map[string]interface{}{
@@ -129,19 +150,42 @@ var _ = Describe("ValuesProvider", func() {
 			"nodeTags":       namespace,
 		}
 
-		ccmChartValues = map[string]interface{}{
-			"replicas":          1,
-			"kubernetesVersion": "1.13.4",
-			"clusterName":       namespace,
-			"podNetwork":        cidr,
-			"podAnnotations": map[string]interface{}{
-				"checksum/secret-cloud-controller-manager":        "3d791b164a808638da9a8df03924be2a41e34cd664e42231c00fe369e3588272",
-				"checksum/secret-cloud-controller-manager-server": "6dff2a2e6f14444b66d8e4a351c049f7e89ee24ba3eaab95dbec40ba6bdebb52",
-				"checksum/secret-cloudprovider":                   "8bafb35ff1ac60275d62e1cbd495aceb511fb354f74a20f7d06ecb48b3a68432",
-				"checksum/configmap-cloud-provider-config":        "08a7bc7fe8f59b055f173145e211760a83f02cf89635cef26ebb351378635606",
+		vpaChartValues = map[string]interface{}{
+			"enabled": true,
+			"updatePolicy": map[string]interface{}{
+				"updateMode": "Auto",
+			},
+		}
+		disabledChartValues = map[string]interface{}{
+			"enabled": false,
+		}
+
+		controlPlaneChartValues = map[string]interface{}{
+			"cloud-controller-manager": map[string]interface{}{
+				"replicas":          1,
+				"kubernetesVersion": "1.13.4",
+				"clusterName":       namespace,
+				"podNetwork":        cidr,
+				"podAnnotations": map[string]interface{}{
+					"checksum/secret-cloud-controller-manager":        "3d791b164a808638da9a8df03924be2a41e34cd664e42231c00fe369e3588272",
+					"checksum/secret-cloud-controller-manager-server": "6dff2a2e6f14444b66d8e4a351c049f7e89ee24ba3eaab95dbec40ba6bdebb52",
+					"checksum/secret-cloudprovider":                   "8bafb35ff1ac60275d62e1cbd495aceb511fb354f74a20f7d06ecb48b3a68432",
+					"checksum/configmap-cloud-provider-config":        "08a7bc7fe8f59b055f173145e211760a83f02cf89635cef26ebb351378635606",
+				},
+				"featureGates": map[string]bool{
+					"CustomResourceValidation": true,
+				},
+				"routeReconciliationPeriod": "30s",
+				"configureCloudRoutes":      false,
+				"vpa":                       vpaChartValues,
+				"podDisruptionBudget":       disabledChartValues,
+				"podAntiAffinity":           disabledChartValues,
 			},
-			"featureGates": map[string]bool{
-				"CustomResourceValidation": true,
+			"csi-driver-controller": map[string]interface{}{
+				"enabled":             false,
+				"vpa":                 vpaChartValues,
+				"podDisruptionBudget": disabledChartValues,
+				"podAntiAffinity":     disabledChartValues,
 			},
 		}
 
@@ -163,7 +207,7 @@ var _ = Describe("ValuesProvider", func() {
 			client.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
 
 			// Create valuesProvider
-			vp := NewValuesProvider(logger)
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
 			err := vp.(inject.Scheme).InjectScheme(scheme)
 			Expect(err).NotTo(HaveOccurred())
 			err = vp.(inject.Client).InjectClient(client)
@@ -179,14 +223,112 @@ var _ = Describe("ValuesProvider", func() {
 	Describe("#GetControlPlaneChartValues", func() {
 		It("should return correct control plane chart values", func() {
 			// Create valuesProvider
-			vp := NewValuesProvider(logger)
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
 			err := vp.(inject.Scheme).InjectScheme(scheme)
 			Expect(err).NotTo(HaveOccurred())
 
 			// Call GetControlPlaneChartValues method and check the result
 			values, err := vp.GetControlPlaneChartValues(context.TODO(), cp, cluster, checksums, false)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(values).To(Equal(ccmChartValues))
+			Expect(values).To(Equal(controlPlaneChartValues))
+		})
+
+		It("should return correct control plane chart values if the CSI driver is used", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetControlPlaneChartValues method and check the result
+			values, err := vp.GetControlPlaneChartValues(context.TODO(), cp, csiCluster, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("csi-driver-controller", map[string]interface{}{
+				"enabled":  true,
+				"replicas": 1,
+				"podAnnotations": map[string]interface{}{
+					"checksum/secret-csi-provisioner": "65b1dac6b50673535cff480564c2e5c71077ed19b1b6e0e2291207225bdf77d4",
+					"checksum/secret-csi-attacher":    "2da58ad61c401a2af779a909d22fb42eed93a1524cbfdab974ceedb413fcb914",
+					"checksum/secret-csi-resizer":     "a77e663ba1af340fb3dd7f6f8a1be47c7aa9e658198695480641e6b934c0b9ed",
+					"checksum/secret-cloudprovider":   "8bafb35ff1ac60275d62e1cbd495aceb511fb354f74a20f7d06ecb48b3a68432",
+				},
+				"vpa":                 vpaChartValues,
+				"podDisruptionBudget": disabledChartValues,
+				"podAntiAffinity":     disabledChartValues,
+			}))
+		})
+	})
+
+	Describe("#GetControlPlaneShootChartValues", func() {
+		It("should return correct control plane shoot chart values", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
+
+			// Call GetControlPlaneShootChartValues method and check the result
+			values, err := vp.GetControlPlaneShootChartValues(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"csi-driver-node": map[string]interface{}{"enabled": false},
+			}))
+
+			values, err = vp.GetControlPlaneShootChartValues(context.TODO(), cp, csiCluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"csi-driver-node": map[string]interface{}{"enabled": true},
+			}))
+		})
+	})
+
+	Describe("#GetStorageClassesChartValues", func() {
+		It("should return correct storage classes chart values", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetStorageClassesChartValues method and check the result
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{"useCSI": false, "storageClasses": []map[string]interface{}{}, "customDefaultStorageClass": false}))
+
+			values, err = vp.GetStorageClassesChartValues(context.TODO(), cp, csiCluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{"useCSI": true, "storageClasses": []map[string]interface{}{}, "customDefaultStorageClass": false}))
+		})
+
+		It("should return correct storage classes chart values for additional storage classes", func() {
+			var (
+				retain    = corev1.PersistentVolumeReclaimRetain
+				isDefault = true
+			)
+			cpWithStorageClasses := cp.DeepCopy()
+			cpWithStorageClasses.Spec.ProviderConfig = &runtime.RawExtension{
+				Raw: encode(&apisgcp.ControlPlaneConfig{
+					StorageClasses: []apisgcp.StorageClass{
+						{Name: "fast", Type: "pd-ssd", ReclaimPolicy: &retain, Default: &isDefault},
+					},
+				}),
+			}
+
+			// Create valuesProvider
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetStorageClassesChartValues method and check the result
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), cpWithStorageClasses, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"useCSI": false,
+				"storageClasses": []map[string]interface{}{
+					{
+						"name":          "fast",
+						"default":       true,
+						"reclaimPolicy": "Retain",
+						"parameters":    map[string]interface{}{"type": "pd-ssd"},
+					},
+				},
+				"customDefaultStorageClass": true,
+			}))
 		})
 	})
 })
@@ -73,12 +73,17 @@ func (a *actuator) updateProviderStatus(
 	tf *terraformer.Terraformer,
 	infra *extensionsv1alpha1.Infrastructure,
 	config *gcpv1alpha1.InfrastructureConfig,
+	autoAllocatedNatIPs []string,
 ) error {
 	status, err := infrainternal.ComputeStatus(tf, config)
 	if err != nil {
 		return err
 	}
 
+	for _, natIP := range autoAllocatedNatIPs {
+		status.Networks.NatIPs = append(status.Networks.NatIPs, gcpv1alpha1.NatIP{IP: natIP})
+	}
+
 	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, a.client, infra, func() error {
 		infra.Status.ProviderStatus = &runtime.RawExtension{Object: status}
 		return nil
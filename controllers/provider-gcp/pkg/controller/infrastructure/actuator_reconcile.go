@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal"
+	gcpclient "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/client"
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/infrastructure"
 	"github.com/gardener/gardener-extensions/pkg/controller"
 	controllererrors "github.com/gardener/gardener-extensions/pkg/controller/error"
@@ -60,5 +61,18 @@ func (a *actuator) Reconcile(ctx context.Context, infra *extensionsv1alpha1.Infr
 		}
 	}
 
-	return a.updateProviderStatus(ctx, tf, infra, config)
+	var autoAllocatedNatIPs []string
+	if config.Networks.CloudNAT != nil && len(config.Networks.CloudNAT.NatIPNames) == 0 {
+		gcpClient, err := gcpclient.NewFromServiceAccount(ctx, serviceAccount.Raw)
+		if err != nil {
+			return err
+		}
+
+		autoAllocatedNatIPs, err = infrastructure.ListCloudNATAutoAllocatedIPs(ctx, gcpClient, serviceAccount.ProjectID, infra.Spec.Region, infrastructure.CloudRouterName(infra.Namespace))
+		if err != nil {
+			return err
+		}
+	}
+
+	return a.updateProviderStatus(ctx, tf, infra, config, autoAllocatedNatIPs)
 }
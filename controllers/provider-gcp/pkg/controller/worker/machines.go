@@ -118,6 +118,10 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			return err
 		}
 
+		networkInterface := map[string]interface{}{
+			"subnetwork": nodesSubnet.Name,
+		}
+
 		for zoneIndex, zone := range pool.Zones {
 			machineClassSpec := map[string]interface{}{
 				"region":             w.worker.Spec.Region,
@@ -140,12 +144,8 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				"labels": map[string]interface{}{
 					"name": w.worker.Name,
 				},
-				"machineType": pool.MachineType,
-				"networkInterfaces": []map[string]interface{}{
-					{
-						"subnetwork": nodesSubnet.Name,
-					},
-				},
+				"machineType":       pool.MachineType,
+				"networkInterfaces": []map[string]interface{}{networkInterface},
 				"scheduling": map[string]interface{}{
 					"automaticRestart":  true,
 					"onHostMaintenance": "MIGRATE",
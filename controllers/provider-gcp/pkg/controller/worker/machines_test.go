@@ -63,7 +63,7 @@ var _ = Describe("Machines", func() {
 	})
 
 	Context("workerDelegate", func() {
-		workerDelegate := NewWorkerDelegate(nil, nil, nil, nil, nil, "", nil, nil)
+		workerDelegate := NewWorkerDelegate(nil, nil, nil, nil, config.ControlPlaneComponents{}, nil, "", nil, nil)
 
 		Describe("#MachineClassKind", func() {
 			It("should return the correct kind of the machine class", func() {
@@ -252,7 +252,7 @@ var _ = Describe("Machines", func() {
 				_ = gcpv1alpha1.AddToScheme(scheme)
 				decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
 
-				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImages, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImages, config.ControlPlaneComponents{}, chartApplier, "", w, cluster)
 			})
 
 			It("should return the expected machine deployments", func() {
@@ -417,6 +417,41 @@ var _ = Describe("Machines", func() {
 				Expect(result).To(Equal(machineDeployments))
 			})
 
+			It("should not request an IPv6 stack for dual-stack subnets", func() {
+				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
+					Raw: encode(&apisgcp.InfrastructureStatus{
+						ServiceAccountEmail: serviceAccountEmail,
+						Networks: apisgcp.NetworkStatus{
+							Subnets: []apisgcp.Subnet{
+								{
+									Name:     subnetName,
+									Purpose:  apisgcp.PurposeNodes,
+									IPv6CIDR: "2600:1900:4000:1a2b::/64",
+								},
+							},
+						},
+					}),
+				}
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImages, config.ControlPlaneComponents{}, chartApplier, "", w, cluster)
+
+				expectGetSecretCallToWork(c, serviceAccountJSON)
+				chartApplier.
+					EXPECT().
+					ApplyChart(context.TODO(), filepath.Join(gcp.InternalChartsPath, "machineclass"), namespace, "machineclass", gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, _, _, _ string, values, _ map[string]interface{}) error {
+						machineClasses := values["machineClasses"].([]map[string]interface{})
+						Expect(machineClasses).To(HaveLen(4))
+						for _, machineClass := range machineClasses {
+							Expect(machineClass["networkInterfaces"]).To(Equal([]map[string]interface{}{
+								{"subnetwork": subnetName},
+							}))
+						}
+						return nil
+					})
+
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+			})
+
 			It("should fail because the secret cannot be read", func() {
 				c.EXPECT().
 					Get(context.TODO(), gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).
@@ -431,7 +466,7 @@ var _ = Describe("Machines", func() {
 				expectGetSecretCallToWork(c, serviceAccountJSON)
 
 				cluster.CoreShoot.Spec.Kubernetes.Version = "invalid"
-				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImages, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImages, config.ControlPlaneComponents{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -443,7 +478,7 @@ var _ = Describe("Machines", func() {
 
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{}
 
-				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImages, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImages, config.ControlPlaneComponents{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -457,7 +492,7 @@ var _ = Describe("Machines", func() {
 					Raw: encode(&apisgcp.InfrastructureStatus{}),
 				}
 
-				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImages, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImages, config.ControlPlaneComponents{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -467,7 +502,7 @@ var _ = Describe("Machines", func() {
 			It("should fail because the machine image cannot be found", func() {
 				expectGetSecretCallToWork(c, serviceAccountJSON)
 
-				workerDelegate = NewWorkerDelegate(c, scheme, decoder, nil, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, nil, config.ControlPlaneComponents{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -479,7 +514,7 @@ var _ = Describe("Machines", func() {
 
 				w.Spec.Pools[0].Volume.Size = "not-decodeable"
 
-				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImages, chartApplier, "", w, cluster)
+				workerDelegate = NewWorkerDelegate(c, scheme, decoder, machineImages, config.ControlPlaneComponents{}, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -0,0 +1,41 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
+)
+
+// objectStore is an objectstore.ObjectStore for the GCS buckets of a region.
+type objectStore struct {
+	StorageClient
+	region string
+}
+
+// NewObjectStore creates a new objectstore.ObjectStore for the GCS buckets in <region> using the given storage client.
+func NewObjectStore(storageClient StorageClient, region string) objectstore.ObjectStore {
+	return &objectStore{
+		StorageClient: storageClient,
+		region:        region,
+	}
+}
+
+// CreateBucketIfNotExists creates the GCS bucket with name <bucketName> in the region of the object store. If it
+// already exists, no error is returned.
+func (s *objectStore) CreateBucketIfNotExists(ctx context.Context, bucketName string) error {
+	return s.StorageClient.CreateBucketIfNotExists(ctx, bucketName, s.region)
+}
@@ -16,11 +16,15 @@ package client
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"time"
 
 	"google.golang.org/api/googleapi"
 
 	"cloud.google.com/go/storage"
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal"
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
 	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 
@@ -30,14 +34,26 @@ import (
 
 const (
 	errCodeBucketAlreadyOwnedByYou = 409
+	errCodeBucketNotEmpty          = 409
+
+	// maxBucketEmptyingAttempts is the maximum number of attempts to empty a bucket before it is deleted.
+	maxBucketEmptyingAttempts = 3
 )
 
 // StorageClient is an interface which must be implemented by GCS clients.
 type StorageClient interface {
 	// GCS wrappers
 	CreateBucketIfNotExists(ctx context.Context, bucketName, region string) error
+	UpdateBucketVersioning(ctx context.Context, bucketName string, enabled bool) error
+	UpdateBucketRetentionPolicy(ctx context.Context, bucketName string, retentionPeriod time.Duration, locked bool) error
+	UpdateBucketEncryption(ctx context.Context, bucketName, kmsKeyName string) error
+	UpdateBucketLifecycle(ctx context.Context, bucketName string, expirationDays, numNewerVersions *int64) error
 	DeleteBucketIfExists(ctx context.Context, bucketName string) error
 	DeleteObjectsWithPrefix(ctx context.Context, bucketName, prefix string) error
+	DeleteObject(ctx context.Context, bucketName, key string) error
+	ListObjectsWithPrefix(ctx context.Context, bucketName, prefix string) (map[string]time.Time, error)
+	GetObject(ctx context.Context, bucketName, key string) (io.ReadCloser, error)
+	PutObject(ctx context.Context, bucketName, key string, body io.ReadSeeker) error
 }
 
 type storageClient struct {
@@ -67,6 +83,16 @@ func NewStorageClientFromSecretRef(ctx context.Context, c client.Client, secretR
 	return NewStorageClient(ctx, serviceAccount)
 }
 
+// NewObjectStoreFromSecretRef creates a new object store for the GCS buckets in <region> accessible with the service
+// account from the given secret reference.
+func NewObjectStoreFromSecretRef(ctx context.Context, c client.Client, secretRef corev1.SecretReference, region string) (objectstore.ObjectStore, error) {
+	storageClient, err := NewStorageClientFromSecretRef(ctx, c, secretRef)
+	if err != nil {
+		return nil, err
+	}
+	return NewObjectStore(storageClient, region), nil
+}
+
 func (s *storageClient) CreateBucketIfNotExists(ctx context.Context, bucketName, region string) error {
 	if err := s.client.Bucket(bucketName).Create(ctx, s.serviceAccount.ProjectID, &storage.BucketAttrs{
 		Name:     bucketName,
@@ -80,13 +106,111 @@ func (s *storageClient) CreateBucketIfNotExists(ctx context.Context, bucketName,
 	return nil
 }
 
-func (s *storageClient) DeleteBucketIfExists(ctx context.Context, bucketName string) error {
-	if err := s.client.Bucket(bucketName).Delete(ctx); err != nil && err != storage.ErrBucketNotExist {
+func (s *storageClient) UpdateBucketVersioning(ctx context.Context, bucketName string, enabled bool) error {
+	_, err := s.client.Bucket(bucketName).Update(ctx, storage.BucketAttrsToUpdate{VersioningEnabled: enabled})
+	return err
+}
+
+// UpdateBucketRetentionPolicy sets the retention period of the bucket with name <bucketName> and locks the retention
+// policy if <locked> is true. A locked retention policy can only be extended, hence an error is returned if
+// <retentionPeriod> is shorter than the retention period of a locked policy.
+func (s *storageClient) UpdateBucketRetentionPolicy(ctx context.Context, bucketName string, retentionPeriod time.Duration, locked bool) error {
+	bucketHandle := s.client.Bucket(bucketName)
+
+	attrs, err := bucketHandle.Attrs(ctx)
+	if err != nil {
 		return err
 	}
+
+	if policy := attrs.RetentionPolicy; policy != nil && policy.IsLocked && retentionPeriod < policy.RetentionPeriod {
+		return fmt.Errorf("the retention period of the locked retention policy cannot be reduced from %s to %s", policy.RetentionPeriod, retentionPeriod)
+	}
+
+	if attrs.RetentionPolicy == nil || attrs.RetentionPolicy.RetentionPeriod != retentionPeriod {
+		if attrs, err = bucketHandle.Update(ctx, storage.BucketAttrsToUpdate{
+			RetentionPolicy: &storage.RetentionPolicy{RetentionPeriod: retentionPeriod},
+		}); err != nil {
+			return err
+		}
+	}
+
+	if locked && !attrs.RetentionPolicy.IsLocked {
+		return bucketHandle.If(storage.BucketConditions{MetagenerationMatch: attrs.MetaGeneration}).LockRetentionPolicy(ctx)
+	}
 	return nil
 }
 
+func (s *storageClient) UpdateBucketEncryption(ctx context.Context, bucketName, kmsKeyName string) error {
+	_, err := s.client.Bucket(bucketName).Update(ctx, storage.BucketAttrsToUpdate{
+		Encryption: &storage.BucketEncryption{DefaultKMSKeyName: kmsKeyName},
+	})
+	return err
+}
+
+// UpdateBucketLifecycle replaces the lifecycle rules of the bucket with name <bucketName>. Objects are deleted after
+// <expirationDays> and noncurrent object versions once <numNewerVersions> newer versions exist.
+func (s *storageClient) UpdateBucketLifecycle(ctx context.Context, bucketName string, expirationDays, numNewerVersions *int64) error {
+	var rules []storage.LifecycleRule
+	if expirationDays != nil {
+		rules = append(rules, storage.LifecycleRule{
+			Action:    storage.LifecycleAction{Type: storage.DeleteAction},
+			Condition: storage.LifecycleCondition{AgeInDays: *expirationDays},
+		})
+	}
+	if numNewerVersions != nil {
+		rules = append(rules, storage.LifecycleRule{
+			Action: storage.LifecycleAction{Type: storage.DeleteAction},
+			Condition: storage.LifecycleCondition{
+				Liveness:         storage.Archived,
+				NumNewerVersions: *numNewerVersions,
+			},
+		})
+	}
+
+	_, err := s.client.Bucket(bucketName).Update(ctx, storage.BucketAttrsToUpdate{
+		Lifecycle: &storage.Lifecycle{Rules: rules},
+	})
+	return err
+}
+
+func (s *storageClient) DeleteBucketIfExists(ctx context.Context, bucketName string) error {
+	for attempt := 0; ; attempt++ {
+		err := s.client.Bucket(bucketName).Delete(ctx)
+		if err == nil || err == storage.ErrBucketNotExist {
+			return nil
+		}
+		if gerr, ok := err.(*googleapi.Error); !ok || gerr.Code != errCodeBucketNotEmpty {
+			return err
+		}
+		if attempt == maxBucketEmptyingAttempts {
+			return fmt.Errorf("bucket '%s' could not be emptied after %d attempts", bucketName, maxBucketEmptyingAttempts)
+		}
+
+		if err := s.deleteObjectGenerations(ctx, bucketName); err != nil {
+			return err
+		}
+	}
+}
+
+// deleteObjectGenerations deletes all live and noncurrent object generations of the bucket. Objects under a
+// retention policy cannot be deleted before their retention period has expired.
+func (s *storageClient) deleteObjectGenerations(ctx context.Context, bucketName string) error {
+	bucketHandle := s.client.Bucket(bucketName)
+	itr := bucketHandle.Objects(ctx, &storage.Query{Versions: true})
+	for {
+		attr, err := itr.Next()
+		if err != nil {
+			if err == iterator.Done {
+				return nil
+			}
+			return err
+		}
+		if err := bucketHandle.Object(attr.Name).Generation(attr.Generation).Delete(ctx); err != nil && err != storage.ErrObjectNotExist {
+			return err
+		}
+	}
+}
+
 func (s *storageClient) DeleteObjectsWithPrefix(ctx context.Context, bucketName, prefix string) error {
 	bucketHandle := s.client.Bucket(bucketName)
 	itr := bucketHandle.Objects(ctx, &storage.Query{Prefix: prefix})
@@ -103,3 +227,38 @@ func (s *storageClient) DeleteObjectsWithPrefix(ctx context.Context, bucketName,
 		}
 	}
 }
+
+func (s *storageClient) DeleteObject(ctx context.Context, bucketName, key string) error {
+	if err := s.client.Bucket(bucketName).Object(key).Delete(ctx); err != nil && err != storage.ErrObjectNotExist {
+		return err
+	}
+	return nil
+}
+
+func (s *storageClient) ListObjectsWithPrefix(ctx context.Context, bucketName, prefix string) (map[string]time.Time, error) {
+	objects := make(map[string]time.Time)
+	itr := s.client.Bucket(bucketName).Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attr, err := itr.Next()
+		if err != nil {
+			if err == iterator.Done {
+				return objects, nil
+			}
+			return nil, err
+		}
+		objects[attr.Name] = attr.Updated
+	}
+}
+
+func (s *storageClient) GetObject(ctx context.Context, bucketName, key string) (io.ReadCloser, error) {
+	return s.client.Bucket(bucketName).Object(key).NewReader(ctx)
+}
+
+func (s *storageClient) PutObject(ctx context.Context, bucketName, key string, body io.ReadSeeker) error {
+	writer := s.client.Bucket(bucketName).Object(key).NewWriter(ctx)
+	if _, err := io.Copy(writer, body); err != nil {
+		writer.Close()
+		return err
+	}
+	return writer.Close()
+}
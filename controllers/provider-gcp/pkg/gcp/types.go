@@ -14,7 +14,11 @@
 
 package gcp
 
-import "path/filepath"
+import (
+	"path/filepath"
+
+	"github.com/gardener/gardener/pkg/utils"
+)
 
 const (
 	// Name is the name of the GCP provider.
@@ -28,6 +32,18 @@ const (
 	MachineControllerManagerImageName = "machine-controller-manager"
 	// ETCDBackupRestoreImageName is the name of the etcd backup and restore image.
 	ETCDBackupRestoreImageName = "etcd-backup-restore"
+	// CSIDriverImageName is the name of the GCP Compute Persistent Disk CSI driver image.
+	CSIDriverImageName = "csi-driver-gcp"
+	// CSIProvisionerImageName is the name of the CSI provisioner image.
+	CSIProvisionerImageName = "csi-provisioner"
+	// CSIAttacherImageName is the name of the CSI attacher image.
+	CSIAttacherImageName = "csi-attacher"
+	// CSIResizerImageName is the name of the CSI resizer image.
+	CSIResizerImageName = "csi-resizer"
+	// CSINodeDriverRegistrarImageName is the name of the CSI node driver registrar image.
+	CSINodeDriverRegistrarImageName = "csi-node-driver-registrar"
+	// CSILivenessProbeImageName is the name of the CSI liveness probe image.
+	CSILivenessProbeImageName = "csi-liveness-probe"
 
 	// ServiceAccountJSONField is the field in a secret where the service account JSON is stored at.
 	ServiceAccountJSONField = "serviceaccount.json"
@@ -48,6 +64,12 @@ const (
 	MachineControllerManagerMonitoringConfigName = "machine-controller-manager-monitoring-config"
 	// BackupSecretName is the name of the secret containing the credentials for storing the backups of Shoot clusters.
 	BackupSecretName = "etcd-backup"
+
+	// CSIDriverName is the name of the GCP Compute Persistent Disk CSI driver.
+	CSIDriverName = "pd.csi.storage.gke.io"
+	// CSIMinimumKubernetesVersion is the minimum Kubernetes version for which the GCP Compute Persistent Disk CSI
+	// driver is used instead of the in-tree volume plugin.
+	CSIMinimumKubernetesVersion = "1.18"
 )
 
 var (
@@ -56,3 +78,9 @@ var (
 	// InternalChartsPath is the path to the internal charts
 	InternalChartsPath = filepath.Join(ChartsPath, "internal")
 )
+
+// UseCSI returns true if the GCP Compute Persistent Disk CSI driver shall be used instead of the in-tree volume
+// plugin for the given Kubernetes version.
+func UseCSI(kubernetesVersion string) (bool, error) {
+	return utils.CompareVersions(kubernetesVersion, ">=", CSIMinimumKubernetesVersion)
+}
@@ -36,6 +36,10 @@ type routesService struct {
 	routesService *compute.RoutesService
 }
 
+type routersService struct {
+	routersService *compute.RoutersService
+}
+
 type firewallsListCall struct {
 	firewallsListCall *compute.FirewallsListCall
 }
@@ -52,6 +56,10 @@ type routesDeleteCall struct {
 	routesDeleteCall *compute.RoutesDeleteCall
 }
 
+type routersGetRouterStatusCall struct {
+	routersGetRouterStatusCall *compute.RoutersGetRouterStatusCall
+}
+
 // NewFromServiceAccount creates a new client from the given service account.
 func NewFromServiceAccount(ctx context.Context, serviceAccount []byte) (Interface, error) {
 	jwt, err := google.JWTConfigFromJSON(serviceAccount, compute.CloudPlatformScope)
@@ -83,6 +91,11 @@ func (c *client) Routes() RoutesService {
 	return &routesService{c.service.Routes}
 }
 
+// Routers implements Interface.
+func (c *client) Routers() RoutersService {
+	return &routersService{c.service.Routers}
+}
+
 // List implements FirewallsService.
 func (f *firewallsService) List(projectID string) FirewallsListCall {
 	return &firewallsListCall{f.firewallsService.List(projectID)}
@@ -132,3 +145,18 @@ func (c *firewallsDeleteCall) Do(opts ...googleapi.CallOption) (*compute.Operati
 func (c *routesDeleteCall) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
 	return c.routesDeleteCall.Do(opts...)
 }
+
+// GetRouterStatus implements RoutersService.
+func (r *routersService) GetRouterStatus(projectID, region, router string) RoutersGetRouterStatusCall {
+	return &routersGetRouterStatusCall{r.routersService.GetRouterStatus(projectID, region, router)}
+}
+
+// Context implements RoutersGetRouterStatusCall.
+func (c *routersGetRouterStatusCall) Context(ctx context.Context) RoutersGetRouterStatusCall {
+	return &routersGetRouterStatusCall{c.routersGetRouterStatusCall.Context(ctx)}
+}
+
+// Do implements RoutersGetRouterStatusCall.
+func (c *routersGetRouterStatusCall) Do(opts ...googleapi.CallOption) (*compute.RouterStatusResponse, error) {
+	return c.routersGetRouterStatusCall.Do(opts...)
+}
@@ -27,6 +27,8 @@ type Interface interface {
 	Firewalls() FirewallsService
 	// Routes retrieves the GCP routes service.
 	Routes() RoutesService
+	// Routers retrieves the GCP routers service.
+	Routers() RoutersService
 }
 
 // FirewallsService is the interface for the GCP firewalls service.
@@ -45,6 +47,12 @@ type RoutesService interface {
 	Delete(projectID, route string) RoutesDeleteCall
 }
 
+// RoutersService is the interface for the GCP routers service.
+type RoutersService interface {
+	// GetRouterStatus initiates a RoutersGetRouterStatusCall.
+	GetRouterStatus(projectID, region, router string) RoutersGetRouterStatusCall
+}
+
 // FirewallsListCall is a list call to the firewalls service.
 type FirewallsListCall interface {
 	// Pages runs the given function on the paginated result of listing the firewalls.
@@ -72,3 +80,11 @@ type RoutesDeleteCall interface {
 	// Context sets the context for the deletion call.
 	Context(context.Context) RoutesDeleteCall
 }
+
+// RoutersGetRouterStatusCall is a call to retrieve the status of a router.
+type RoutersGetRouterStatusCall interface {
+	// Do executes the call.
+	Do(opts ...googleapi.CallOption) (*compute.RouterStatusResponse, error)
+	// Context sets the context for the call.
+	Context(context.Context) RoutersGetRouterStatusCall
+}
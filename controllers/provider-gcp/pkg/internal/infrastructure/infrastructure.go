@@ -124,6 +124,28 @@ func CleanupKubernetesRoutes(ctx context.Context, client gcpclient.Interface, pr
 	return DeleteRoutes(ctx, client, projectID, routeNames)
 }
 
+// CloudRouterName returns the name of the Cloud Router that is created for the given shoot's seed namespace.
+func CloudRouterName(shootSeedNamespace string) string {
+	return shootSeedNamespace + "-cloud-router"
+}
+
+// ListCloudNATAutoAllocatedIPs lists the external IP addresses that have been allocated automatically for the Cloud NAT
+// of the given router.
+func ListCloudNATAutoAllocatedIPs(ctx context.Context, client gcpclient.Interface, projectID, region, router string) ([]string, error) {
+	status, err := client.Routers().GetRouterStatus(projectID, region, router).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	var ips []string
+	if status.Result != nil {
+		for _, natStatus := range status.Result.NatStatus {
+			ips = append(ips, natStatus.AutoAllocatedNatIps...)
+		}
+	}
+	return ips, nil
+}
+
 // GetServiceAccountFromInfrastructure retrieves the ServiceAccount from the Secret referenced in the given Infrastructure.
 func GetServiceAccountFromInfrastructure(ctx context.Context, c client.Client, config *extensionsv1alpha1.Infrastructure) (*internal.ServiceAccount, error) {
 	return internal.GetServiceAccount(ctx, c, config.Spec.SecretRef)
@@ -181,4 +181,37 @@ var _ = Describe("Infrastructure", func() {
 			Expect(DeleteRoutes(ctx, client, projectID, routeNames)).To(Succeed())
 		})
 	})
+
+	Describe("#ListCloudNATAutoAllocatedIPs", func() {
+		It("should list the automatically allocated Cloud NAT IPs of the router", func() {
+			var (
+				ctx       = context.TODO()
+				projectID = "foo"
+				region    = "europe-west1"
+				router    = CloudRouterName("shoot--foobar--gcp")
+
+				client                     = mockgcpclient.NewMockInterface(ctrl)
+				routers                    = mockgcpclient.NewMockRoutersService(ctrl)
+				routersGetRouterStatusCall = mockgcpclient.NewMockRoutersGetRouterStatusCall(ctrl)
+			)
+
+			gomock.InOrder(
+				client.EXPECT().Routers().Return(routers),
+				routers.EXPECT().GetRouterStatus(projectID, region, "shoot--foobar--gcp-cloud-router").Return(routersGetRouterStatusCall),
+				routersGetRouterStatusCall.EXPECT().Context(ctx).Return(routersGetRouterStatusCall),
+				routersGetRouterStatusCall.EXPECT().Do().Return(&compute.RouterStatusResponse{
+					Result: &compute.RouterStatus{
+						NatStatus: []*compute.RouterStatusNatStatus{
+							{AutoAllocatedNatIps: []string{"1.2.3.4", "5.6.7.8"}},
+						},
+					},
+				}, nil),
+			)
+
+			actual, err := ListCloudNATAutoAllocatedIPs(ctx, client, projectID, region, router)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual).To(Equal([]string{"1.2.3.4", "5.6.7.8"}))
+		})
+	})
 })
@@ -15,6 +15,7 @@
 package infrastructure
 
 import (
+	"fmt"
 	"path/filepath"
 
 	gcpv1alpha1 "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/v1alpha1"
@@ -43,6 +44,15 @@ const (
 	TerraformerOutputKeySubnetNodes = "subnet_nodes"
 	// TerraformerOutputKeySubnetInternal is the name of the subnet_internal terraform output variable.
 	TerraformerOutputKeySubnetInternal = "subnet_internal"
+	// TerraformerOutputKeySubnetNodesIPv6CIDR is the name of the subnet_nodes_ipv6_cidr terraform output variable.
+	TerraformerOutputKeySubnetNodesIPv6CIDR = "subnet_nodes_ipv6_cidr"
+	// TerraformerOutputKeySubnetInternalIPv6CIDR is the name of the subnet_internal_ipv6_cidr terraform output variable.
+	TerraformerOutputKeySubnetInternalIPv6CIDR = "subnet_internal_ipv6_cidr"
+	// TerraformerOutputKeyNatIPPrefix is the name prefix of the nat_ip terraform output variables.
+	TerraformerOutputKeyNatIPPrefix = "nat_ip_"
+
+	// DefaultMinPortsPerVM is the default minimum number of ports allocated to a VM by the Cloud NAT.
+	DefaultMinPortsPerVM = 2048
 )
 
 var (
@@ -70,6 +80,19 @@ func ComputeTerraformerChartValues(
 		vpcName = config.Networks.VPC.Name
 	}
 
+	networks := map[string]interface{}{
+		"pods":     extensionscontroller.GetPodNetwork(cluster),
+		"services": extensionscontroller.GetServiceNetwork(cluster),
+		"worker":   config.Networks.Worker,
+		"internal": config.Networks.Internal,
+	}
+	if config.Networks.CloudNAT != nil {
+		networks["cloudNAT"] = computeCloudNATValues(config.Networks.CloudNAT)
+	}
+	if config.Networks.FlowLogs != nil {
+		networks["flowLogs"] = computeFlowLogsValues(config.Networks.FlowLogs)
+	}
+
 	return map[string]interface{}{
 		"google": map[string]interface{}{
 			"region":  infra.Spec.Region,
@@ -81,22 +104,71 @@ func ComputeTerraformerChartValues(
 		"vpc": map[string]interface{}{
 			"name": vpcName,
 		},
+		"dualStack":   IsDualStack(config),
 		"clusterName": infra.Namespace,
-		"networks": map[string]interface{}{
-			"pods":     extensionscontroller.GetPodNetwork(cluster),
-			"services": extensionscontroller.GetServiceNetwork(cluster),
-			"worker":   config.Networks.Worker,
-			"internal": config.Networks.Internal,
-		},
+		"networks":    networks,
 		"outputKeys": map[string]interface{}{
 			"vpcName":             TerraformerOutputKeyVPCName,
 			"serviceAccountEmail": TerraformerOutputKeyServiceAccountEmail,
 			"subnetNodes":         TerraformerOutputKeySubnetNodes,
 			"subnetInternal":      TerraformerOutputKeySubnetInternal,
+			"subnetNodesIPv6":     TerraformerOutputKeySubnetNodesIPv6CIDR,
+			"subnetInternalIPv6":  TerraformerOutputKeySubnetInternalIPv6CIDR,
+			"natIPPrefix":         TerraformerOutputKeyNatIPPrefix,
 		},
 	}
 }
 
+func computeCloudNATValues(cloudNAT *gcpv1alpha1.CloudNAT) map[string]interface{} {
+	values := map[string]interface{}{
+		"minPortsPerVM": int32(DefaultMinPortsPerVM),
+	}
+	if cloudNAT.MinPortsPerVM != nil {
+		values["minPortsPerVM"] = *cloudNAT.MinPortsPerVM
+	}
+	if len(cloudNAT.NatIPNames) > 0 {
+		var natIPNames []string
+		for _, natIPName := range cloudNAT.NatIPNames {
+			natIPNames = append(natIPNames, natIPName.Name)
+		}
+		values["natIPNames"] = natIPNames
+	}
+	if cloudNAT.IcmpIdleTimeoutSec != nil {
+		values["icmpIdleTimeoutSec"] = *cloudNAT.IcmpIdleTimeoutSec
+	}
+	if cloudNAT.TCPEstablishedIdleTimeoutSec != nil {
+		values["tcpEstablishedIdleTimeoutSec"] = *cloudNAT.TCPEstablishedIdleTimeoutSec
+	}
+	if cloudNAT.TCPTransitoryIdleTimeoutSec != nil {
+		values["tcpTransitoryIdleTimeoutSec"] = *cloudNAT.TCPTransitoryIdleTimeoutSec
+	}
+	if cloudNAT.UDPIdleTimeoutSec != nil {
+		values["udpIdleTimeoutSec"] = *cloudNAT.UDPIdleTimeoutSec
+	}
+	return values
+}
+
+func computeFlowLogsValues(flowLogs *gcpv1alpha1.FlowLogs) map[string]interface{} {
+	values := map[string]interface{}{
+		"enabled": true,
+	}
+	if flowLogs.AggregationInterval != nil {
+		values["aggregationInterval"] = *flowLogs.AggregationInterval
+	}
+	if flowLogs.FlowSampling != nil {
+		values["flowSampling"] = *flowLogs.FlowSampling
+	}
+	if flowLogs.Metadata != nil {
+		values["metadata"] = *flowLogs.Metadata
+	}
+	return values
+}
+
+// IsDualStack returns true if the given InfrastructureConfig requests IPv4 and IPv6 subnets.
+func IsDualStack(config *gcpv1alpha1.InfrastructureConfig) bool {
+	return config.Networks.DualStack != nil && config.Networks.DualStack.Enabled
+}
+
 // RenderTerraformerChart renders the gcp-infra chart with the given values.
 func RenderTerraformerChart(
 	renderer chartrenderer.Interface,
@@ -136,6 +208,12 @@ type TerraformState struct {
 	SubnetNodes string
 	// SubnetInternal is the CIDR of the internal subnet of an infrastructure.
 	SubnetInternal *string
+	// SubnetNodesIPv6CIDR is the IPv6 range of the nodes subnet of a dual-stack infrastructure.
+	SubnetNodesIPv6CIDR string
+	// SubnetInternalIPv6CIDR is the IPv6 range of the internal subnet of a dual-stack infrastructure.
+	SubnetInternalIPv6CIDR string
+	// NatIPs are the user provided external IP addresses used by the Cloud NAT.
+	NatIPs []string
 }
 
 // ExtractTerraformState extracts the TerraformState from the given Terraformer.
@@ -151,6 +229,22 @@ func ExtractTerraformState(tf *terraformer.Terraformer, config *gcpv1alpha1.Infr
 		outputKeys = append(outputKeys, TerraformerOutputKeySubnetInternal)
 	}
 
+	dualStack := IsDualStack(config)
+	if dualStack {
+		outputKeys = append(outputKeys, TerraformerOutputKeySubnetNodesIPv6CIDR)
+		if hasInternal {
+			outputKeys = append(outputKeys, TerraformerOutputKeySubnetInternalIPv6CIDR)
+		}
+	}
+
+	var natIPKeys []string
+	if config.Networks.CloudNAT != nil {
+		for i := range config.Networks.CloudNAT.NatIPNames {
+			natIPKeys = append(natIPKeys, fmt.Sprintf("%s%d", TerraformerOutputKeyNatIPPrefix, i))
+		}
+	}
+	outputKeys = append(outputKeys, natIPKeys...)
+
 	vars, err := tf.GetStateOutputVariables(outputKeys...)
 	if err != nil {
 		return nil, err
@@ -165,6 +259,13 @@ func ExtractTerraformState(tf *terraformer.Terraformer, config *gcpv1alpha1.Infr
 		subnetInternal := vars[TerraformerOutputKeySubnetInternal]
 		state.SubnetInternal = &subnetInternal
 	}
+	if dualStack {
+		state.SubnetNodesIPv6CIDR = vars[TerraformerOutputKeySubnetNodesIPv6CIDR]
+		state.SubnetInternalIPv6CIDR = vars[TerraformerOutputKeySubnetInternalIPv6CIDR]
+	}
+	for _, natIPKey := range natIPKeys {
+		state.NatIPs = append(state.NatIPs, vars[natIPKey])
+	}
 	return state, nil
 }
 
@@ -180,8 +281,9 @@ func StatusFromTerraformState(state *TerraformState) *gcpv1alpha1.Infrastructure
 				},
 				Subnets: []gcpv1alpha1.Subnet{
 					{
-						Purpose: gcpv1alpha1.PurposeNodes,
-						Name:    state.SubnetNodes,
+						Purpose:  gcpv1alpha1.PurposeNodes,
+						Name:     state.SubnetNodes,
+						IPv6CIDR: state.SubnetNodesIPv6CIDR,
 					},
 				},
 			},
@@ -191,10 +293,15 @@ func StatusFromTerraformState(state *TerraformState) *gcpv1alpha1.Infrastructure
 
 	if state.SubnetInternal != nil {
 		status.Networks.Subnets = append(status.Networks.Subnets, gcpv1alpha1.Subnet{
-			Purpose: gcpv1alpha1.PurposeInternal,
-			Name:    *state.SubnetInternal,
+			Purpose:  gcpv1alpha1.PurposeInternal,
+			Name:     *state.SubnetInternal,
+			IPv6CIDR: state.SubnetInternalIPv6CIDR,
 		})
 	}
+
+	for _, natIP := range state.NatIPs {
+		status.Networks.NatIPs = append(status.Networks.NatIPs, gcpv1alpha1.NatIP{IP: natIP})
+	}
 	return status
 }
 
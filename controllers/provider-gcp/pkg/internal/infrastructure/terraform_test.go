@@ -105,6 +105,7 @@ var _ = Describe("Terraform", func() {
 				"vpc": map[string]interface{}{
 					"name": config.Networks.VPC.Name,
 				},
+				"dualStack":   false,
 				"clusterName": infra.Namespace,
 				"networks": map[string]interface{}{
 					"pods":     podsCIDR,
@@ -117,6 +118,9 @@ var _ = Describe("Terraform", func() {
 					"serviceAccountEmail": TerraformerOutputKeyServiceAccountEmail,
 					"subnetNodes":         TerraformerOutputKeySubnetNodes,
 					"subnetInternal":      TerraformerOutputKeySubnetInternal,
+					"subnetNodesIPv6":     TerraformerOutputKeySubnetNodesIPv6CIDR,
+					"subnetInternalIPv6":  TerraformerOutputKeySubnetInternalIPv6CIDR,
+					"natIPPrefix":         TerraformerOutputKeyNatIPPrefix,
 				},
 			}))
 		})
@@ -136,6 +140,7 @@ var _ = Describe("Terraform", func() {
 				"vpc": map[string]interface{}{
 					"name": DefaultVPCName,
 				},
+				"dualStack":   false,
 				"clusterName": infra.Namespace,
 				"networks": map[string]interface{}{
 					"pods":     podsCIDR,
@@ -148,11 +153,82 @@ var _ = Describe("Terraform", func() {
 					"serviceAccountEmail": TerraformerOutputKeyServiceAccountEmail,
 					"subnetNodes":         TerraformerOutputKeySubnetNodes,
 					"subnetInternal":      TerraformerOutputKeySubnetInternal,
+					"subnetNodesIPv6":     TerraformerOutputKeySubnetNodesIPv6CIDR,
+					"subnetInternalIPv6":  TerraformerOutputKeySubnetInternalIPv6CIDR,
+					"natIPPrefix":         TerraformerOutputKeyNatIPPrefix,
 				},
 			}))
 		})
 	})
 
+	Describe("#ComputeTerraformerChartValues with Cloud NAT and flow logs", func() {
+		It("should correctly compute the Cloud NAT and flow logs values", func() {
+			var (
+				minPortsPerVM       int32   = 4096
+				udpIdleTimeoutSec   int32   = 60
+				aggregationInterval         = "INTERVAL_5_SEC"
+				flowSampling        float32 = 0.5
+			)
+			config.Networks.CloudNAT = &gcpv1alpha1.CloudNAT{
+				MinPortsPerVM:     &minPortsPerVM,
+				NatIPNames:        []gcpv1alpha1.NatIPName{{Name: "manual-nat-ip"}},
+				UDPIdleTimeoutSec: &udpIdleTimeoutSec,
+			}
+			config.Networks.FlowLogs = &gcpv1alpha1.FlowLogs{
+				AggregationInterval: &aggregationInterval,
+				FlowSampling:        &flowSampling,
+			}
+
+			values := ComputeTerraformerChartValues(infra, serviceAccount, config, cluster)
+
+			Expect(values["networks"]).To(HaveKeyWithValue("cloudNAT", map[string]interface{}{
+				"minPortsPerVM":     minPortsPerVM,
+				"natIPNames":        []string{"manual-nat-ip"},
+				"udpIdleTimeoutSec": udpIdleTimeoutSec,
+			}))
+			Expect(values["networks"]).To(HaveKeyWithValue("flowLogs", map[string]interface{}{
+				"enabled":             true,
+				"aggregationInterval": aggregationInterval,
+				"flowSampling":        flowSampling,
+			}))
+		})
+
+		It("should default the minimum ports per VM of the Cloud NAT", func() {
+			config.Networks.CloudNAT = &gcpv1alpha1.CloudNAT{}
+
+			values := ComputeTerraformerChartValues(infra, serviceAccount, config, cluster)
+
+			Expect(values["networks"]).To(HaveKeyWithValue("cloudNAT", map[string]interface{}{
+				"minPortsPerVM": int32(DefaultMinPortsPerVM),
+			}))
+		})
+
+		It("should keep a flow sampling rate of zero", func() {
+			var flowSampling float32
+			config.Networks.FlowLogs = &gcpv1alpha1.FlowLogs{
+				FlowSampling: &flowSampling,
+			}
+
+			values := ComputeTerraformerChartValues(infra, serviceAccount, config, cluster)
+
+			Expect(values["networks"]).To(HaveKeyWithValue("flowLogs", map[string]interface{}{
+				"enabled":      true,
+				"flowSampling": flowSampling,
+			}))
+		})
+	})
+
+	Describe("#IsDualStack", func() {
+		It("should return false if no dual-stack settings are given", func() {
+			Expect(IsDualStack(config)).To(BeFalse())
+		})
+
+		It("should return true if dual-stack is enabled", func() {
+			config.Networks.DualStack = &gcpv1alpha1.DualStack{Enabled: true}
+			Expect(IsDualStack(config)).To(BeTrue())
+		})
+	})
+
 	Describe("#StatusFromTerraformState", func() {
 		var (
 			serviceAccountEmail string
@@ -201,6 +277,35 @@ var _ = Describe("Terraform", func() {
 			}))
 		})
 
+		It("should correctly compute the status of a dual-stack infrastructure", func() {
+			state.SubnetNodesIPv6CIDR = "2600:1900:4000:1::/64"
+			state.SubnetInternalIPv6CIDR = "2600:1900:4000:2::/64"
+			status := StatusFromTerraformState(state)
+
+			Expect(status.Networks.Subnets).To(Equal([]gcpv1alpha1.Subnet{
+				{
+					Purpose:  gcpv1alpha1.PurposeNodes,
+					Name:     subnetNodes,
+					IPv6CIDR: "2600:1900:4000:1::/64",
+				},
+				{
+					Purpose:  gcpv1alpha1.PurposeInternal,
+					Name:     subnetInternal,
+					IPv6CIDR: "2600:1900:4000:2::/64",
+				},
+			}))
+		})
+
+		It("should correctly compute the status with Cloud NAT IPs", func() {
+			state.NatIPs = []string{"1.2.3.4", "5.6.7.8"}
+			status := StatusFromTerraformState(state)
+
+			Expect(status.Networks.NatIPs).To(Equal([]gcpv1alpha1.NatIP{
+				{IP: "1.2.3.4"},
+				{IP: "5.6.7.8"},
+			}))
+		})
+
 		It("should correctly compute the status without internal subnet", func() {
 			state.SubnetInternal = nil
 			status := StatusFromTerraformState(state)
@@ -1,3 +1,3 @@
-//go:generate mockgen -package=client -destination=mocks.go github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/client Interface,FirewallsService,RoutesService,FirewallsListCall,RoutesListCall,FirewallsDeleteCall,RoutesDeleteCall
+//go:generate mockgen -package=client -destination=mocks.go github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/client Interface,FirewallsService,RoutesService,RoutersService,FirewallsListCall,RoutesListCall,FirewallsDeleteCall,RoutesDeleteCall,RoutersGetRouterStatusCall
 
 package client
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/client (interfaces: Interface,FirewallsService,RoutesService,FirewallsListCall,RoutesListCall,FirewallsDeleteCall,RoutesDeleteCall)
+// Source: github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/internal/client (interfaces: Interface,FirewallsService,RoutesService,RoutersService,FirewallsListCall,RoutesListCall,FirewallsDeleteCall,RoutesDeleteCall,RoutersGetRouterStatusCall)
 
 // Package client is a generated GoMock package.
 package client
@@ -50,6 +50,20 @@ func (mr *MockInterfaceMockRecorder) Firewalls() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Firewalls", reflect.TypeOf((*MockInterface)(nil).Firewalls))
 }
 
+// Routers mocks base method
+func (m *MockInterface) Routers() client.RoutersService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Routers")
+	ret0, _ := ret[0].(client.RoutersService)
+	return ret0
+}
+
+// Routers indicates an expected call of Routers
+func (mr *MockInterfaceMockRecorder) Routers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Routers", reflect.TypeOf((*MockInterface)(nil).Routers))
+}
+
 // Routes mocks base method
 func (m *MockInterface) Routes() client.RoutesService {
 	m.ctrl.T.Helper()
@@ -166,6 +180,43 @@ func (mr *MockRoutesServiceMockRecorder) List(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRoutesService)(nil).List), arg0)
 }
 
+// MockRoutersService is a mock of RoutersService interface
+type MockRoutersService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoutersServiceMockRecorder
+}
+
+// MockRoutersServiceMockRecorder is the mock recorder for MockRoutersService
+type MockRoutersServiceMockRecorder struct {
+	mock *MockRoutersService
+}
+
+// NewMockRoutersService creates a new mock instance
+func NewMockRoutersService(ctrl *gomock.Controller) *MockRoutersService {
+	mock := &MockRoutersService{ctrl: ctrl}
+	mock.recorder = &MockRoutersServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRoutersService) EXPECT() *MockRoutersServiceMockRecorder {
+	return m.recorder
+}
+
+// GetRouterStatus mocks base method
+func (m *MockRoutersService) GetRouterStatus(arg0, arg1, arg2 string) client.RoutersGetRouterStatusCall {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRouterStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].(client.RoutersGetRouterStatusCall)
+	return ret0
+}
+
+// GetRouterStatus indicates an expected call of GetRouterStatus
+func (mr *MockRoutersServiceMockRecorder) GetRouterStatus(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRouterStatus", reflect.TypeOf((*MockRoutersService)(nil).GetRouterStatus), arg0, arg1, arg2)
+}
+
 // MockFirewallsListCall is a mock of FirewallsListCall interface
 type MockFirewallsListCall struct {
 	ctrl     *gomock.Controller
@@ -351,3 +402,59 @@ func (mr *MockRoutesDeleteCallMockRecorder) Do(arg0 ...interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockRoutesDeleteCall)(nil).Do), arg0...)
 }
+
+// MockRoutersGetRouterStatusCall is a mock of RoutersGetRouterStatusCall interface
+type MockRoutersGetRouterStatusCall struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoutersGetRouterStatusCallMockRecorder
+}
+
+// MockRoutersGetRouterStatusCallMockRecorder is the mock recorder for MockRoutersGetRouterStatusCall
+type MockRoutersGetRouterStatusCallMockRecorder struct {
+	mock *MockRoutersGetRouterStatusCall
+}
+
+// NewMockRoutersGetRouterStatusCall creates a new mock instance
+func NewMockRoutersGetRouterStatusCall(ctrl *gomock.Controller) *MockRoutersGetRouterStatusCall {
+	mock := &MockRoutersGetRouterStatusCall{ctrl: ctrl}
+	mock.recorder = &MockRoutersGetRouterStatusCallMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRoutersGetRouterStatusCall) EXPECT() *MockRoutersGetRouterStatusCallMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method
+func (m *MockRoutersGetRouterStatusCall) Context(arg0 context.Context) client.RoutersGetRouterStatusCall {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context", arg0)
+	ret0, _ := ret[0].(client.RoutersGetRouterStatusCall)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockRoutersGetRouterStatusCallMockRecorder) Context(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockRoutersGetRouterStatusCall)(nil).Context), arg0)
+}
+
+// Do mocks base method
+func (m *MockRoutersGetRouterStatusCall) Do(arg0 ...googleapi.CallOption) (*v1.RouterStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.RouterStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do
+func (mr *MockRoutersGetRouterStatusCallMockRecorder) Do(arg0 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockRoutersGetRouterStatusCall)(nil).Do), arg0...)
+}
@@ -19,8 +19,12 @@ import (
 	"path"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/config"
+	confighelper "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/config/helper"
+	apisgcp "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp"
+	apisgcphelper "github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/apis/gcp/helper"
 	"github.com/gardener/gardener-extensions/controllers/provider-gcp/pkg/gcp"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupentry"
 	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane/genericmutator"
@@ -32,14 +36,16 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // NewEnsurer creates a new controlplaneexposure ensurer.
-func NewEnsurer(etcdBackup *config.ETCDBackup, imageVector imagevector.ImageVector, logger logr.Logger) genericmutator.Ensurer {
+func NewEnsurer(etcdBackup *config.ETCDBackup, imageVector imagevector.ImageVector, decoder runtime.Decoder, logger logr.Logger) genericmutator.Ensurer {
 	return &ensurer{
 		etcdBackup:  etcdBackup,
 		imageVector: imageVector,
+		decoder:     decoder,
 		logger:      logger.WithName("gcp-controlplanebackup-ensurer"),
 	}
 }
@@ -48,6 +54,7 @@ type ensurer struct {
 	genericmutator.NoopEnsurer
 	etcdBackup  *config.ETCDBackup
 	imageVector imagevector.ImageVector
+	decoder     runtime.Decoder
 	client      client.Client
 	logger      logr.Logger
 }
@@ -60,7 +67,7 @@ func (e *ensurer) InjectClient(client client.Client) error {
 
 // EnsureETCDStatefulSet ensures that the etcd stateful sets conform to the provider requirements.
 func (e *ensurer) EnsureETCDStatefulSet(ctx context.Context, ss *appsv1.StatefulSet, cluster *extensionscontroller.Cluster) error {
-	if err := e.ensureContainers(&ss.Spec.Template.Spec, ss.Name, cluster); err != nil {
+	if err := e.ensureContainers(ctx, &ss.Spec.Template.Spec, ss.Namespace, ss.Name, cluster); err != nil {
 		return err
 	}
 
@@ -69,9 +76,9 @@ func (e *ensurer) EnsureETCDStatefulSet(ctx context.Context, ss *appsv1.Stateful
 	return e.ensureChecksumAnnotations(ctx, &ss.Spec.Template, ss.Namespace, ss.Name, backupConfigured)
 }
 
-func (e *ensurer) ensureContainers(ps *corev1.PodSpec, name string, cluster *extensionscontroller.Cluster) error {
+func (e *ensurer) ensureContainers(ctx context.Context, ps *corev1.PodSpec, namespace, name string, cluster *extensionscontroller.Cluster) error {
 	backupRestoreContainer := extensionswebhook.ContainerWithName(ps.Containers, controlplane.BackupRestoreContainerName)
-	c, err := e.ensureBackupRestoreContainer(backupRestoreContainer, name, cluster)
+	c, err := e.ensureBackupRestoreContainer(ctx, backupRestoreContainer, namespace, name, cluster)
 	if err != nil {
 		return err
 	}
@@ -86,7 +93,7 @@ func (e *ensurer) ensureChecksumAnnotations(ctx context.Context, template *corev
 	return nil
 }
 
-func (e *ensurer) ensureBackupRestoreContainer(existingContainer *corev1.Container, name string, cluster *extensionscontroller.Cluster) (*corev1.Container, error) {
+func (e *ensurer) ensureBackupRestoreContainer(ctx context.Context, existingContainer *corev1.Container, namespace, name string, cluster *extensionscontroller.Cluster) (*corev1.Container, error) {
 	// Find etcd-backup-restore image
 	// TODO Get seed version from clientset when it's possible to inject it
 	image, err := e.imageVector.FindImage(gcp.ETCDBackupRestoreImageName, imagevector.TargetVersion(extensionscontroller.GetKubernetesVersion(cluster)))
@@ -111,7 +118,11 @@ func (e *ensurer) ensureBackupRestoreContainer(existingContainer *corev1.Contain
 		if extensionscontroller.IsSeedBackupNil(cluster) {
 			e.logger.Info("Backup profile is not configured; backup will not be taken for etcd-main")
 		} else {
-			prefix = common.GenerateBackupEntryName(extensionscontroller.GetTechnicalID(cluster), extensionscontroller.GetUID(cluster))
+			backupEntryName := common.GenerateBackupEntryName(extensionscontroller.GetTechnicalID(cluster), extensionscontroller.GetUID(cluster))
+			if prefix, err = backupentry.GetStorePrefixByName(ctx, e.client, backupEntryName); err != nil {
+				prefix = controlplane.GetStorePrefixFromContainer(existingContainer, name, backupEntryName)
+				e.logger.Error(err, "Could not determine the store prefix of the etcd backups, keeping the current one", "prefix", prefix)
+			}
 
 			provider = gcp.StorageProviderName
 			env = []corev1.EnvVar{
@@ -151,7 +162,24 @@ func (e *ensurer) ensureBackupRestoreContainer(existingContainer *corev1.Contain
 		}
 	}
 
-	return controlplane.GetBackupRestoreContainer(name, volumeClaimTemplateName, schedule, provider, prefix, image.String(), nil, env, volumeMounts), nil
+	backupRestoreConfig, err := e.getBackupRestoreConfig(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return controlplane.GetBackupRestoreContainer(name, volumeClaimTemplateName, schedule, provider, prefix, image.String(), backupRestoreConfig, nil, env, volumeMounts), nil
+}
+
+// getBackupRestoreConfig returns the backup-restore configuration for the shoot in the given namespace. Settings in the
+// ControlPlaneConfig of the shoot take precedence over the etcd backup configuration of the controller.
+func (e *ensurer) getBackupRestoreConfig(ctx context.Context, namespace string) (*controlplane.BackupRestoreConfig, error) {
+	return controlplane.GetBackupRestoreConfig(ctx, e.client, namespace, gcp.Type, confighelper.GetBackupRestoreConfig(e.etcdBackup), func(providerConfig []byte) (*controlplane.BackupRestoreConfig, error) {
+		cpConfig := &apisgcp.ControlPlaneConfig{}
+		if _, _, err := e.decoder.Decode(providerConfig, nil, cpConfig); err != nil {
+			return nil, err
+		}
+		return apisgcphelper.GetBackupRestoreConfig(cpConfig.ETCDBackup), nil
+	})
 }
 
 func (e *ensurer) ensureVolumes(ps *corev1.PodSpec, name string, backupConfigured bool) {
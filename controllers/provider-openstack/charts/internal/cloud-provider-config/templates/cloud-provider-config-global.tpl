@@ -7,9 +7,9 @@ username="{{ .Values.username }}"
 password="{{ .Values.password }}"
 [LoadBalancer]
 create-monitor=true
-monitor-delay=60s
-monitor-timeout=30s
-monitor-max-retries=5
+monitor-delay={{ .Values.monitorDelay | default "60s" }}
+monitor-timeout={{ .Values.monitorTimeout | default "30s" }}
+monitor-max-retries={{ .Values.monitorMaxRetries | default 5 }}
 lb-version=v2
 lb-provider="{{ .Values.lbProvider }}"
 floating-network-id="{{ .Values.floatingNetworkID }}"
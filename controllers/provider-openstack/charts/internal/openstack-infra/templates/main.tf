@@ -21,18 +21,27 @@ resource "openstack_networking_router_v2" "router" {
   name                = "{{ required "clusterName is required" .Values.clusterName }}"
   region              = "{{ required "openstack.region is required" .Values.openstack.region }}"
   external_network_id = "${data.openstack_networking_network_v2.fip.id}"
+  {{- range $ip := .Values.router.externalFixedIPs }}
+
+  external_fixed_ip {
+    ip_address = "{{ $ip }}"
+  }
+  {{- end }}
 }
 {{- end}}
 
+{{ if .Values.create.network -}}
 resource "openstack_networking_network_v2" "cluster" {
   name           = "{{ required "clusterName is required" .Values.clusterName }}"
   admin_state_up = "true"
 }
 
+{{ end -}}
+{{ if .Values.create.subnet -}}
 resource "openstack_networking_subnet_v2" "cluster" {
   name            = "{{ required "clusterName is required" .Values.clusterName }}"
   cidr            = "{{ required "networks.worker is required" .Values.networks.worker }}"
-  network_id      = "${openstack_networking_network_v2.cluster.id}"
+  network_id      = "{{ required "networks.id is required" .Values.networks.id }}"
   ip_version      = 4
   {{- if .Values.dnsServers }}
   dns_nameservers = [{{- include "openstack-infra.dnsServers" . | trimSuffix ", " }}]
@@ -46,6 +55,7 @@ resource "openstack_networking_router_interface_v2" "router_nodes" {
   subnet_id = "${openstack_networking_subnet_v2.cluster.id}"
 }
 
+{{ end -}}
 resource "openstack_networking_secgroup_v2" "cluster" {
   name                 = "{{ required "clusterName is required" .Values.clusterName }}"
   description          = "Cluster Nodes"
@@ -103,7 +113,7 @@ output "{{ .Values.outputKeys.routerID }}" {
 }
 
 output "{{ .Values.outputKeys.networkID }}" {
-  value = "${openstack_networking_network_v2.cluster.id}"
+  value = "{{ required "networks.id is required" .Values.networks.id }}"
 }
 
 output "{{ .Values.outputKeys.keyName }}" {
@@ -123,5 +133,5 @@ output "{{ .Values.outputKeys.floatingNetworkID }}" {
 }
 
 output "{{ .Values.outputKeys.subnetID }}" {
-  value = "${openstack_networking_subnet_v2.cluster.id}"
+  value = "{{ required "networks.subnetID is required" .Values.networks.subnetID }}"
 }
\ No newline at end of file
@@ -0,0 +1,7 @@
+{{- define "csi-driver-node.name" -}}
+cinder.csi.openstack.org
+{{- end -}}
+
+{{- define "csi-driver-node.plugin-dir" -}}
+/var/lib/kubelet/plugins/{{ include "csi-driver-node.name" . }}
+{{- end -}}
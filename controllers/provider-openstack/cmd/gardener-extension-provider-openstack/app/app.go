@@ -60,6 +60,8 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 		backupEntryCtrlOpts = &controllercmd.ControllerOptions{
 			MaxConcurrentReconciles: 5,
 		}
+		backupEntryDeletionOpts       = &controllercmd.DeletionOptions{}
+		backupEntryCtrlOptsUnprefixed = controllercmd.NewOptionAggregator(backupEntryCtrlOpts, backupEntryDeletionOpts)
 
 		// options for the infrastructure controller
 		infraCtrlOpts = &controllercmd.ControllerOptions{
@@ -95,7 +97,7 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			restOpts,
 			mgrOpts,
 			controllercmd.PrefixOption("backupbucket-", backupBucketCtrlOpts),
-			controllercmd.PrefixOption("backupentry-", backupEntryCtrlOpts),
+			controllercmd.PrefixOption("backupentry-", &backupEntryCtrlOptsUnprefixed),
 			controllercmd.PrefixOption("controlplane-", controlPlaneCtrlOpts),
 			controllercmd.PrefixOption("infrastructure-", infraCtrlOpts),
 			controllercmd.PrefixOption("worker-", &workerCtrlOptsUnprefixed),
@@ -136,10 +138,17 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			}
 
 			configFileOpts.Completed().ApplyMachineImages(&openstackworker.DefaultAddOptions.MachineImagesToCloudProfilesMapping)
+			configFileOpts.Completed().ApplyControlPlaneComponents(&openstackcontrolplane.DefaultAddOptions.ControlPlaneComponents)
+			configFileOpts.Completed().ApplyControlPlaneComponents(&openstackworker.DefaultAddOptions.ControlPlaneComponents)
 			configFileOpts.Completed().ApplyETCDStorage(&openstackcontrolplaneexposure.DefaultAddOptions.ETCDStorage)
 			configFileOpts.Completed().ApplyETCDBackup(&openstackcontrolplanebackup.DefaultAddOptions.ETCDBackup)
+			configFileOpts.Completed().ApplyBackupBucketConfig(&openstackbackupbucket.DefaultAddOptions.BackupBucketConfig)
+			configFileOpts.Completed().ApplyBackupStorage(&openstackcontrolplanebackup.DefaultAddOptions.BackupStorage)
+			configFileOpts.Completed().ApplyBackupStorage(&openstackbackupbucket.DefaultAddOptions.BackupStorage)
+			configFileOpts.Completed().ApplyBackupStorage(&openstackbackupentry.DefaultAddOptions.BackupStorage)
 			backupBucketCtrlOpts.Completed().Apply(&openstackbackupbucket.DefaultAddOptions.Controller)
 			backupEntryCtrlOpts.Completed().Apply(&openstackbackupentry.DefaultAddOptions.Controller)
+			backupEntryDeletionOpts.Completed().Apply(&openstackbackupentry.DefaultAddOptions.Deletion.GracePeriod, &openstackbackupentry.DefaultAddOptions.Deletion.GarbageCollectionPeriod)
 			controlPlaneCtrlOpts.Completed().Apply(&openstackcontrolplane.DefaultAddOptions.Controller)
 			infraCtrlOpts.Completed().Apply(&openstackinfrastructure.DefaultAddOptions.Controller)
 			reconcileOpts.Completed().Apply(&openstackinfrastructure.DefaultAddOptions.IgnoreOperationAnnotation)
@@ -147,9 +156,11 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			reconcileOpts.Completed().Apply(&openstackworker.DefaultAddOptions.IgnoreOperationAnnotation)
 			workerCtrlOpts.Completed().Apply(&openstackworker.DefaultAddOptions.Controller)
 
-			if _, _, err := webhookOptions.Completed().AddToManager(mgr); err != nil {
+			_, shootWebhooks, err := webhookOptions.Completed().AddToManager(mgr)
+			if err != nil {
 				controllercmd.LogErrAndExit(err, "Could not add webhooks to manager")
 			}
+			openstackcontrolplane.DefaultAddOptions.ShootWebhooks = shootWebhooks
 
 			if err := controllerSwitches.Completed().AddToManager(mgr); err != nil {
 				controllercmd.LogErrAndExit(err, "Could not add controllers to manager")
@@ -18,6 +18,11 @@ import (
 	"fmt"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/config"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/objectstore/s3compat"
+	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 // FindImageForCloudProfile takes a list of machine images, and the desired image name, version, and cloud profile name. It tries
@@ -38,3 +43,73 @@ func FindImageForCloudProfile(machineImages []config.MachineImage, imageName, ve
 
 	return "", fmt.Errorf("could not find an image for cloud profile %q and machine image %q in version %q", cloudProfileName, imageName, version)
 }
+
+// GetControlPlaneComponentChartValues returns the chart values for the vertical pod autoscaling, PodDisruptionBudget
+// and pod anti-affinity settings of the control plane components deployed by the extension.
+func GetControlPlaneComponentChartValues(controlPlaneComponents config.ControlPlaneComponents) map[string]interface{} {
+	var (
+		vpaEnabled    *bool
+		vpaUpdateMode *string
+	)
+
+	if vpa := controlPlaneComponents.VPA; vpa != nil {
+		vpaEnabled = vpa.Enabled
+		vpaUpdateMode = vpa.UpdateMode
+	}
+
+	return extensionscontroller.GetControlPlaneComponentChartValues(vpaEnabled, vpaUpdateMode, controlPlaneComponents.PodDisruptionBudget, controlPlaneComponents.PodAntiAffinity)
+}
+
+// GetControlPlaneComponentReplicas returns the number of replicas of the control plane components deployed by the extension.
+func GetControlPlaneComponentReplicas(controlPlaneComponents config.ControlPlaneComponents) int {
+	return extensionscontroller.GetControlPlaneComponentReplicas(controlPlaneComponents.Replicas)
+}
+
+// ValidateControlPlaneComponents validates the configuration of the control plane components deployed by the extension.
+func ValidateControlPlaneComponents(controlPlaneComponents config.ControlPlaneComponents, fldPath *field.Path) field.ErrorList {
+	var vpaUpdateMode *string
+	if vpa := controlPlaneComponents.VPA; vpa != nil {
+		vpaUpdateMode = vpa.UpdateMode
+	}
+
+	return extensionscontroller.ValidateControlPlaneComponents(vpaUpdateMode, controlPlaneComponents.Replicas, fldPath)
+}
+
+// IsS3CompatBackupStorage returns true if the given backup storage configuration specifies an S3-compatible object
+// storage that is used instead of Swift.
+func IsS3CompatBackupStorage(backupStorage config.BackupStorage) bool {
+	return len(backupStorage.Endpoint) != 0
+}
+
+// GetS3CompatConfig returns the configuration of the S3-compatible object storage for the given backup storage
+// configuration.
+func GetS3CompatConfig(backupStorage config.BackupStorage) *s3compat.Config {
+	s3CompatConfig := &s3compat.Config{
+		Endpoint: backupStorage.Endpoint,
+	}
+	if backupStorage.Region != nil {
+		s3CompatConfig.Region = *backupStorage.Region
+	}
+	if backupStorage.ForcePathStyle != nil {
+		s3CompatConfig.ForcePathStyle = *backupStorage.ForcePathStyle
+	}
+	if backupStorage.CABundle != nil {
+		s3CompatConfig.CABundle = []byte(*backupStorage.CABundle)
+	}
+	return s3CompatConfig
+}
+
+// GetBackupRestoreConfig returns the backup-restore configuration for the given etcd backup configuration of the controller.
+func GetBackupRestoreConfig(etcdBackup *config.ETCDBackup) *controlplane.BackupRestoreConfig {
+	if etcdBackup == nil {
+		return nil
+	}
+	return &controlplane.BackupRestoreConfig{
+		DeltaSnapshotPeriod:      etcdBackup.DeltaSnapshotPeriod,
+		DeltaSnapshotMemoryLimit: etcdBackup.DeltaSnapshotMemoryLimit,
+		GarbageCollectionPeriod:  etcdBackup.GarbageCollectionPeriod,
+		GarbageCollectionPolicy:  etcdBackup.GarbageCollectionPolicy,
+		MaxBackups:               etcdBackup.MaxBackups,
+		Resources:                etcdBackup.Resources,
+	}
+}
@@ -17,6 +17,8 @@ package helper_test
 import (
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/config"
 	. "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/config/helper"
+	"github.com/gardener/gardener-extensions/pkg/objectstore/s3compat"
+	"github.com/gardener/gardener-extensions/pkg/util"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -43,6 +45,40 @@ var _ = Describe("Helper", func() {
 		Entry("entry not found (region does not exist)", makeMachineImages("ubuntu", "1", "us-ca-1", "0"), "ubuntu", "1", "eu-de-1", ""),
 		Entry("entry", makeMachineImages("ubuntu", "1", "eu-de-1", "image-1234"), "ubuntu", "1", "eu-de-1", "image-1234"),
 	)
+
+	DescribeTable("#IsS3CompatBackupStorage",
+		func(backupStorage config.BackupStorage, expected bool) {
+			Expect(IsS3CompatBackupStorage(backupStorage)).To(Equal(expected))
+		},
+
+		Entry("no endpoint", config.BackupStorage{}, false),
+		Entry("endpoint", config.BackupStorage{Endpoint: "https://s3.example.com"}, true),
+	)
+
+	DescribeTable("#GetS3CompatConfig",
+		func(backupStorage config.BackupStorage, expected *s3compat.Config) {
+			Expect(GetS3CompatConfig(backupStorage)).To(Equal(expected))
+		},
+
+		Entry("endpoint only",
+			config.BackupStorage{Endpoint: "https://s3.example.com"},
+			&s3compat.Config{Endpoint: "https://s3.example.com"},
+		),
+		Entry("all settings",
+			config.BackupStorage{
+				Endpoint:       "https://s3.example.com",
+				Region:         util.StringPtr("eu-de-1"),
+				ForcePathStyle: util.BoolPtr(true),
+				CABundle:       util.StringPtr("ca"),
+			},
+			&s3compat.Config{
+				Endpoint:       "https://s3.example.com",
+				Region:         "eu-de-1",
+				ForcePathStyle: true,
+				CABundle:       []byte("ca"),
+			},
+		),
+	)
 })
 
 func makeMachineImages(name, version, region, image string) []config.MachineImage {
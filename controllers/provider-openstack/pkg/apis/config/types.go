@@ -15,8 +15,10 @@
 package config
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	componentbaseconfig "k8s.io/component-base/config"
 )
 
@@ -34,6 +36,13 @@ type ControllerConfiguration struct {
 	MachineImages []MachineImage
 	// ETCD is the etcd configuration.
 	ETCD ETCD
+	// ControlPlaneComponents is the configuration of the control plane components deployed by the controller.
+	ControlPlaneComponents ControlPlaneComponents
+	// BackupStorage is the configuration of an S3-compatible object storage used for etcd backups instead of Swift.
+	BackupStorage BackupStorage
+	// BackupBucket is the BackupBucketConfig (openstack.provider.extensions.gardener.cloud/v1alpha1) applied to all backup buckets
+	// managed by the controller.
+	BackupBucket *runtime.RawExtension
 }
 
 // MachineImage is a mapping from logical names and versions to OpenStack-specific identifiers.
@@ -74,4 +83,55 @@ type ETCDStorage struct {
 type ETCDBackup struct {
 	// Schedule is the etcd backup schedule.
 	Schedule *string
+	// DeltaSnapshotPeriod is the period after which delta snapshots are taken. Defaults to 5m.
+	DeltaSnapshotPeriod *metav1.Duration
+	// DeltaSnapshotMemoryLimit is the size of accumulated events after which a delta snapshot is taken
+	// before the delta snapshot period has passed. Defaults to 100Mi.
+	DeltaSnapshotMemoryLimit *resource.Quantity
+	// GarbageCollectionPeriod is the period after which old snapshots are garbage collected. Defaults to 12h.
+	GarbageCollectionPeriod *metav1.Duration
+	// GarbageCollectionPolicy is the policy for garbage collecting old snapshots, either Exponential or LimitBased.
+	GarbageCollectionPolicy *string
+	// MaxBackups is the maximum number of full snapshots kept by the LimitBased garbage collection policy.
+	MaxBackups *int32
+	// Resources are the compute resources of the backup-restore sidecar.
+	Resources *corev1.ResourceRequirements
+}
+
+// BackupStorage is the configuration of an S3-compatible object storage like Ceph RGW.
+type BackupStorage struct {
+	// Endpoint is the URL of the S3-compatible endpoint, e.g. https://s3.example.com. If it is empty, Swift is used
+	// for etcd backups.
+	Endpoint string
+	// Region overrides the region of the backup buckets and entries if it is set. Some S3-compatible object storages
+	// only accept requests signed for a specific region.
+	Region *string
+	// ForcePathStyle specifies whether path-style addressing (https://s3.example.com/bucket/key) is used instead of
+	// virtual-hosted-style addressing (https://bucket.s3.example.com/key). Defaults to false.
+	ForcePathStyle *bool
+	// CABundle is a PEM-encoded bundle of CA certificates used to verify the certificate of the endpoint. If it is
+	// not set, the CA certificates of the system are used.
+	CABundle *string
+}
+
+// ControlPlaneComponents is the configuration of the cloud-controller-manager, the CSI controllers and the
+// machine-controller-manager deployed into the shoot namespaces of the seed.
+type ControlPlaneComponents struct {
+	// VPA is the vertical pod autoscaling configuration of the components.
+	VPA *VPA
+	// PodDisruptionBudget specifies whether PodDisruptionBudgets are deployed for the components. Defaults to false.
+	PodDisruptionBudget *bool
+	// PodAntiAffinity specifies whether the replicas of the components are spread across the seed nodes. Defaults to false.
+	PodAntiAffinity *bool
+	// Replicas is the number of replicas of the components while the shoot is awake. The PodDisruptionBudgets and the
+	// pod anti-affinity only take effect with more than one replica. Defaults to 1.
+	Replicas *int32
+}
+
+// VPA is a vertical pod autoscaling configuration.
+type VPA struct {
+	// Enabled specifies whether VerticalPodAutoscalers are deployed. Defaults to true.
+	Enabled *bool
+	// UpdateMode is the update mode of the VerticalPodAutoscalers, one of Off, Initial, Recreate or Auto. Defaults to Auto.
+	UpdateMode *string
 }
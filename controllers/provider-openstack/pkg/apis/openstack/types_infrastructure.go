@@ -33,6 +33,13 @@ type InfrastructureConfig struct {
 type Networks struct {
 	// Router indicates whether to use an existing router or create a new one.
 	Router *Router
+	// RouterExternalFixedIPs is a list of IP addresses of the floating pool which should be assigned to the created router.
+	RouterExternalFixedIPs []string
+	// ID is the id of an existing private network which should be used instead of creating a new one.
+	ID *string
+	// SubnetID is the id of an existing subnet of the private network which should be used instead of creating a new one.
+	// The subnet must already be attached to the router of the shoot.
+	SubnetID *string
 	// Worker is a CIDRs of a worker subnet (private) to create (used for the VMs).
 	Worker string
 }
@@ -0,0 +1,86 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackupBucketConfig contains configuration settings for the backup bucket.
+type BackupBucketConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Versioning indicates whether object versioning shall be enabled for the bucket. For Swift, previous versions of
+	// overwritten or deleted objects are kept in a separate archive container.
+	// +optional
+	Versioning *bool `json:"versioning,omitempty"`
+
+	// ObjectLock contains the default retention settings for objects in the bucket. Object lock can only be enabled
+	// while the bucket is created, afterwards only the default retention can be changed. It is only supported for an
+	// S3-compatible backup storage.
+	// +optional
+	ObjectLock *ObjectLockConfig `json:"objectLock,omitempty"`
+
+	// Encryption contains the server-side encryption settings for the bucket. It is only supported for an
+	// S3-compatible backup storage.
+	// +optional
+	Encryption *EncryptionConfig `json:"encryption,omitempty"`
+
+	// Lifecycle contains the expiry rules for objects in the bucket. It is only supported for an S3-compatible
+	// backup storage.
+	// +optional
+	Lifecycle *LifecycleConfig `json:"lifecycle,omitempty"`
+}
+
+// ObjectLockConfig contains the default retention settings for objects in the bucket. The controller never bypasses
+// the retention, hence deleting the backup bucket fails until the retention of all objects has expired.
+type ObjectLockConfig struct {
+	// Mode is the default retention mode, either GOVERNANCE or COMPLIANCE.
+	Mode ObjectLockMode `json:"mode"`
+
+	// Days is the number of days objects are retained.
+	Days int32 `json:"days"`
+}
+
+// ObjectLockMode is a string alias.
+type ObjectLockMode string
+
+const (
+	// ObjectLockModeGovernance is the retention mode that allows privileged users to remove the retention.
+	ObjectLockModeGovernance ObjectLockMode = "GOVERNANCE"
+	// ObjectLockModeCompliance is the retention mode that does not allow anybody to remove the retention.
+	ObjectLockModeCompliance ObjectLockMode = "COMPLIANCE"
+)
+
+// EncryptionConfig contains the server-side encryption settings for the bucket.
+type EncryptionConfig struct {
+	// KMSKeyID is the id of the key used for encrypting the objects. If not set, the objects are encrypted with keys
+	// managed by the object storage.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyID,omitempty"`
+}
+
+// LifecycleConfig contains the expiry rules for objects in the bucket.
+type LifecycleConfig struct {
+	// ExpirationDays is the number of days after which objects expire.
+	// +optional
+	ExpirationDays *int32 `json:"expirationDays,omitempty"`
+
+	// NoncurrentVersionExpirationDays is the number of days after which noncurrent object versions expire.
+	// +optional
+	NoncurrentVersionExpirationDays *int32 `json:"noncurrentVersionExpirationDays,omitempty"`
+}
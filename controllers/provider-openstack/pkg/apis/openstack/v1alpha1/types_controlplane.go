@@ -15,6 +15,9 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -37,6 +40,46 @@ type ControlPlaneConfig struct {
 	// CloudControllerManager contains configuration settings for the cloud-controller-manager.
 	// +optional
 	CloudControllerManager *CloudControllerManagerConfig `json:"cloudControllerManager,omitempty"`
+
+	// StorageClasses contains additional storage classes that shall be deployed into the shoot cluster.
+	// If the CSI driver is used, each of them is additionally deployed with the CSI provisioner under its name
+	// suffixed with "-csi", and the default annotation moves to these storage classes.
+	// +optional
+	StorageClasses []StorageClass `json:"storageClasses,omitempty"`
+
+	// Octavia contains Octavia specific settings for the load balancers of the shoot cluster.
+	// +optional
+	Octavia *OctaviaConfig `json:"octavia,omitempty"`
+
+	// ETCDBackup contains configuration settings for the etcd backup-restore sidecar.
+	// +optional
+	ETCDBackup *ETCDBackupConfig `json:"etcdBackup,omitempty"`
+}
+
+// OctaviaConfig contains Octavia specific settings for the load balancers of the shoot cluster.
+type OctaviaConfig struct {
+	// FlavorID is the ID of the Octavia flavor used for the load balancers.
+	// +optional
+	FlavorID *string `json:"flavorID,omitempty"`
+	// AvailabilityZone is the Octavia availability zone the load balancers are created in.
+	// +optional
+	AvailabilityZone *string `json:"availabilityZone,omitempty"`
+	// HealthMonitor contains settings for the health monitors of the load balancers.
+	// +optional
+	HealthMonitor *HealthMonitorConfig `json:"healthMonitor,omitempty"`
+}
+
+// HealthMonitorConfig contains settings for the health monitors of the load balancers.
+type HealthMonitorConfig struct {
+	// Delay is the time between sending probes to members. Defaults to 60s.
+	// +optional
+	Delay *metav1.Duration `json:"delay,omitempty"`
+	// Timeout is the maximum time to wait for a probe to respond. Defaults to 30s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// MaxRetries is the number of successful probes before a member is considered online. Defaults to 5.
+	// +optional
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
@@ -44,4 +87,64 @@ type CloudControllerManagerConfig struct {
 	// FeatureGates contains information about enabled feature gates.
 	// +optional
 	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+
+	// ConcurrentServiceSyncs is the number of services that are allowed to sync concurrently. Defaults to 10.
+	// +optional
+	ConcurrentServiceSyncs *int32 `json:"concurrentServiceSyncs,omitempty"`
+
+	// NodeMonitorPeriod is the period for syncing the status of the nodes with the cloud provider.
+	// +optional
+	NodeMonitorPeriod *metav1.Duration `json:"nodeMonitorPeriod,omitempty"`
+
+	// Verbosity is the log verbosity of the cloud-controller-manager. Defaults to 2.
+	// +optional
+	Verbosity *int32 `json:"verbosity,omitempty"`
+
+	// RouteReconciliationPeriod is the period for reconciling the routes created for the nodes by the cloud provider.
+	// +optional
+	RouteReconciliationPeriod *metav1.Duration `json:"routeReconciliationPeriod,omitempty"`
+}
+
+// StorageClass contains configuration for a storage class deployed into the shoot cluster.
+type StorageClass struct {
+	// Name is the name of the storage class.
+	Name string `json:"name"`
+
+	// Type is the volume type provisioned by the storage class.
+	Type string `json:"type"`
+
+	// ReclaimPolicy is the reclaim policy of the dynamically provisioned volumes. Defaults to Delete.
+	// +optional
+	ReclaimPolicy *corev1.PersistentVolumeReclaimPolicy `json:"reclaimPolicy,omitempty"`
+
+	// VolumeBindingMode indicates how volumes shall be provisioned and bound. Defaults to Immediate.
+	// +optional
+	VolumeBindingMode *storagev1.VolumeBindingMode `json:"volumeBindingMode,omitempty"`
+
+	// Default indicates whether the storage class shall be the default storage class of the shoot cluster.
+	// +optional
+	Default *bool `json:"default,omitempty"`
+}
+
+// ETCDBackupConfig contains configuration settings for the etcd backup-restore sidecar.
+type ETCDBackupConfig struct {
+	// DeltaSnapshotPeriod is the period after which delta snapshots are taken. Defaults to 5m.
+	// +optional
+	DeltaSnapshotPeriod *metav1.Duration `json:"deltaSnapshotPeriod,omitempty"`
+	// DeltaSnapshotMemoryLimit is the size of accumulated events after which a delta snapshot is taken
+	// before the delta snapshot period has passed. Defaults to 100Mi.
+	// +optional
+	DeltaSnapshotMemoryLimit *resource.Quantity `json:"deltaSnapshotMemoryLimit,omitempty"`
+	// GarbageCollectionPeriod is the period after which old snapshots are garbage collected. Defaults to 12h.
+	// +optional
+	GarbageCollectionPeriod *metav1.Duration `json:"garbageCollectionPeriod,omitempty"`
+	// GarbageCollectionPolicy is the policy for garbage collecting old snapshots, either Exponential or LimitBased.
+	// +optional
+	GarbageCollectionPolicy *string `json:"garbageCollectionPolicy,omitempty"`
+	// MaxBackups is the maximum number of full snapshots kept by the LimitBased garbage collection policy.
+	// +optional
+	MaxBackups *int32 `json:"maxBackups,omitempty"`
+	// Resources are the compute resources of the backup-restore sidecar.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
 }
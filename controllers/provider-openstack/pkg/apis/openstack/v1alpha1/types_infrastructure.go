@@ -34,6 +34,16 @@ type Networks struct {
 	// Router indicates whether to use an existing router or create a new one.
 	// +optional
 	Router *Router `json:"router,omitempty"`
+	// RouterExternalFixedIPs is a list of IP addresses of the floating pool which should be assigned to the created router.
+	// +optional
+	RouterExternalFixedIPs []string `json:"routerExternalFixedIPs,omitempty"`
+	// ID is the id of an existing private network which should be used instead of creating a new one.
+	// +optional
+	ID *string `json:"id,omitempty"`
+	// SubnetID is the id of an existing subnet of the private network which should be used instead of creating a new one.
+	// The subnet must already be attached to the router of the shoot.
+	// +optional
+	SubnetID *string `json:"subnetID,omitempty"`
 	// Worker is a CIDRs of a worker subnet (private) to create (used for the VMs).
 	Worker string `json:"worker"`
 }
@@ -24,6 +24,10 @@ import (
 	unsafe "unsafe"
 
 	openstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -35,6 +39,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*BackupBucketConfig)(nil), (*openstack.BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketConfig_To_openstack_BackupBucketConfig(a.(*BackupBucketConfig), b.(*openstack.BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.BackupBucketConfig)(nil), (*BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(a.(*openstack.BackupBucketConfig), b.(*BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudControllerManagerConfig)(nil), (*openstack.CloudControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudControllerManagerConfig_To_openstack_CloudControllerManagerConfig(a.(*CloudControllerManagerConfig), b.(*openstack.CloudControllerManagerConfig), scope)
 	}); err != nil {
@@ -75,6 +89,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ETCDBackupConfig)(nil), (*openstack.ETCDBackupConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ETCDBackupConfig_To_openstack_ETCDBackupConfig(a.(*ETCDBackupConfig), b.(*openstack.ETCDBackupConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.ETCDBackupConfig)(nil), (*ETCDBackupConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_ETCDBackupConfig_To_v1alpha1_ETCDBackupConfig(a.(*openstack.ETCDBackupConfig), b.(*ETCDBackupConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EncryptionConfig)(nil), (*openstack.EncryptionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EncryptionConfig_To_openstack_EncryptionConfig(a.(*EncryptionConfig), b.(*openstack.EncryptionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.EncryptionConfig)(nil), (*EncryptionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_EncryptionConfig_To_v1alpha1_EncryptionConfig(a.(*openstack.EncryptionConfig), b.(*EncryptionConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*FloatingPool)(nil), (*openstack.FloatingPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_FloatingPool_To_openstack_FloatingPool(a.(*FloatingPool), b.(*openstack.FloatingPool), scope)
 	}); err != nil {
@@ -95,6 +129,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HealthMonitorConfig)(nil), (*openstack.HealthMonitorConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HealthMonitorConfig_To_openstack_HealthMonitorConfig(a.(*HealthMonitorConfig), b.(*openstack.HealthMonitorConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.HealthMonitorConfig)(nil), (*HealthMonitorConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_HealthMonitorConfig_To_v1alpha1_HealthMonitorConfig(a.(*openstack.HealthMonitorConfig), b.(*HealthMonitorConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InfrastructureConfig)(nil), (*openstack.InfrastructureConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InfrastructureConfig_To_openstack_InfrastructureConfig(a.(*InfrastructureConfig), b.(*openstack.InfrastructureConfig), scope)
 	}); err != nil {
@@ -115,6 +159,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*LifecycleConfig)(nil), (*openstack.LifecycleConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LifecycleConfig_To_openstack_LifecycleConfig(a.(*LifecycleConfig), b.(*openstack.LifecycleConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.LifecycleConfig)(nil), (*LifecycleConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_LifecycleConfig_To_v1alpha1_LifecycleConfig(a.(*openstack.LifecycleConfig), b.(*LifecycleConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*LoadBalancerClass)(nil), (*openstack.LoadBalancerClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_LoadBalancerClass_To_openstack_LoadBalancerClass(a.(*LoadBalancerClass), b.(*openstack.LoadBalancerClass), scope)
 	}); err != nil {
@@ -195,6 +249,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ObjectLockConfig)(nil), (*openstack.ObjectLockConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ObjectLockConfig_To_openstack_ObjectLockConfig(a.(*ObjectLockConfig), b.(*openstack.ObjectLockConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.ObjectLockConfig)(nil), (*ObjectLockConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_ObjectLockConfig_To_v1alpha1_ObjectLockConfig(a.(*openstack.ObjectLockConfig), b.(*ObjectLockConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OctaviaConfig)(nil), (*openstack.OctaviaConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OctaviaConfig_To_openstack_OctaviaConfig(a.(*OctaviaConfig), b.(*openstack.OctaviaConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.OctaviaConfig)(nil), (*OctaviaConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_OctaviaConfig_To_v1alpha1_OctaviaConfig(a.(*openstack.OctaviaConfig), b.(*OctaviaConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Router)(nil), (*openstack.Router)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Router_To_openstack_Router(a.(*Router), b.(*openstack.Router), scope)
 	}); err != nil {
@@ -225,6 +299,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*StorageClass)(nil), (*openstack.StorageClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageClass_To_openstack_StorageClass(a.(*StorageClass), b.(*openstack.StorageClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.StorageClass)(nil), (*StorageClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_StorageClass_To_v1alpha1_StorageClass(a.(*openstack.StorageClass), b.(*StorageClass), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Subnet)(nil), (*openstack.Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Subnet_To_openstack_Subnet(a.(*Subnet), b.(*openstack.Subnet), scope)
 	}); err != nil {
@@ -248,8 +332,38 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha1_BackupBucketConfig_To_openstack_BackupBucketConfig(in *BackupBucketConfig, out *openstack.BackupBucketConfig, s conversion.Scope) error {
+	out.Versioning = (*bool)(unsafe.Pointer(in.Versioning))
+	out.ObjectLock = (*openstack.ObjectLockConfig)(unsafe.Pointer(in.ObjectLock))
+	out.Encryption = (*openstack.EncryptionConfig)(unsafe.Pointer(in.Encryption))
+	out.Lifecycle = (*openstack.LifecycleConfig)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketConfig_To_openstack_BackupBucketConfig is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketConfig_To_openstack_BackupBucketConfig(in *BackupBucketConfig, out *openstack.BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketConfig_To_openstack_BackupBucketConfig(in, out, s)
+}
+
+func autoConvert_openstack_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *openstack.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	out.Versioning = (*bool)(unsafe.Pointer(in.Versioning))
+	out.ObjectLock = (*ObjectLockConfig)(unsafe.Pointer(in.ObjectLock))
+	out.Encryption = (*EncryptionConfig)(unsafe.Pointer(in.Encryption))
+	out.Lifecycle = (*LifecycleConfig)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_openstack_BackupBucketConfig_To_v1alpha1_BackupBucketConfig is an autogenerated conversion function.
+func Convert_openstack_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *openstack.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_openstack_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudControllerManagerConfig_To_openstack_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *openstack.CloudControllerManagerConfig, s conversion.Scope) error {
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.ConcurrentServiceSyncs = (*int32)(unsafe.Pointer(in.ConcurrentServiceSyncs))
+	out.NodeMonitorPeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorPeriod))
+	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
+	out.RouteReconciliationPeriod = (*v1.Duration)(unsafe.Pointer(in.RouteReconciliationPeriod))
 	return nil
 }
 
@@ -260,6 +374,10 @@ func Convert_v1alpha1_CloudControllerManagerConfig_To_openstack_CloudControllerM
 
 func autoConvert_openstack_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in *openstack.CloudControllerManagerConfig, out *CloudControllerManagerConfig, s conversion.Scope) error {
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.ConcurrentServiceSyncs = (*int32)(unsafe.Pointer(in.ConcurrentServiceSyncs))
+	out.NodeMonitorPeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorPeriod))
+	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
+	out.RouteReconciliationPeriod = (*v1.Duration)(unsafe.Pointer(in.RouteReconciliationPeriod))
 	return nil
 }
 
@@ -329,6 +447,9 @@ func autoConvert_v1alpha1_ControlPlaneConfig_To_openstack_ControlPlaneConfig(in
 	out.Zone = in.Zone
 	out.LoadBalancerClasses = *(*[]openstack.LoadBalancerClass)(unsafe.Pointer(&in.LoadBalancerClasses))
 	out.CloudControllerManager = (*openstack.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.StorageClasses = *(*[]openstack.StorageClass)(unsafe.Pointer(&in.StorageClasses))
+	out.Octavia = (*openstack.OctaviaConfig)(unsafe.Pointer(in.Octavia))
+	out.ETCDBackup = (*openstack.ETCDBackupConfig)(unsafe.Pointer(in.ETCDBackup))
 	return nil
 }
 
@@ -342,6 +463,9 @@ func autoConvert_openstack_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in
 	out.Zone = in.Zone
 	out.LoadBalancerClasses = *(*[]LoadBalancerClass)(unsafe.Pointer(&in.LoadBalancerClasses))
 	out.CloudControllerManager = (*CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.StorageClasses = *(*[]StorageClass)(unsafe.Pointer(&in.StorageClasses))
+	out.Octavia = (*OctaviaConfig)(unsafe.Pointer(in.Octavia))
+	out.ETCDBackup = (*ETCDBackupConfig)(unsafe.Pointer(in.ETCDBackup))
 	return nil
 }
 
@@ -350,6 +474,56 @@ func Convert_openstack_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *ope
 	return autoConvert_openstack_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_ETCDBackupConfig_To_openstack_ETCDBackupConfig(in *ETCDBackupConfig, out *openstack.ETCDBackupConfig, s conversion.Scope) error {
+	out.DeltaSnapshotPeriod = (*v1.Duration)(unsafe.Pointer(in.DeltaSnapshotPeriod))
+	out.DeltaSnapshotMemoryLimit = (*resource.Quantity)(unsafe.Pointer(in.DeltaSnapshotMemoryLimit))
+	out.GarbageCollectionPeriod = (*v1.Duration)(unsafe.Pointer(in.GarbageCollectionPeriod))
+	out.GarbageCollectionPolicy = (*string)(unsafe.Pointer(in.GarbageCollectionPolicy))
+	out.MaxBackups = (*int32)(unsafe.Pointer(in.MaxBackups))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	return nil
+}
+
+// Convert_v1alpha1_ETCDBackupConfig_To_openstack_ETCDBackupConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ETCDBackupConfig_To_openstack_ETCDBackupConfig(in *ETCDBackupConfig, out *openstack.ETCDBackupConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ETCDBackupConfig_To_openstack_ETCDBackupConfig(in, out, s)
+}
+
+func autoConvert_openstack_ETCDBackupConfig_To_v1alpha1_ETCDBackupConfig(in *openstack.ETCDBackupConfig, out *ETCDBackupConfig, s conversion.Scope) error {
+	out.DeltaSnapshotPeriod = (*v1.Duration)(unsafe.Pointer(in.DeltaSnapshotPeriod))
+	out.DeltaSnapshotMemoryLimit = (*resource.Quantity)(unsafe.Pointer(in.DeltaSnapshotMemoryLimit))
+	out.GarbageCollectionPeriod = (*v1.Duration)(unsafe.Pointer(in.GarbageCollectionPeriod))
+	out.GarbageCollectionPolicy = (*string)(unsafe.Pointer(in.GarbageCollectionPolicy))
+	out.MaxBackups = (*int32)(unsafe.Pointer(in.MaxBackups))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	return nil
+}
+
+// Convert_openstack_ETCDBackupConfig_To_v1alpha1_ETCDBackupConfig is an autogenerated conversion function.
+func Convert_openstack_ETCDBackupConfig_To_v1alpha1_ETCDBackupConfig(in *openstack.ETCDBackupConfig, out *ETCDBackupConfig, s conversion.Scope) error {
+	return autoConvert_openstack_ETCDBackupConfig_To_v1alpha1_ETCDBackupConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_EncryptionConfig_To_openstack_EncryptionConfig(in *EncryptionConfig, out *openstack.EncryptionConfig, s conversion.Scope) error {
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
+	return nil
+}
+
+// Convert_v1alpha1_EncryptionConfig_To_openstack_EncryptionConfig is an autogenerated conversion function.
+func Convert_v1alpha1_EncryptionConfig_To_openstack_EncryptionConfig(in *EncryptionConfig, out *openstack.EncryptionConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EncryptionConfig_To_openstack_EncryptionConfig(in, out, s)
+}
+
+func autoConvert_openstack_EncryptionConfig_To_v1alpha1_EncryptionConfig(in *openstack.EncryptionConfig, out *EncryptionConfig, s conversion.Scope) error {
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
+	return nil
+}
+
+// Convert_openstack_EncryptionConfig_To_v1alpha1_EncryptionConfig is an autogenerated conversion function.
+func Convert_openstack_EncryptionConfig_To_v1alpha1_EncryptionConfig(in *openstack.EncryptionConfig, out *EncryptionConfig, s conversion.Scope) error {
+	return autoConvert_openstack_EncryptionConfig_To_v1alpha1_EncryptionConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_FloatingPool_To_openstack_FloatingPool(in *FloatingPool, out *openstack.FloatingPool, s conversion.Scope) error {
 	out.Name = in.Name
 	out.LoadBalancerClasses = *(*[]openstack.LoadBalancerClass)(unsafe.Pointer(&in.LoadBalancerClasses))
@@ -394,6 +568,30 @@ func Convert_openstack_FloatingPoolStatus_To_v1alpha1_FloatingPoolStatus(in *ope
 	return autoConvert_openstack_FloatingPoolStatus_To_v1alpha1_FloatingPoolStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_HealthMonitorConfig_To_openstack_HealthMonitorConfig(in *HealthMonitorConfig, out *openstack.HealthMonitorConfig, s conversion.Scope) error {
+	out.Delay = (*v1.Duration)(unsafe.Pointer(in.Delay))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	out.MaxRetries = (*int32)(unsafe.Pointer(in.MaxRetries))
+	return nil
+}
+
+// Convert_v1alpha1_HealthMonitorConfig_To_openstack_HealthMonitorConfig is an autogenerated conversion function.
+func Convert_v1alpha1_HealthMonitorConfig_To_openstack_HealthMonitorConfig(in *HealthMonitorConfig, out *openstack.HealthMonitorConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HealthMonitorConfig_To_openstack_HealthMonitorConfig(in, out, s)
+}
+
+func autoConvert_openstack_HealthMonitorConfig_To_v1alpha1_HealthMonitorConfig(in *openstack.HealthMonitorConfig, out *HealthMonitorConfig, s conversion.Scope) error {
+	out.Delay = (*v1.Duration)(unsafe.Pointer(in.Delay))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	out.MaxRetries = (*int32)(unsafe.Pointer(in.MaxRetries))
+	return nil
+}
+
+// Convert_openstack_HealthMonitorConfig_To_v1alpha1_HealthMonitorConfig is an autogenerated conversion function.
+func Convert_openstack_HealthMonitorConfig_To_v1alpha1_HealthMonitorConfig(in *openstack.HealthMonitorConfig, out *HealthMonitorConfig, s conversion.Scope) error {
+	return autoConvert_openstack_HealthMonitorConfig_To_v1alpha1_HealthMonitorConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_InfrastructureConfig_To_openstack_InfrastructureConfig(in *InfrastructureConfig, out *openstack.InfrastructureConfig, s conversion.Scope) error {
 	out.FloatingPoolName = in.FloatingPoolName
 	if err := Convert_v1alpha1_Networks_To_openstack_Networks(&in.Networks, &out.Networks, s); err != nil {
@@ -452,6 +650,28 @@ func Convert_openstack_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in
 	return autoConvert_openstack_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_LifecycleConfig_To_openstack_LifecycleConfig(in *LifecycleConfig, out *openstack.LifecycleConfig, s conversion.Scope) error {
+	out.ExpirationDays = (*int32)(unsafe.Pointer(in.ExpirationDays))
+	out.NoncurrentVersionExpirationDays = (*int32)(unsafe.Pointer(in.NoncurrentVersionExpirationDays))
+	return nil
+}
+
+// Convert_v1alpha1_LifecycleConfig_To_openstack_LifecycleConfig is an autogenerated conversion function.
+func Convert_v1alpha1_LifecycleConfig_To_openstack_LifecycleConfig(in *LifecycleConfig, out *openstack.LifecycleConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LifecycleConfig_To_openstack_LifecycleConfig(in, out, s)
+}
+
+func autoConvert_openstack_LifecycleConfig_To_v1alpha1_LifecycleConfig(in *openstack.LifecycleConfig, out *LifecycleConfig, s conversion.Scope) error {
+	out.ExpirationDays = (*int32)(unsafe.Pointer(in.ExpirationDays))
+	out.NoncurrentVersionExpirationDays = (*int32)(unsafe.Pointer(in.NoncurrentVersionExpirationDays))
+	return nil
+}
+
+// Convert_openstack_LifecycleConfig_To_v1alpha1_LifecycleConfig is an autogenerated conversion function.
+func Convert_openstack_LifecycleConfig_To_v1alpha1_LifecycleConfig(in *openstack.LifecycleConfig, out *LifecycleConfig, s conversion.Scope) error {
+	return autoConvert_openstack_LifecycleConfig_To_v1alpha1_LifecycleConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_LoadBalancerClass_To_openstack_LoadBalancerClass(in *LoadBalancerClass, out *openstack.LoadBalancerClass, s conversion.Scope) error {
 	out.Name = in.Name
 	out.FloatingSubnetID = (*string)(unsafe.Pointer(in.FloatingSubnetID))
@@ -602,6 +822,9 @@ func Convert_openstack_NetworkStatus_To_v1alpha1_NetworkStatus(in *openstack.Net
 
 func autoConvert_v1alpha1_Networks_To_openstack_Networks(in *Networks, out *openstack.Networks, s conversion.Scope) error {
 	out.Router = (*openstack.Router)(unsafe.Pointer(in.Router))
+	out.RouterExternalFixedIPs = *(*[]string)(unsafe.Pointer(&in.RouterExternalFixedIPs))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	out.SubnetID = (*string)(unsafe.Pointer(in.SubnetID))
 	out.Worker = in.Worker
 	return nil
 }
@@ -613,6 +836,9 @@ func Convert_v1alpha1_Networks_To_openstack_Networks(in *Networks, out *openstac
 
 func autoConvert_openstack_Networks_To_v1alpha1_Networks(in *openstack.Networks, out *Networks, s conversion.Scope) error {
 	out.Router = (*Router)(unsafe.Pointer(in.Router))
+	out.RouterExternalFixedIPs = *(*[]string)(unsafe.Pointer(&in.RouterExternalFixedIPs))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	out.SubnetID = (*string)(unsafe.Pointer(in.SubnetID))
 	out.Worker = in.Worker
 	return nil
 }
@@ -642,6 +868,52 @@ func Convert_openstack_NodeStatus_To_v1alpha1_NodeStatus(in *openstack.NodeStatu
 	return autoConvert_openstack_NodeStatus_To_v1alpha1_NodeStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_ObjectLockConfig_To_openstack_ObjectLockConfig(in *ObjectLockConfig, out *openstack.ObjectLockConfig, s conversion.Scope) error {
+	out.Mode = openstack.ObjectLockMode(in.Mode)
+	out.Days = in.Days
+	return nil
+}
+
+// Convert_v1alpha1_ObjectLockConfig_To_openstack_ObjectLockConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ObjectLockConfig_To_openstack_ObjectLockConfig(in *ObjectLockConfig, out *openstack.ObjectLockConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ObjectLockConfig_To_openstack_ObjectLockConfig(in, out, s)
+}
+
+func autoConvert_openstack_ObjectLockConfig_To_v1alpha1_ObjectLockConfig(in *openstack.ObjectLockConfig, out *ObjectLockConfig, s conversion.Scope) error {
+	out.Mode = ObjectLockMode(in.Mode)
+	out.Days = in.Days
+	return nil
+}
+
+// Convert_openstack_ObjectLockConfig_To_v1alpha1_ObjectLockConfig is an autogenerated conversion function.
+func Convert_openstack_ObjectLockConfig_To_v1alpha1_ObjectLockConfig(in *openstack.ObjectLockConfig, out *ObjectLockConfig, s conversion.Scope) error {
+	return autoConvert_openstack_ObjectLockConfig_To_v1alpha1_ObjectLockConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_OctaviaConfig_To_openstack_OctaviaConfig(in *OctaviaConfig, out *openstack.OctaviaConfig, s conversion.Scope) error {
+	out.FlavorID = (*string)(unsafe.Pointer(in.FlavorID))
+	out.AvailabilityZone = (*string)(unsafe.Pointer(in.AvailabilityZone))
+	out.HealthMonitor = (*openstack.HealthMonitorConfig)(unsafe.Pointer(in.HealthMonitor))
+	return nil
+}
+
+// Convert_v1alpha1_OctaviaConfig_To_openstack_OctaviaConfig is an autogenerated conversion function.
+func Convert_v1alpha1_OctaviaConfig_To_openstack_OctaviaConfig(in *OctaviaConfig, out *openstack.OctaviaConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OctaviaConfig_To_openstack_OctaviaConfig(in, out, s)
+}
+
+func autoConvert_openstack_OctaviaConfig_To_v1alpha1_OctaviaConfig(in *openstack.OctaviaConfig, out *OctaviaConfig, s conversion.Scope) error {
+	out.FlavorID = (*string)(unsafe.Pointer(in.FlavorID))
+	out.AvailabilityZone = (*string)(unsafe.Pointer(in.AvailabilityZone))
+	out.HealthMonitor = (*HealthMonitorConfig)(unsafe.Pointer(in.HealthMonitor))
+	return nil
+}
+
+// Convert_openstack_OctaviaConfig_To_v1alpha1_OctaviaConfig is an autogenerated conversion function.
+func Convert_openstack_OctaviaConfig_To_v1alpha1_OctaviaConfig(in *openstack.OctaviaConfig, out *OctaviaConfig, s conversion.Scope) error {
+	return autoConvert_openstack_OctaviaConfig_To_v1alpha1_OctaviaConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_Router_To_openstack_Router(in *Router, out *openstack.Router, s conversion.Scope) error {
 	out.ID = in.ID
 	return nil
@@ -706,6 +978,34 @@ func Convert_openstack_SecurityGroup_To_v1alpha1_SecurityGroup(in *openstack.Sec
 	return autoConvert_openstack_SecurityGroup_To_v1alpha1_SecurityGroup(in, out, s)
 }
 
+func autoConvert_v1alpha1_StorageClass_To_openstack_StorageClass(in *StorageClass, out *openstack.StorageClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	out.ReclaimPolicy = (*corev1.PersistentVolumeReclaimPolicy)(unsafe.Pointer(in.ReclaimPolicy))
+	out.VolumeBindingMode = (*storagev1.VolumeBindingMode)(unsafe.Pointer(in.VolumeBindingMode))
+	out.Default = (*bool)(unsafe.Pointer(in.Default))
+	return nil
+}
+
+// Convert_v1alpha1_StorageClass_To_openstack_StorageClass is an autogenerated conversion function.
+func Convert_v1alpha1_StorageClass_To_openstack_StorageClass(in *StorageClass, out *openstack.StorageClass, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageClass_To_openstack_StorageClass(in, out, s)
+}
+
+func autoConvert_openstack_StorageClass_To_v1alpha1_StorageClass(in *openstack.StorageClass, out *StorageClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	out.ReclaimPolicy = (*corev1.PersistentVolumeReclaimPolicy)(unsafe.Pointer(in.ReclaimPolicy))
+	out.VolumeBindingMode = (*storagev1.VolumeBindingMode)(unsafe.Pointer(in.VolumeBindingMode))
+	out.Default = (*bool)(unsafe.Pointer(in.Default))
+	return nil
+}
+
+// Convert_openstack_StorageClass_To_v1alpha1_StorageClass is an autogenerated conversion function.
+func Convert_openstack_StorageClass_To_v1alpha1_StorageClass(in *openstack.StorageClass, out *StorageClass, s conversion.Scope) error {
+	return autoConvert_openstack_StorageClass_To_v1alpha1_StorageClass(in, out, s)
+}
+
 func autoConvert_v1alpha1_Subnet_To_openstack_Subnet(in *Subnet, out *openstack.Subnet, s conversion.Scope) error {
 	out.Purpose = openstack.Purpose(in.Purpose)
 	out.ID = in.ID
@@ -0,0 +1,77 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var supportedObjectLockModes = sets.NewString(string(apisopenstack.ObjectLockModeGovernance), string(apisopenstack.ObjectLockModeCompliance))
+
+// ValidateBackupBucketConfig validates a BackupBucketConfig object. Swift containers only support versioning, hence
+// all other settings are forbidden unless <s3Compatible> is true.
+func ValidateBackupBucketConfig(backupBucketConfig *apisopenstack.BackupBucketConfig, s3Compatible bool) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if objectLock := backupBucketConfig.ObjectLock; objectLock != nil {
+		objectLockPath := field.NewPath("objectLock")
+
+		if !s3Compatible {
+			allErrs = append(allErrs, field.Forbidden(objectLockPath, "object lock is only supported for an S3-compatible backup storage"))
+		}
+		if backupBucketConfig.Versioning != nil && !*backupBucketConfig.Versioning {
+			allErrs = append(allErrs, field.Forbidden(objectLockPath, "object lock requires versioning to be enabled"))
+		}
+		if !supportedObjectLockModes.Has(string(objectLock.Mode)) {
+			allErrs = append(allErrs, field.NotSupported(objectLockPath.Child("mode"), objectLock.Mode, supportedObjectLockModes.List()))
+		}
+		if objectLock.Days <= 0 {
+			allErrs = append(allErrs, field.Invalid(objectLockPath.Child("days"), objectLock.Days, "must be greater than 0"))
+		}
+	}
+
+	if encryption := backupBucketConfig.Encryption; encryption != nil {
+		encryptionPath := field.NewPath("encryption")
+
+		if !s3Compatible {
+			allErrs = append(allErrs, field.Forbidden(encryptionPath, "encryption is only supported for an S3-compatible backup storage"))
+		}
+		if encryption.KMSKeyID != nil && len(*encryption.KMSKeyID) == 0 {
+			allErrs = append(allErrs, field.Invalid(encryptionPath.Child("kmsKeyID"), *encryption.KMSKeyID, "must not be empty"))
+		}
+	}
+
+	if lifecycle := backupBucketConfig.Lifecycle; lifecycle != nil {
+		lifecyclePath := field.NewPath("lifecycle")
+
+		if !s3Compatible {
+			allErrs = append(allErrs, field.Forbidden(lifecyclePath, "lifecycle is only supported for an S3-compatible backup storage"))
+		}
+		if lifecycle.ExpirationDays != nil && *lifecycle.ExpirationDays <= 0 {
+			allErrs = append(allErrs, field.Invalid(lifecyclePath.Child("expirationDays"), *lifecycle.ExpirationDays, "must be greater than 0"))
+		}
+		if lifecycle.NoncurrentVersionExpirationDays != nil && *lifecycle.NoncurrentVersionExpirationDays <= 0 {
+			allErrs = append(allErrs, field.Invalid(lifecyclePath.Child("noncurrentVersionExpirationDays"), *lifecycle.NoncurrentVersionExpirationDays, "must be greater than 0"))
+		}
+		if objectLock := backupBucketConfig.ObjectLock; objectLock != nil && lifecycle.ExpirationDays != nil && *lifecycle.ExpirationDays < objectLock.Days {
+			allErrs = append(allErrs, field.Invalid(lifecyclePath.Child("expirationDays"), *lifecycle.ExpirationDays, "must not be less than the object lock retention days"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+	. "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("BackupBucketConfig validation", func() {
+	var backupBucketConfig *apisopenstack.BackupBucketConfig
+
+	BeforeEach(func() {
+		backupBucketConfig = &apisopenstack.BackupBucketConfig{}
+	})
+
+	Describe("#ValidateBackupBucketConfig", func() {
+		It("should allow an empty configuration", func() {
+			Expect(ValidateBackupBucketConfig(backupBucketConfig, false)).To(BeEmpty())
+		})
+
+		It("should allow versioning for Swift", func() {
+			versioning := true
+			backupBucketConfig.Versioning = &versioning
+
+			Expect(ValidateBackupBucketConfig(backupBucketConfig, false)).To(BeEmpty())
+		})
+
+		It("should allow a valid configuration for an S3-compatible backup storage", func() {
+			var (
+				versioning                      = true
+				kmsKeyID                        = "key-id"
+				expirationDays                  = int32(30)
+				noncurrentVersionExpirationDays = int32(7)
+			)
+			backupBucketConfig.Versioning = &versioning
+			backupBucketConfig.ObjectLock = &apisopenstack.ObjectLockConfig{
+				Mode: apisopenstack.ObjectLockModeCompliance,
+				Days: 14,
+			}
+			backupBucketConfig.Encryption = &apisopenstack.EncryptionConfig{KMSKeyID: &kmsKeyID}
+			backupBucketConfig.Lifecycle = &apisopenstack.LifecycleConfig{
+				ExpirationDays:                  &expirationDays,
+				NoncurrentVersionExpirationDays: &noncurrentVersionExpirationDays,
+			}
+
+			Expect(ValidateBackupBucketConfig(backupBucketConfig, true)).To(BeEmpty())
+		})
+
+		It("should forbid object lock, encryption and lifecycle for Swift", func() {
+			expirationDays := int32(30)
+			backupBucketConfig.ObjectLock = &apisopenstack.ObjectLockConfig{
+				Mode: apisopenstack.ObjectLockModeGovernance,
+				Days: 14,
+			}
+			backupBucketConfig.Encryption = &apisopenstack.EncryptionConfig{}
+			backupBucketConfig.Lifecycle = &apisopenstack.LifecycleConfig{ExpirationDays: &expirationDays}
+
+			Expect(ValidateBackupBucketConfig(backupBucketConfig, false)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("objectLock"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("encryption"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("lifecycle"),
+				})),
+			))
+		})
+
+		It("should forbid object lock if versioning is disabled", func() {
+			versioning := false
+			backupBucketConfig.Versioning = &versioning
+			backupBucketConfig.ObjectLock = &apisopenstack.ObjectLockConfig{
+				Mode: apisopenstack.ObjectLockModeGovernance,
+				Days: 14,
+			}
+
+			Expect(ValidateBackupBucketConfig(backupBucketConfig, true)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("objectLock"),
+				})),
+			))
+		})
+
+		It("should forbid an invalid configuration", func() {
+			var (
+				kmsKeyID                        = ""
+				expirationDays                  = int32(7)
+				noncurrentVersionExpirationDays = int32(0)
+			)
+			backupBucketConfig.ObjectLock = &apisopenstack.ObjectLockConfig{
+				Mode: "Legal",
+				Days: 14,
+			}
+			backupBucketConfig.Encryption = &apisopenstack.EncryptionConfig{KMSKeyID: &kmsKeyID}
+			backupBucketConfig.Lifecycle = &apisopenstack.LifecycleConfig{
+				ExpirationDays:                  &expirationDays,
+				NoncurrentVersionExpirationDays: &noncurrentVersionExpirationDays,
+			}
+
+			Expect(ValidateBackupBucketConfig(backupBucketConfig, true)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("objectLock.mode"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("encryption.kmsKeyID"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("lifecycle.noncurrentVersionExpirationDays"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("lifecycle.expirationDays"),
+				})),
+			))
+		})
+	})
+})
@@ -0,0 +1,153 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+
+	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack/helper"
+	extensionsvalidation "github.com/gardener/gardener-extensions/pkg/util/validation"
+	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	// maxCloudControllerManagerVerbosity is the highest log verbosity supported by the cloud-controller-manager.
+	maxCloudControllerManagerVerbosity = 10
+
+	// csiStorageClassNameSuffix is the suffix of the names of the storage classes which use the CSI provisioner.
+	csiStorageClassNameSuffix = "-csi"
+)
+
+var (
+	defaultStorageClassNames = sets.NewString("default-class")
+)
+
+// ValidateControlPlaneConfig validates a ControlPlaneConfig object.
+func ValidateControlPlaneConfig(controlPlaneConfig *apisopenstack.ControlPlaneConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if controlPlaneConfig.CloudControllerManager != nil {
+		allErrs = append(allErrs, validateCloudControllerManager(controlPlaneConfig.CloudControllerManager, field.NewPath("cloudControllerManager"))...)
+	}
+	allErrs = append(allErrs, validateStorageClasses(controlPlaneConfig.StorageClasses, field.NewPath("storageClasses"))...)
+
+	if controlPlaneConfig.ETCDBackup != nil {
+		allErrs = append(allErrs, controlplane.ValidateBackupRestoreConfig(helper.GetBackupRestoreConfig(controlPlaneConfig.ETCDBackup), field.NewPath("etcdBackup"))...)
+	}
+	allErrs = append(allErrs, validateLoadBalancerClasses(controlPlaneConfig.LoadBalancerClasses, field.NewPath("loadBalancerClasses"))...)
+
+	if controlPlaneConfig.Octavia != nil {
+		allErrs = append(allErrs, validateOctavia(controlPlaneConfig.Octavia, field.NewPath("octavia"))...)
+	}
+
+	return allErrs
+}
+
+func validateLoadBalancerClasses(loadBalancerClasses []apisopenstack.LoadBalancerClass, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.NewString()
+	)
+
+	for i, class := range loadBalancerClasses {
+		namePath := fldPath.Index(i).Child("name")
+		if len(class.Name) == 0 {
+			allErrs = append(allErrs, field.Required(namePath, "must provide a name"))
+			continue
+		}
+		if names.Has(class.Name) {
+			allErrs = append(allErrs, field.Duplicate(namePath, class.Name))
+		}
+		names.Insert(class.Name)
+	}
+
+	return allErrs
+}
+
+func validateOctavia(octavia *apisopenstack.OctaviaConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if octavia.FlavorID != nil && len(*octavia.FlavorID) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("flavorID"), *octavia.FlavorID, "must not be empty"))
+	}
+	if octavia.AvailabilityZone != nil && len(*octavia.AvailabilityZone) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("availabilityZone"), *octavia.AvailabilityZone, "must not be empty"))
+	}
+
+	if hm := octavia.HealthMonitor; hm != nil {
+		hmPath := fldPath.Child("healthMonitor")
+		if hm.Delay != nil && hm.Delay.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(hmPath.Child("delay"), hm.Delay.Duration.String(), "must be greater than 0"))
+		}
+		if hm.Timeout != nil && hm.Timeout.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(hmPath.Child("timeout"), hm.Timeout.Duration.String(), "must be greater than 0"))
+		}
+		if hm.Delay != nil && hm.Timeout != nil && hm.Timeout.Duration > hm.Delay.Duration {
+			allErrs = append(allErrs, field.Invalid(hmPath.Child("timeout"), hm.Timeout.Duration.String(), "must not be greater than the delay"))
+		}
+		if hm.MaxRetries != nil && (*hm.MaxRetries < 1 || *hm.MaxRetries > 10) {
+			allErrs = append(allErrs, field.Invalid(hmPath.Child("maxRetries"), *hm.MaxRetries, "must be between 1 and 10"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateCloudControllerManager(ccm *apisopenstack.CloudControllerManagerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if ccm.ConcurrentServiceSyncs != nil && *ccm.ConcurrentServiceSyncs < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("concurrentServiceSyncs"), *ccm.ConcurrentServiceSyncs, "must be at least 1"))
+	}
+	if ccm.NodeMonitorPeriod != nil && ccm.NodeMonitorPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("nodeMonitorPeriod"), ccm.NodeMonitorPeriod.Duration.String(), "must be greater than 0"))
+	}
+	if ccm.RouteReconciliationPeriod != nil && ccm.RouteReconciliationPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("routeReconciliationPeriod"), ccm.RouteReconciliationPeriod.Duration.String(), "must be greater than 0"))
+	}
+	if ccm.Verbosity != nil && (*ccm.Verbosity < 0 || *ccm.Verbosity > maxCloudControllerManagerVerbosity) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("verbosity"), *ccm.Verbosity, fmt.Sprintf("must be between 0 and %d", maxCloudControllerManagerVerbosity)))
+	}
+
+	return allErrs
+}
+
+func validateStorageClasses(storageClasses []apisopenstack.StorageClass, fldPath *field.Path) field.ErrorList {
+	genericStorageClasses := make([]extensionsvalidation.StorageClass, 0, len(storageClasses))
+	for _, sc := range storageClasses {
+		genericStorageClasses = append(genericStorageClasses, extensionsvalidation.StorageClass{
+			Name:              sc.Name,
+			ReclaimPolicy:     sc.ReclaimPolicy,
+			VolumeBindingMode: sc.VolumeBindingMode,
+			Default:           sc.Default,
+		})
+	}
+
+	allErrs := extensionsvalidation.ValidateStorageClasses(genericStorageClasses, defaultStorageClassNames, []string{csiStorageClassNameSuffix}, fldPath)
+
+	for i, sc := range storageClasses {
+		idxPath := fldPath.Index(i)
+
+		if len(sc.Type) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("type"), "must provide a volume type"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"net"
+
+	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateInfrastructureConfig validates a InfrastructureConfig object.
+func ValidateInfrastructureConfig(infra *apisopenstack.InfrastructureConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(infra.FloatingPoolName) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("floatingPoolName"), "must provide the name of a floating pool"))
+	}
+
+	networksPath := field.NewPath("networks")
+	if len(infra.Networks.Worker) == 0 {
+		allErrs = append(allErrs, field.Required(networksPath.Child("worker"), "must specify the network range for the worker network"))
+	}
+
+	if infra.Networks.ID != nil && len(*infra.Networks.ID) == 0 {
+		allErrs = append(allErrs, field.Invalid(networksPath.Child("id"), *infra.Networks.ID, "must not be empty"))
+	}
+	if infra.Networks.SubnetID != nil {
+		if len(*infra.Networks.SubnetID) == 0 {
+			allErrs = append(allErrs, field.Invalid(networksPath.Child("subnetID"), *infra.Networks.SubnetID, "must not be empty"))
+		}
+		if infra.Networks.ID == nil {
+			allErrs = append(allErrs, field.Required(networksPath.Child("id"), "must specify the network of the existing subnet"))
+		}
+	}
+
+	externalFixedIPsPath := networksPath.Child("routerExternalFixedIPs")
+	if len(infra.Networks.RouterExternalFixedIPs) > 0 && infra.Networks.Router != nil {
+		allErrs = append(allErrs, field.Forbidden(externalFixedIPsPath, "external fixed ips can only be specified if the router is created"))
+	}
+	for i, ip := range infra.Networks.RouterExternalFixedIPs {
+		if net.ParseIP(ip) == nil {
+			allErrs = append(allErrs, field.Invalid(externalFixedIPsPath.Index(i), ip, "must be a valid IP address"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateInfrastructureConfigUpdate validates a InfrastructureConfig object before an update.
+func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *apisopenstack.InfrastructureConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	networksPath := field.NewPath("networks")
+	allErrs = append(allErrs, validateImmutableID(oldConfig.Networks.ID, newConfig.Networks.ID, networksPath.Child("id"))...)
+	allErrs = append(allErrs, validateImmutableID(oldConfig.Networks.SubnetID, newConfig.Networks.SubnetID, networksPath.Child("subnetID"))...)
+
+	return allErrs
+}
+
+func validateImmutableID(oldID, newID *string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if (oldID == nil) != (newID == nil) || (oldID != nil && *oldID != *newID) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "field is immutable"))
+	}
+
+	return allErrs
+}
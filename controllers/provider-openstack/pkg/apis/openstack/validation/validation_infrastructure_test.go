@@ -0,0 +1,135 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+	. "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("InfrastructureConfig validation", func() {
+	var (
+		infrastructureConfig *apisopenstack.InfrastructureConfig
+
+		networkID = "network-id"
+		subnetID  = "subnet-id"
+	)
+
+	BeforeEach(func() {
+		infrastructureConfig = &apisopenstack.InfrastructureConfig{
+			FloatingPoolName: "pool",
+			Networks: apisopenstack.Networks{
+				Worker: "10.250.0.0/19",
+			},
+		}
+	})
+
+	Describe("#ValidateInfrastructureConfig", func() {
+		It("should allow a valid configuration", func() {
+			infrastructureConfig.Networks.ID = &networkID
+			infrastructureConfig.Networks.SubnetID = &subnetID
+			infrastructureConfig.Networks.RouterExternalFixedIPs = []string{"10.0.0.10"}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig)).To(BeEmpty())
+		})
+
+		It("should forbid a missing floating pool name and worker range", func() {
+			infrastructureConfig.FloatingPoolName = ""
+			infrastructureConfig.Networks.Worker = ""
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("floatingPoolName"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.worker"),
+				})),
+			))
+		})
+
+		It("should forbid an existing subnet without an existing network", func() {
+			infrastructureConfig.Networks.SubnetID = &subnetID
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("networks.id"),
+			}))))
+		})
+
+		It("should forbid external fixed ips for an existing router", func() {
+			infrastructureConfig.Networks.Router = &apisopenstack.Router{ID: "router-id"}
+			infrastructureConfig.Networks.RouterExternalFixedIPs = []string{"10.0.0.10"}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.routerExternalFixedIPs"),
+			}))))
+		})
+
+		It("should forbid invalid external fixed ips", func() {
+			infrastructureConfig.Networks.RouterExternalFixedIPs = []string{"foo"}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.routerExternalFixedIPs[0]"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateInfrastructureConfigUpdate", func() {
+		It("should allow unchanged network references", func() {
+			infrastructureConfig.Networks.ID = &networkID
+			newConfig := infrastructureConfig.DeepCopy()
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newConfig)).To(BeEmpty())
+		})
+
+		It("should forbid changing the network references", func() {
+			otherSubnetID := "other-subnet-id"
+			infrastructureConfig.Networks.SubnetID = &subnetID
+			newConfig := infrastructureConfig.DeepCopy()
+			newConfig.Networks.ID = &networkID
+			newConfig.Networks.SubnetID = &otherSubnetID
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newConfig)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.id"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.subnetID"),
+				})),
+			))
+		})
+	})
+})
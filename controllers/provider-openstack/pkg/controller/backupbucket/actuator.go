@@ -17,24 +17,34 @@ package backupbucket
 import (
 	"context"
 
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/config"
+	confighelper "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/config/helper"
+	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack/validation"
 	openstackclient "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack/client"
-	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket/genericactuator"
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
+	"github.com/gardener/gardener-extensions/pkg/objectstore/s3compat"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
 
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
 )
 
 type actuator struct {
-	backupbucket.Actuator
-	client client.Client
-	logger logr.Logger
+	backupStorage *config.BackupStorage
+	bbConfig      *apisopenstack.BackupBucketConfig
+	client        client.Client
+	logger        logr.Logger
 }
 
-func newActuator() backupbucket.Actuator {
+func newActuator(backupStorage *config.BackupStorage, bbConfig *apisopenstack.BackupBucketConfig) genericactuator.BackupBucketDelegate {
 	return &actuator{
-		logger: log.Log.WithName("openstack-backupbucket-actuator"),
+		backupStorage: backupStorage,
+		bbConfig:      bbConfig,
+		logger:        logger,
 	}
 }
 
@@ -43,20 +53,87 @@ func (a *actuator) InjectClient(client client.Client) error {
 	return nil
 }
 
-func (a *actuator) Reconcile(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+func (a *actuator) GetObjectStore(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) (objectstore.ObjectStore, error) {
+	if confighelper.IsS3CompatBackupStorage(*a.backupStorage) {
+		return s3compat.NewObjectStoreFromSecretRef(ctx, a.client, bb.Spec.SecretRef, a.getS3CompatConfig(), bb.Spec.Region)
+	}
+	return openstackclient.NewObjectStoreFromSecretRef(ctx, a.client, bb.Spec.SecretRef, bb.Spec.Region)
+}
+
+func (a *actuator) ReconcileBucketConfig(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+	if confighelper.IsS3CompatBackupStorage(*a.backupStorage) {
+		reconciler, err := s3compat.NewBucketConfigReconcilerFromSecretRef(ctx, a.client, bb.Spec.SecretRef, a.getS3CompatConfig(), bb.Spec.Region)
+		if err != nil {
+			return err
+		}
+		return reconciler.ReconcileBucketConfig(ctx, bb.Name)
+	}
+
 	openstackClient, err := openstackclient.NewStorageClientFromSecretRef(ctx, a.client, bb.Spec.SecretRef, bb.Spec.Region)
 	if err != nil {
 		return err
 	}
 
-	return openstackClient.CreateContainerIfNotExists(ctx, bb.Name)
+	// The versioning settings are applied on every reconciliation to revert any changes that were made to the
+	// container outside of Gardener. Swift containers do not support any other settings.
+	if a.bbConfig.Versioning != nil {
+		if err := openstackClient.UpdateContainerVersioning(ctx, bb.Name, *a.bbConfig.Versioning); err != nil {
+			return errors.Wrapf(err, "could not update versioning of container '%s'", bb.Name)
+		}
+	}
+
+	return nil
 }
 
-func (a *actuator) Delete(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
-	openstackClient, err := openstackclient.NewStorageClientFromSecretRef(ctx, a.client, bb.Spec.SecretRef, bb.Spec.Region)
-	if err != nil {
-		return err
+func (a *actuator) getS3CompatConfig() *s3compat.Config {
+	s3CompatConfig := confighelper.GetS3CompatConfig(*a.backupStorage)
+	s3CompatConfig.BucketConfig = toS3CompatBucketConfig(a.bbConfig)
+	return s3CompatConfig
+}
+
+// decodeBackupBucketConfig decodes and validates the given BackupBucketConfig of the controller configuration.
+func decodeBackupBucketConfig(decoder runtime.Decoder, backupBucketConfig *runtime.RawExtension, s3Compatible bool) (*apisopenstack.BackupBucketConfig, error) {
+	bbConfig := &apisopenstack.BackupBucketConfig{}
+	if backupBucketConfig == nil {
+		return bbConfig, nil
 	}
 
-	return openstackClient.DeleteContainerIfExists(ctx, bb.Name)
+	if _, _, err := decoder.Decode(backupBucketConfig.Raw, nil, bbConfig); err != nil {
+		return nil, errors.Wrap(err, "could not decode the backup bucket configuration")
+	}
+	if errs := validation.ValidateBackupBucketConfig(bbConfig, s3Compatible); len(errs) > 0 {
+		return nil, errors.Wrap(errs.ToAggregate(), "invalid backup bucket configuration")
+	}
+
+	return bbConfig, nil
+}
+
+func toS3CompatBucketConfig(bbConfig *apisopenstack.BackupBucketConfig) *s3compat.BucketConfig {
+	bucketConfig := &s3compat.BucketConfig{
+		Versioning: bbConfig.Versioning,
+	}
+	if objectLock := bbConfig.ObjectLock; objectLock != nil {
+		bucketConfig.ObjectLock = &s3compat.ObjectLockConfig{
+			Mode: string(objectLock.Mode),
+			Days: int64(objectLock.Days),
+		}
+	}
+	if encryption := bbConfig.Encryption; encryption != nil {
+		bucketConfig.Encryption = &s3compat.EncryptionConfig{KMSKeyID: encryption.KMSKeyID}
+	}
+	if lifecycle := bbConfig.Lifecycle; lifecycle != nil {
+		bucketConfig.Lifecycle = &s3compat.LifecycleConfig{
+			ExpirationDays:                  toInt64Ptr(lifecycle.ExpirationDays),
+			NoncurrentVersionExpirationDays: toInt64Ptr(lifecycle.NoncurrentVersionExpirationDays),
+		}
+	}
+	return bucketConfig
+}
+
+func toInt64Ptr(i *int32) *int64 {
+	if i == nil {
+		return nil
+	}
+	v := int64(*i)
+	return &v
 }
@@ -15,16 +15,24 @@
 package backupbucket
 
 import (
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/config"
+	confighelper "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/config/helper"
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack"
 	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket/genericactuator"
 
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
 )
 
 var (
 	// DefaultAddOptions are the default options for AddToManager.
 	DefaultAddOptions = AddOptions{}
+
+	logger = log.Log.WithName("openstack-backupbucket-actuator")
 )
 
 // AddOptions are options to apply when adding the Openstack backupbucket controller to the manager.
@@ -33,13 +41,23 @@ type AddOptions struct {
 	Controller controller.Options
 	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
 	IgnoreOperationAnnotation bool
+	// BackupStorage is the configuration of the S3-compatible object storage the buckets are created in. If its endpoint
+	// is empty, Swift is used.
+	BackupStorage config.BackupStorage
+	// BackupBucketConfig is the BackupBucketConfig applied to all backup buckets.
+	BackupBucketConfig *runtime.RawExtension
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
+	bbConfig, err := decodeBackupBucketConfig(serializer.NewCodecFactory(mgr.GetScheme()).UniversalDecoder(), opts.BackupBucketConfig, confighelper.IsS3CompatBackupStorage(opts.BackupStorage))
+	if err != nil {
+		return err
+	}
+
 	return backupbucket.Add(mgr, backupbucket.AddArgs{
-		Actuator:          newActuator(),
+		Actuator:          genericactuator.NewActuator(newActuator(&opts.BackupStorage, bbConfig), logger),
 		ControllerOptions: opts.Controller,
 		Predicates:        backupbucket.DefaultPredicates(openstack.Type, opts.IgnoreOperationAnnotation),
 	})
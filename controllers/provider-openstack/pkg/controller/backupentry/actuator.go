@@ -17,24 +17,32 @@ package backupentry
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/config"
+	confighelper "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/config/helper"
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack"
 	openstackclient "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack/client"
 	"github.com/gardener/gardener-extensions/pkg/controller/backupentry/genericactuator"
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
+	"github.com/gardener/gardener-extensions/pkg/objectstore/s3compat"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
 
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type actuator struct {
-	client client.Client
-	logger logr.Logger
+	backupStorage *config.BackupStorage
+	client        client.Client
+	logger        logr.Logger
 }
 
-func newActuator() genericactuator.BackupEntryDelegate {
+func newActuator(backupStorage *config.BackupStorage) genericactuator.BackupEntryDelegate {
 	return &actuator{
-		logger: logger,
+		backupStorage: backupStorage,
+		logger:        logger,
 	}
 }
 
@@ -44,15 +52,43 @@ func (a *actuator) InjectClient(client client.Client) error {
 }
 
 func (a *actuator) GetETCDSecretData(ctx context.Context, be *extensionsv1alpha1.BackupEntry, backupSecretData map[string][]byte) (map[string][]byte, error) {
+	if confighelper.IsS3CompatBackupStorage(*a.backupStorage) {
+		return s3compat.ETCDSecretData(confighelper.GetS3CompatConfig(*a.backupStorage), be.Spec.Region, backupSecretData), nil
+	}
+
 	backupSecretData[openstack.Region] = []byte(be.Spec.Region)
 	return backupSecretData, nil
 }
 
 func (a *actuator) Delete(ctx context.Context, be *extensionsv1alpha1.BackupEntry) error {
-	openstackClient, err := openstackclient.NewStorageClientFromSecretRef(ctx, a.client, be.Spec.SecretRef, be.Spec.Region)
+	store, err := a.getObjectStore(ctx, be)
 	if err != nil {
 		return err
 	}
 
-	return openstackClient.DeleteObjectsWithPrefix(ctx, be.Spec.BucketName, fmt.Sprintf("%s/", be.Name))
+	return store.DeleteObjectsWithPrefix(ctx, be.Spec.BucketName, fmt.Sprintf("%s/", be.Name))
+}
+
+func (a *actuator) ListObjects(ctx context.Context, be *extensionsv1alpha1.BackupEntry, prefix string) (map[string]time.Time, error) {
+	store, err := a.getObjectStore(ctx, be)
+	if err != nil {
+		return nil, err
+	}
+
+	return store.ListObjectsWithPrefix(ctx, be.Spec.BucketName, fmt.Sprintf("%s/%s", be.Name, prefix))
+}
+
+func (a *actuator) getObjectStore(ctx context.Context, be *extensionsv1alpha1.BackupEntry) (objectstore.ObjectStore, error) {
+	return newObjectStore(a.backupStorage)(ctx, a.client, be.Spec.SecretRef, be.Spec.Region)
+}
+
+// newObjectStore returns the factory of the object store the backups are stored in, i.e. of the S3-compatible object
+// storage if it is configured and of Swift otherwise.
+func newObjectStore(backupStorage *config.BackupStorage) objectstore.FactoryFunc {
+	if confighelper.IsS3CompatBackupStorage(*backupStorage) {
+		return func(ctx context.Context, c client.Client, secretRef corev1.SecretReference, region string) (objectstore.ObjectStore, error) {
+			return s3compat.NewObjectStoreFromSecretRef(ctx, c, secretRef, confighelper.GetS3CompatConfig(*backupStorage), region)
+		}
+	}
+	return openstackclient.NewObjectStoreFromSecretRef
 }
@@ -15,6 +15,7 @@
 package backupentry
 
 import (
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/config"
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack"
 	"github.com/gardener/gardener-extensions/pkg/controller/backupentry"
 	"github.com/gardener/gardener-extensions/pkg/controller/backupentry/genericactuator"
@@ -26,7 +27,9 @@ import (
 
 var (
 	// DefaultAddOptions are the default DefaultAddOptions for AddToManager.
-	DefaultAddOptions = AddOptions{}
+	DefaultAddOptions = AddOptions{
+		SnapshotCheck: backupentry.DefaultSnapshotCheckConfig(),
+	}
 
 	logger = log.Log.WithName("openstack-backupentry-actuator")
 )
@@ -37,15 +40,26 @@ type AddOptions struct {
 	Controller controller.Options
 	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
 	IgnoreOperationAnnotation bool
+	// BackupStorage is the configuration of the S3-compatible object storage the backups are stored in. If its endpoint
+	// is empty, Swift is used.
+	BackupStorage config.BackupStorage
+	// SnapshotCheck contains the settings of the etcd snapshot check. If it is nil, snapshots are not checked.
+	SnapshotCheck *backupentry.SnapshotCheckConfig
+	// Deletion contains the settings for deferring the deletion of the backups of deleted backupentries.
+	Deletion backupentry.DeletionConfig
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
 	return backupentry.Add(mgr, backupentry.AddArgs{
-		Actuator:          genericactuator.NewActuator(newActuator(), logger),
+		Actuator:          genericactuator.NewActuator(newActuator(&opts.BackupStorage), logger),
 		ControllerOptions: opts.Controller,
 		Predicates:        backupentry.DefaultPredicates(openstack.Type, opts.IgnoreOperationAnnotation),
+		Type:              openstack.Type,
+		SnapshotCheck:     opts.SnapshotCheck,
+		ObjectStore:       newObjectStore(&opts.BackupStorage),
+		Deletion:          opts.Deletion,
 	})
 }
 
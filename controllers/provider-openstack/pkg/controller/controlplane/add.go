@@ -15,12 +15,15 @@
 package controlplane
 
 import (
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/config"
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/imagevector"
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/controlplane"
 	"github.com/gardener/gardener-extensions/pkg/controller/controlplane/genericactuator"
 	"github.com/gardener/gardener-extensions/pkg/util"
+
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
@@ -39,15 +42,19 @@ type AddOptions struct {
 	Controller controller.Options
 	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
 	IgnoreOperationAnnotation bool
+	// ShootWebhooks specifies the list of desired shoot webhooks.
+	ShootWebhooks []admissionregistrationv1beta1.Webhook
+	// ControlPlaneComponents are the vertical pod autoscaling and high availability settings of the control plane components.
+	ControlPlaneComponents config.ControlPlaneComponents
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
 	return controlplane.Add(mgr, controlplane.AddArgs{
-		Actuator: genericactuator.NewActuator(openstack.Name, controlPlaneSecrets, nil, configChart, ccmChart, ccmShootChart,
-			storageClassChart, nil, NewValuesProvider(logger), extensionscontroller.ChartRendererFactoryFunc(util.NewChartRendererForShoot),
-			imagevector.ImageVector(), openstack.CloudProviderConfigCloudControllerManagerName, nil, mgr.GetWebhookServer().Port, logger),
+		Actuator: genericactuator.NewActuator(openstack.Name, controlPlaneSecrets, nil, configChart, controlPlaneChart, controlPlaneShootChart,
+			storageClassChart, nil, NewValuesProvider(opts.ControlPlaneComponents, logger), extensionscontroller.ChartRendererFactoryFunc(util.NewChartRendererForShoot),
+			imagevector.ImageVector(), openstack.CloudProviderConfigCloudControllerManagerName, opts.ShootWebhooks, mgr.GetWebhookServer().Port, logger),
 		ControllerOptions: opts.Controller,
 		Predicates:        controlplane.DefaultPredicates(openstack.Type, opts.IgnoreOperationAnnotation),
 	})
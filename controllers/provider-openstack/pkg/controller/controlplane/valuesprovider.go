@@ -18,6 +18,8 @@ import (
 	"context"
 	"path/filepath"
 
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/config"
+	confighelper "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/config/helper"
 	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack/helper"
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal"
@@ -30,14 +32,16 @@ import (
 
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
-	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/chart"
 	"github.com/gardener/gardener/pkg/utils/secrets"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1beta1 "k8s.io/api/storage/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apiserver/pkg/authentication/user"
@@ -48,6 +52,10 @@ import (
 const (
 	cloudControllerManagerDeploymentName = "cloud-controller-manager"
 	cloudControllerManagerServerName     = "cloud-controller-manager-server"
+	csiDriverControllerDeploymentName    = "csi-driver-controller"
+	csiProvisionerName                   = "csi-provisioner"
+	csiAttacherName                      = "csi-attacher"
+	csiResizerName                       = "csi-resizer"
 )
 
 var controlPlaneSecrets = &secrets.Secrets{
@@ -82,6 +90,45 @@ var controlPlaneSecrets = &secrets.Secrets{
 					SigningCA:  cas[v1alpha1constants.SecretNameCACluster],
 				},
 			},
+			&secrets.ControlPlaneSecretConfig{
+				CertificateSecretConfig: &secrets.CertificateSecretConfig{
+					Name:         csiProvisionerName,
+					CommonName:   "system:csi-provisioner",
+					Organization: []string{user.SystemPrivilegedGroup},
+					CertType:     secrets.ClientCert,
+					SigningCA:    cas[v1alpha1constants.SecretNameCACluster],
+				},
+				KubeConfigRequest: &secrets.KubeConfigRequest{
+					ClusterName:  clusterName,
+					APIServerURL: v1alpha1constants.DeploymentNameKubeAPIServer,
+				},
+			},
+			&secrets.ControlPlaneSecretConfig{
+				CertificateSecretConfig: &secrets.CertificateSecretConfig{
+					Name:         csiAttacherName,
+					CommonName:   "system:csi-attacher",
+					Organization: []string{user.SystemPrivilegedGroup},
+					CertType:     secrets.ClientCert,
+					SigningCA:    cas[v1alpha1constants.SecretNameCACluster],
+				},
+				KubeConfigRequest: &secrets.KubeConfigRequest{
+					ClusterName:  clusterName,
+					APIServerURL: v1alpha1constants.DeploymentNameKubeAPIServer,
+				},
+			},
+			&secrets.ControlPlaneSecretConfig{
+				CertificateSecretConfig: &secrets.CertificateSecretConfig{
+					Name:         csiResizerName,
+					CommonName:   "system:csi-resizer",
+					Organization: []string{user.SystemPrivilegedGroup},
+					CertType:     secrets.ClientCert,
+					SigningCA:    cas[v1alpha1constants.SecretNameCACluster],
+				},
+				KubeConfigRequest: &secrets.KubeConfigRequest{
+					ClusterName:  clusterName,
+					APIServerURL: v1alpha1constants.DeploymentNameKubeAPIServer,
+				},
+			},
 		}
 	},
 }
@@ -95,23 +142,74 @@ var configChart = &chart.Chart{
 	},
 }
 
-var ccmChart = &chart.Chart{
-	Name:   "cloud-controller-manager",
-	Path:   filepath.Join(openstacktypes.InternalChartsPath, "cloud-controller-manager"),
-	Images: []string{openstacktypes.CloudControllerImageName},
-	Objects: []*chart.Object{
-		{Type: &corev1.Service{}, Name: "cloud-controller-manager"},
-		{Type: &appsv1.Deployment{}, Name: "cloud-controller-manager"},
-		{Type: &corev1.ConfigMap{}, Name: "cloud-controller-manager-monitoring-config"},
+var controlPlaneChart = &chart.Chart{
+	Name: "seed-controlplane",
+	Path: filepath.Join(openstacktypes.InternalChartsPath, "seed-controlplane"),
+	SubCharts: []*chart.Chart{
+		{
+			Name:   "cloud-controller-manager",
+			Images: []string{openstacktypes.CloudControllerImageName},
+			Objects: []*chart.Object{
+				{Type: &corev1.Service{}, Name: "cloud-controller-manager"},
+				{Type: &appsv1.Deployment{}, Name: "cloud-controller-manager"},
+				{Type: extensionscontroller.GetVerticalPodAutoscalerObject(), Name: "cloud-controller-manager-vpa"},
+				{Type: &policyv1beta1.PodDisruptionBudget{}, Name: "cloud-controller-manager"},
+				{Type: &corev1.ConfigMap{}, Name: "cloud-controller-manager-monitoring-config"},
+			},
+		},
+		{
+			Name: "csi-driver-controller",
+			Images: []string{
+				openstacktypes.CSIDriverImageName,
+				openstacktypes.CSIProvisionerImageName,
+				openstacktypes.CSIAttacherImageName,
+				openstacktypes.CSIResizerImageName,
+				openstacktypes.CSILivenessProbeImageName,
+			},
+			Objects: []*chart.Object{
+				{Type: &appsv1.Deployment{}, Name: csiDriverControllerDeploymentName},
+				{Type: extensionscontroller.GetVerticalPodAutoscalerObject(), Name: csiDriverControllerDeploymentName + "-vpa"},
+				{Type: &policyv1beta1.PodDisruptionBudget{}, Name: csiDriverControllerDeploymentName},
+			},
+		},
 	},
 }
 
-var ccmShootChart = &chart.Chart{
-	Name: "cloud-controller-manager-shoot",
-	Path: filepath.Join(openstacktypes.InternalChartsPath, "cloud-controller-manager-shoot"),
-	Objects: []*chart.Object{
-		{Type: &rbacv1.ClusterRole{}, Name: "system:controller:cloud-node-controller"},
-		{Type: &rbacv1.ClusterRoleBinding{}, Name: "system:controller:cloud-node-controller"},
+var controlPlaneShootChart = &chart.Chart{
+	Name: "shoot-system-components",
+	Path: filepath.Join(openstacktypes.InternalChartsPath, "shoot-system-components"),
+	SubCharts: []*chart.Chart{
+		{
+			Name: "cloud-controller-manager",
+			Objects: []*chart.Object{
+				{Type: &rbacv1.ClusterRole{}, Name: "system:controller:cloud-node-controller"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "system:controller:cloud-node-controller"},
+			},
+		},
+		{
+			Name: "csi-driver-node",
+			Images: []string{
+				openstacktypes.CSIDriverImageName,
+				openstacktypes.CSINodeDriverRegistrarImageName,
+				openstacktypes.CSILivenessProbeImageName,
+			},
+			Objects: []*chart.Object{
+				{Type: &appsv1.DaemonSet{}, Name: "csi-driver-node"},
+				{Type: &storagev1beta1.CSIDriver{}, Name: openstacktypes.CSIDriverName},
+				{Type: &corev1.ServiceAccount{}, Name: "csi-driver-node"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:psp:kube-system:csi-driver-node"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:psp:csi-driver-node"},
+				{Type: &policyv1beta1.PodSecurityPolicy{}, Name: "gardener.kube-system.csi-driver-node"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:kube-system:csi-provisioner"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:csi-provisioner"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:kube-system:csi-attacher"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:csi-attacher"},
+				{Type: &rbacv1.ClusterRole{}, Name: "garden.sapcloud.io:kube-system:csi-resizer"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: "garden.sapcloud.io:csi-resizer"},
+				{Type: &rbacv1.Role{}, Name: "csi-leader-election"},
+				{Type: &rbacv1.RoleBinding{}, Name: "csi-leader-election"},
+			},
+		},
 	},
 }
 
@@ -121,18 +219,20 @@ var storageClassChart = &chart.Chart{
 }
 
 // NewValuesProvider creates a new ValuesProvider for the generic actuator.
-func NewValuesProvider(logger logr.Logger) genericactuator.ValuesProvider {
+func NewValuesProvider(controlPlaneComponents config.ControlPlaneComponents, logger logr.Logger) genericactuator.ValuesProvider {
 	return &valuesProvider{
-		logger: logger.WithName("openstack-values-provider"),
+		controlPlaneComponents: controlPlaneComponents,
+		logger:                 logger.WithName("openstack-values-provider"),
 	}
 }
 
 // valuesProvider is a ValuesProvider that provides OpenStack-specific values for the 2 charts applied by the generic actuator.
 type valuesProvider struct {
 	genericactuator.NoopValuesProvider
-	decoder runtime.Decoder
-	client  client.Client
-	logger  logr.Logger
+	controlPlaneComponents config.ControlPlaneComponents
+	decoder                runtime.Decoder
+	client                 client.Client
+	logger                 logr.Logger
 }
 
 // InjectScheme injects the given scheme into the valuesProvider.
@@ -195,8 +295,31 @@ func (vp *valuesProvider) GetControlPlaneChartValues(
 		return nil, errors.Wrapf(err, "could not decode providerConfig of controlplane '%s'", util.ObjectName(cp))
 	}
 
-	// Get CCM chart values
-	return getCCMChartValues(cpConfig, cp, cluster, checksums, scaledDown)
+	// Get control plane chart values
+	values, err := getControlPlaneChartValues(cpConfig, cp, cluster, checksums, scaledDown, confighelper.GetControlPlaneComponentReplicas(vp.controlPlaneComponents))
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply the vertical pod autoscaling and high availability settings to all control plane components
+	componentValues := confighelper.GetControlPlaneComponentChartValues(vp.controlPlaneComponents)
+	for _, subChart := range controlPlaneChart.SubCharts {
+		if subChartValues, ok := values[subChart.Name].(map[string]interface{}); ok {
+			values[subChart.Name] = gardenerutils.MergeMaps(subChartValues, componentValues)
+		}
+	}
+
+	return values, nil
+}
+
+// GetControlPlaneShootChartValues returns the values for the control plane shoot chart applied by the generic actuator.
+func (vp *valuesProvider) GetControlPlaneShootChartValues(
+	ctx context.Context,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+) (map[string]interface{}, error) {
+	// Get control plane shoot chart values
+	return getControlPlaneShootChartValues(cluster)
 }
 
 // GetStorageClassesChartValues returns the values for the shoot storageclasses chart applied by the generic actuator.
@@ -211,9 +334,8 @@ func (vp *valuesProvider) GetStorageClassesChartValues(
 		return nil, errors.Wrapf(err, "could not decode providerConfig of controlplane '%s'", util.ObjectName(cp))
 	}
 
-	return map[string]interface{}{
-		"availability": cpConfig.Zone,
-	}, nil
+	// Get storage classes chart values
+	return getStorageClassesChartValues(cpConfig, cluster)
 }
 
 // getConfigChartValues collects and returns the configuration chart values.
@@ -261,24 +383,24 @@ func getConfigChartValues(
 		"requestTimeout":    requestTimeout,
 	}
 
-	if cpConfig.LoadBalancerClasses == nil {
-		if cluster.CloudProfile != nil && cluster.Shoot != nil {
-			for _, pool := range cluster.CloudProfile.Spec.OpenStack.Constraints.FloatingPools {
-				if pool.Name == cluster.Shoot.Spec.Cloud.OpenStack.FloatingPoolName {
-					cpConfig.LoadBalancerClasses = gardenV1beta1OpenStackLoadBalancerClassToOpenStackV1alpha1LoadBalancerClass(pool.LoadBalancerClasses)
-					break
-				}
+	if octavia := cpConfig.Octavia; octavia != nil {
+		utils.SetStringValue(values, "flavorID", octavia.FlavorID)
+		utils.SetStringValue(values, "availabilityZone", octavia.AvailabilityZone)
+		if hm := octavia.HealthMonitor; hm != nil {
+			if hm.Delay != nil {
+				values["monitorDelay"] = hm.Delay.Duration.String()
 			}
-		} else if cluster.CoreCloudProfile != nil && cluster.CoreShoot != nil && cloudProfileConfig != nil {
-			for _, pool := range cloudProfileConfig.Constraints.FloatingPools {
-				if pool.Name == infraStatus.Networks.FloatingPool.Name {
-					cpConfig.LoadBalancerClasses = pool.LoadBalancerClasses
-					break
-				}
+			if hm.Timeout != nil {
+				values["monitorTimeout"] = hm.Timeout.Duration.String()
+			}
+			if hm.MaxRetries != nil {
+				values["monitorMaxRetries"] = *hm.MaxRetries
 			}
 		}
 	}
 
+	cpConfig.LoadBalancerClasses = internal.GetLoadBalancerClasses(cpConfig, infraStatus, cloudProfileConfig, cluster)
+
 	for _, class := range cpConfig.LoadBalancerClasses {
 		if class.Name == apisopenstack.DefaultLoadBalancerClass {
 			utils.SetStringValue(values, "floatingNetworkID", class.FloatingNetworkID)
@@ -315,6 +437,31 @@ func getConfigChartValues(
 	return values, nil
 }
 
+// getControlPlaneChartValues collects and returns the control plane chart values.
+func getControlPlaneChartValues(
+	cpConfig *apisopenstack.ControlPlaneConfig,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+	checksums map[string]string,
+	scaledDown bool,
+	replicas int,
+) (map[string]interface{}, error) {
+	ccm, err := getCCMChartValues(cpConfig, cp, cluster, checksums, scaledDown, replicas)
+	if err != nil {
+		return nil, err
+	}
+
+	csi, err := getCSIControllerChartValues(cluster, checksums, scaledDown, replicas)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"cloud-controller-manager": ccm,
+		"csi-driver-controller":    csi,
+	}, nil
+}
+
 // getCCMChartValues collects and returns the CCM chart values.
 func getCCMChartValues(
 	cpConfig *apisopenstack.ControlPlaneConfig,
@@ -322,9 +469,10 @@ func getCCMChartValues(
 	cluster *extensionscontroller.Cluster,
 	checksums map[string]string,
 	scaledDown bool,
+	replicas int,
 ) (map[string]interface{}, error) {
 	values := map[string]interface{}{
-		"replicas":          extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
+		"replicas":          extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, replicas),
 		"clusterName":       cp.Namespace,
 		"kubernetesVersion": extensionscontroller.GetKubernetesVersion(cluster),
 		"podNetwork":        extensionscontroller.GetPodNetwork(cluster),
@@ -336,22 +484,128 @@ func getCCMChartValues(
 		},
 	}
 
-	if cpConfig.CloudControllerManager != nil {
-		values["featureGates"] = cpConfig.CloudControllerManager.FeatureGates
+	if ccmConfig := cpConfig.CloudControllerManager; ccmConfig != nil {
+		values["featureGates"] = ccmConfig.FeatureGates
+		if ccmConfig.ConcurrentServiceSyncs != nil {
+			values["concurrentServiceSyncs"] = *ccmConfig.ConcurrentServiceSyncs
+		}
+		if ccmConfig.NodeMonitorPeriod != nil {
+			values["nodeMonitorPeriod"] = ccmConfig.NodeMonitorPeriod.Duration.String()
+		}
+		if ccmConfig.RouteReconciliationPeriod != nil {
+			values["routeReconciliationPeriod"] = ccmConfig.RouteReconciliationPeriod.Duration.String()
+		}
+		if ccmConfig.Verbosity != nil {
+			values["verbosity"] = *ccmConfig.Verbosity
+		}
 	}
 
 	return values, nil
 }
 
-func gardenV1beta1OpenStackLoadBalancerClassToOpenStackV1alpha1LoadBalancerClass(loadBalancerClasses []gardenv1beta1.OpenStackLoadBalancerClass) []apisopenstack.LoadBalancerClass {
-	out := make([]apisopenstack.LoadBalancerClass, 0, len(loadBalancerClasses))
-	for _, loadBalancerClass := range loadBalancerClasses {
-		out = append(out, apisopenstack.LoadBalancerClass{
-			Name:              loadBalancerClass.Name,
-			FloatingSubnetID:  loadBalancerClass.FloatingSubnetID,
-			FloatingNetworkID: loadBalancerClass.FloatingNetworkID,
-			SubnetID:          loadBalancerClass.SubnetID,
-		})
+// getCSIControllerChartValues collects and returns the CSI controller chart values.
+func getCSIControllerChartValues(
+	cluster *extensionscontroller.Cluster,
+	checksums map[string]string,
+	scaledDown bool,
+	replicas int,
+) (map[string]interface{}, error) {
+	useCSI, err := useCSI(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	if !useCSI {
+		return map[string]interface{}{"enabled": false}, nil
+	}
+
+	return map[string]interface{}{
+		"enabled":  true,
+		"replicas": extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, replicas),
+		"podAnnotations": map[string]interface{}{
+			"checksum/secret-" + csiProvisionerName:                                              checksums[csiProvisionerName],
+			"checksum/secret-" + csiAttacherName:                                                 checksums[csiAttacherName],
+			"checksum/secret-" + csiResizerName:                                                  checksums[csiResizerName],
+			"checksum/configmap-" + openstacktypes.CloudProviderConfigCloudControllerManagerName: checksums[openstacktypes.CloudProviderConfigCloudControllerManagerName],
+		},
+	}, nil
+}
+
+// getControlPlaneShootChartValues collects and returns the control plane shoot chart values.
+func getControlPlaneShootChartValues(cluster *extensionscontroller.Cluster) (map[string]interface{}, error) {
+	useCSI, err := useCSI(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"csi-driver-node": map[string]interface{}{
+			"enabled": useCSI,
+		},
+	}, nil
+}
+
+// getStorageClassesChartValues collects and returns the storage classes chart values.
+func getStorageClassesChartValues(
+	cpConfig *apisopenstack.ControlPlaneConfig,
+	cluster *extensionscontroller.Cluster,
+) (map[string]interface{}, error) {
+	useCSI, err := useCSI(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	storageClasses, customDefaultStorageClass := getStorageClasses(cpConfig.StorageClasses, cpConfig.Zone)
+
+	return map[string]interface{}{
+		"availability":              cpConfig.Zone,
+		"useCSI":                    useCSI,
+		"storageClasses":            storageClasses,
+		"customDefaultStorageClass": customDefaultStorageClass,
+	}, nil
+}
+
+// getStorageClasses collects and returns the chart values for the given additional storage classes. It also
+// returns whether one of them shall be the default storage class of the shoot cluster.
+func getStorageClasses(storageClasses []apisopenstack.StorageClass, zone string) ([]map[string]interface{}, bool) {
+	var (
+		values     = make([]map[string]interface{}, 0, len(storageClasses))
+		hasDefault bool
+	)
+
+	for _, sc := range storageClasses {
+		parameters := map[string]interface{}{
+			"type":         sc.Type,
+			"availability": zone,
+		}
+
+		isDefault := sc.Default != nil && *sc.Default
+		if isDefault {
+			hasDefault = true
+		}
+
+		value := map[string]interface{}{
+			"name":       sc.Name,
+			"default":    isDefault,
+			"parameters": parameters,
+		}
+		if sc.ReclaimPolicy != nil {
+			value["reclaimPolicy"] = string(*sc.ReclaimPolicy)
+		}
+		if sc.VolumeBindingMode != nil {
+			value["volumeBindingMode"] = string(*sc.VolumeBindingMode)
+		}
+		values = append(values, value)
+	}
+
+	return values, hasDefault
+}
+
+// useCSI returns true if the OpenStack Cinder CSI driver shall be used for the given cluster.
+func useCSI(cluster *extensionscontroller.Cluster) (bool, error) {
+	useCSI, err := openstacktypes.UseCSI(extensionscontroller.GetKubernetesVersion(cluster))
+	if err != nil {
+		return false, errors.Wrapf(err, "could not determine whether the CSI driver shall be used")
 	}
-	return out
+	return useCSI, nil
 }
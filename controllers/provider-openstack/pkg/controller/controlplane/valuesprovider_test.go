@@ -17,7 +17,9 @@ package controlplane
 import (
 	"context"
 	"encoding/json"
+	"time"
 
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/config"
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
 	openstacktypes "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
@@ -55,6 +57,8 @@ func defaultControlPlane() *extensionsv1alpha1.ControlPlane {
 				FeatureGates: map[string]bool{
 					"CustomResourceValidation": true,
 				},
+				NodeMonitorPeriod:         &metav1.Duration{Duration: 10 * time.Second},
+				RouteReconciliationPeriod: &metav1.Duration{Duration: 30 * time.Second},
 			},
 		})
 }
@@ -129,6 +133,27 @@ var _ = Describe("ValuesProvider", func() {
 				},
 			},
 		}
+		csiCluster = &extensionscontroller.Cluster{
+			CoreCloudProfile: &gardencorev1alpha1.CloudProfile{
+				Spec: gardencorev1alpha1.CloudProfileSpec{
+					ProviderConfig: &gardencorev1alpha1.ProviderConfig{
+						RawExtension: runtime.RawExtension{
+							Raw: cloudProfileConfigJSON,
+						},
+					},
+				},
+			},
+			CoreShoot: &gardencorev1alpha1.Shoot{
+				Spec: gardencorev1alpha1.ShootSpec{
+					Networking: gardencorev1alpha1.Networking{
+						Pods: &cidr,
+					},
+					Kubernetes: gardencorev1alpha1.Kubernetes{
+						Version: "1.19.0",
+					},
+				},
+			},
+		}
 
 		cpSecretKey = client.ObjectKey{Namespace: namespace, Name: v1alpha1constants.SecretNameCloudProvider}
 		cpSecret    = &corev1.Secret{
@@ -150,6 +175,9 @@ var _ = Describe("ValuesProvider", func() {
 			"cloud-controller-manager":                                   "3d791b164a808638da9a8df03924be2a41e34cd664e42231c00fe369e3588272",
 			"cloud-controller-manager-server":                            "6dff2a2e6f14444b66d8e4a351c049f7e89ee24ba3eaab95dbec40ba6bdebb52",
 			openstacktypes.CloudProviderConfigCloudControllerManagerName: "08a7bc7fe8f59b055f173145e211760a83f02cf89635cef26ebb351378635606",
+			csiProvisionerName:                                           "65b1dac6b50673535cff480564c2e5c71077ed19b1b6e0e2291207225bdf77d4",
+			csiAttacherName:                                              "2da58ad61c401a2af779a909d22fb42eed93a1524cbfdab974ceedb413fcb914",
+			csiResizerName:                                               "a77e663ba1af340fb3dd7f6f8a1be47c7aa9e658198695480641e6b934c0b9ed",
 		}
 
 		configChartValues = map[string]interface{}{
@@ -166,19 +194,42 @@ var _ = Describe("ValuesProvider", func() {
 			"requestTimeout":    requestTimeout,
 		}
 
-		ccmChartValues = map[string]interface{}{
-			"replicas":          1,
-			"kubernetesVersion": "1.13.4",
-			"clusterName":       namespace,
-			"podNetwork":        cidr,
-			"podAnnotations": map[string]interface{}{
-				"checksum/secret-cloud-controller-manager":                          "3d791b164a808638da9a8df03924be2a41e34cd664e42231c00fe369e3588272",
-				"checksum/secret-cloud-controller-manager-server":                   "6dff2a2e6f14444b66d8e4a351c049f7e89ee24ba3eaab95dbec40ba6bdebb52",
-				"checksum/secret-cloudprovider":                                     "8bafb35ff1ac60275d62e1cbd495aceb511fb354f74a20f7d06ecb48b3a68432",
-				"checksum/configmap-cloud-provider-config-cloud-controller-manager": "08a7bc7fe8f59b055f173145e211760a83f02cf89635cef26ebb351378635606",
+		vpaChartValues = map[string]interface{}{
+			"enabled": true,
+			"updatePolicy": map[string]interface{}{
+				"updateMode": "Auto",
 			},
-			"featureGates": map[string]bool{
-				"CustomResourceValidation": true,
+		}
+		disabledChartValues = map[string]interface{}{
+			"enabled": false,
+		}
+
+		controlPlaneChartValues = map[string]interface{}{
+			"cloud-controller-manager": map[string]interface{}{
+				"replicas":          1,
+				"kubernetesVersion": "1.13.4",
+				"clusterName":       namespace,
+				"podNetwork":        cidr,
+				"podAnnotations": map[string]interface{}{
+					"checksum/secret-cloud-controller-manager":                          "3d791b164a808638da9a8df03924be2a41e34cd664e42231c00fe369e3588272",
+					"checksum/secret-cloud-controller-manager-server":                   "6dff2a2e6f14444b66d8e4a351c049f7e89ee24ba3eaab95dbec40ba6bdebb52",
+					"checksum/secret-cloudprovider":                                     "8bafb35ff1ac60275d62e1cbd495aceb511fb354f74a20f7d06ecb48b3a68432",
+					"checksum/configmap-cloud-provider-config-cloud-controller-manager": "08a7bc7fe8f59b055f173145e211760a83f02cf89635cef26ebb351378635606",
+				},
+				"featureGates": map[string]bool{
+					"CustomResourceValidation": true,
+				},
+				"nodeMonitorPeriod":         "10s",
+				"routeReconciliationPeriod": "30s",
+				"vpa":                       vpaChartValues,
+				"podDisruptionBudget":       disabledChartValues,
+				"podAntiAffinity":           disabledChartValues,
+			},
+			"csi-driver-controller": map[string]interface{}{
+				"enabled":             false,
+				"vpa":                 vpaChartValues,
+				"podDisruptionBudget": disabledChartValues,
+				"podAntiAffinity":     disabledChartValues,
 			},
 		}
 
@@ -200,7 +251,7 @@ var _ = Describe("ValuesProvider", func() {
 			client.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
 
 			// Create valuesProvider
-			vp := NewValuesProvider(logger)
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
 			err := vp.(inject.Scheme).InjectScheme(scheme)
 			Expect(err).NotTo(HaveOccurred())
 			err = vp.(inject.Client).InjectClient(client)
@@ -216,14 +267,157 @@ var _ = Describe("ValuesProvider", func() {
 	Describe("#GetControlPlaneChartValues", func() {
 		It("should return correct control plane chart values", func() {
 			// Create valuesProvider
-			vp := NewValuesProvider(logger)
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
 			err := vp.(inject.Scheme).InjectScheme(scheme)
 			Expect(err).NotTo(HaveOccurred())
 
 			// Call GetControlPlaneChartValues method and check the result
 			values, err := vp.GetControlPlaneChartValues(context.TODO(), cp, cluster, checksums, false)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(values).To(Equal(ccmChartValues))
+			Expect(values).To(Equal(controlPlaneChartValues))
+		})
+
+		It("should return correct control plane chart values if the CSI driver is used", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetControlPlaneChartValues method and check the result
+			values, err := vp.GetControlPlaneChartValues(context.TODO(), cp, csiCluster, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("csi-driver-controller", map[string]interface{}{
+				"enabled":  true,
+				"replicas": 1,
+				"podAnnotations": map[string]interface{}{
+					"checksum/secret-csi-provisioner":                                   "65b1dac6b50673535cff480564c2e5c71077ed19b1b6e0e2291207225bdf77d4",
+					"checksum/secret-csi-attacher":                                      "2da58ad61c401a2af779a909d22fb42eed93a1524cbfdab974ceedb413fcb914",
+					"checksum/secret-csi-resizer":                                       "a77e663ba1af340fb3dd7f6f8a1be47c7aa9e658198695480641e6b934c0b9ed",
+					"checksum/configmap-cloud-provider-config-cloud-controller-manager": "08a7bc7fe8f59b055f173145e211760a83f02cf89635cef26ebb351378635606",
+				},
+				"vpa":                 vpaChartValues,
+				"podDisruptionBudget": disabledChartValues,
+				"podAntiAffinity":     disabledChartValues,
+			}))
+		})
+	})
+
+	Describe("#GetControlPlaneShootChartValues", func() {
+		It("should return correct control plane shoot chart values", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
+
+			// Call GetControlPlaneShootChartValues method and check the result
+			values, err := vp.GetControlPlaneShootChartValues(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"csi-driver-node": map[string]interface{}{"enabled": false},
+			}))
+
+			values, err = vp.GetControlPlaneShootChartValues(context.TODO(), cp, csiCluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"csi-driver-node": map[string]interface{}{"enabled": true},
+			}))
+		})
+	})
+
+	Describe("#GetStorageClassesChartValues", func() {
+		It("should return correct storage classes chart values", func() {
+			// Create valuesProvider
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetStorageClassesChartValues method and check the result
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{"availability": "", "useCSI": false, "storageClasses": []map[string]interface{}{}, "customDefaultStorageClass": false}))
+
+			values, err = vp.GetStorageClassesChartValues(context.TODO(), cp, csiCluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{"availability": "", "useCSI": true, "storageClasses": []map[string]interface{}{}, "customDefaultStorageClass": false}))
+		})
+
+		It("should return correct storage classes chart values for additional storage classes", func() {
+			var (
+				retain    = corev1.PersistentVolumeReclaimRetain
+				isDefault = true
+			)
+			cpWithStorageClasses := cp.DeepCopy()
+			cpWithStorageClasses.Spec.ProviderConfig = &runtime.RawExtension{
+				Raw: encode(&openstack.ControlPlaneConfig{
+					Zone: "zone-1",
+					StorageClasses: []openstack.StorageClass{
+						{Name: "fast", Type: "ssd", ReclaimPolicy: &retain, Default: &isDefault},
+					},
+				}),
+			}
+
+			// Create valuesProvider
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Call GetStorageClassesChartValues method and check the result
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), cpWithStorageClasses, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"availability": "zone-1", "useCSI": false,
+				"storageClasses": []map[string]interface{}{
+					{
+						"name":          "fast",
+						"default":       true,
+						"reclaimPolicy": "Retain",
+						"parameters":    map[string]interface{}{"type": "ssd", "availability": "zone-1"},
+					},
+				},
+				"customDefaultStorageClass": true,
+			}))
+		})
+	})
+
+	Describe("#GetConfigChartValues with Octavia settings", func() {
+		It("should return correct config chart values", func() {
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+
+			// Create valuesProvider
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
+			err := vp.(inject.Scheme).InjectScheme(scheme)
+			Expect(err).NotTo(HaveOccurred())
+			err = vp.(inject.Client).InjectClient(client)
+			Expect(err).NotTo(HaveOccurred())
+
+			var (
+				flavorID               = "flavor"
+				availabilityZone       = "nova"
+				maxRetries       int32 = 3
+			)
+			cp := controlPlane(
+				"floating-network-id",
+				&openstack.ControlPlaneConfig{
+					LoadBalancerProvider: "octavia",
+					Octavia: &openstack.OctaviaConfig{
+						FlavorID:         &flavorID,
+						AvailabilityZone: &availabilityZone,
+						HealthMonitor: &openstack.HealthMonitorConfig{
+							Delay:      &metav1.Duration{Duration: 2 * time.Minute},
+							MaxRetries: &maxRetries,
+						},
+					},
+				})
+
+			// Call GetConfigChartValues method and check the result
+			values, err := vp.GetConfigChartValues(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("lbProvider", "octavia"))
+			Expect(values).To(HaveKeyWithValue("flavorID", flavorID))
+			Expect(values).To(HaveKeyWithValue("availabilityZone", availabilityZone))
+			Expect(values).To(HaveKeyWithValue("monitorDelay", "2m0s"))
+			Expect(values).To(HaveKeyWithValue("monitorMaxRetries", maxRetries))
+			Expect(values).NotTo(HaveKey("monitorTimeout"))
 		})
 	})
 
@@ -234,7 +428,7 @@ var _ = Describe("ValuesProvider", func() {
 			client.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
 
 			// Create valuesProvider
-			vp := NewValuesProvider(logger)
+			vp := NewValuesProvider(config.ControlPlaneComponents{}, logger)
 			err := vp.(inject.Scheme).InjectScheme(scheme)
 			Expect(err).NotTo(HaveOccurred())
 			err = vp.(inject.Client).InjectClient(client)
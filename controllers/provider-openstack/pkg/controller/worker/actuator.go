@@ -47,13 +47,15 @@ type delegateFactory struct {
 	decoder runtime.Decoder
 
 	machineImageToCloudProfilesMapping []config.MachineImage
+	controlPlaneComponents             config.ControlPlaneComponents
 }
 
 // NewActuator creates a new Actuator that updates the status of the handled WorkerPoolConfigs.
-func NewActuator(machineImageToCloudProfilesMapping []config.MachineImage) worker.Actuator {
+func NewActuator(machineImageToCloudProfilesMapping []config.MachineImage, controlPlaneComponents config.ControlPlaneComponents) worker.Actuator {
 	delegateFactory := &delegateFactory{
 		logger:                             log.Log.WithName("worker-actuator"),
 		machineImageToCloudProfilesMapping: machineImageToCloudProfilesMapping,
+		controlPlaneComponents:             controlPlaneComponents,
 	}
 
 	return genericactuator.NewActuator(
@@ -105,6 +107,7 @@ func (d *delegateFactory) WorkerDelegate(ctx context.Context, worker *extensions
 		d.decoder,
 
 		d.machineImageToCloudProfilesMapping,
+		d.controlPlaneComponents,
 		seedChartApplier,
 		serverVersion.GitVersion,
 
@@ -119,6 +122,7 @@ type workerDelegate struct {
 	decoder runtime.Decoder
 
 	machineImageToCloudProfilesMapping []config.MachineImage
+	controlPlaneComponents             config.ControlPlaneComponents
 	seedChartApplier                   gardener.ChartApplier
 	serverVersion                      string
 
@@ -137,6 +141,7 @@ func NewWorkerDelegate(
 	decoder runtime.Decoder,
 
 	machineImageToCloudProfilesMapping []config.MachineImage,
+	controlPlaneComponents config.ControlPlaneComponents,
 	seedChartApplier gardener.ChartApplier,
 	serverVersion string,
 
@@ -149,6 +154,7 @@ func NewWorkerDelegate(
 		decoder: decoder,
 
 		machineImageToCloudProfilesMapping: machineImageToCloudProfilesMapping,
+		controlPlaneComponents:             controlPlaneComponents,
 		seedChartApplier:                   seedChartApplier,
 		serverVersion:                      serverVersion,
 
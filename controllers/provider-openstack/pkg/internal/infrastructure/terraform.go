@@ -47,6 +47,10 @@ const (
 	TerraformOutputKeySubnetID = "subnet_id"
 	// DefaultRouterID is the computed router ID as generated by terraform.
 	DefaultRouterID = "${openstack_networking_router_v2.router.id}"
+	// DefaultNetworkID is the computed network ID as generated by terraform.
+	DefaultNetworkID = "${openstack_networking_network_v2.cluster.id}"
+	// DefaultSubnetID is the computed subnet ID as generated by terraform.
+	DefaultSubnetID = "${openstack_networking_subnet_v2.cluster.id}"
 )
 
 var (
@@ -69,8 +73,12 @@ func ComputeTerraformerChartValues(
 	cluster *controller.Cluster,
 ) (map[string]interface{}, error) {
 	var (
-		routerID     = DefaultRouterID
-		createRouter = true
+		routerID      = DefaultRouterID
+		createRouter  = true
+		networkID     = DefaultNetworkID
+		createNetwork = true
+		subnetID      = DefaultSubnetID
+		createSubnet  = true
 	)
 
 	if router := config.Networks.Router; router != nil {
@@ -78,6 +86,17 @@ func ComputeTerraformerChartValues(
 		routerID = router.ID
 	}
 
+	// Pre-existing networks and subnets are only referenced by their ids and never become part of the
+	// Terraform state, hence they are not removed when the infrastructure is deleted.
+	if config.Networks.ID != nil {
+		createNetwork = false
+		networkID = *config.Networks.ID
+	}
+	if config.Networks.SubnetID != nil {
+		createSubnet = false
+		subnetID = *config.Networks.SubnetID
+	}
+
 	var (
 		keyStoneURL string
 		dnsServers  []string
@@ -103,16 +122,21 @@ func ComputeTerraformerChartValues(
 			"floatingPoolName": config.FloatingPoolName,
 		},
 		"create": map[string]interface{}{
-			"router": createRouter,
+			"router":  createRouter,
+			"network": createNetwork,
+			"subnet":  createSubnet,
 		},
 		"dnsServers":   dnsServers,
 		"sshPublicKey": string(infra.Spec.SSHPublicKey),
 		"router": map[string]interface{}{
-			"id": routerID,
+			"id":               routerID,
+			"externalFixedIPs": config.Networks.RouterExternalFixedIPs,
 		},
 		"clusterName": infra.Namespace,
 		"networks": map[string]interface{}{
-			"worker": config.Networks.Worker,
+			"id":       networkID,
+			"subnetID": subnetID,
+			"worker":   config.Networks.Worker,
 		},
 		"outputKeys": map[string]interface{}{
 			"routerID":          TerraformOutputKeyRouterID,
@@ -116,16 +116,21 @@ var _ = Describe("Terraform", func() {
 					"floatingPoolName": config.FloatingPoolName,
 				},
 				"create": map[string]interface{}{
-					"router": false,
+					"router":  false,
+					"network": true,
+					"subnet":  true,
 				},
 				"dnsServers":   dnsServers,
 				"sshPublicKey": string(infra.Spec.SSHPublicKey),
 				"router": map[string]interface{}{
-					"id": "1",
+					"id":               "1",
+					"externalFixedIPs": config.Networks.RouterExternalFixedIPs,
 				},
 				"clusterName": infra.Namespace,
 				"networks": map[string]interface{}{
-					"worker": config.Networks.Worker,
+					"id":       DefaultNetworkID,
+					"subnetID": DefaultSubnetID,
+					"worker":   config.Networks.Worker,
 				},
 				"outputKeys": map[string]interface{}{
 					"routerID":          TerraformOutputKeyRouterID,
@@ -154,16 +159,21 @@ var _ = Describe("Terraform", func() {
 					"floatingPoolName": config.FloatingPoolName,
 				},
 				"create": map[string]interface{}{
-					"router": true,
+					"router":  true,
+					"network": true,
+					"subnet":  true,
 				},
 				"dnsServers":   dnsServers,
 				"sshPublicKey": string(infra.Spec.SSHPublicKey),
 				"router": map[string]interface{}{
-					"id": DefaultRouterID,
+					"id":               DefaultRouterID,
+					"externalFixedIPs": config.Networks.RouterExternalFixedIPs,
 				},
 				"clusterName": infra.Namespace,
 				"networks": map[string]interface{}{
-					"worker": config.Networks.Worker,
+					"id":       DefaultNetworkID,
+					"subnetID": DefaultSubnetID,
+					"worker":   config.Networks.Worker,
 				},
 				"outputKeys": map[string]interface{}{
 					"routerID":          TerraformOutputKeyRouterID,
@@ -176,6 +186,42 @@ var _ = Describe("Terraform", func() {
 				},
 			}))
 		})
+
+		It("should correctly compute the terraformer chart values with existing network and subnet", func() {
+			var (
+				networkID = "network-id"
+				subnetID  = "subnet-id"
+			)
+			config.Networks.ID = &networkID
+			config.Networks.SubnetID = &subnetID
+
+			values, err := ComputeTerraformerChartValues(infra, credentials, config, cluster)
+			Expect(err).To(BeNil())
+
+			Expect(values["create"]).To(Equal(map[string]interface{}{
+				"router":  false,
+				"network": false,
+				"subnet":  false,
+			}))
+			Expect(values["networks"]).To(Equal(map[string]interface{}{
+				"id":       networkID,
+				"subnetID": subnetID,
+				"worker":   config.Networks.Worker,
+			}))
+		})
+
+		It("should correctly compute the terraformer chart values with router external fixed ips", func() {
+			config.Networks.Router = nil
+			config.Networks.RouterExternalFixedIPs = []string{"10.0.0.10"}
+
+			values, err := ComputeTerraformerChartValues(infra, credentials, config, cluster)
+			Expect(err).To(BeNil())
+
+			Expect(values["router"]).To(Equal(map[string]interface{}{
+				"id":               DefaultRouterID,
+				"externalFixedIPs": []string{"10.0.0.10"},
+			}))
+		})
 	})
 
 	Describe("#StatusFromTerraformState", func() {
@@ -0,0 +1,64 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+)
+
+// GetLoadBalancerClasses returns the load balancer classes of the given ControlPlaneConfig. If it does not contain any,
+// the load balancer classes of the shoot's floating pool in the cloud profile are returned.
+func GetLoadBalancerClasses(
+	cpConfig *apisopenstack.ControlPlaneConfig,
+	infraStatus *apisopenstack.InfrastructureStatus,
+	cloudProfileConfig *apisopenstack.CloudProfileConfig,
+	cluster *extensionscontroller.Cluster,
+) []apisopenstack.LoadBalancerClass {
+	if cpConfig.LoadBalancerClasses != nil {
+		return cpConfig.LoadBalancerClasses
+	}
+
+	if cluster.CloudProfile != nil && cluster.Shoot != nil {
+		for _, pool := range cluster.CloudProfile.Spec.OpenStack.Constraints.FloatingPools {
+			if pool.Name == cluster.Shoot.Spec.Cloud.OpenStack.FloatingPoolName {
+				return gardenV1beta1OpenStackLoadBalancerClassToOpenStackV1alpha1LoadBalancerClass(pool.LoadBalancerClasses)
+			}
+		}
+	} else if cluster.CoreCloudProfile != nil && cluster.CoreShoot != nil && cloudProfileConfig != nil {
+		for _, pool := range cloudProfileConfig.Constraints.FloatingPools {
+			if pool.Name == infraStatus.Networks.FloatingPool.Name {
+				return pool.LoadBalancerClasses
+			}
+		}
+	}
+
+	return nil
+}
+
+func gardenV1beta1OpenStackLoadBalancerClassToOpenStackV1alpha1LoadBalancerClass(loadBalancerClasses []gardenv1beta1.OpenStackLoadBalancerClass) []apisopenstack.LoadBalancerClass {
+	out := make([]apisopenstack.LoadBalancerClass, 0, len(loadBalancerClasses))
+	for _, loadBalancerClass := range loadBalancerClasses {
+		out = append(out, apisopenstack.LoadBalancerClass{
+			Name:              loadBalancerClass.Name,
+			FloatingSubnetID:  loadBalancerClass.FloatingSubnetID,
+			FloatingNetworkID: loadBalancerClass.FloatingNetworkID,
+			SubnetID:          loadBalancerClass.SubnetID,
+		})
+	}
+	return out
+}
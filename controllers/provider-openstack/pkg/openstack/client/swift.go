@@ -16,8 +16,11 @@ package client
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal"
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack"
 	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
@@ -39,6 +42,16 @@ func NewStorageClientFromSecretRef(ctx context.Context, c client.Client, secretR
 	return newStorageClientFromCredentials(credentials, region)
 }
 
+// NewObjectStoreFromSecretRef creates a new object store for the swift containers accessible with the credentials
+// from the secret reference in the region <region>.
+func NewObjectStoreFromSecretRef(ctx context.Context, c client.Client, secretRef corev1.SecretReference, region string) (objectstore.ObjectStore, error) {
+	storageClient, err := NewStorageClientFromSecretRef(ctx, c, secretRef, region)
+	if err != nil {
+		return nil, err
+	}
+	return NewObjectStore(storageClient), nil
+}
+
 // newStorageClientFromCredentials create the storage client from credentials.
 func newStorageClientFromCredentials(credentials *internal.Credentials, region string) (*StorageClient, error) {
 	opts := &clientconfig.ClientOpts{
@@ -106,14 +119,58 @@ func (s *StorageClient) DeleteObjectsWithPrefix(ctx context.Context, container,
 	})
 }
 
+// ListObjectsWithPrefix returns the names of the objects with the specific <prefix> in <container> together with
+// their last modification time.
+func (s *StorageClient) ListObjectsWithPrefix(ctx context.Context, container, prefix string) (map[string]time.Time, error) {
+	opts := &objects.ListOpts{
+		Full:   true,
+		Prefix: prefix,
+	}
+
+	result := make(map[string]time.Time)
+	if err := objects.List(s.client, container, opts).EachPage(func(page pagination.Page) (bool, error) {
+		objectList, err := objects.ExtractInfo(page)
+		if err != nil {
+			return false, err
+		}
+		for _, object := range objectList {
+			result[object.Name] = object.LastModified
+		}
+		return true, nil
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetObject returns the contents of the object with name <key> in <container>.
+func (s *StorageClient) GetObject(ctx context.Context, container, key string) (io.ReadCloser, error) {
+	result := objects.Download(s.client, container, key, nil)
+	if result.Err != nil {
+		return nil, result.Err
+	}
+	return result.Body, nil
+}
+
+// PutObject stores the contents of <body> as the object with name <key> in <container>.
+func (s *StorageClient) PutObject(ctx context.Context, container, key string, body io.ReadSeeker) error {
+	return objects.Create(s.client, container, key, objects.CreateOpts{Content: body}).Err
+}
+
+// DeleteObject deletes the object with name <key> from <container>. If it does not exist, no error is returned.
+func (s *StorageClient) DeleteObject(ctx context.Context, container, key string) error {
+	return s.deleteObjectIfExists(ctx, container, key)
+}
+
 // deleteObjectIfExists deletes the openstack object with name <objectName> from <container>. If it does not exist,
 // no error is returned.
 func (s *StorageClient) deleteObjectIfExists(ctx context.Context, container, objectName string) error {
 	result := objects.Delete(s.client, container, objectName, nil)
 	if _, err := result.Extract(); err != nil {
-		if _, ok := result.Err.(gophercloud.Err404er); ok {
-			return result.Err
+		if _, ok := result.Err.(gophercloud.ErrDefault404); ok {
+			return nil
 		}
+		return err
 	}
 	return nil
 }
@@ -130,6 +187,27 @@ func (s *StorageClient) CreateContainerIfNotExists(ctx context.Context, containe
 	return nil
 }
 
+// UpdateContainerVersioning enables or disables the object versioning of the openstack blob container with name
+// <container>. Previous versions of objects are kept in the archive container returned by VersionsContainerName.
+func (s *StorageClient) UpdateContainerVersioning(ctx context.Context, container string, enabled bool) error {
+	opts := containers.UpdateOpts{RemoveHistoryLocation: "true"}
+	if enabled {
+		versionsContainer := VersionsContainerName(container)
+		if err := s.CreateContainerIfNotExists(ctx, versionsContainer); err != nil {
+			return err
+		}
+		opts = containers.UpdateOpts{HistoryLocation: versionsContainer}
+	}
+
+	_, err := containers.Update(s.client, container, opts).Extract()
+	return err
+}
+
+// VersionsContainerName returns the name of the archive container for previous object versions of <container>.
+func VersionsContainerName(container string) string {
+	return container + "-versions"
+}
+
 // DeleteContainerIfExists deletes the openstack blob container with name <container>. If it does not exist,
 // no error is returned.
 func (s *StorageClient) DeleteContainerIfExists(ctx context.Context, container string) error {
@@ -16,6 +16,8 @@ package client
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/gophercloud/gophercloud"
 )
@@ -28,6 +30,11 @@ type StorageClient struct {
 // Storage represents a Openstack swift storage client.
 type Storage interface {
 	DeleteObjectsWithPrefix(ctx context.Context, container, prefix string) error
+	DeleteObject(ctx context.Context, container, key string) error
+	ListObjectsWithPrefix(ctx context.Context, container, prefix string) (map[string]time.Time, error)
+	GetObject(ctx context.Context, container, key string) (io.ReadCloser, error)
+	PutObject(ctx context.Context, container, key string, body io.ReadSeeker) error
 	CreateContainerIfNotExists(ctx context.Context, container string) error
+	UpdateContainerVersioning(ctx context.Context, container string, enabled bool) error
 	DeleteContainerIfExists(ctx context.Context, container string) error
 }
@@ -14,7 +14,11 @@
 
 package openstack
 
-import "path/filepath"
+import (
+	"path/filepath"
+
+	"github.com/gardener/gardener/pkg/utils"
+)
 
 const (
 	// Name is the name of the OpenStack provider.
@@ -28,6 +32,21 @@ const (
 	CloudControllerImageName = "openstack-cloud-controller-manager"
 	// ETCDBackupRestoreImageName is the name of the etcd backup and restore image.
 	ETCDBackupRestoreImageName = "etcd-backup-restore"
+	// ETCDBackupRestoreS3CompatImageName is the name of the etcd backup and restore image for storing backups in an
+	// S3-compatible object storage.
+	ETCDBackupRestoreS3CompatImageName = "etcd-backup-restore-s3compat"
+	// CSIDriverImageName is the name of the OpenStack Cinder CSI driver image.
+	CSIDriverImageName = "csi-driver-openstack"
+	// CSIProvisionerImageName is the name of the CSI provisioner image.
+	CSIProvisionerImageName = "csi-provisioner"
+	// CSIAttacherImageName is the name of the CSI attacher image.
+	CSIAttacherImageName = "csi-attacher"
+	// CSIResizerImageName is the name of the CSI resizer image.
+	CSIResizerImageName = "csi-resizer"
+	// CSINodeDriverRegistrarImageName is the name of the CSI node driver registrar image.
+	CSINodeDriverRegistrarImageName = "csi-node-driver-registrar"
+	// CSILivenessProbeImageName is the name of the CSI liveness probe image.
+	CSILivenessProbeImageName = "csi-liveness-probe"
 
 	// AuthURL is a constant for the key in a cloud provider secret that holds the OpenStack auth url.
 	AuthURL = "authURL"
@@ -61,6 +80,12 @@ const (
 	// BackupSecretName defines the name of the secret containing the credentials which are required to
 	// authenticate against the respective cloud provider (required to store the backups of Shoot clusters).
 	BackupSecretName = "etcd-backup"
+
+	// CSIDriverName is the name of the OpenStack Cinder CSI driver.
+	CSIDriverName = "cinder.csi.openstack.org"
+	// CSIMinimumKubernetesVersion is the minimum Kubernetes version for which the OpenStack Cinder CSI driver is used
+	// instead of the in-tree volume plugin.
+	CSIMinimumKubernetesVersion = "1.19"
 )
 
 var (
@@ -69,3 +94,9 @@ var (
 	// InternalChartsPath is the path to the internal charts
 	InternalChartsPath = filepath.Join(ChartsPath, "internal")
 )
+
+// UseCSI returns true if the OpenStack Cinder CSI driver shall be used instead of the in-tree volume plugin
+// for the given Kubernetes version.
+func UseCSI(kubernetesVersion string) (bool, error) {
+	return utils.CompareVersions(kubernetesVersion, ">=", CSIMinimumKubernetesVersion)
+}
@@ -16,14 +16,18 @@ package controlplanebackup
 
 import (
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/config"
+	confighelper "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/config/helper"
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/imagevector"
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack"
 	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane/genericmutator"
 
+	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
 )
@@ -37,6 +41,9 @@ var (
 type AddOptions struct {
 	// ETCDBackup is the etcd backup configuration.
 	ETCDBackup config.ETCDBackup
+	// BackupStorage is the configuration of the S3-compatible object storage the backups are stored in. If its endpoint
+	// is empty, Swift is used.
+	BackupStorage config.BackupStorage
 }
 
 var logger = log.Log.WithName("openstack-controlplanebackup-webhook")
@@ -44,11 +51,14 @@ var logger = log.Log.WithName("openstack-controlplanebackup-webhook")
 // AddToManagerWithOptions creates a webhook with the given options and adds it to the manager.
 func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) (*extensionswebhook.Webhook, error) {
 	logger.Info("Adding webhook to manager")
+	if errs := controlplane.ValidateBackupRestoreConfig(confighelper.GetBackupRestoreConfig(&opts.ETCDBackup), field.NewPath("etcd", "backup")); len(errs) > 0 {
+		return nil, errors.Wrap(errs.ToAggregate(), "invalid etcd backup configuration")
+	}
 	return controlplane.Add(mgr, controlplane.AddArgs{
 		Kind:     controlplane.KindBackup,
 		Provider: openstack.Type,
 		Types:    []runtime.Object{&appsv1.StatefulSet{}},
-		Mutator:  genericmutator.NewMutator(NewEnsurer(&opts.ETCDBackup, imagevector.ImageVector(), logger), nil, nil, nil, logger),
+		Mutator:  genericmutator.NewMutator(NewEnsurer(&opts.ETCDBackup, &opts.BackupStorage, imagevector.ImageVector(), serializer.NewCodecFactory(mgr.GetScheme()).UniversalDecoder(), logger), nil, nil, nil, logger),
 	})
 }
 
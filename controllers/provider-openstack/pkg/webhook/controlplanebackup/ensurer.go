@@ -18,8 +18,13 @@ import (
 	"context"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/config"
+	confighelper "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/config/helper"
+	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+	apisopenstackhelper "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack/helper"
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupentry"
+	"github.com/gardener/gardener-extensions/pkg/objectstore/s3compat"
 	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane/genericmutator"
@@ -31,24 +36,29 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // NewEnsurer creates a new controlplaneexposure ensurer.
-func NewEnsurer(etcdBackup *config.ETCDBackup, imageVector imagevector.ImageVector, logger logr.Logger) genericmutator.Ensurer {
+func NewEnsurer(etcdBackup *config.ETCDBackup, backupStorage *config.BackupStorage, imageVector imagevector.ImageVector, decoder runtime.Decoder, logger logr.Logger) genericmutator.Ensurer {
 	return &ensurer{
-		etcdBackup:  etcdBackup,
-		imageVector: imageVector,
-		logger:      logger.WithName("openstack-controlplanebackup-ensurer"),
+		etcdBackup:    etcdBackup,
+		backupStorage: backupStorage,
+		imageVector:   imageVector,
+		decoder:       decoder,
+		logger:        logger.WithName("openstack-controlplanebackup-ensurer"),
 	}
 }
 
 type ensurer struct {
 	genericmutator.NoopEnsurer
-	etcdBackup  *config.ETCDBackup
-	imageVector imagevector.ImageVector
-	client      client.Client
-	logger      logr.Logger
+	etcdBackup    *config.ETCDBackup
+	backupStorage *config.BackupStorage
+	imageVector   imagevector.ImageVector
+	decoder       runtime.Decoder
+	client        client.Client
+	logger        logr.Logger
 }
 
 // InjectClient injects the given client into the ensurer.
@@ -59,15 +69,18 @@ func (e *ensurer) InjectClient(client client.Client) error {
 
 // EnsureETCDStatefulSet ensures that the etcd stateful sets conform to the provider requirements.
 func (e *ensurer) EnsureETCDStatefulSet(ctx context.Context, ss *appsv1.StatefulSet, cluster *extensionscontroller.Cluster) error {
-	if err := e.ensureContainers(&ss.Spec.Template.Spec, ss.Name, cluster); err != nil {
+	if err := e.ensureContainers(ctx, &ss.Spec.Template.Spec, ss.Namespace, ss.Name, cluster); err != nil {
 		return err
 	}
-	return e.ensureChecksumAnnotations(ctx, &ss.Spec.Template, ss.Namespace, ss.Name, !extensionscontroller.IsSeedBackupNil(cluster))
+
+	backupConfigured := !extensionscontroller.IsSeedBackupNil(cluster)
+	e.ensureVolumes(&ss.Spec.Template.Spec, ss.Name, backupConfigured)
+	return e.ensureChecksumAnnotations(ctx, &ss.Spec.Template, ss.Namespace, ss.Name, backupConfigured)
 }
 
-func (e *ensurer) ensureContainers(ps *corev1.PodSpec, name string, cluster *extensionscontroller.Cluster) error {
+func (e *ensurer) ensureContainers(ctx context.Context, ps *corev1.PodSpec, namespace, name string, cluster *extensionscontroller.Cluster) error {
 	backupRestoreContainer := extensionswebhook.ContainerWithName(ps.Containers, controlplane.BackupRestoreContainerName)
-	c, err := e.ensureBackupRestoreContainer(backupRestoreContainer, name, cluster)
+	c, err := e.ensureBackupRestoreContainer(ctx, backupRestoreContainer, namespace, name, cluster)
 	if err != nil {
 		return err
 	}
@@ -82,91 +95,51 @@ func (e *ensurer) ensureChecksumAnnotations(ctx context.Context, template *corev
 	return nil
 }
 
-func (e *ensurer) ensureBackupRestoreContainer(existingContainer *corev1.Container, name string, cluster *extensionscontroller.Cluster) (*corev1.Container, error) {
-	// Find etcd-backup-restore image
-	// TODO Get seed version from clientset when it's possible to inject it
-	image, err := e.imageVector.FindImage(openstack.ETCDBackupRestoreImageName, imagevector.TargetVersion(extensionscontroller.GetKubernetesVersion(cluster)))
-	if err != nil {
-		return nil, errors.Wrapf(err, "could not find image %s", openstack.ETCDBackupRestoreImageName)
-	}
-
+func (e *ensurer) ensureBackupRestoreContainer(ctx context.Context, existingContainer *corev1.Container, namespace, name string, cluster *extensionscontroller.Cluster) (*corev1.Container, error) {
 	// Determine provider and container env variables
 	// They are only specified for the etcd-main stateful set (backup is enabled)
 	var (
+		err                     error
 		provider                string
 		prefix                  string
 		env                     []corev1.EnvVar
+		volumeMounts            []corev1.VolumeMount
 		volumeClaimTemplateName = name
 	)
 	if name == v1alpha1constants.StatefulSetNameETCDMain {
 		if extensionscontroller.IsSeedBackupNil(cluster) {
 			e.logger.Info("Backup profile is not configured; backup will not be taken for etcd-main")
 		} else {
-			prefix = common.GenerateBackupEntryName(extensionscontroller.GetTechnicalID(cluster), extensionscontroller.GetUID(cluster))
-
-			provider = openstack.StorageProviderName
-			env = []corev1.EnvVar{
-				{
-					Name: "STORAGE_CONTAINER",
-					// The bucket name is written to the backup secret by Gardener as a temporary solution.
-					// TODO In the future, the bucket name should come from a BackupBucket resource (see https://github.com/gardener/gardener/blob/master/docs/proposals/02-backupinfra.md)
-					ValueFrom: &corev1.EnvVarSource{
-						SecretKeyRef: &corev1.SecretKeySelector{
-							Key:                  openstack.BucketName,
-							LocalObjectReference: corev1.LocalObjectReference{Name: openstack.BackupSecretName},
-						},
-					},
-				},
-				{
-					Name: "OS_AUTH_URL",
-					ValueFrom: &corev1.EnvVarSource{
-						SecretKeyRef: &corev1.SecretKeySelector{
-							Key:                  openstack.AuthURL,
-							LocalObjectReference: corev1.LocalObjectReference{Name: openstack.BackupSecretName},
-						},
-					},
-				},
-				{
-					Name: "OS_DOMAIN_NAME",
-					ValueFrom: &corev1.EnvVarSource{
-						SecretKeyRef: &corev1.SecretKeySelector{
-							Key:                  openstack.DomainName,
-							LocalObjectReference: corev1.LocalObjectReference{Name: openstack.BackupSecretName},
-						},
-					},
-				},
-				{
-					Name: "OS_USERNAME",
-					ValueFrom: &corev1.EnvVarSource{
-						SecretKeyRef: &corev1.SecretKeySelector{
-							Key:                  openstack.UserName,
-							LocalObjectReference: corev1.LocalObjectReference{Name: openstack.BackupSecretName},
-						},
-					},
-				},
-				{
-					Name: "OS_PASSWORD",
-					ValueFrom: &corev1.EnvVarSource{
-						SecretKeyRef: &corev1.SecretKeySelector{
-							Key:                  openstack.Password,
-							LocalObjectReference: corev1.LocalObjectReference{Name: openstack.BackupSecretName},
-						},
-					},
-				},
-				{
-					Name: "OS_TENANT_NAME",
-					ValueFrom: &corev1.EnvVarSource{
-						SecretKeyRef: &corev1.SecretKeySelector{
-							Key:                  openstack.TenantName,
-							LocalObjectReference: corev1.LocalObjectReference{Name: openstack.BackupSecretName},
-						},
-					},
-				},
+			backupEntryName := common.GenerateBackupEntryName(extensionscontroller.GetTechnicalID(cluster), extensionscontroller.GetUID(cluster))
+			if prefix, err = backupentry.GetStorePrefixByName(ctx, e.client, backupEntryName); err != nil {
+				prefix = controlplane.GetStorePrefixFromContainer(existingContainer, name, backupEntryName)
+				e.logger.Error(err, "Could not determine the store prefix of the etcd backups, keeping the current one", "prefix", prefix)
+			}
+
+			if e.isS3CompatBackupStorage() {
+				provider = s3compat.StorageProviderName
+				env = s3compat.GetBackupRestoreEnv(openstack.BackupSecretName)
+				volumeMounts = s3compat.GetBackupRestoreVolumeMounts(openstack.BackupSecretName)
+			} else {
+				provider = openstack.StorageProviderName
+				env = getSwiftEnv()
 			}
 		}
 		volumeClaimTemplateName = controlplane.EtcdMainVolumeClaimTemplateName
 	}
 
+	// Find etcd-backup-restore image
+	// Backups in an S3-compatible object storage require a newer version of etcd-backup-restore
+	// TODO Get seed version from clientset when it's possible to inject it
+	imageName := openstack.ETCDBackupRestoreImageName
+	if provider == s3compat.StorageProviderName {
+		imageName = openstack.ETCDBackupRestoreS3CompatImageName
+	}
+	image, err := e.imageVector.FindImage(imageName, imagevector.TargetVersion(extensionscontroller.GetKubernetesVersion(cluster)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not find image %s", imageName)
+	}
+
 	var schedule string
 	if e.etcdBackup != nil && e.etcdBackup.Schedule != nil {
 		schedule = *e.etcdBackup.Schedule
@@ -177,5 +150,99 @@ func (e *ensurer) ensureBackupRestoreContainer(existingContainer *corev1.Contain
 		}
 	}
 
-	return controlplane.GetBackupRestoreContainer(name, volumeClaimTemplateName, schedule, provider, prefix, image.String(), nil, env, nil), nil
+	backupRestoreConfig, err := e.getBackupRestoreConfig(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	c := controlplane.GetBackupRestoreContainer(name, volumeClaimTemplateName, schedule, provider, prefix, image.String(), backupRestoreConfig, nil, env, volumeMounts)
+	if provider == s3compat.StorageProviderName {
+		s3compat.EnsureBackupRestoreFlags(c)
+	}
+	return c, nil
+}
+
+func (e *ensurer) ensureVolumes(ps *corev1.PodSpec, name string, backupConfigured bool) {
+	if name == v1alpha1constants.StatefulSetNameETCDMain && backupConfigured && e.isS3CompatBackupStorage() {
+		ps.Volumes = extensionswebhook.EnsureVolumeWithName(ps.Volumes, s3compat.GetBackupRestoreVolume(openstack.BackupSecretName))
+	}
+}
+
+// isS3CompatBackupStorage returns true if etcd backups are stored in an S3-compatible object storage instead of Swift.
+func (e *ensurer) isS3CompatBackupStorage() bool {
+	return e.backupStorage != nil && confighelper.IsS3CompatBackupStorage(*e.backupStorage)
+}
+
+// getSwiftEnv returns the env variables of the backup-restore container for storing backups in Swift.
+func getSwiftEnv() []corev1.EnvVar {
+	return []corev1.EnvVar{
+		{
+			Name: "STORAGE_CONTAINER",
+			// The bucket name is written to the backup secret by Gardener as a temporary solution.
+			// TODO In the future, the bucket name should come from a BackupBucket resource (see https://github.com/gardener/gardener/blob/master/docs/proposals/02-backupinfra.md)
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					Key:                  openstack.BucketName,
+					LocalObjectReference: corev1.LocalObjectReference{Name: openstack.BackupSecretName},
+				},
+			},
+		},
+		{
+			Name: "OS_AUTH_URL",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					Key:                  openstack.AuthURL,
+					LocalObjectReference: corev1.LocalObjectReference{Name: openstack.BackupSecretName},
+				},
+			},
+		},
+		{
+			Name: "OS_DOMAIN_NAME",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					Key:                  openstack.DomainName,
+					LocalObjectReference: corev1.LocalObjectReference{Name: openstack.BackupSecretName},
+				},
+			},
+		},
+		{
+			Name: "OS_USERNAME",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					Key:                  openstack.UserName,
+					LocalObjectReference: corev1.LocalObjectReference{Name: openstack.BackupSecretName},
+				},
+			},
+		},
+		{
+			Name: "OS_PASSWORD",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					Key:                  openstack.Password,
+					LocalObjectReference: corev1.LocalObjectReference{Name: openstack.BackupSecretName},
+				},
+			},
+		},
+		{
+			Name: "OS_TENANT_NAME",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					Key:                  openstack.TenantName,
+					LocalObjectReference: corev1.LocalObjectReference{Name: openstack.BackupSecretName},
+				},
+			},
+		},
+	}
+}
+
+// getBackupRestoreConfig returns the backup-restore configuration for the shoot in the given namespace. Settings in the
+// ControlPlaneConfig of the shoot take precedence over the etcd backup configuration of the controller.
+func (e *ensurer) getBackupRestoreConfig(ctx context.Context, namespace string) (*controlplane.BackupRestoreConfig, error) {
+	return controlplane.GetBackupRestoreConfig(ctx, e.client, namespace, openstack.Type, confighelper.GetBackupRestoreConfig(e.etcdBackup), func(providerConfig []byte) (*controlplane.BackupRestoreConfig, error) {
+		cpConfig := &apisopenstack.ControlPlaneConfig{}
+		if _, _, err := e.decoder.Decode(providerConfig, nil, cpConfig); err != nil {
+			return nil, err
+		}
+		return apisopenstackhelper.GetBackupRestoreConfig(cpConfig.ETCDBackup), nil
+	})
 }
@@ -16,26 +16,33 @@ package controlplanebackup
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/config"
+	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
 	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+	"github.com/gardener/gardener-extensions/pkg/objectstore/s3compat"
 	"github.com/gardener/gardener-extensions/pkg/util"
 	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
@@ -55,16 +62,27 @@ var _ = Describe("Ensurer", func() {
 		var (
 			ctrl *gomock.Controller
 
+			scheme  = runtime.NewScheme()
+			_       = apisopenstack.AddToScheme(scheme)
+			decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
+
 			etcdBackup = &config.ETCDBackup{
 				Schedule: util.StringPtr("0 */24 * * *"),
 			}
 
+			backupStorage = &config.BackupStorage{}
+
 			imageVector = imagevector.ImageVector{
 				{
 					Name:       openstack.ETCDBackupRestoreImageName,
 					Repository: "test-repository",
 					Tag:        util.StringPtr("test-tag"),
 				},
+				{
+					Name:       openstack.ETCDBackupRestoreS3CompatImageName,
+					Repository: "test-repository",
+					Tag:        util.StringPtr("test-s3compat-tag"),
+				},
 			}
 
 			cluster = &extensionscontroller.Cluster{
@@ -86,8 +104,9 @@ var _ = Describe("Ensurer", func() {
 				},
 			}
 
-			secretKey = client.ObjectKey{Namespace: namespace, Name: openstack.BackupSecretName}
-			secret    = &corev1.Secret{
+			secretKey      = client.ObjectKey{Namespace: namespace, Name: openstack.BackupSecretName}
+			backupEntryKey = client.ObjectKey{Name: "shoot--test--sample--test-uid"}
+			secret         = &corev1.Secret{
 				ObjectMeta: metav1.ObjectMeta{Name: openstack.BackupSecretName, Namespace: namespace},
 				Data:       map[string][]byte{"foo": []byte("bar")},
 			}
@@ -114,10 +133,12 @@ var _ = Describe("Ensurer", func() {
 
 			// Create mock client
 			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), &extensionsv1alpha1.ControlPlaneList{}, gomock.Any()).AnyTimes()
 			client.EXPECT().Get(context.TODO(), secretKey, &corev1.Secret{}).DoAndReturn(clientGet(secret))
+			client.EXPECT().Get(context.TODO(), backupEntryKey, &extensionsv1alpha1.BackupEntry{}).Return(apierrors.NewNotFound(extensionsv1alpha1.Resource("backupentries"), backupEntryKey.Name))
 
 			// Create ensurer
-			ensurer := NewEnsurer(etcdBackup, imageVector, logger)
+			ensurer := NewEnsurer(etcdBackup, backupStorage, imageVector, decoder, logger)
 			err := ensurer.(inject.Client).InjectClient(client)
 			Expect(err).To(Not(HaveOccurred()))
 
@@ -147,10 +168,12 @@ var _ = Describe("Ensurer", func() {
 
 			// Create mock client
 			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), &extensionsv1alpha1.ControlPlaneList{}, gomock.Any()).AnyTimes()
 			client.EXPECT().Get(context.TODO(), secretKey, &corev1.Secret{}).DoAndReturn(clientGet(secret))
+			client.EXPECT().Get(context.TODO(), backupEntryKey, &extensionsv1alpha1.BackupEntry{}).Return(apierrors.NewNotFound(extensionsv1alpha1.Resource("backupentries"), backupEntryKey.Name))
 
 			// Create ensurer
-			ensurer := NewEnsurer(etcdBackup, imageVector, logger)
+			ensurer := NewEnsurer(etcdBackup, backupStorage, imageVector, decoder, logger)
 			err := ensurer.(inject.Client).InjectClient(client)
 			Expect(err).To(Not(HaveOccurred()))
 
@@ -160,6 +183,30 @@ var _ = Describe("Ensurer", func() {
 			checkETCDMainStatefulSet(ss, annotations)
 		})
 
+		It("should configure backup to an S3-compatible object storage for etcd-main statefulset", func() {
+			var (
+				ss = &appsv1.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: v1alpha1constants.StatefulSetNameETCDMain},
+				}
+			)
+
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), &extensionsv1alpha1.ControlPlaneList{}, gomock.Any()).AnyTimes()
+			client.EXPECT().Get(context.TODO(), secretKey, &corev1.Secret{}).DoAndReturn(clientGet(secret))
+			client.EXPECT().Get(context.TODO(), backupEntryKey, &extensionsv1alpha1.BackupEntry{}).Return(apierrors.NewNotFound(extensionsv1alpha1.Resource("backupentries"), backupEntryKey.Name))
+
+			// Create ensurer
+			ensurer := NewEnsurer(etcdBackup, &config.BackupStorage{Endpoint: "https://s3.example.com"}, imageVector, decoder, logger)
+			err := ensurer.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
+
+			// Call EnsureETCDStatefulSet method and check the result
+			err = ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
+			Expect(err).To(Not(HaveOccurred()))
+			checkETCDMainStatefulSetWithS3CompatBackupStorage(ss, annotations)
+		})
+
 		It("should not configure backup to etcd-main statefulset if backup profile is missing", func() {
 			ss := &appsv1.StatefulSet{
 				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: v1alpha1constants.StatefulSetNameETCDMain},
@@ -168,9 +215,10 @@ var _ = Describe("Ensurer", func() {
 
 			// Create mock client
 			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), &extensionsv1alpha1.ControlPlaneList{}, gomock.Any()).AnyTimes()
 
 			// Create ensurer
-			ensurer := NewEnsurer(etcdBackup, imageVector, logger)
+			ensurer := NewEnsurer(etcdBackup, backupStorage, imageVector, decoder, logger)
 			err := ensurer.(inject.Client).InjectClient(client)
 			Expect(err).To(Not(HaveOccurred()))
 
@@ -189,10 +237,11 @@ var _ = Describe("Ensurer", func() {
 
 			// Create mock client
 			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), &extensionsv1alpha1.ControlPlaneList{}, gomock.Any()).AnyTimes()
 			//client.EXPECT().Get(context.TODO(), secretKey, &corev1.Secret{}).DoAndReturn(clientGet(secret))
 
 			// Create ensurer
-			ensurer := NewEnsurer(etcdBackup, imageVector, logger)
+			ensurer := NewEnsurer(etcdBackup, backupStorage, imageVector, decoder, logger)
 			err := ensurer.(inject.Client).InjectClient(client)
 			Expect(err).To(Not(HaveOccurred()))
 
@@ -215,11 +264,17 @@ var _ = Describe("Ensurer", func() {
 				}
 			)
 
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), &extensionsv1alpha1.ControlPlaneList{}, gomock.Any()).AnyTimes()
+
 			// Create ensurer
-			ensurer := NewEnsurer(etcdBackup, imageVector, logger)
+			ensurer := NewEnsurer(etcdBackup, backupStorage, imageVector, decoder, logger)
+			err := ensurer.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
 
 			// Call EnsureETCDStatefulSet method and check the result
-			err := ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
+			err = ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
 			Expect(err).To(Not(HaveOccurred()))
 			checkETCDEventsStatefulSet(ss)
 		})
@@ -242,14 +297,73 @@ var _ = Describe("Ensurer", func() {
 				}
 			)
 
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), &extensionsv1alpha1.ControlPlaneList{}, gomock.Any()).AnyTimes()
+
 			// Create ensurer
-			ensurer := NewEnsurer(etcdBackup, imageVector, logger)
+			ensurer := NewEnsurer(etcdBackup, backupStorage, imageVector, decoder, logger)
+			err := ensurer.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
 
 			// Call EnsureETCDStatefulSet method and check the result
-			err := ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
+			err = ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
 			Expect(err).To(Not(HaveOccurred()))
 			checkETCDEventsStatefulSet(ss)
 		})
+
+		It("should apply the backup-restore configuration of the controller and the shoot", func() {
+			var (
+				ss = &appsv1.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: v1alpha1constants.StatefulSetNameETCDEvents},
+				}
+				limitBased = controlplane.GarbageCollectionPolicyLimitBased
+				maxBackups = int32(3)
+				cp         = extensionsv1alpha1.ControlPlane{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "control-plane"},
+					Spec: extensionsv1alpha1.ControlPlaneSpec{
+						DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: openstack.Type},
+						ProviderConfig: &runtime.RawExtension{
+							Raw: encode(&apisopenstack.ControlPlaneConfig{
+								ETCDBackup: &apisopenstack.ETCDBackupConfig{
+									GarbageCollectionPeriod: &metav1.Duration{Duration: time.Hour},
+									GarbageCollectionPolicy: &limitBased,
+									MaxBackups:              &maxBackups,
+								},
+							}),
+						},
+					},
+				}
+				etcdBackup = &config.ETCDBackup{
+					Schedule:                util.StringPtr("0 */24 * * *"),
+					DeltaSnapshotPeriod:     &metav1.Duration{Duration: time.Minute},
+					GarbageCollectionPeriod: &metav1.Duration{Duration: 2 * time.Hour},
+				}
+			)
+
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().List(context.TODO(), &extensionsv1alpha1.ControlPlaneList{}, gomock.Any()).
+				DoAndReturn(func(_ context.Context, list *extensionsv1alpha1.ControlPlaneList, _ ...interface{}) error {
+					list.Items = []extensionsv1alpha1.ControlPlane{cp}
+					return nil
+				})
+
+			// Create ensurer
+			ensurer := NewEnsurer(etcdBackup, backupStorage, imageVector, decoder, logger)
+			err := ensurer.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
+
+			// Call EnsureETCDStatefulSet method and check the result
+			err = ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
+			Expect(err).To(Not(HaveOccurred()))
+			c := extensionswebhook.ContainerWithName(ss.Spec.Template.Spec.Containers, "backup-restore")
+			Expect(c).NotTo(BeNil())
+			Expect(c.Command).To(ContainElement("--delta-snapshot-period-seconds=60"))
+			Expect(c.Command).To(ContainElement("--garbage-collection-period-seconds=3600"))
+			Expect(c.Command).To(ContainElement("--garbage-collection-policy=LimitBased"))
+			Expect(c.Command).To(ContainElement("--max-backups=3"))
+		})
 	})
 })
 
@@ -315,21 +429,66 @@ func checkETCDMainStatefulSet(ss *appsv1.StatefulSet, annotations map[string]str
 
 	c := extensionswebhook.ContainerWithName(ss.Spec.Template.Spec.Containers, "backup-restore")
 	Expect(c).To(Equal(controlplane.GetBackupRestoreContainer(v1alpha1constants.StatefulSetNameETCDMain, controlplane.EtcdMainVolumeClaimTemplateName, "0 */24 * * *", openstack.StorageProviderName, "shoot--test--sample--test-uid",
-		"test-repository:test-tag", nil, env, nil)))
+		"test-repository:test-tag", nil, nil, env, nil)))
 	Expect(ss.Spec.Template.Annotations).To(Equal(annotations))
+	Expect(ss.Spec.Template.Spec.Volumes).To(BeEmpty())
+}
+
+func checkETCDMainStatefulSetWithS3CompatBackupStorage(ss *appsv1.StatefulSet, annotations map[string]string) {
+	var (
+		env = []corev1.EnvVar{
+			{
+				Name: "STORAGE_CONTAINER",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						Key:                  openstack.BucketName,
+						LocalObjectReference: corev1.LocalObjectReference{Name: openstack.BackupSecretName},
+					},
+				},
+			},
+			{
+				Name:  "AWS_APPLICATION_CREDENTIALS",
+				Value: "/root/.s3",
+			},
+		}
+		volumeMounts = []corev1.VolumeMount{
+			{
+				Name:      openstack.BackupSecretName,
+				MountPath: "/root/.s3",
+			},
+		}
+		etcdBackupSecretVolume = corev1.Volume{
+			Name: openstack.BackupSecretName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: openstack.BackupSecretName,
+				},
+			},
+		}
+	)
+
+	expected := controlplane.GetBackupRestoreContainer(v1alpha1constants.StatefulSetNameETCDMain, controlplane.EtcdMainVolumeClaimTemplateName, "0 */24 * * *", s3compat.StorageProviderName, "shoot--test--sample--test-uid",
+		"test-repository:test-s3compat-tag", nil, nil, env, volumeMounts)
+	s3compat.EnsureBackupRestoreFlags(expected)
+
+	c := extensionswebhook.ContainerWithName(ss.Spec.Template.Spec.Containers, "backup-restore")
+	Expect(c).To(Equal(expected))
+	Expect(c.Command).To(ContainElement("--etcd-connection-timeout=5m0s"))
+	Expect(ss.Spec.Template.Annotations).To(Equal(annotations))
+	Expect(ss.Spec.Template.Spec.Volumes).To(ContainElement(etcdBackupSecretVolume))
 }
 
 func checkETCDMainStatefulSetWithoutBackup(ss *appsv1.StatefulSet, annotations map[string]string) {
 	c := extensionswebhook.ContainerWithName(ss.Spec.Template.Spec.Containers, "backup-restore")
 	Expect(c).To(Equal(controlplane.GetBackupRestoreContainer(v1alpha1constants.StatefulSetNameETCDMain, controlplane.EtcdMainVolumeClaimTemplateName, "0 */24 * * *", "", "",
-		"test-repository:test-tag", nil, nil, nil)))
+		"test-repository:test-tag", nil, nil, nil, nil)))
 	Expect(ss.Spec.Template.Annotations).To(BeNil())
 }
 
 func checkETCDEventsStatefulSet(ss *appsv1.StatefulSet) {
 	c := extensionswebhook.ContainerWithName(ss.Spec.Template.Spec.Containers, "backup-restore")
 	Expect(c).To(Equal(controlplane.GetBackupRestoreContainer(v1alpha1constants.StatefulSetNameETCDEvents, v1alpha1constants.StatefulSetNameETCDEvents, "0 */24 * * *", "", "",
-		"test-repository:test-tag", nil, nil, nil)))
+		"test-repository:test-tag", nil, nil, nil, nil)))
 }
 
 func clientGet(result runtime.Object) interface{} {
@@ -341,3 +500,8 @@ func clientGet(result runtime.Object) interface{} {
 		return nil
 	}
 }
+
+func encode(obj runtime.Object) []byte {
+	data, _ := json.Marshal(obj)
+	return data
+}
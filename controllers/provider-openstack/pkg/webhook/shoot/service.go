@@ -0,0 +1,148 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/internal"
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack"
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/util"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// AnnotationLoadBalancerClass is the annotation a service of type LoadBalancer uses to select one of the
+	// load balancer classes of the shoot.
+	AnnotationLoadBalancerClass = "loadbalancer.openstack.org/class"
+
+	annotationFloatingNetworkID    = "loadbalancer.openstack.org/floating-network-id"
+	annotationFloatingSubnetID     = "loadbalancer.openstack.org/floating-subnet-id"
+	annotationSubnetID             = "loadbalancer.openstack.org/subnet-id"
+	annotationInternalLoadBalancer = "service.beta.kubernetes.io/openstack-internal-load-balancer"
+)
+
+// mutateLoadBalancerService sets the network annotations of the load balancer class selected by the given service.
+func (m *mutator) mutateLoadBalancerService(ctx context.Context, service *corev1.Service, shootNamespace string) error {
+	className := service.Annotations[AnnotationLoadBalancerClass]
+
+	classes, floatingPoolID, err := m.getLoadBalancerClasses(ctx, shootNamespace)
+	if err != nil {
+		return err
+	}
+
+	var (
+		class *apisopenstack.LoadBalancerClass
+		names = make([]string, 0, len(classes))
+	)
+	for i := range classes {
+		if classes[i].Name == className {
+			class = &classes[i]
+		}
+		names = append(names, classes[i].Name)
+	}
+	if class == nil {
+		return fmt.Errorf("load balancer class %q is not available, available classes are [%s]", className, strings.Join(names, ", "))
+	}
+
+	setLoadBalancerClassAnnotations(service, class, floatingPoolID)
+	return nil
+}
+
+// setLoadBalancerClassAnnotations replaces the network annotations of the given service with the ones of the given class.
+// Classes without floating network or subnet result in internal load balancers.
+func setLoadBalancerClassAnnotations(service *corev1.Service, class *apisopenstack.LoadBalancerClass, floatingPoolID string) {
+	for _, key := range []string{annotationFloatingNetworkID, annotationFloatingSubnetID, annotationSubnetID, annotationInternalLoadBalancer} {
+		delete(service.Annotations, key)
+	}
+
+	floatingNetworkID := class.FloatingNetworkID
+	if floatingNetworkID == nil && class.FloatingSubnetID != nil {
+		floatingNetworkID = &floatingPoolID
+	}
+
+	if floatingNetworkID == nil {
+		service.Annotations[annotationInternalLoadBalancer] = "true"
+	} else {
+		service.Annotations[annotationFloatingNetworkID] = *floatingNetworkID
+	}
+	if class.FloatingSubnetID != nil {
+		service.Annotations[annotationFloatingSubnetID] = *class.FloatingSubnetID
+	}
+	if class.SubnetID != nil {
+		service.Annotations[annotationSubnetID] = *class.SubnetID
+	}
+}
+
+// getLoadBalancerClasses returns the load balancer classes of the shoot in the given namespace
+// and the ID of its floating pool network.
+func (m *mutator) getLoadBalancerClasses(ctx context.Context, namespace string) ([]apisopenstack.LoadBalancerClass, string, error) {
+	cp, err := m.getControlPlane(ctx, namespace)
+	if err != nil {
+		return nil, "", err
+	}
+
+	cluster, err := extensionscontroller.GetCluster(ctx, m.client, namespace)
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "could not get cluster for namespace '%s'", namespace)
+	}
+
+	cpConfig := &apisopenstack.ControlPlaneConfig{}
+	if cp.Spec.ProviderConfig != nil {
+		if _, _, err := m.decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, cpConfig); err != nil {
+			return nil, "", errors.Wrapf(err, "could not decode providerConfig of controlplane '%s'", util.ObjectName(cp))
+		}
+	}
+
+	infraStatus := &apisopenstack.InfrastructureStatus{}
+	if cp.Spec.InfrastructureProviderStatus != nil {
+		if _, _, err := m.decoder.Decode(cp.Spec.InfrastructureProviderStatus.Raw, nil, infraStatus); err != nil {
+			return nil, "", errors.Wrapf(err, "could not decode infrastructureProviderStatus of controlplane '%s'", util.ObjectName(cp))
+		}
+	}
+
+	var cloudProfileConfig *apisopenstack.CloudProfileConfig
+	if cluster.CoreCloudProfile != nil && cluster.CoreCloudProfile.Spec.ProviderConfig != nil && cluster.CoreCloudProfile.Spec.ProviderConfig.Raw != nil {
+		cloudProfileConfig = &apisopenstack.CloudProfileConfig{}
+		if _, _, err := m.decoder.Decode(cluster.CoreCloudProfile.Spec.ProviderConfig.Raw, nil, cloudProfileConfig); err != nil {
+			return nil, "", errors.Wrapf(err, "could not decode providerConfig of cloudProfile for '%s'", util.ObjectName(cp))
+		}
+	}
+
+	return internal.GetLoadBalancerClasses(cpConfig, infraStatus, cloudProfileConfig, cluster), infraStatus.Networks.FloatingPool.ID, nil
+}
+
+// getControlPlane returns the OpenStack ControlPlane of the shoot in the given namespace.
+func (m *mutator) getControlPlane(ctx context.Context, namespace string) (*extensionsv1alpha1.ControlPlane, error) {
+	cpList := &extensionsv1alpha1.ControlPlaneList{}
+	if err := m.client.List(ctx, cpList, client.InNamespace(namespace)); err != nil {
+		return nil, errors.Wrapf(err, "could not list controlplanes in namespace '%s'", namespace)
+	}
+
+	for i, cp := range cpList.Items {
+		if cp.Spec.Type == openstack.Type && (cp.Spec.Purpose == nil || *cp.Spec.Purpose == extensionsv1alpha1.Normal) {
+			return &cpList.Items[i], nil
+		}
+	}
+	return nil, fmt.Errorf("could not find controlplane in namespace '%s'", namespace)
+}
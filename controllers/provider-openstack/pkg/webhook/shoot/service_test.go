@@ -0,0 +1,160 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+	"encoding/json"
+
+	apisopenstack "github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/apis/openstack/install"
+	"github.com/gardener/gardener-extensions/controllers/provider-openstack/pkg/openstack"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/kubernetes/scheme"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const namespace = "shoot--foo--bar"
+
+var _ = Describe("Mutator", func() {
+	var (
+		ctx = context.TODO()
+
+		floatingPoolID    = "floating-pool-id"
+		floatingNetworkID = "floating-network-id"
+		floatingSubnetID  = "floating-subnet-id"
+		subnetID          = "subnet-id"
+
+		m       *mutator
+		service *corev1.Service
+	)
+
+	BeforeEach(func() {
+		s := runtime.NewScheme()
+		Expect(scheme.AddToScheme(s)).To(Succeed())
+		Expect(extensionsv1alpha1.AddToScheme(s)).To(Succeed())
+		Expect(install.AddToScheme(s)).To(Succeed())
+
+		cp := &extensionsv1alpha1.ControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "control-plane", Namespace: namespace},
+			Spec: extensionsv1alpha1.ControlPlaneSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: openstack.Type},
+				ProviderConfig: &runtime.RawExtension{
+					Raw: encode(&apisopenstack.ControlPlaneConfig{
+						LoadBalancerClasses: []apisopenstack.LoadBalancerClass{
+							{Name: "default", FloatingNetworkID: &floatingNetworkID},
+							{Name: "dedicated", FloatingSubnetID: &floatingSubnetID},
+							{Name: "private", SubnetID: &subnetID},
+						},
+					}),
+				},
+				InfrastructureProviderStatus: &runtime.RawExtension{
+					Raw: encode(&apisopenstack.InfrastructureStatus{
+						Networks: apisopenstack.NetworkStatus{
+							FloatingPool: apisopenstack.FloatingPoolStatus{ID: floatingPoolID},
+						},
+					}),
+				},
+			},
+		}
+		cluster := &extensionsv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: namespace}}
+
+		m = &mutator{decoder: serializer.NewCodecFactory(s).UniversalDecoder()}
+		Expect(m.InjectClient(fakeclient.NewFakeClientWithScheme(s, cp, cluster))).To(Succeed())
+
+		service = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ingress",
+				Namespace: "default",
+				Annotations: map[string]string{
+					annotationInternalLoadBalancer: "true",
+				},
+			},
+			Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+		}
+	})
+
+	Describe("#Handles", func() {
+		It("should only handle load balancer services selecting a load balancer class", func() {
+			Expect(m.Handles(service)).To(BeFalse())
+
+			service.Annotations[AnnotationLoadBalancerClass] = "default"
+			Expect(m.Handles(service)).To(BeTrue())
+
+			service.Spec.Type = corev1.ServiceTypeClusterIP
+			Expect(m.Handles(service)).To(BeFalse())
+		})
+	})
+
+	Describe("#Mutate", func() {
+		It("should not mutate services without load balancer class", func() {
+			expected := service.DeepCopy()
+
+			Expect(m.Mutate(ctx, service, namespace)).To(Succeed())
+			Expect(service).To(Equal(expected))
+		})
+
+		It("should set the floating network of the selected class", func() {
+			service.Annotations[AnnotationLoadBalancerClass] = "default"
+
+			Expect(m.Mutate(ctx, service, namespace)).To(Succeed())
+			Expect(service.Annotations).To(Equal(map[string]string{
+				AnnotationLoadBalancerClass: "default",
+				annotationFloatingNetworkID: floatingNetworkID,
+			}))
+		})
+
+		It("should default the floating network to the floating pool if only a floating subnet is given", func() {
+			service.Annotations[AnnotationLoadBalancerClass] = "dedicated"
+
+			Expect(m.Mutate(ctx, service, namespace)).To(Succeed())
+			Expect(service.Annotations).To(Equal(map[string]string{
+				AnnotationLoadBalancerClass: "dedicated",
+				annotationFloatingNetworkID: floatingPoolID,
+				annotationFloatingSubnetID:  floatingSubnetID,
+			}))
+		})
+
+		It("should create internal load balancers for classes without floating network", func() {
+			service.Annotations[AnnotationLoadBalancerClass] = "private"
+
+			Expect(m.Mutate(ctx, service, namespace)).To(Succeed())
+			Expect(service.Annotations).To(Equal(map[string]string{
+				AnnotationLoadBalancerClass:    "private",
+				annotationInternalLoadBalancer: "true",
+				annotationSubnetID:             subnetID,
+			}))
+		})
+
+		It("should reject unknown load balancer classes", func() {
+			service.Annotations[AnnotationLoadBalancerClass] = "unknown"
+
+			err := m.Mutate(ctx, service, namespace)
+			Expect(err).To(MatchError(`load balancer class "unknown" is not available, available classes are [default, dedicated, private]`))
+		})
+	})
+})
+
+func encode(obj runtime.Object) []byte {
+	data, _ := json.Marshal(obj)
+	return data
+}
@@ -17,6 +17,8 @@ package helper_test
 import (
 	"github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/apis/config"
 	. "github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/apis/config/helper"
+	"github.com/gardener/gardener-extensions/pkg/objectstore/s3compat"
+	"github.com/gardener/gardener-extensions/pkg/util"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -44,6 +46,31 @@ var _ = Describe("Helper", func() {
 		Entry("entry not found (version does not exist)", makeMachineImages("ubuntu", "2"), "ubuntu", "1", ""),
 		Entry("entry", makeMachineImages("ubuntu", "1"), "ubuntu", "1", image),
 	)
+
+	DescribeTable("#GetS3CompatConfig",
+		func(backupStorage config.BackupStorage, expected *s3compat.Config) {
+			Expect(GetS3CompatConfig(backupStorage)).To(Equal(expected))
+		},
+
+		Entry("endpoint only",
+			config.BackupStorage{Endpoint: "https://s3.example.com"},
+			&s3compat.Config{Endpoint: "https://s3.example.com"},
+		),
+		Entry("all settings",
+			config.BackupStorage{
+				Endpoint:       "https://s3.example.com",
+				Region:         util.StringPtr("eu-1"),
+				ForcePathStyle: util.BoolPtr(true),
+				CABundle:       util.StringPtr("ca"),
+			},
+			&s3compat.Config{
+				Endpoint:       "https://s3.example.com",
+				Region:         "eu-1",
+				ForcePathStyle: true,
+				CABundle:       []byte("ca"),
+			},
+		),
+	)
 })
 
 func makeMachineImages(name, version string) []config.MachineImage {
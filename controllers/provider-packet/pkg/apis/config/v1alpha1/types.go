@@ -15,6 +15,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
@@ -35,6 +36,12 @@ type ControllerConfiguration struct {
 	MachineImages []MachineImage `json:"machineImages,omitempty"`
 	// ETCD is the etcd configuration.
 	ETCD ETCD `json:"etcd"`
+	// ControlPlaneComponents is the configuration of the control plane components deployed by the controller.
+	// +optional
+	ControlPlaneComponents ControlPlaneComponents `json:"controlPlaneComponents"`
+	// BackupStorage is the configuration of the S3-compatible object storage used for etcd backups.
+	// +optional
+	BackupStorage BackupStorage `json:"backupStorage"`
 }
 
 // MachineImage is a mapping from logical names and versions to Packet-specific identifiers.
@@ -51,6 +58,8 @@ type MachineImage struct {
 type ETCD struct {
 	// ETCDStorage is the etcd storage configuration.
 	Storage ETCDStorage `json:"storage"`
+	// ETCDBackup is the etcd backup configuration.
+	Backup ETCDBackup `json:"backup"`
 }
 
 // ETCDStorage is an etcd storage configuration.
@@ -62,3 +71,77 @@ type ETCDStorage struct {
 	// +optional
 	Capacity *resource.Quantity `json:"capacity,omitempty"`
 }
+
+// ETCDBackup is an etcd backup configuration.
+type ETCDBackup struct {
+	// Schedule is the etcd backup schedule.
+	// +optional
+	Schedule *string `json:"schedule,omitempty"`
+	// DeltaSnapshotPeriod is the period after which delta snapshots are taken. Defaults to 5m.
+	// +optional
+	DeltaSnapshotPeriod *metav1.Duration `json:"deltaSnapshotPeriod,omitempty"`
+	// DeltaSnapshotMemoryLimit is the size of accumulated events after which a delta snapshot is taken
+	// before the delta snapshot period has passed. Defaults to 100Mi.
+	// +optional
+	DeltaSnapshotMemoryLimit *resource.Quantity `json:"deltaSnapshotMemoryLimit,omitempty"`
+	// GarbageCollectionPeriod is the period after which old snapshots are garbage collected. Defaults to 12h.
+	// +optional
+	GarbageCollectionPeriod *metav1.Duration `json:"garbageCollectionPeriod,omitempty"`
+	// GarbageCollectionPolicy is the policy for garbage collecting old snapshots, either Exponential or LimitBased.
+	// +optional
+	GarbageCollectionPolicy *string `json:"garbageCollectionPolicy,omitempty"`
+	// MaxBackups is the maximum number of full snapshots kept by the LimitBased garbage collection policy.
+	// +optional
+	MaxBackups *int32 `json:"maxBackups,omitempty"`
+	// Resources are the compute resources of the backup-restore sidecar.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// BackupStorage is the configuration of an S3-compatible object storage.
+type BackupStorage struct {
+	// Endpoint is the URL of the S3-compatible endpoint, e.g. https://s3.example.com. If it is empty, etcd backups
+	// are not supported.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+	// Region overrides the region of the backup buckets and entries if it is set. Some S3-compatible object storages
+	// only accept requests signed for a specific region.
+	// +optional
+	Region *string `json:"region,omitempty"`
+	// ForcePathStyle specifies whether path-style addressing (https://s3.example.com/bucket/key) is used instead of
+	// virtual-hosted-style addressing (https://bucket.s3.example.com/key). Defaults to false.
+	// +optional
+	ForcePathStyle *bool `json:"forcePathStyle,omitempty"`
+	// CABundle is a PEM-encoded bundle of CA certificates used to verify the certificate of the endpoint. If it is
+	// not set, the CA certificates of the system are used.
+	// +optional
+	CABundle *string `json:"caBundle,omitempty"`
+}
+
+// ControlPlaneComponents is the configuration of the cloud-controller-manager, the CSI controllers and the
+// machine-controller-manager deployed into the shoot namespaces of the seed.
+type ControlPlaneComponents struct {
+	// VPA is the vertical pod autoscaling configuration of the components.
+	// +optional
+	VPA *VPA `json:"vpa,omitempty"`
+	// PodDisruptionBudget specifies whether PodDisruptionBudgets are deployed for the components. Defaults to false.
+	// +optional
+	PodDisruptionBudget *bool `json:"podDisruptionBudget,omitempty"`
+	// PodAntiAffinity specifies whether the replicas of the components are spread across the seed nodes. Defaults to false.
+	// +optional
+	PodAntiAffinity *bool `json:"podAntiAffinity,omitempty"`
+	// Replicas is the number of replicas of the components while the shoot is awake. The PodDisruptionBudgets and the
+	// pod anti-affinity only take effect with more than one replica. Defaults to 1.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+}
+
+// VPA is a vertical pod autoscaling configuration.
+type VPA struct {
+	// Enabled specifies whether VerticalPodAutoscalers are deployed. Defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// UpdateMode is the update mode of the VerticalPodAutoscalers, one of Off, Initial, Recreate or Auto. Defaults to Auto.
+	// +optional
+	UpdateMode *string `json:"updateMode,omitempty"`
+}
@@ -24,6 +24,8 @@ import (
 	unsafe "unsafe"
 
 	packet "github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/apis/packet"
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -105,6 +107,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*StorageClass)(nil), (*packet.StorageClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageClass_To_packet_StorageClass(a.(*StorageClass), b.(*packet.StorageClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*packet.StorageClass)(nil), (*StorageClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_packet_StorageClass_To_v1alpha1_StorageClass(a.(*packet.StorageClass), b.(*StorageClass), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerStatus)(nil), (*packet.WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_WorkerStatus_To_packet_WorkerStatus(a.(*WorkerStatus), b.(*packet.WorkerStatus), scope)
 	}); err != nil {
@@ -139,6 +151,7 @@ func Convert_packet_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *packet
 }
 
 func autoConvert_v1alpha1_ControlPlaneConfig_To_packet_ControlPlaneConfig(in *ControlPlaneConfig, out *packet.ControlPlaneConfig, s conversion.Scope) error {
+	out.StorageClasses = *(*[]packet.StorageClass)(unsafe.Pointer(&in.StorageClasses))
 	return nil
 }
 
@@ -148,6 +161,7 @@ func Convert_v1alpha1_ControlPlaneConfig_To_packet_ControlPlaneConfig(in *Contro
 }
 
 func autoConvert_packet_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *packet.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
+	out.StorageClasses = *(*[]StorageClass)(unsafe.Pointer(&in.StorageClasses))
 	return nil
 }
 
@@ -262,6 +276,34 @@ func Convert_packet_MachineImages_To_v1alpha1_MachineImages(in *packet.MachineIm
 	return autoConvert_packet_MachineImages_To_v1alpha1_MachineImages(in, out, s)
 }
 
+func autoConvert_v1alpha1_StorageClass_To_packet_StorageClass(in *StorageClass, out *packet.StorageClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	out.ReclaimPolicy = (*v1.PersistentVolumeReclaimPolicy)(unsafe.Pointer(in.ReclaimPolicy))
+	out.VolumeBindingMode = (*storagev1.VolumeBindingMode)(unsafe.Pointer(in.VolumeBindingMode))
+	out.Default = (*bool)(unsafe.Pointer(in.Default))
+	return nil
+}
+
+// Convert_v1alpha1_StorageClass_To_packet_StorageClass is an autogenerated conversion function.
+func Convert_v1alpha1_StorageClass_To_packet_StorageClass(in *StorageClass, out *packet.StorageClass, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageClass_To_packet_StorageClass(in, out, s)
+}
+
+func autoConvert_packet_StorageClass_To_v1alpha1_StorageClass(in *packet.StorageClass, out *StorageClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	out.ReclaimPolicy = (*v1.PersistentVolumeReclaimPolicy)(unsafe.Pointer(in.ReclaimPolicy))
+	out.VolumeBindingMode = (*storagev1.VolumeBindingMode)(unsafe.Pointer(in.VolumeBindingMode))
+	out.Default = (*bool)(unsafe.Pointer(in.Default))
+	return nil
+}
+
+// Convert_packet_StorageClass_To_v1alpha1_StorageClass is an autogenerated conversion function.
+func Convert_packet_StorageClass_To_v1alpha1_StorageClass(in *packet.StorageClass, out *StorageClass, s conversion.Scope) error {
+	return autoConvert_packet_StorageClass_To_v1alpha1_StorageClass(in, out, s)
+}
+
 func autoConvert_v1alpha1_WorkerStatus_To_packet_WorkerStatus(in *WorkerStatus, out *packet.WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]packet.MachineImage)(unsafe.Pointer(&in.MachineImages))
 	return nil
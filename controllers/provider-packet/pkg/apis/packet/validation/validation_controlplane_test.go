@@ -0,0 +1,87 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	apispacket "github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/apis/packet"
+	. "github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/apis/packet/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("ControlPlaneConfig validation", func() {
+	var controlPlaneConfig *apispacket.ControlPlaneConfig
+
+	BeforeEach(func() {
+		controlPlaneConfig = &apispacket.ControlPlaneConfig{}
+	})
+
+	Describe("#ValidateControlPlaneConfig", func() {
+		It("should allow a configuration without storage classes", func() {
+			Expect(ValidateControlPlaneConfig(controlPlaneConfig)).To(BeEmpty())
+		})
+
+		It("should allow valid storage classes", func() {
+			var (
+				retain          = corev1.PersistentVolumeReclaimRetain
+				waitForConsumer = storagev1.VolumeBindingWaitForFirstConsumer
+				isDefault       = true
+			)
+			controlPlaneConfig.StorageClasses = []apispacket.StorageClass{
+				{
+					Name:              "fast",
+					Type:              "performance",
+					ReclaimPolicy:     &retain,
+					VolumeBindingMode: &waitForConsumer,
+					Default:           &isDefault,
+				},
+				{Name: "slow", Type: "standard"},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlaneConfig)).To(BeEmpty())
+		})
+
+		It("should forbid the names of the storage classes deployed by default", func() {
+			controlPlaneConfig.StorageClasses = []apispacket.StorageClass{
+				{Name: "csi-packet-standard", Type: "standard"},
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlaneConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("storageClasses[0].name"),
+			}))))
+		})
+
+		It("should forbid unsupported types", func() {
+			controlPlaneConfig.StorageClasses = []apispacket.StorageClass{
+				{Name: "fast", Type: "foo"},
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlaneConfig)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("storageClasses[0].type"),
+			}))))
+		})
+	})
+})
@@ -18,9 +18,11 @@ import (
 	"fmt"
 
 	"github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/apis/config"
+	confighelper "github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/apis/config/helper"
 	configloader "github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/apis/config/loader"
 
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 // ConfigOptions are command line options that can be set for config.ControllerConfiguration.
@@ -51,6 +53,10 @@ func (c *ConfigOptions) Complete() error {
 		return err
 	}
 
+	if errs := confighelper.ValidateControlPlaneComponents(config.ControlPlaneComponents, field.NewPath("controlPlaneComponents")); len(errs) > 0 {
+		return fmt.Errorf("invalid control plane component configuration: %v", errs.ToAggregate())
+	}
+
 	c.config = &Config{config}
 	return nil
 }
@@ -80,6 +86,21 @@ func (c *Config) ApplyETCDStorage(etcdStorage *config.ETCDStorage) {
 	*etcdStorage = c.Config.ETCD.Storage
 }
 
+// ApplyETCDBackup sets the given etcd backup configuration to that of this Config.
+func (c *Config) ApplyETCDBackup(etcdBackup *config.ETCDBackup) {
+	*etcdBackup = c.Config.ETCD.Backup
+}
+
+// ApplyBackupStorage sets the given backup storage configuration to that of this Config.
+func (c *Config) ApplyBackupStorage(backupStorage *config.BackupStorage) {
+	*backupStorage = c.Config.BackupStorage
+}
+
+// ApplyControlPlaneComponents sets the given control plane component configuration to that of this Config.
+func (c *Config) ApplyControlPlaneComponents(controlPlaneComponents *config.ControlPlaneComponents) {
+	*controlPlaneComponents = c.Config.ControlPlaneComponents
+}
+
 // Options initializes empty config.ControllerConfiguration, applies the set values and returns it.
 func (c *Config) Options() config.ControllerConfiguration {
 	var cfg config.ControllerConfiguration
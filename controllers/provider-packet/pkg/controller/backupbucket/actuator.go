@@ -0,0 +1,60 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupbucket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/apis/config"
+	confighelper "github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/apis/config/helper"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket/genericactuator"
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
+	"github.com/gardener/gardener-extensions/pkg/objectstore/s3compat"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type actuator struct {
+	backupStorage *config.BackupStorage
+	client        client.Client
+	logger        logr.Logger
+}
+
+func newActuator(backupStorage *config.BackupStorage) genericactuator.BackupBucketDelegate {
+	return &actuator{
+		backupStorage: backupStorage,
+		logger:        logger,
+	}
+}
+
+func (a *actuator) InjectClient(client client.Client) error {
+	a.client = client
+	return nil
+}
+
+func (a *actuator) GetObjectStore(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) (objectstore.ObjectStore, error) {
+	if len(a.backupStorage.Endpoint) == 0 {
+		return nil, fmt.Errorf("backup storage is not configured")
+	}
+	return s3compat.NewObjectStoreFromSecretRef(ctx, a.client, bb.Spec.SecretRef, confighelper.GetS3CompatConfig(*a.backupStorage), bb.Spec.Region)
+}
+
+func (a *actuator) ReconcileBucketConfig(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+	// Buckets of S3-compatible object storages have no provider-specific configuration.
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupentry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/apis/config"
+	confighelper "github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/apis/config/helper"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupentry/genericactuator"
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
+	"github.com/gardener/gardener-extensions/pkg/objectstore/s3compat"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type actuator struct {
+	backupStorage *config.BackupStorage
+	client        client.Client
+	logger        logr.Logger
+}
+
+func newActuator(backupStorage *config.BackupStorage) genericactuator.BackupEntryDelegate {
+	return &actuator{
+		backupStorage: backupStorage,
+		logger:        logger,
+	}
+}
+
+func (a *actuator) InjectClient(client client.Client) error {
+	a.client = client
+	return nil
+}
+
+func (a *actuator) GetETCDSecretData(ctx context.Context, be *extensionsv1alpha1.BackupEntry, backupSecretData map[string][]byte) (map[string][]byte, error) {
+	if len(a.backupStorage.Endpoint) == 0 {
+		return nil, fmt.Errorf("backup storage is not configured")
+	}
+	return s3compat.ETCDSecretData(confighelper.GetS3CompatConfig(*a.backupStorage), be.Spec.Region, backupSecretData), nil
+}
+
+func (a *actuator) Delete(ctx context.Context, be *extensionsv1alpha1.BackupEntry) error {
+	store, err := a.getObjectStore(ctx, be)
+	if err != nil {
+		return err
+	}
+
+	return store.DeleteObjectsWithPrefix(ctx, be.Spec.BucketName, fmt.Sprintf("%s/", be.Name))
+}
+
+func (a *actuator) ListObjects(ctx context.Context, be *extensionsv1alpha1.BackupEntry, prefix string) (map[string]time.Time, error) {
+	store, err := a.getObjectStore(ctx, be)
+	if err != nil {
+		return nil, err
+	}
+
+	return store.ListObjectsWithPrefix(ctx, be.Spec.BucketName, fmt.Sprintf("%s/%s", be.Name, prefix))
+}
+
+func (a *actuator) getObjectStore(ctx context.Context, be *extensionsv1alpha1.BackupEntry) (objectstore.ObjectStore, error) {
+	return newObjectStore(a.backupStorage)(ctx, a.client, be.Spec.SecretRef, be.Spec.Region)
+}
+
+// newObjectStore returns the factory of the S3-compatible object store the backups are stored in.
+func newObjectStore(backupStorage *config.BackupStorage) objectstore.FactoryFunc {
+	return func(ctx context.Context, c client.Client, secretRef corev1.SecretReference, region string) (objectstore.ObjectStore, error) {
+		if len(backupStorage.Endpoint) == 0 {
+			return nil, fmt.Errorf("backup storage is not configured")
+		}
+		return s3compat.NewObjectStoreFromSecretRef(ctx, c, secretRef, confighelper.GetS3CompatConfig(*backupStorage), region)
+	}
+}
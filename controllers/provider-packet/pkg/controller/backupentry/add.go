@@ -0,0 +1,68 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupentry
+
+import (
+	"github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/apis/config"
+	"github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/packet"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupentry"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupentry/genericactuator"
+
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+var (
+	// DefaultAddOptions are the default AddOptions for AddToManager.
+	DefaultAddOptions = AddOptions{
+		SnapshotCheck: backupentry.DefaultSnapshotCheckConfig(),
+	}
+
+	logger = log.Log.WithName("packet-backupentry-actuator")
+)
+
+// AddOptions are options to apply when adding the Packet backupentry controller to the manager.
+type AddOptions struct {
+	// Controller are the controller.Options.
+	Controller controller.Options
+	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
+	IgnoreOperationAnnotation bool
+	// SnapshotCheck contains the settings of the etcd snapshot check. If it is nil, snapshots are not checked.
+	SnapshotCheck *backupentry.SnapshotCheckConfig
+	// Deletion contains the settings for deferring the deletion of the backups of deleted backupentries.
+	Deletion backupentry.DeletionConfig
+	// BackupStorage is the configuration of the S3-compatible object storage the backups are stored in.
+	BackupStorage config.BackupStorage
+}
+
+// AddToManagerWithOptions adds a controller with the given Options to the given manager.
+// The opts.Reconciler is being set with a newly instantiated actuator.
+func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
+	return backupentry.Add(mgr, backupentry.AddArgs{
+		Actuator:          genericactuator.NewActuator(newActuator(&opts.BackupStorage), logger),
+		ControllerOptions: opts.Controller,
+		Predicates:        backupentry.DefaultPredicates(packet.Type, opts.IgnoreOperationAnnotation),
+		Type:              packet.Type,
+		SnapshotCheck:     opts.SnapshotCheck,
+		ObjectStore:       newObjectStore(&opts.BackupStorage),
+		Deletion:          opts.Deletion,
+	})
+}
+
+// AddToManager adds a controller with the default Options.
+func AddToManager(mgr manager.Manager) error {
+	return AddToManagerWithOptions(mgr, DefaultAddOptions)
+}
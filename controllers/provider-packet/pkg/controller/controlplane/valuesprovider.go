@@ -19,6 +19,8 @@ import (
 	"encoding/base64"
 	"path/filepath"
 
+	"github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/apis/config"
+	confighelper "github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/apis/config/helper"
 	apispacket "github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/apis/packet"
 	"github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/packet"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
@@ -27,6 +29,7 @@ import (
 
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/chart"
 	"github.com/gardener/gardener/pkg/utils/secrets"
 	"github.com/go-logr/logr"
@@ -103,6 +106,8 @@ var controlPlaneChart = &chart.Chart{
 			Images: []string{packet.CloudControllerManagerImageName},
 			Objects: []*chart.Object{
 				{Type: &appsv1.Deployment{}, Name: "cloud-controller-manager"},
+				{Type: extensionscontroller.GetVerticalPodAutoscalerObject(), Name: "cloud-controller-manager-vpa"},
+				{Type: &policyv1beta1.PodDisruptionBudget{}, Name: "cloud-controller-manager"},
 				{Type: &corev1.ConfigMap{}, Name: "cloud-controller-manager-monitoring-config"},
 			},
 		},
@@ -112,6 +117,8 @@ var controlPlaneChart = &chart.Chart{
 			Objects: []*chart.Object{
 				{Type: &corev1.Service{}, Name: "csi-packet-pd"},
 				{Type: &appsv1.StatefulSet{}, Name: "csi-packet-controller"},
+				{Type: extensionscontroller.GetVerticalPodAutoscalerObject(), Name: "csi-packet-controller-vpa"},
+				{Type: &policyv1beta1.PodDisruptionBudget{}, Name: "csi-packet-controller"},
 			},
 		},
 	},
@@ -160,18 +167,20 @@ var storageClassChart = &chart.Chart{
 }
 
 // NewValuesProvider creates a new ValuesProvider for the generic actuator.
-func NewValuesProvider(logger logr.Logger) genericactuator.ValuesProvider {
+func NewValuesProvider(controlPlaneComponents config.ControlPlaneComponents, logger logr.Logger) genericactuator.ValuesProvider {
 	return &valuesProvider{
-		logger: logger.WithName("packet-values-provider"),
+		controlPlaneComponents: controlPlaneComponents,
+		logger:                 logger.WithName("packet-values-provider"),
 	}
 }
 
 // valuesProvider is a ValuesProvider that provides Packet-specific values for the 2 charts applied by the generic actuator.
 type valuesProvider struct {
 	genericactuator.NoopValuesProvider
-	decoder runtime.Decoder
-	client  client.Client
-	logger  logr.Logger
+	controlPlaneComponents config.ControlPlaneComponents
+	decoder                runtime.Decoder
+	client                 client.Client
+	logger                 logr.Logger
 }
 
 // InjectScheme injects the given scheme into the valuesProvider.
@@ -201,7 +210,20 @@ func (vp *valuesProvider) GetControlPlaneChartValues(
 	}
 
 	// Get control plane chart values
-	return getControlPlaneChartValues(cp, cluster, checksums, scaledDown)
+	values, err := getControlPlaneChartValues(cp, cluster, checksums, scaledDown, confighelper.GetControlPlaneComponentReplicas(vp.controlPlaneComponents))
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply the vertical pod autoscaling and high availability settings to all control plane components
+	componentValues := confighelper.GetControlPlaneComponentChartValues(vp.controlPlaneComponents)
+	for _, subChart := range controlPlaneChart.SubCharts {
+		if subChartValues, ok := values[subChart.Name].(map[string]interface{}); ok {
+			values[subChart.Name] = utils.MergeMaps(subChartValues, componentValues)
+		}
+	}
+
+	return values, nil
 }
 
 // GetControlPlaneShootChartValues returns the values for the control plane shoot chart applied by the generic actuator.
@@ -220,6 +242,22 @@ func (vp *valuesProvider) GetControlPlaneShootChartValues(
 	return getControlPlaneShootChartValues(cluster, credentials)
 }
 
+// GetStorageClassesChartValues returns the values for the storage classes chart applied by the generic actuator.
+func (vp *valuesProvider) GetStorageClassesChartValues(
+	ctx context.Context,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+) (map[string]interface{}, error) {
+	// Decode providerConfig
+	cpConfig := &apispacket.ControlPlaneConfig{}
+	if _, _, err := vp.decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, cpConfig); err != nil {
+		return nil, errors.Wrapf(err, "could not decode providerConfig of controlplane '%s'", util.ObjectName(cp))
+	}
+
+	// Get storage classes chart values
+	return getStorageClassesChartValues(cpConfig), nil
+}
+
 // getCredentials determines the credentials from the secret referenced in the ControlPlane resource.
 func (vp *valuesProvider) getCredentials(
 	ctx context.Context,
@@ -242,10 +280,11 @@ func getControlPlaneChartValues(
 	cluster *extensionscontroller.Cluster,
 	checksums map[string]string,
 	scaledDown bool,
+	replicas int,
 ) (map[string]interface{}, error) {
 	values := map[string]interface{}{
 		"packet-cloud-controller-manager": map[string]interface{}{
-			"replicas":          extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
+			"replicas":          extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, replicas),
 			"clusterName":       cp.Namespace,
 			"kubernetesVersion": extensionscontroller.GetKubernetesVersion(cluster),
 			"podNetwork":        extensionscontroller.GetPodNetwork(cluster),
@@ -255,6 +294,7 @@ func getControlPlaneChartValues(
 			},
 		},
 		"csi-packet": map[string]interface{}{
+			// The CSI controller does not run leader election, hence it cannot run more than one replica.
 			"replicas":          extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
 			"kubernetesVersion": extensionscontroller.GetKubernetesVersion(cluster),
 			"regionID":          cp.Spec.Region,
@@ -286,3 +326,48 @@ func getControlPlaneShootChartValues(
 
 	return values, nil
 }
+
+// getStorageClassesChartValues collects and returns the storage classes chart values.
+func getStorageClassesChartValues(cpConfig *apispacket.ControlPlaneConfig) map[string]interface{} {
+	storageClasses, customDefaultStorageClass := getStorageClasses(cpConfig.StorageClasses)
+
+	return map[string]interface{}{
+		"storageClasses":            storageClasses,
+		"customDefaultStorageClass": customDefaultStorageClass,
+	}
+}
+
+// getStorageClasses collects and returns the chart values for the given additional storage classes. It also
+// returns whether one of them shall be the default storage class of the shoot cluster.
+func getStorageClasses(storageClasses []apispacket.StorageClass) ([]map[string]interface{}, bool) {
+	var (
+		values     = make([]map[string]interface{}, 0, len(storageClasses))
+		hasDefault bool
+	)
+
+	for _, sc := range storageClasses {
+		parameters := map[string]interface{}{
+			"type": sc.Type,
+		}
+
+		isDefault := sc.Default != nil && *sc.Default
+		if isDefault {
+			hasDefault = true
+		}
+
+		value := map[string]interface{}{
+			"name":       sc.Name,
+			"default":    isDefault,
+			"parameters": parameters,
+		}
+		if sc.ReclaimPolicy != nil {
+			value["reclaimPolicy"] = string(*sc.ReclaimPolicy)
+		}
+		if sc.VolumeBindingMode != nil {
+			value["volumeBindingMode"] = string(*sc.VolumeBindingMode)
+		}
+		values = append(values, value)
+	}
+
+	return values, hasDefault
+}
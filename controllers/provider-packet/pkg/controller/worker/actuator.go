@@ -46,14 +46,16 @@ type delegateFactory struct {
 	scheme  *runtime.Scheme
 	decoder runtime.Decoder
 
-	machineImageMapping []config.MachineImage
+	machineImageMapping    []config.MachineImage
+	controlPlaneComponents config.ControlPlaneComponents
 }
 
 // NewActuator creates a new Actuator that updates the status of the handled WorkerPoolConfigs.
-func NewActuator(machineImageMapping []config.MachineImage) worker.Actuator {
+func NewActuator(machineImageMapping []config.MachineImage, controlPlaneComponents config.ControlPlaneComponents) worker.Actuator {
 	delegateFactory := &delegateFactory{
-		logger:              log.Log.WithName("worker-actuator"),
-		machineImageMapping: machineImageMapping,
+		logger:                 log.Log.WithName("worker-actuator"),
+		machineImageMapping:    machineImageMapping,
+		controlPlaneComponents: controlPlaneComponents,
 	}
 
 	return genericactuator.NewActuator(
@@ -105,6 +107,7 @@ func (d *delegateFactory) WorkerDelegate(ctx context.Context, worker *extensions
 		d.decoder,
 
 		d.machineImageMapping,
+		d.controlPlaneComponents,
 		seedChartApplier,
 		serverVersion.GitVersion,
 
@@ -118,9 +121,10 @@ type workerDelegate struct {
 	scheme  *runtime.Scheme
 	decoder runtime.Decoder
 
-	machineImageMapping []config.MachineImage
-	seedChartApplier    gardener.ChartApplier
-	serverVersion       string
+	machineImageMapping    []config.MachineImage
+	controlPlaneComponents config.ControlPlaneComponents
+	seedChartApplier       gardener.ChartApplier
+	serverVersion          string
 
 	cluster *extensionscontroller.Cluster
 	worker  *extensionsv1alpha1.Worker
@@ -137,6 +141,7 @@ func NewWorkerDelegate(
 	decoder runtime.Decoder,
 
 	machineImageMapping []config.MachineImage,
+	controlPlaneComponents config.ControlPlaneComponents,
 	seedChartApplier gardener.ChartApplier,
 	serverVersion string,
 
@@ -148,9 +153,10 @@ func NewWorkerDelegate(
 		scheme:  scheme,
 		decoder: decoder,
 
-		machineImageMapping: machineImageMapping,
-		seedChartApplier:    seedChartApplier,
-		serverVersion:       serverVersion,
+		machineImageMapping:    machineImageMapping,
+		controlPlaneComponents: controlPlaneComponents,
+		seedChartApplier:       seedChartApplier,
+		serverVersion:          serverVersion,
 
 		cluster: cluster,
 		worker:  worker,
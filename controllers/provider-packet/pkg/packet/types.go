@@ -28,13 +28,16 @@ const (
 	MachineControllerManagerImageName = "machine-controller-manager"
 	// ETCDBackupRestoreImageName is the name of the etcd backup and restore image.
 	ETCDBackupRestoreImageName = "etcd-backup-restore"
+	// ETCDBackupRestoreS3CompatImageName is the name of the etcd backup and restore image for storing backups in an
+	// S3-compatible object storage.
+	ETCDBackupRestoreS3CompatImageName = "etcd-backup-restore-s3compat"
 
 	// BucketName is a constant for the key in a backup secret that holds the bucket name.
 	// The bucket name is written to the backup secret by Gardener as a temporary solution.
 	// TODO In the future, the bucket name should come from a BackupBucket resource (see https://github.com/gardener/gardener/blob/master/docs/proposals/02-backupinfra.md)
 	BucketName = "bucketName"
 
-	// AccessKeyID is a constant for the key in a cloud provider secret and backup secret that holds the Packet API token.
+	// APIToken is a constant for the key in a cloud provider secret and backup secret that holds the Packet API token.
 	APIToken = "apiToken"
 	// ProjectID is a constant for the key in a cloud provider secret and backup secret that holds the Packet project id.
 	ProjectID = "projectID"
@@ -56,6 +59,9 @@ const (
 	// SSHKeyID key for accessing SSH key ID from outputs in terraform
 	SSHKeyID = "key_pair_id"
 
+	// BackupSecretName is the name of the secret containing the credentials for storing the backups of Shoot clusters.
+	BackupSecretName = "etcd-backup"
+
 	// MachineControllerManagerName is a constant for the name of the machine-controller-manager.
 	MachineControllerManagerName = "machine-controller-manager"
 	// MachineControllerManagerVpaName is the name of the VerticalPodAutoscaler of the machine-controller-manager deployment.
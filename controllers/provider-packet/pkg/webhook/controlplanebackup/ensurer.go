@@ -17,23 +17,30 @@ package controlplanebackup
 import (
 	"context"
 
+	"github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/apis/config"
+	confighelper "github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/apis/config/helper"
 	"github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/packet"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupentry"
+	"github.com/gardener/gardener-extensions/pkg/objectstore/s3compat"
 	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane/genericmutator"
 
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // NewEnsurer creates a new controlplaneexposure ensurer.
-func NewEnsurer(imageVector imagevector.ImageVector, logger logr.Logger) genericmutator.Ensurer {
+func NewEnsurer(etcdBackup *config.ETCDBackup, imageVector imagevector.ImageVector, logger logr.Logger) genericmutator.Ensurer {
 	return &ensurer{
+		etcdBackup:  etcdBackup,
 		imageVector: imageVector,
 		logger:      logger.WithName("packet-controlplanebackup-ensurer"),
 	}
@@ -41,17 +48,32 @@ func NewEnsurer(imageVector imagevector.ImageVector, logger logr.Logger) generic
 
 type ensurer struct {
 	genericmutator.NoopEnsurer
+	etcdBackup  *config.ETCDBackup
 	imageVector imagevector.ImageVector
+	client      client.Client
 	logger      logr.Logger
 }
 
+// InjectClient injects the given client into the ensurer.
+func (e *ensurer) InjectClient(client client.Client) error {
+	e.client = client
+	return nil
+}
+
 // EnsureETCDStatefulSet ensures that the etcd stateful sets conform to the provider requirements.
 func (e *ensurer) EnsureETCDStatefulSet(ctx context.Context, ss *appsv1.StatefulSet, cluster *extensionscontroller.Cluster) error {
-	return e.ensureContainers(&ss.Spec.Template.Spec, ss.Name, cluster)
+	if err := e.ensureContainers(ctx, &ss.Spec.Template.Spec, ss.Name, cluster); err != nil {
+		return err
+	}
+
+	backupConfigured := !extensionscontroller.IsSeedBackupNil(cluster)
+	e.ensureVolumes(&ss.Spec.Template.Spec, ss.Name, backupConfigured)
+	return e.ensureChecksumAnnotations(ctx, &ss.Spec.Template, ss.Namespace, ss.Name, backupConfigured)
 }
 
-func (e *ensurer) ensureContainers(ps *corev1.PodSpec, name string, cluster *extensionscontroller.Cluster) error {
-	c, err := e.getBackupRestoreContainer(name, cluster)
+func (e *ensurer) ensureContainers(ctx context.Context, ps *corev1.PodSpec, name string, cluster *extensionscontroller.Cluster) error {
+	backupRestoreContainer := extensionswebhook.ContainerWithName(ps.Containers, controlplane.BackupRestoreContainerName)
+	c, err := e.getBackupRestoreContainer(ctx, backupRestoreContainer, name, cluster)
 	if err != nil {
 		return err
 	}
@@ -59,22 +81,72 @@ func (e *ensurer) ensureContainers(ps *corev1.PodSpec, name string, cluster *ext
 	return nil
 }
 
-func (e *ensurer) getBackupRestoreContainer(name string, cluster *extensionscontroller.Cluster) (*corev1.Container, error) {
-	// Find etcd-backup-restore image
-	// TODO Get seed version from clientset when it's possible to inject it
-	image, err := e.imageVector.FindImage(packet.ETCDBackupRestoreImageName, imagevector.TargetVersion(extensionscontroller.GetKubernetesVersion(cluster)))
-	if err != nil {
-		return nil, errors.Wrapf(err, "could not find image %s", packet.ETCDBackupRestoreImageName)
+func (e *ensurer) ensureChecksumAnnotations(ctx context.Context, template *corev1.PodTemplateSpec, namespace, name string, backupConfigured bool) error {
+	if name == v1alpha1constants.StatefulSetNameETCDMain && backupConfigured {
+		return controlplane.EnsureSecretChecksumAnnotation(ctx, template, e.client, namespace, packet.BackupSecretName)
 	}
+	return nil
+}
 
-	// Determine volume claim template name
-	// It is only specified for the etcd-main stateful set (backup is enabled)
+func (e *ensurer) getBackupRestoreContainer(ctx context.Context, existingContainer *corev1.Container, name string, cluster *extensionscontroller.Cluster) (*corev1.Container, error) {
+	// Determine provider and container env variables
+	// They are only specified for the etcd-main stateful set (backup is enabled)
 	var (
+		err                     error
+		provider                string
+		prefix                  string
+		env                     []corev1.EnvVar
+		volumeMounts            []corev1.VolumeMount
 		volumeClaimTemplateName = name
 	)
 	if name == v1alpha1constants.StatefulSetNameETCDMain {
+		if extensionscontroller.IsSeedBackupNil(cluster) {
+			e.logger.Info("Backup profile is not configured; backup will not be taken for etcd-main")
+		} else {
+			backupEntryName := common.GenerateBackupEntryName(extensionscontroller.GetTechnicalID(cluster), extensionscontroller.GetUID(cluster))
+			if prefix, err = backupentry.GetStorePrefixByName(ctx, e.client, backupEntryName); err != nil {
+				prefix = controlplane.GetStorePrefixFromContainer(existingContainer, name, backupEntryName)
+				e.logger.Error(err, "Could not determine the store prefix of the etcd backups, keeping the current one", "prefix", prefix)
+			}
+
+			provider = s3compat.StorageProviderName
+			env = s3compat.GetBackupRestoreEnv(packet.BackupSecretName)
+			volumeMounts = s3compat.GetBackupRestoreVolumeMounts(packet.BackupSecretName)
+		}
 		volumeClaimTemplateName = controlplane.EtcdMainVolumeClaimTemplateName
 	}
 
-	return controlplane.GetBackupRestoreContainer(name, volumeClaimTemplateName, "", "", "", image.String(), nil, nil, nil), nil
+	// Find etcd-backup-restore image
+	// Backups in an S3-compatible object storage require a newer version of etcd-backup-restore
+	// TODO Get seed version from clientset when it's possible to inject it
+	imageName := packet.ETCDBackupRestoreImageName
+	if provider == s3compat.StorageProviderName {
+		imageName = packet.ETCDBackupRestoreS3CompatImageName
+	}
+	image, err := e.imageVector.FindImage(imageName, imagevector.TargetVersion(extensionscontroller.GetKubernetesVersion(cluster)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not find image %s", imageName)
+	}
+
+	var schedule string
+	if e.etcdBackup != nil && e.etcdBackup.Schedule != nil {
+		schedule = *e.etcdBackup.Schedule
+	} else {
+		schedule, err = controlplane.DetermineBackupSchedule(existingContainer, cluster)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	c := controlplane.GetBackupRestoreContainer(name, volumeClaimTemplateName, schedule, provider, prefix, image.String(), confighelper.GetBackupRestoreConfig(e.etcdBackup), nil, env, volumeMounts)
+	if provider == s3compat.StorageProviderName {
+		s3compat.EnsureBackupRestoreFlags(c)
+	}
+	return c, nil
+}
+
+func (e *ensurer) ensureVolumes(ps *corev1.PodSpec, name string, backupConfigured bool) {
+	if name == v1alpha1constants.StatefulSetNameETCDMain && backupConfigured {
+		ps.Volumes = extensionswebhook.EnsureVolumeWithName(ps.Volumes, s3compat.GetBackupRestoreVolume(packet.BackupSecretName))
+	}
 }
@@ -17,22 +17,32 @@ package controlplanebackup
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/apis/config"
 	"github.com/gardener/gardener-extensions/controllers/provider-packet/pkg/packet"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
+	"github.com/gardener/gardener-extensions/pkg/objectstore/s3compat"
 	"github.com/gardener/gardener-extensions/pkg/util"
 	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
 	"github.com/gardener/gardener-extensions/pkg/webhook/controlplane"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
 )
 
 const (
@@ -49,13 +59,39 @@ var _ = Describe("Ensurer", func() {
 		var (
 			ctrl *gomock.Controller
 
+			etcdBackup = &config.ETCDBackup{
+				Schedule: util.StringPtr("0 */24 * * *"),
+			}
+
 			imageVector = imagevector.ImageVector{
 				{
 					Name:       packet.ETCDBackupRestoreImageName,
 					Repository: "test-repository",
 					Tag:        util.StringPtr("test-tag"),
 				},
+				{
+					Name:       packet.ETCDBackupRestoreS3CompatImageName,
+					Repository: "test-repository",
+					Tag:        util.StringPtr("test-s3compat-tag"),
+				},
+			}
+
+			cluster *extensionscontroller.Cluster
+
+			secretKey      = client.ObjectKey{Namespace: namespace, Name: packet.BackupSecretName}
+			backupEntryKey = client.ObjectKey{Name: "shoot--test--sample--test-uid"}
+			secret         = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: packet.BackupSecretName, Namespace: namespace},
+				Data:       map[string][]byte{"foo": []byte("bar")},
+			}
+
+			annotations = map[string]string{
+				"checksum/secret-" + packet.BackupSecretName: "8bafb35ff1ac60275d62e1cbd495aceb511fb354f74a20f7d06ecb48b3a68432",
 			}
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
 
 			cluster = &extensionscontroller.Cluster{
 				CoreShoot: &gardencorev1alpha1.Shoot{
@@ -64,12 +100,17 @@ var _ = Describe("Ensurer", func() {
 							Version: "1.13.4",
 						},
 					},
+					Status: gardencorev1alpha1.ShootStatus{
+						TechnicalID: "shoot--test--sample",
+						UID:         types.UID("test-uid"),
+					},
+				},
+				CoreSeed: &gardencorev1alpha1.Seed{
+					Spec: gardencorev1alpha1.SeedSpec{
+						Backup: &gardencorev1alpha1.SeedBackup{},
+					},
 				},
 			}
-		)
-
-		BeforeEach(func() {
-			ctrl = gomock.NewController(GinkgoT())
 		})
 
 		AfterEach(func() {
@@ -83,13 +124,20 @@ var _ = Describe("Ensurer", func() {
 				}
 			)
 
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), secretKey, &corev1.Secret{}).DoAndReturn(clientGet(secret))
+			client.EXPECT().Get(context.TODO(), backupEntryKey, &extensionsv1alpha1.BackupEntry{}).Return(apierrors.NewNotFound(extensionsv1alpha1.Resource("backupentries"), backupEntryKey.Name))
+
 			// Create ensurer
-			ensurer := NewEnsurer(imageVector, logger)
+			ensurer := NewEnsurer(etcdBackup, imageVector, logger)
+			err := ensurer.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
 
 			// Call EnsureETCDStatefulSet method and check the result
-			err := ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
+			err = ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
 			Expect(err).To(Not(HaveOccurred()))
-			checkETCDMainStatefulSet(ss, nil)
+			checkETCDMainStatefulSet(ss, annotations)
 		})
 
 		It("should modify existing elements of etcd-main statefulset", func() {
@@ -110,13 +158,37 @@ var _ = Describe("Ensurer", func() {
 				}
 			)
 
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), secretKey, &corev1.Secret{}).DoAndReturn(clientGet(secret))
+			client.EXPECT().Get(context.TODO(), backupEntryKey, &extensionsv1alpha1.BackupEntry{}).Return(apierrors.NewNotFound(extensionsv1alpha1.Resource("backupentries"), backupEntryKey.Name))
+
 			// Create ensurer
-			ensurer := NewEnsurer(imageVector, logger)
+			ensurer := NewEnsurer(etcdBackup, imageVector, logger)
+			err := ensurer.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
 
 			// Call EnsureETCDStatefulSet method and check the result
-			err := ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
+			err = ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
 			Expect(err).To(Not(HaveOccurred()))
-			checkETCDMainStatefulSet(ss, nil)
+			checkETCDMainStatefulSet(ss, annotations)
+		})
+
+		It("should not configure backup to etcd-main statefulset if backup profile is missing", func() {
+			ss := &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: v1alpha1constants.StatefulSetNameETCDMain},
+			}
+			cluster.CoreSeed.Spec.Backup = nil
+
+			// Create ensurer
+			ensurer := NewEnsurer(etcdBackup, imageVector, logger)
+			err := ensurer.(inject.Client).InjectClient(mockclient.NewMockClient(ctrl))
+			Expect(err).To(Not(HaveOccurred()))
+
+			// Call EnsureETCDStatefulSet method and check the result
+			err = ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
+			Expect(err).To(Not(HaveOccurred()))
+			checkETCDMainStatefulSetWithoutBackup(ss)
 		})
 
 		It("should not modify elements to same etcd-main statefulset", func() {
@@ -126,11 +198,18 @@ var _ = Describe("Ensurer", func() {
 				}
 			)
 
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), secretKey, &corev1.Secret{}).DoAndReturn(clientGet(secret)).Times(2)
+			client.EXPECT().Get(context.TODO(), backupEntryKey, &extensionsv1alpha1.BackupEntry{}).Return(apierrors.NewNotFound(extensionsv1alpha1.Resource("backupentries"), backupEntryKey.Name)).Times(2)
+
 			// Create ensurer
-			ensurer := NewEnsurer(imageVector, logger)
+			ensurer := NewEnsurer(etcdBackup, imageVector, logger)
+			err := ensurer.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
 
 			// Call EnsureETCDStatefulSet method and check the result
-			err := ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
+			err = ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
 			Expect(err).To(Not(HaveOccurred()))
 			oldSS := ss.DeepCopy()
 
@@ -148,10 +227,12 @@ var _ = Describe("Ensurer", func() {
 			)
 
 			// Create ensurer
-			ensurer := NewEnsurer(imageVector, logger)
+			ensurer := NewEnsurer(etcdBackup, imageVector, logger)
+			err := ensurer.(inject.Client).InjectClient(mockclient.NewMockClient(ctrl))
+			Expect(err).To(Not(HaveOccurred()))
 
 			// Call EnsureETCDStatefulSet method and check the result
-			err := ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
+			err = ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
 			Expect(err).To(Not(HaveOccurred()))
 			checkETCDEventsStatefulSet(ss)
 		})
@@ -175,25 +256,115 @@ var _ = Describe("Ensurer", func() {
 			)
 
 			// Create ensurer
-			ensurer := NewEnsurer(imageVector, logger)
+			ensurer := NewEnsurer(etcdBackup, imageVector, logger)
+			err := ensurer.(inject.Client).InjectClient(mockclient.NewMockClient(ctrl))
+			Expect(err).To(Not(HaveOccurred()))
 
 			// Call EnsureETCDStatefulSet method and check the result
-			err := ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
+			err = ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
 			Expect(err).To(Not(HaveOccurred()))
 			checkETCDEventsStatefulSet(ss)
 		})
+
+		It("should apply the backup-restore configuration of the controller", func() {
+			var (
+				ss = &appsv1.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{Name: v1alpha1constants.StatefulSetNameETCDEvents},
+				}
+				limitBased = controlplane.GarbageCollectionPolicyLimitBased
+				maxBackups = int32(3)
+				etcdBackup = &config.ETCDBackup{
+					Schedule:                util.StringPtr("0 */24 * * *"),
+					DeltaSnapshotPeriod:     &metav1.Duration{Duration: time.Minute},
+					GarbageCollectionPeriod: &metav1.Duration{Duration: time.Hour},
+					GarbageCollectionPolicy: &limitBased,
+					MaxBackups:              &maxBackups,
+				}
+			)
+
+			// Create ensurer
+			ensurer := NewEnsurer(etcdBackup, imageVector, logger)
+			err := ensurer.(inject.Client).InjectClient(mockclient.NewMockClient(ctrl))
+			Expect(err).To(Not(HaveOccurred()))
+
+			// Call EnsureETCDStatefulSet method and check the result
+			err = ensurer.EnsureETCDStatefulSet(context.TODO(), ss, cluster)
+			Expect(err).To(Not(HaveOccurred()))
+			c := extensionswebhook.ContainerWithName(ss.Spec.Template.Spec.Containers, "backup-restore")
+			Expect(c).NotTo(BeNil())
+			Expect(c.Command).To(ContainElement("--delta-snapshot-period-seconds=60"))
+			Expect(c.Command).To(ContainElement("--garbage-collection-period-seconds=3600"))
+			Expect(c.Command).To(ContainElement("--garbage-collection-policy=LimitBased"))
+			Expect(c.Command).To(ContainElement("--max-backups=3"))
+		})
 	})
 })
 
 func checkETCDMainStatefulSet(ss *appsv1.StatefulSet, annotations map[string]string) {
+	var (
+		env = []corev1.EnvVar{
+			{
+				Name: "STORAGE_CONTAINER",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						Key:                  s3compat.BucketName,
+						LocalObjectReference: corev1.LocalObjectReference{Name: packet.BackupSecretName},
+					},
+				},
+			},
+			{
+				Name:  "AWS_APPLICATION_CREDENTIALS",
+				Value: "/root/.s3",
+			},
+		}
+		volumeMounts = []corev1.VolumeMount{
+			{
+				Name:      packet.BackupSecretName,
+				MountPath: "/root/.s3",
+			},
+		}
+		etcdBackupSecretVolume = corev1.Volume{
+			Name: packet.BackupSecretName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: packet.BackupSecretName,
+				},
+			},
+		}
+	)
+
+	expected := controlplane.GetBackupRestoreContainer(v1alpha1constants.StatefulSetNameETCDMain, controlplane.EtcdMainVolumeClaimTemplateName, "0 */24 * * *", s3compat.StorageProviderName, "shoot--test--sample--test-uid",
+		"test-repository:test-s3compat-tag", nil, nil, env, volumeMounts)
+	s3compat.EnsureBackupRestoreFlags(expected)
+
 	c := extensionswebhook.ContainerWithName(ss.Spec.Template.Spec.Containers, "backup-restore")
-	Expect(c).To(Equal(controlplane.GetBackupRestoreContainer(v1alpha1constants.StatefulSetNameETCDMain, controlplane.EtcdMainVolumeClaimTemplateName, "", "", "",
-		"test-repository:test-tag", nil, nil, nil)))
+	Expect(c).To(Equal(expected))
+	Expect(c.Command).To(ContainElement("--etcd-connection-timeout=5m0s"))
 	Expect(ss.Spec.Template.Annotations).To(Equal(annotations))
+	Expect(ss.Spec.Template.Spec.Volumes).To(ContainElement(etcdBackupSecretVolume))
+}
+
+func checkETCDMainStatefulSetWithoutBackup(ss *appsv1.StatefulSet) {
+	c := extensionswebhook.ContainerWithName(ss.Spec.Template.Spec.Containers, "backup-restore")
+	Expect(c).To(Equal(controlplane.GetBackupRestoreContainer(v1alpha1constants.StatefulSetNameETCDMain, controlplane.EtcdMainVolumeClaimTemplateName, "0 */24 * * *", "", "",
+		"test-repository:test-tag", nil, nil, nil, nil)))
+	Expect(ss.Spec.Template.Annotations).To(BeNil())
+	Expect(ss.Spec.Template.Spec.Volumes).To(BeEmpty())
 }
 
 func checkETCDEventsStatefulSet(ss *appsv1.StatefulSet) {
 	c := extensionswebhook.ContainerWithName(ss.Spec.Template.Spec.Containers, "backup-restore")
-	Expect(c).To(Equal(controlplane.GetBackupRestoreContainer(v1alpha1constants.StatefulSetNameETCDEvents, v1alpha1constants.StatefulSetNameETCDEvents, "", "", "",
-		"test-repository:test-tag", nil, nil, nil)))
+	Expect(c).To(Equal(controlplane.GetBackupRestoreContainer(v1alpha1constants.StatefulSetNameETCDEvents, v1alpha1constants.StatefulSetNameETCDEvents, "0 */24 * * *", "", "",
+		"test-repository:test-tag", nil, nil, nil, nil)))
+	Expect(ss.Spec.Template.Spec.Volumes).To(BeEmpty())
+}
+
+func clientGet(result runtime.Object) interface{} {
+	return func(ctx context.Context, key client.ObjectKey, obj runtime.Object) error {
+		switch obj.(type) {
+		case *corev1.Secret:
+			*obj.(*corev1.Secret) = *result.(*corev1.Secret)
+		}
+		return nil
+	}
 }
@@ -0,0 +1,97 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupentry"
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+)
+
+// DeletedBackupsRequeuePeriod is the period after which the deletion of a BackupBucket whose bucket still contains
+// the backups of deleted BackupEntry resources is retried.
+const DeletedBackupsRequeuePeriod = 10 * time.Minute
+
+type actuator struct {
+	backupBucketDelegate BackupBucketDelegate
+	logger               logr.Logger
+}
+
+// InjectFunc enables injecting Kubernetes dependencies into actuator's dependencies.
+func (a *actuator) InjectFunc(f inject.Func) error {
+	return f(a.backupBucketDelegate)
+}
+
+// NewActuator creates a new Actuator that manages the buckets of the handled BackupBucket resources in the object
+// stores returned by the given delegate.
+func NewActuator(backupBucketDelegate BackupBucketDelegate, logger logr.Logger) backupbucket.Actuator {
+	return &actuator{
+		logger:               logger,
+		backupBucketDelegate: backupBucketDelegate,
+	}
+}
+
+// Reconcile reconciles the update of a BackupBucket
+func (a *actuator) Reconcile(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+	store, err := a.backupBucketDelegate.GetObjectStore(ctx, bb)
+	if err != nil {
+		return err
+	}
+
+	if err := store.CreateBucketIfNotExists(ctx, bb.Name); err != nil {
+		a.logger.Error(err, "failed to create bucket", "backupbucket", bb.Name)
+		return err
+	}
+
+	return a.backupBucketDelegate.ReconcileBucketConfig(ctx, bb)
+}
+
+// Delete deletes the BackupBucket
+func (a *actuator) Delete(ctx context.Context, bb *extensionsv1alpha1.BackupBucket) error {
+	store, err := a.backupBucketDelegate.GetObjectStore(ctx, bb)
+	if err != nil {
+		return err
+	}
+
+	// The backups of deleted backupentries are kept until their deletion grace period has expired.
+	deleted, err := backupentry.ListDeleted(ctx, store, bb.Name)
+	if err != nil {
+		return err
+	}
+	if len(deleted) > 0 {
+		return &controllererror.RequeueAfterError{
+			Cause:        fmt.Errorf("bucket still contains the backups of deleted backupentries %v that are purged after the deletion grace period", deleted),
+			RequeueAfter: DeletedBackupsRequeuePeriod,
+		}
+	}
+
+	if err := store.DeleteBucketIfExists(ctx, bb.Name); err != nil {
+		a.logger.Error(err, "failed to delete bucket", "backupbucket", bb.Name)
+		return err
+	}
+
+	if finalizer, ok := a.backupBucketDelegate.(BackupBucketFinalizer); ok {
+		return finalizer.FinalizeBackupBucket(ctx, bb)
+	}
+	return nil
+}
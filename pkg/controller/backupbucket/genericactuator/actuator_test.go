@@ -0,0 +1,138 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener-extensions/pkg/controller/backupbucket/genericactuator"
+	"github.com/gardener/gardener-extensions/pkg/controller/backupentry"
+	controllererror "github.com/gardener/gardener-extensions/pkg/controller/error"
+	mockgenericactuator "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/controller/backupbucket/genericactuator"
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+const bucketName = "test-bucket"
+
+var _ = Describe("Actuator", func() {
+	var (
+		ctrl *gomock.Controller
+		ctx  = context.TODO()
+
+		bb = &extensionsv1alpha1.BackupBucket{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: bucketName,
+			},
+		}
+
+		logger = log.Log.WithName("test")
+
+		store                *objectstore.InMemoryObjectStore
+		backupBucketDelegate *mockgenericactuator.MockBackupBucketDelegate
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		store = objectstore.NewInMemoryObjectStore()
+		backupBucketDelegate = mockgenericactuator.NewMockBackupBucketDelegate(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#Reconcile", func() {
+		It("should create the bucket and reconcile its configuration", func() {
+			backupBucketDelegate.EXPECT().GetObjectStore(ctx, bb).Return(store, nil)
+			backupBucketDelegate.EXPECT().ReconcileBucketConfig(ctx, bb).Return(nil)
+
+			a := genericactuator.NewActuator(backupBucketDelegate, logger)
+			Expect(a.Reconcile(ctx, bb)).To(Succeed())
+
+			Expect(store.BucketExists(bucketName)).To(BeTrue())
+		})
+
+		It("should not reconcile the configuration if the object store cannot be created", func() {
+			backupBucketDelegate.EXPECT().GetObjectStore(ctx, bb).Return(nil, fmt.Errorf("error"))
+
+			a := genericactuator.NewActuator(backupBucketDelegate, logger)
+			Expect(a.Reconcile(ctx, bb)).NotTo(Succeed())
+		})
+	})
+
+	Describe("#Delete", func() {
+		It("should delete the bucket", func() {
+			Expect(store.CreateBucketIfNotExists(ctx, bucketName)).To(Succeed())
+			Expect(store.PutObject(ctx, bucketName, "foo", bytes.NewReader([]byte("bar")))).To(Succeed())
+			backupBucketDelegate.EXPECT().GetObjectStore(ctx, bb).Return(store, nil)
+
+			a := genericactuator.NewActuator(backupBucketDelegate, logger)
+			Expect(a.Delete(ctx, bb)).To(Succeed())
+
+			Expect(store.BucketExists(bucketName)).To(BeFalse())
+		})
+
+		It("should not delete the bucket while it contains backups marked as deleted", func() {
+			Expect(store.CreateBucketIfNotExists(ctx, bucketName)).To(Succeed())
+			Expect(backupentry.MarkDeleted(ctx, store, bucketName, "shoot--foo--bar--uid")).To(Succeed())
+			backupBucketDelegate.EXPECT().GetObjectStore(ctx, bb).Return(store, nil)
+
+			a := genericactuator.NewActuator(backupBucketDelegate, logger)
+			err := a.Delete(ctx, bb)
+
+			Expect(err).To(BeAssignableToTypeOf(&controllererror.RequeueAfterError{}))
+			Expect(store.BucketExists(bucketName)).To(BeTrue())
+		})
+
+		It("should finalize the backupbucket after the bucket has been deleted", func() {
+			Expect(store.CreateBucketIfNotExists(ctx, bucketName)).To(Succeed())
+			backupBucketDelegate.EXPECT().GetObjectStore(ctx, bb).Return(store, nil)
+
+			finalized := false
+			delegate := &finalizingDelegate{
+				BackupBucketDelegate: backupBucketDelegate,
+				finalize: func() error {
+					Expect(store.BucketExists(bucketName)).To(BeFalse())
+					finalized = true
+					return nil
+				},
+			}
+
+			a := genericactuator.NewActuator(delegate, logger)
+			Expect(a.Delete(ctx, bb)).To(Succeed())
+
+			Expect(finalized).To(BeTrue())
+		})
+	})
+})
+
+type finalizingDelegate struct {
+	genericactuator.BackupBucketDelegate
+	finalize func() error
+}
+
+func (d *finalizingDelegate) FinalizeBackupBucket(_ context.Context, _ *extensionsv1alpha1.BackupBucket) error {
+	return d.finalize()
+}
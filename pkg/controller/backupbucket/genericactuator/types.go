@@ -0,0 +1,38 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genericactuator
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// BackupBucketDelegate performs provider specific operations with BackupBucket resources.
+type BackupBucketDelegate interface {
+	// GetObjectStore returns the object store managing the bucket of the BackupBucket.
+	GetObjectStore(context.Context, *extensionsv1alpha1.BackupBucket) (objectstore.ObjectStore, error)
+	// ReconcileBucketConfig applies the provider specific configuration of the BackupBucket to its bucket.
+	// It is called after the bucket has been created.
+	ReconcileBucketConfig(context.Context, *extensionsv1alpha1.BackupBucket) error
+}
+
+// BackupBucketFinalizer can be implemented by a BackupBucketDelegate to clean up provider specific resources of a
+// BackupBucket, e.g. generated credentials. It is called after the bucket has been deleted.
+type BackupBucketFinalizer interface {
+	// FinalizeBackupBucket cleans up the provider specific resources of the BackupBucket.
+	FinalizeBackupBucket(context.Context, *extensionsv1alpha1.BackupBucket) error
+}
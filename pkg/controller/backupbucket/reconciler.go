@@ -145,6 +145,18 @@ func (r *reconciler) delete(ctx context.Context, bb *extensionsv1alpha1.BackupBu
 	}
 
 	operationType := gardencorev1alpha1helper.ComputeOperationType(bb.ObjectMeta, bb.Status.LastOperation)
+
+	if IsDeletionProtected(bb) {
+		// Removing the annotation does not change the generation, hence the deletion is retried periodically.
+		msg := fmt.Sprintf("Deletion of backupbucket is blocked by annotation %s=%s", AnnotationDeletionProtected, bb.Annotations[AnnotationDeletionProtected])
+		r.logger.Info(msg, "backupbucket", bb.Name)
+		r.recorder.Event(bb, corev1.EventTypeWarning, EventBackupBucketDeletion, msg)
+		if err := r.updateStatusProcessing(ctx, bb, operationType, msg); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{RequeueAfter: DeletionProtectedRequeuePeriod}, nil
+	}
+
 	if err := r.updateStatusProcessing(ctx, bb, operationType, "Deleting the backupbucket"); err != nil {
 		return reconcile.Result{}, err
 	}
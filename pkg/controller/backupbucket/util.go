@@ -0,0 +1,37 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupbucket
+
+import (
+	"time"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+const (
+	// AnnotationDeletionProtected is the annotation of BackupBucket resources whose deletion is blocked as long as its
+	// value is `true`.
+	AnnotationDeletionProtected = "backupbucket.extensions.gardener.cloud/deletion-protected"
+
+	// DeletionProtectedRequeuePeriod is the period after which the deletion of a BackupBucket blocked by the
+	// AnnotationDeletionProtected annotation is retried.
+	DeletionProtectedRequeuePeriod = time.Minute
+)
+
+// IsDeletionProtected returns true if the deletion of the given BackupBucket is blocked by the
+// AnnotationDeletionProtected annotation.
+func IsDeletionProtected(bb *extensionsv1alpha1.BackupBucket) bool {
+	return bb.Annotations[AnnotationDeletionProtected] == "true"
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupbucket_test
+
+import (
+	. "github.com/gardener/gardener-extensions/pkg/controller/backupbucket"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Util", func() {
+	DescribeTable("#IsDeletionProtected",
+		func(annotations map[string]string, expected bool) {
+			bb := &extensionsv1alpha1.BackupBucket{ObjectMeta: metav1.ObjectMeta{Annotations: annotations}}
+			Expect(IsDeletionProtected(bb)).To(Equal(expected))
+		},
+		Entry("no annotations", nil, false),
+		Entry("annotation set to true", map[string]string{AnnotationDeletionProtected: "true"}, true),
+		Entry("annotation set to false", map[string]string{AnnotationDeletionProtected: "false"}, false),
+	)
+})
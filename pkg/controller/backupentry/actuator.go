@@ -16,6 +16,7 @@ package backupentry
 
 import (
 	"context"
+	"time"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 )
@@ -27,3 +28,37 @@ type Actuator interface {
 	// Delete deletes the BackupEntry.
 	Delete(context.Context, *extensionsv1alpha1.BackupEntry) error
 }
+
+// SnapshotChecker is implemented by Actuators that are able to check the etcd snapshots of BackupEntry resources.
+type SnapshotChecker interface {
+	// GetLatestSnapshots returns the latest snapshots stored for the BackupEntry. It returns nil if the snapshots
+	// of the BackupEntry are not expected to be up to date, e.g. because the shoot is hibernated or does not exist
+	// anymore.
+	GetLatestSnapshots(context.Context, *extensionsv1alpha1.BackupEntry) (*Snapshots, error)
+}
+
+// Snapshots contains information about the latest etcd snapshots stored for a BackupEntry.
+type Snapshots struct {
+	// LatestFull is the time of the latest full snapshot. It is nil if there is no full snapshot.
+	LatestFull *time.Time
+	// LatestDelta is the time of the latest delta snapshot. It is nil if there is no delta snapshot.
+	LatestDelta *time.Time
+}
+
+// SnapshotLister is implemented by Actuators that are able to list the etcd snapshots of BackupEntry resources.
+type SnapshotLister interface {
+	// ListSnapshots returns the snapshots stored for the BackupEntry.
+	ListSnapshots(context.Context, *extensionsv1alpha1.BackupEntry) ([]Snapshot, error)
+}
+
+// Snapshot contains information about an etcd snapshot stored for a BackupEntry.
+type Snapshot struct {
+	// Name is the name of the snapshot, i.e. the last element of its key.
+	Name string
+	// Key is the key of the snapshot in the bucket.
+	Key string
+	// Kind is the kind of the snapshot, i.e. SnapshotKindFull or SnapshotKindDelta.
+	Kind string
+	// Time is the time the snapshot has been taken.
+	Time time.Time
+}
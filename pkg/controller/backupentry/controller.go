@@ -15,7 +15,10 @@
 package backupentry
 
 import (
+	"fmt"
+
 	extensionshandler "github.com/gardener/gardener-extensions/pkg/handler"
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
 	extensionspredicate "github.com/gardener/gardener-extensions/pkg/predicate"
 	corev1 "k8s.io/api/core/v1"
 
@@ -24,6 +27,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
@@ -45,6 +49,19 @@ type AddArgs struct {
 	// Predicates are the predicates to use.
 	// If unset, GenerationChanged will be used.
 	Predicates []predicate.Predicate
+	// Type is the type of the BackupEntry resources handled by the Actuator. It is required for the etcd snapshot check.
+	Type string
+	// SnapshotCheck contains the settings of the etcd snapshot check. If it is set and the Actuator implements
+	// SnapshotChecker, the etcd snapshots of the BackupEntry resources are checked periodically.
+	SnapshotCheck *SnapshotCheckConfig
+	// ObjectStore creates the object store clients used to mark and purge the backups of deleted BackupEntry
+	// resources and to stage the snapshots of restore requests. It is required if a deletion grace period is
+	// configured. Restore requests are only handled if it is set.
+	ObjectStore objectstore.FactoryFunc
+	// Deletion contains the settings for deferring the deletion of the backups. If its grace period is set, the
+	// backups of deleted BackupEntry resources are only marked as deleted and purged periodically after the grace
+	// period has expired.
+	Deletion DeletionConfig
 }
 
 // DefaultPredicates returns the default predicates for a controlplane reconciler.
@@ -73,7 +90,28 @@ func DefaultPredicates(typeName string, ignoreOperationAnnotation bool) []predic
 // Add creates a new BackupEntry Controller and adds it to the Manager.
 // and Start it when the Manager is Started.
 func Add(mgr manager.Manager, args AddArgs) error {
-	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator)
+	if checker, ok := args.Actuator.(SnapshotChecker); ok && args.SnapshotCheck != nil {
+		if err := addSnapshotController(mgr, NewSnapshotReconciler(checker, args.SnapshotCheck), args.Type); err != nil {
+			return err
+		}
+	}
+
+	if lister, ok := args.Actuator.(SnapshotLister); ok && args.ObjectStore != nil {
+		if err := addRestoreController(mgr, NewRestoreReconciler(lister, args.ObjectStore), args.Type); err != nil {
+			return err
+		}
+	}
+
+	if args.Deletion.GracePeriod > 0 {
+		if args.ObjectStore == nil {
+			return fmt.Errorf("an object store is required for a deletion grace period of %s", args.Deletion.GracePeriod)
+		}
+		if err := addGarbageCollectionController(mgr, NewGarbageCollector(args.ObjectStore, args.Deletion), args.Type); err != nil {
+			return err
+		}
+	}
+
+	args.ControllerOptions.Reconciler = NewReconciler(mgr, args.Actuator, args.ObjectStore, args.Deletion)
 	return add(mgr, args.ControllerOptions, args.Predicates)
 }
 
@@ -102,3 +140,51 @@ func add(mgr manager.Manager, options controller.Options, predicates []predicate
 
 	return ctrl.Watch(&source.Kind{Type: &extensionsv1alpha1.BackupEntry{}}, &handler.EnqueueRequestForObject{}, predicates...)
 }
+
+// addSnapshotController adds a new Controller checking the etcd snapshots of BackupEntry resources of the given type
+// to mgr. The periodic checks are triggered by the reconciler itself.
+func addSnapshotController(mgr manager.Manager, reconciler reconcile.Reconciler, typeName string) error {
+	ctrl, err := controller.New(SnapshotControllerName, mgr, controller.Options{Reconciler: reconciler})
+	if err != nil {
+		return err
+	}
+
+	return ctrl.Watch(
+		&source.Kind{Type: &extensionsv1alpha1.BackupEntry{}},
+		&handler.EnqueueRequestForObject{},
+		extensionspredicate.HasType(typeName),
+		extensionspredicate.GenerationChanged(),
+	)
+}
+
+// addGarbageCollectionController adds a new Controller purging the backups of deleted BackupEntry resources in the
+// buckets of BackupBucket resources of the given type to mgr. The periodic runs are triggered by the reconciler itself.
+func addGarbageCollectionController(mgr manager.Manager, reconciler reconcile.Reconciler, typeName string) error {
+	ctrl, err := controller.New(GarbageCollectorName, mgr, controller.Options{Reconciler: reconciler})
+	if err != nil {
+		return err
+	}
+
+	return ctrl.Watch(
+		&source.Kind{Type: &extensionsv1alpha1.BackupBucket{}},
+		&handler.EnqueueRequestForObject{},
+		extensionspredicate.HasType(typeName),
+		extensionspredicate.GenerationChanged(),
+	)
+}
+
+// addRestoreController adds a new Controller handling the etcd restore requests of BackupEntry resources of the
+// given type to mgr.
+func addRestoreController(mgr manager.Manager, reconciler reconcile.Reconciler, typeName string) error {
+	ctrl, err := controller.New(RestoreControllerName, mgr, controller.Options{Reconciler: reconciler})
+	if err != nil {
+		return err
+	}
+
+	return ctrl.Watch(
+		&source.Kind{Type: &extensionsv1alpha1.BackupEntry{}},
+		&handler.EnqueueRequestForObject{},
+		extensionspredicate.HasType(typeName),
+		RestoreAnnotationsChanged(),
+	)
+}
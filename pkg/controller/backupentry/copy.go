@@ -0,0 +1,139 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupentry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AnnotationCopiedFrom is the annotation of BackupEntry resources whose snapshots have been copied from another
+// BackupEntry. It carries the prefix `<bucket name>/<backupentry name>` the snapshots have been copied from.
+const AnnotationCopiedFrom = "backupentry.extensions.gardener.cloud/copied-from"
+
+// CopyArgs are the arguments for copying the snapshots of a BackupEntry to another BackupBucket.
+type CopyArgs struct {
+	// SourceClient is the client for the cluster containing the source BackupEntry.
+	SourceClient client.Client
+	// TargetClient is the client for the cluster containing the target BackupBucket.
+	TargetClient client.Client
+	// ObjectStores maps the types of BackupBucket and BackupEntry resources to the functions creating the object
+	// stores of the respective providers.
+	ObjectStores map[string]objectstore.FactoryFunc
+
+	// BackupEntryName is the name of the source BackupEntry.
+	BackupEntryName string
+	// TargetBackupBucketName is the name of the target BackupBucket.
+	TargetBackupBucketName string
+	// TargetBackupEntryName is the name of the BackupEntry the copied snapshots are meant for. The snapshots are
+	// stored with the prefix `<name>/` in the target BackupBucket. The BackupEntry is created in the cluster of the
+	// target BackupBucket unless it already exists for the target BackupBucket.
+	// If unset, the name of the source BackupEntry will be used.
+	TargetBackupEntryName string
+}
+
+// Copy copies all snapshots of the source BackupEntry to the target BackupBucket, which may belong to another region
+// or provider. Every copied snapshot is verified by its checksum. The target BackupEntry is recorded with the
+// AnnotationCopiedFrom annotation. It returns the prefix of the copied snapshots, i.e.
+// `<target bucket name>/<target backupentry name>`.
+func Copy(ctx context.Context, args CopyArgs) (string, error) {
+	be := &extensionsv1alpha1.BackupEntry{}
+	if err := args.SourceClient.Get(ctx, kutil.Key(args.BackupEntryName), be); err != nil {
+		return "", fmt.Errorf("could not get backupentry '%s': %v", args.BackupEntryName, err)
+	}
+
+	bb := &extensionsv1alpha1.BackupBucket{}
+	if err := args.TargetClient.Get(ctx, kutil.Key(args.TargetBackupBucketName), bb); err != nil {
+		return "", fmt.Errorf("could not get backupbucket '%s': %v", args.TargetBackupBucketName, err)
+	}
+
+	sourceFactory, ok := args.ObjectStores[be.Spec.Type]
+	if !ok {
+		return "", fmt.Errorf("unsupported type '%s' of backupentry '%s'", be.Spec.Type, be.Name)
+	}
+	targetFactory, ok := args.ObjectStores[bb.Spec.Type]
+	if !ok {
+		return "", fmt.Errorf("unsupported type '%s' of backupbucket '%s'", bb.Spec.Type, bb.Name)
+	}
+
+	source, err := sourceFactory(ctx, args.SourceClient, be.Spec.SecretRef, be.Spec.Region)
+	if err != nil {
+		return "", err
+	}
+
+	targetSecretRef := bb.Spec.SecretRef
+	if bb.Status.GeneratedSecretRef != nil {
+		targetSecretRef = *bb.Status.GeneratedSecretRef
+	}
+	target, err := targetFactory(ctx, args.TargetClient, targetSecretRef, bb.Spec.Region)
+	if err != nil {
+		return "", err
+	}
+
+	targetName := args.TargetBackupEntryName
+	if len(targetName) == 0 {
+		targetName = be.Name
+	}
+
+	targetBE := &extensionsv1alpha1.BackupEntry{}
+	targetBEExists := true
+	if err := args.TargetClient.Get(ctx, kutil.Key(targetName), targetBE); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return "", fmt.Errorf("could not get backupentry '%s': %v", targetName, err)
+		}
+		targetBEExists = false
+	}
+	if targetBEExists && targetBE.Spec.BucketName != bb.Name {
+		return "", fmt.Errorf("backupentry '%s' already exists for backupbucket '%s'", targetName, targetBE.Spec.BucketName)
+	}
+
+	if _, err := objectstore.CopyObjectsWithPrefix(ctx, source, be.Spec.BucketName, fmt.Sprintf("%s/", be.Name), target, bb.Name, fmt.Sprintf("%s/", targetName)); err != nil {
+		return "", fmt.Errorf("could not copy snapshots of backupentry '%s': %v", be.Name, err)
+	}
+
+	copiedFrom := fmt.Sprintf("%s/%s", be.Spec.BucketName, be.Name)
+	if targetBEExists {
+		metav1.SetMetaDataAnnotation(&targetBE.ObjectMeta, AnnotationCopiedFrom, copiedFrom)
+		if err := args.TargetClient.Update(ctx, targetBE); err != nil {
+			return "", fmt.Errorf("could not update backupentry '%s': %v", targetName, err)
+		}
+	} else {
+		targetBE = &extensionsv1alpha1.BackupEntry{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        targetName,
+				Annotations: map[string]string{AnnotationCopiedFrom: copiedFrom},
+			},
+			Spec: extensionsv1alpha1.BackupEntrySpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: bb.Spec.Type},
+				Region:      bb.Spec.Region,
+				BucketName:  bb.Name,
+				SecretRef:   targetSecretRef,
+			},
+		}
+		if err := args.TargetClient.Create(ctx, targetBE); err != nil {
+			return "", fmt.Errorf("could not create backupentry '%s': %v", targetName, err)
+		}
+	}
+
+	return fmt.Sprintf("%s/%s", bb.Name, targetName), nil
+}
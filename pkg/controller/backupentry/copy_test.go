@@ -0,0 +1,168 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupentry_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	. "github.com/gardener/gardener-extensions/pkg/controller/backupentry"
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Copy", func() {
+	var (
+		ctx = context.TODO()
+
+		sourceStore *objectstore.InMemoryObjectStore
+		targetStore *objectstore.InMemoryObjectStore
+		stores      map[string]objectstore.FactoryFunc
+
+		sourceClient client.Client
+		targetClient client.Client
+
+		be = &extensionsv1alpha1.BackupEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar--uid"},
+			Spec: extensionsv1alpha1.BackupEntrySpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "source"},
+				Region:      "eu-west-1",
+				BucketName:  "source-bucket",
+				SecretRef:   corev1.SecretReference{Name: "source", Namespace: "garden"},
+			},
+		}
+		bb = &extensionsv1alpha1.BackupBucket{
+			ObjectMeta: metav1.ObjectMeta{Name: "target-bucket"},
+			Spec: extensionsv1alpha1.BackupBucketSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "target"},
+				Region:      "us-east-1",
+				SecretRef:   corev1.SecretReference{Name: "target", Namespace: "garden"},
+			},
+			Status: extensionsv1alpha1.BackupBucketStatus{
+				GeneratedSecretRef: &corev1.SecretReference{Name: "generated-target", Namespace: "garden"},
+			},
+		}
+	)
+
+	BeforeEach(func() {
+		sourceStore = objectstore.NewInMemoryObjectStore()
+		Expect(sourceStore.CreateBucketIfNotExists(ctx, "source-bucket")).To(Succeed())
+		Expect(sourceStore.PutObject(ctx, "source-bucket", be.Name+"/v1/Backup-1/Full-1", bytes.NewReader([]byte("full")))).To(Succeed())
+
+		targetStore = objectstore.NewInMemoryObjectStore()
+		Expect(targetStore.CreateBucketIfNotExists(ctx, "target-bucket")).To(Succeed())
+
+		stores = map[string]objectstore.FactoryFunc{
+			"source": func(_ context.Context, _ client.Client, secretRef corev1.SecretReference, region string) (objectstore.ObjectStore, error) {
+				Expect(secretRef).To(Equal(be.Spec.SecretRef))
+				Expect(region).To(Equal(be.Spec.Region))
+				return sourceStore, nil
+			},
+			"target": func(_ context.Context, _ client.Client, secretRef corev1.SecretReference, region string) (objectstore.ObjectStore, error) {
+				Expect(secretRef).To(Equal(*bb.Status.GeneratedSecretRef))
+				Expect(region).To(Equal(bb.Spec.Region))
+				return targetStore, nil
+			},
+		}
+
+		sourceClient = fakeclient.NewFakeClientWithScheme(extensionscontroller.ExtensionsScheme, be.DeepCopy())
+		targetClient = fakeclient.NewFakeClientWithScheme(extensionscontroller.ExtensionsScheme, bb.DeepCopy())
+	})
+
+	It("should copy all snapshots of the backupentry", func() {
+		prefix, err := Copy(ctx, CopyArgs{
+			SourceClient:           sourceClient,
+			TargetClient:           targetClient,
+			ObjectStores:           stores,
+			BackupEntryName:        be.Name,
+			TargetBackupBucketName: bb.Name,
+			TargetBackupEntryName:  "shoot--foo--bar--new-uid",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(prefix).To(Equal("target-bucket/shoot--foo--bar--new-uid"))
+
+		body, err := targetStore.GetObject(ctx, "target-bucket", "shoot--foo--bar--new-uid/v1/Backup-1/Full-1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ioutil.ReadAll(body)).To(Equal([]byte("full")))
+
+		targetBE := &extensionsv1alpha1.BackupEntry{}
+		Expect(targetClient.Get(ctx, kutil.Key("shoot--foo--bar--new-uid"), targetBE)).To(Succeed())
+		Expect(targetBE.Annotations).To(HaveKeyWithValue(AnnotationCopiedFrom, "source-bucket/"+be.Name))
+		Expect(targetBE.Spec).To(Equal(extensionsv1alpha1.BackupEntrySpec{
+			DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "target"},
+			Region:      "us-east-1",
+			BucketName:  "target-bucket",
+			SecretRef:   *bb.Status.GeneratedSecretRef,
+		}))
+	})
+
+	It("should record the copied prefix on an existing backupentry of the target backupbucket", func() {
+		existing := be.DeepCopy()
+		existing.Spec.BucketName = bb.Name
+		targetClient = fakeclient.NewFakeClientWithScheme(extensionscontroller.ExtensionsScheme, bb.DeepCopy(), existing)
+
+		_, err := Copy(ctx, CopyArgs{
+			SourceClient:           sourceClient,
+			TargetClient:           targetClient,
+			ObjectStores:           stores,
+			BackupEntryName:        be.Name,
+			TargetBackupBucketName: bb.Name,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		targetBE := &extensionsv1alpha1.BackupEntry{}
+		Expect(targetClient.Get(ctx, kutil.Key(be.Name), targetBE)).To(Succeed())
+		Expect(targetBE.Annotations).To(HaveKeyWithValue(AnnotationCopiedFrom, "source-bucket/"+be.Name))
+		Expect(targetBE.Spec.BucketName).To(Equal(bb.Name))
+	})
+
+	It("should fail if the target backupentry exists for another backupbucket", func() {
+		targetClient = fakeclient.NewFakeClientWithScheme(extensionscontroller.ExtensionsScheme, bb.DeepCopy(), be.DeepCopy())
+
+		_, err := Copy(ctx, CopyArgs{
+			SourceClient:           sourceClient,
+			TargetClient:           targetClient,
+			ObjectStores:           stores,
+			BackupEntryName:        be.Name,
+			TargetBackupBucketName: bb.Name,
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(targetStore.ListObjectsWithPrefix(ctx, "target-bucket", "")).To(BeEmpty())
+	})
+
+	It("should fail for unsupported types", func() {
+		delete(stores, "target")
+
+		_, err := Copy(ctx, CopyArgs{
+			SourceClient:           sourceClient,
+			TargetClient:           targetClient,
+			ObjectStores:           stores,
+			BackupEntryName:        be.Name,
+			TargetBackupBucketName: bb.Name,
+		})
+		Expect(err).To(HaveOccurred())
+	})
+})
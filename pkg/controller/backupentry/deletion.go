@@ -0,0 +1,235 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupentry
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
+	"github.com/gardener/gardener-extensions/pkg/util"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+const (
+	// GarbageCollectorName is the name of the controller purging the backups of deleted BackupEntry resources
+	// after their deletion grace period has expired.
+	GarbageCollectorName = "backupentry_garbage_collector"
+
+	// AnnotationDeletionProtected is the annotation of BackupEntry resources whose deletion is blocked as long as its
+	// value is `true`.
+	AnnotationDeletionProtected = "backupentry.extensions.gardener.cloud/deletion-protected"
+
+	// DeletedPrefix is the prefix of the objects marking the backups of deleted BackupEntry resources. The marker of
+	// a BackupEntry is stored as `deleted/<backupentry name>` next to its backups; its last modification time is the
+	// time of the deletion.
+	// BackupEntry names are `<shoot technical id>--<shoot uid>`, hence a shoot that is created again with the same
+	// name gets a new BackupEntry and does not pick up the marked backups. To restore them, a BackupEntry with the
+	// name of the deleted one has to be created manually before the grace period expires.
+	DeletedPrefix = "deleted/"
+
+	// DeletionProtectedRequeuePeriod is the period after which the deletion of a BackupEntry blocked by the
+	// AnnotationDeletionProtected annotation is retried.
+	DeletionProtectedRequeuePeriod = time.Minute
+)
+
+// DeletionConfig contains the settings for deferring the deletion of the backups of BackupEntry resources.
+type DeletionConfig struct {
+	// GracePeriod is the period for which the backups of deleted BackupEntry resources are kept before they are
+	// purged. If it is zero, the backups are deleted together with the BackupEntry.
+	GracePeriod time.Duration
+	// GarbageCollectionPeriod is the period after which the buckets are checked again for backups whose grace period
+	// has expired.
+	GarbageCollectionPeriod time.Duration
+}
+
+// IsDeletionProtected returns true if the deletion of the given BackupEntry is blocked by the
+// AnnotationDeletionProtected annotation.
+func IsDeletionProtected(be *extensionsv1alpha1.BackupEntry) bool {
+	return be.Annotations[AnnotationDeletionProtected] == "true"
+}
+
+// MarkDeleted marks the backups of the BackupEntry with the given name in the bucket as deleted. They are purged by
+// CollectGarbage after the deletion grace period has expired. An existing marker is kept so that retries do not extend
+// the grace period.
+func MarkDeleted(ctx context.Context, store objectstore.ObjectStore, bucket, name string) error {
+	key := DeletedPrefix + name
+	markers, err := store.ListObjectsWithPrefix(ctx, bucket, key)
+	if err != nil {
+		return err
+	}
+	if _, ok := markers[key]; ok {
+		return nil
+	}
+	return store.PutObject(ctx, bucket, key, bytes.NewReader(nil))
+}
+
+// UnmarkDeleted removes the deletion marker of the backups of the BackupEntry with the given name in the bucket. It is
+// called when a BackupEntry with this name is reconciled, i.e. when a BackupEntry of a mistakenly deleted shoot has been
+// created again manually (see DeletedPrefix).
+func UnmarkDeleted(ctx context.Context, store objectstore.ObjectStore, bucket, name string) error {
+	return store.DeleteObject(ctx, bucket, DeletedPrefix+name)
+}
+
+// ListDeleted returns the names of the BackupEntry resources whose backups in the bucket are marked as deleted.
+func ListDeleted(ctx context.Context, store objectstore.ObjectStore, bucket string) ([]string, error) {
+	markers, err := store.ListObjectsWithPrefix(ctx, bucket, DeletedPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for key := range markers {
+		if name, ok := deletedName(key); ok {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// CollectGarbage purges the backups in the bucket that have been marked as deleted for longer than the given grace
+// period. The backups of the BackupEntry resources with the given live names are never purged, even if they are still
+// marked as deleted. The deletion markers are removed after the backups. It returns the names of the BackupEntry
+// resources whose backups have been purged.
+func CollectGarbage(ctx context.Context, store objectstore.ObjectStore, bucket string, gracePeriod time.Duration, now time.Time, live sets.String) ([]string, error) {
+	markers, err := store.ListObjectsWithPrefix(ctx, bucket, DeletedPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var purged []string
+	for key, deleted := range markers {
+		name, ok := deletedName(key)
+		if !ok || live.Has(name) || now.Sub(deleted) < gracePeriod {
+			continue
+		}
+
+		if err := store.DeleteObjectsWithPrefix(ctx, bucket, fmt.Sprintf("%s/", name)); err != nil {
+			return purged, err
+		}
+		if err := store.DeleteObject(ctx, bucket, key); err != nil {
+			return purged, err
+		}
+		purged = append(purged, name)
+	}
+	return purged, nil
+}
+
+// deletedName returns the name of the BackupEntry whose backups are marked as deleted by the object with the given key.
+func deletedName(key string) (string, bool) {
+	name := strings.TrimPrefix(key, DeletedPrefix)
+	if len(name) == 0 || strings.Contains(name, "/") {
+		return "", false
+	}
+	return name, true
+}
+
+type garbageCollector struct {
+	logger      logr.Logger
+	objectStore objectstore.FactoryFunc
+	config      DeletionConfig
+
+	ctx    context.Context
+	client client.Client
+}
+
+// NewGarbageCollector creates a new reconcile.Reconciler that periodically purges the backups of deleted BackupEntry
+// resources in the buckets of backupbucket resources of Gardener's `extensions.gardener.cloud` API group.
+func NewGarbageCollector(objectStore objectstore.FactoryFunc, config DeletionConfig) reconcile.Reconciler {
+	return &garbageCollector{
+		logger:      log.Log.WithName(GarbageCollectorName),
+		objectStore: objectStore,
+		config:      config,
+	}
+}
+
+func (r *garbageCollector) InjectClient(client client.Client) error {
+	r.client = client
+	return nil
+}
+
+func (r *garbageCollector) InjectStopChannel(stopCh <-chan struct{}) error {
+	r.ctx = util.ContextFromStopChannel(stopCh)
+	return nil
+}
+
+func (r *garbageCollector) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	bb := &extensionsv1alpha1.BackupBucket{}
+	if err := r.client.Get(r.ctx, request.NamespacedName, bb); err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	// The backups are also purged while the backupbucket is being deleted since its deletion is blocked as long as
+	// they are marked as deleted.
+	live, err := r.getLiveBackupEntryNames(bb.Name)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	secretRef := bb.Spec.SecretRef
+	if bb.Status.GeneratedSecretRef != nil {
+		secretRef = *bb.Status.GeneratedSecretRef
+	}
+	store, err := r.objectStore(r.ctx, r.client, secretRef, bb.Spec.Region)
+	if err != nil {
+		r.logger.Error(err, "Could not create object store of backupbucket", "backupbucket", bb.Name)
+		return reconcile.Result{}, err
+	}
+
+	purged, err := CollectGarbage(r.ctx, store, bb.Name, r.config.GracePeriod, time.Now(), live)
+	for _, name := range purged {
+		r.logger.Info("Purged backups of deleted backupentry", "backupbucket", bb.Name, "backupentry", name)
+	}
+	if err != nil {
+		r.logger.Error(err, "Could not purge backups of deleted backupentries", "backupbucket", bb.Name)
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.config.GarbageCollectionPeriod}, nil
+}
+
+// getLiveBackupEntryNames returns the names of the BackupEntry resources of the given bucket that are not being deleted.
+func (r *garbageCollector) getLiveBackupEntryNames(bucket string) (sets.String, error) {
+	list := &extensionsv1alpha1.BackupEntryList{}
+	if err := r.client.List(r.ctx, list); err != nil {
+		return nil, err
+	}
+
+	live := sets.NewString()
+	for _, be := range list.Items {
+		if be.Spec.BucketName == bucket && be.DeletionTimestamp == nil {
+			live.Insert(be.Name)
+		}
+	}
+	return live, nil
+}
+
+// deletionProtectedMessage returns the message describing why the deletion of the given BackupEntry is blocked.
+func deletionProtectedMessage(be *extensionsv1alpha1.BackupEntry) string {
+	return fmt.Sprintf("Deletion of backupentry is blocked by annotation %s=%s", AnnotationDeletionProtected, be.Annotations[AnnotationDeletionProtected])
+}
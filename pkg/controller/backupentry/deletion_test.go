@@ -0,0 +1,223 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupentry_test
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	. "github.com/gardener/gardener-extensions/pkg/controller/backupentry"
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+)
+
+var _ = Describe("Deletion", func() {
+	var (
+		ctx = context.TODO()
+
+		bucket = "bucket"
+		name   = "shoot--foo--bar--uid"
+		other  = "shoot--foo--baz--uid"
+
+		now   time.Time
+		store *objectstore.InMemoryObjectStore
+	)
+
+	BeforeEach(func() {
+		now = time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+		store = objectstore.NewInMemoryObjectStore()
+		store.Now = func() time.Time { return now }
+		Expect(store.CreateBucketIfNotExists(ctx, bucket)).To(Succeed())
+
+		for _, key := range []string{name + "/v1/Full-00000000-00000001-1569931200", other + "/v1/Full-00000000-00000001-1569931200"} {
+			Expect(store.PutObject(ctx, bucket, key, bytes.NewReader([]byte("snapshot")))).To(Succeed())
+		}
+	})
+
+	DescribeTable("#IsDeletionProtected",
+		func(annotations map[string]string, expected bool) {
+			be := &extensionsv1alpha1.BackupEntry{ObjectMeta: metav1.ObjectMeta{Annotations: annotations}}
+			Expect(IsDeletionProtected(be)).To(Equal(expected))
+		},
+		Entry("no annotations", nil, false),
+		Entry("annotation set to true", map[string]string{AnnotationDeletionProtected: "true"}, true),
+		Entry("annotation set to false", map[string]string{AnnotationDeletionProtected: "false"}, false),
+	)
+
+	Describe("#MarkDeleted", func() {
+		It("should mark the backups as deleted without deleting them", func() {
+			Expect(MarkDeleted(ctx, store, bucket, name)).To(Succeed())
+
+			objects, err := store.ListObjectsWithPrefix(ctx, bucket, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(objects).To(HaveLen(3))
+			Expect(objects).To(HaveKeyWithValue(DeletedPrefix+name, now))
+		})
+
+		It("should not extend the grace period when marking the backups again", func() {
+			Expect(MarkDeleted(ctx, store, bucket, name)).To(Succeed())
+			deleted := now
+
+			now = now.Add(time.Hour)
+			Expect(MarkDeleted(ctx, store, bucket, name)).To(Succeed())
+
+			Expect(store.ListObjectsWithPrefix(ctx, bucket, DeletedPrefix)).To(HaveKeyWithValue(DeletedPrefix+name, deleted))
+		})
+	})
+
+	Describe("#UnmarkDeleted", func() {
+		It("should remove the deletion marker", func() {
+			Expect(MarkDeleted(ctx, store, bucket, name)).To(Succeed())
+			Expect(UnmarkDeleted(ctx, store, bucket, name)).To(Succeed())
+
+			Expect(store.ListObjectsWithPrefix(ctx, bucket, DeletedPrefix)).To(BeEmpty())
+		})
+
+		It("should succeed if the backups are not marked as deleted", func() {
+			Expect(UnmarkDeleted(ctx, store, bucket, name)).To(Succeed())
+		})
+	})
+
+	Describe("#ListDeleted", func() {
+		It("should return the names of the backupentries whose backups are marked as deleted", func() {
+			Expect(MarkDeleted(ctx, store, bucket, name)).To(Succeed())
+
+			Expect(ListDeleted(ctx, store, bucket)).To(ConsistOf(name))
+		})
+
+		It("should return nothing if no backups are marked as deleted", func() {
+			Expect(ListDeleted(ctx, store, bucket)).To(BeEmpty())
+		})
+	})
+
+	Describe("#CollectGarbage", func() {
+		BeforeEach(func() {
+			Expect(MarkDeleted(ctx, store, bucket, name)).To(Succeed())
+		})
+
+		It("should keep the backups within the grace period", func() {
+			purged, err := CollectGarbage(ctx, store, bucket, 72*time.Hour, now.Add(71*time.Hour), sets.NewString())
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(purged).To(BeEmpty())
+			Expect(store.ListObjectsWithPrefix(ctx, bucket, "")).To(HaveLen(3))
+		})
+
+		It("should purge the backups and the marker after the grace period", func() {
+			purged, err := CollectGarbage(ctx, store, bucket, 72*time.Hour, now.Add(72*time.Hour), sets.NewString())
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(purged).To(ConsistOf(name))
+			objects, err := store.ListObjectsWithPrefix(ctx, bucket, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(objects).To(HaveLen(1))
+			Expect(objects).To(HaveKey(other + "/v1/Full-00000000-00000001-1569931200"))
+		})
+
+		It("should not purge the backups of live backupentries", func() {
+			purged, err := CollectGarbage(ctx, store, bucket, 72*time.Hour, now.Add(96*time.Hour), sets.NewString(name))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(purged).To(BeEmpty())
+			Expect(store.ListObjectsWithPrefix(ctx, bucket, "")).To(HaveLen(3))
+		})
+
+		It("should not purge the backups after the marker has been removed", func() {
+			Expect(UnmarkDeleted(ctx, store, bucket, name)).To(Succeed())
+
+			purged, err := CollectGarbage(ctx, store, bucket, 72*time.Hour, now.Add(96*time.Hour), sets.NewString())
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(purged).To(BeEmpty())
+			Expect(store.ListObjectsWithPrefix(ctx, bucket, "")).To(HaveLen(2))
+		})
+	})
+
+	Describe("#NewGarbageCollector", func() {
+		var (
+			c          client.Client
+			reconciler reconcile.Reconciler
+			config     = DeletionConfig{GracePeriod: 72 * time.Hour, GarbageCollectionPeriod: time.Hour}
+
+			bb = &extensionsv1alpha1.BackupBucket{
+				ObjectMeta: metav1.ObjectMeta{Name: bucket},
+				Spec: extensionsv1alpha1.BackupBucketSpec{
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "test"},
+					Region:      "eu-west-1",
+					SecretRef:   corev1.SecretReference{Name: "backupprovider", Namespace: "garden"},
+				},
+			}
+		)
+
+		BeforeEach(func() {
+			c = fakeclient.NewFakeClientWithScheme(extensionscontroller.ExtensionsScheme, bb.DeepCopy())
+			reconciler = NewGarbageCollector(func(_ context.Context, _ client.Client, secretRef corev1.SecretReference, region string) (objectstore.ObjectStore, error) {
+				Expect(secretRef).To(Equal(bb.Spec.SecretRef))
+				Expect(region).To(Equal(bb.Spec.Region))
+				return store, nil
+			}, config)
+			Expect(inject.ClientInto(c, reconciler)).To(BeTrue())
+			Expect(inject.StopChannelInto(make(chan struct{}), reconciler)).To(BeTrue())
+
+			Expect(MarkDeleted(ctx, store, bucket, name)).To(Succeed())
+		})
+
+		It("should purge the expired backups in the bucket and requeue", func() {
+			now = now.Add(config.GracePeriod)
+
+			result, err := reconciler.Reconcile(reconcile.Request{NamespacedName: kutil.Key(bucket)})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{RequeueAfter: config.GarbageCollectionPeriod}))
+			Expect(store.ListObjectsWithPrefix(ctx, bucket, "")).To(HaveLen(1))
+		})
+
+		It("should not purge the backups of a live backupentry", func() {
+			be := &extensionsv1alpha1.BackupEntry{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec:       extensionsv1alpha1.BackupEntrySpec{BucketName: bucket},
+			}
+			Expect(c.Create(ctx, be)).To(Succeed())
+			now = now.Add(config.GracePeriod)
+
+			_, err := reconciler.Reconcile(reconcile.Request{NamespacedName: kutil.Key(bucket)})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(store.ListObjectsWithPrefix(ctx, bucket, "")).To(HaveLen(3))
+		})
+
+		It("should ignore backupbuckets that do not exist", func() {
+			result, err := reconciler.Reconcile(reconcile.Request{NamespacedName: kutil.Key("foo")})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{}))
+		})
+	})
+})
@@ -16,6 +16,12 @@ package genericactuator
 
 import (
 	"context"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
 	"github.com/gardener/gardener-extensions/pkg/controller/backupentry"
@@ -35,6 +41,16 @@ type actuator struct {
 	backupEntryDelegate BackupEntryDelegate
 	client              client.Client
 	logger              logr.Logger
+
+	snapshotDirectoriesLock sync.Mutex
+	snapshotDirectories     map[string]snapshotDirectory
+}
+
+// snapshotDirectory is the directory of the latest full snapshot of a BackupEntry together with the time of the
+// latest snapshot found in it.
+type snapshotDirectory struct {
+	prefix string
+	latest time.Time
 }
 
 // InjectClient injects the given client into the valuesProvider.
@@ -53,6 +69,7 @@ func NewActuator(backupEntryDelegate BackupEntryDelegate, logger logr.Logger) ba
 	return &actuator{
 		logger:              logger,
 		backupEntryDelegate: backupEntryDelegate,
+		snapshotDirectories: make(map[string]snapshotDirectory),
 	}
 }
 
@@ -63,17 +80,12 @@ func (a *actuator) Reconcile(ctx context.Context, be *extensionsv1alpha1.BackupE
 
 func (a *actuator) deployEtcdBackupSecret(ctx context.Context, be *extensionsv1alpha1.BackupEntry) error {
 	shootTechnicalID, _ := backupentry.ExtractShootDetailsFromBackupEntryName(be.Name)
-	namespace := &corev1.Namespace{}
-	if err := a.client.Get(ctx, kutil.Key(shootTechnicalID), namespace); err != nil {
-		if apierrors.IsNotFound(err) {
-			a.logger.Info("SeedNamespace for shoot not found. Avoiding etcd backup secret deployment")
-			return nil
-		}
-		a.logger.Error(err, "failed to get seed namespace")
+	exists, err := a.seedNamespaceExists(ctx, shootTechnicalID)
+	if err != nil {
 		return err
 	}
-	if namespace.DeletionTimestamp != nil {
-		a.logger.Info("SeedNamespace for shoot is being terminated. Avoiding etcd backup secret deployment")
+	if !exists {
+		a.logger.Info("SeedNamespace for shoot not found or being terminated. Avoiding etcd backup secret deployment")
 		return nil
 	}
 
@@ -106,5 +118,183 @@ func (a *actuator) deployEtcdBackupSecret(ctx context.Context, be *extensionsv1a
 
 // Delete deletes the BackupEntry
 func (a *actuator) Delete(ctx context.Context, be *extensionsv1alpha1.BackupEntry) error {
+	a.forgetSnapshotDirectory(be.Name)
 	return a.backupEntryDelegate.Delete(ctx, be)
 }
+
+// GetLatestSnapshots returns the latest etcd snapshots stored for the BackupEntry. It returns nil if the seed namespace
+// of the shoot does not exist or is being terminated, or if the shoot is hibernated, as no snapshots are uploaded in
+// these cases.
+// Only the directory of the latest full snapshot is listed as long as new snapshots are uploaded to it. All objects of
+// the BackupEntry are listed if there is no new snapshot in it, e.g. because a new full snapshot has been taken.
+func (a *actuator) GetLatestSnapshots(ctx context.Context, be *extensionsv1alpha1.BackupEntry) (*backupentry.Snapshots, error) {
+	shootTechnicalID, _ := backupentry.ExtractShootDetailsFromBackupEntryName(be.Name)
+	exists, err := a.seedNamespaceExists(ctx, shootTechnicalID)
+	if err != nil || !exists {
+		a.forgetSnapshotDirectory(be.Name)
+		return nil, err
+	}
+
+	hibernated, err := a.isHibernated(ctx, shootTechnicalID)
+	if err != nil || hibernated {
+		a.forgetSnapshotDirectory(be.Name)
+		return nil, err
+	}
+
+	if directory, ok := a.getSnapshotDirectory(be.Name); ok {
+		objects, err := a.backupEntryDelegate.ListObjects(ctx, be, directory.prefix)
+		if err != nil {
+			return nil, err
+		}
+		if snapshots, _ := latestSnapshots(objects); snapshots.LatestFull != nil && latestSnapshotTime(snapshots).After(directory.latest) {
+			a.setSnapshotDirectory(be.Name, snapshotDirectory{prefix: directory.prefix, latest: latestSnapshotTime(snapshots)})
+			return snapshots, nil
+		}
+	}
+
+	objects, err := a.backupEntryDelegate.ListObjects(ctx, be, "")
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots, latestFullKey := latestSnapshots(objects)
+	if snapshots.LatestFull != nil {
+		a.setSnapshotDirectory(be.Name, snapshotDirectory{
+			prefix: strings.TrimPrefix(path.Dir(latestFullKey), be.Name+"/") + "/",
+			latest: latestSnapshotTime(snapshots),
+		})
+	} else {
+		a.forgetSnapshotDirectory(be.Name)
+	}
+	return snapshots, nil
+}
+
+// ListSnapshots returns the etcd snapshots stored for the BackupEntry.
+func (a *actuator) ListSnapshots(ctx context.Context, be *extensionsv1alpha1.BackupEntry) ([]backupentry.Snapshot, error) {
+	objects, err := a.backupEntryDelegate.ListObjects(ctx, be, "")
+	if err != nil {
+		return nil, err
+	}
+	return Snapshots(objects), nil
+}
+
+// Snapshots returns the snapshots among the given objects sorted by their time. Objects are identified as snapshots by
+// the name prefixes used by the etcd backup-restore sidecar.
+func Snapshots(objects map[string]time.Time) []backupentry.Snapshot {
+	var snapshots []backupentry.Snapshot
+	for key, lastModified := range objects {
+		name := path.Base(key)
+		snapshot := backupentry.Snapshot{Name: name, Key: key, Time: snapshotTime(name, lastModified)}
+		switch {
+		case strings.HasPrefix(snapshot.Name, fullSnapshotPrefix):
+			snapshot.Kind = backupentry.SnapshotKindFull
+		case strings.HasPrefix(snapshot.Name, deltaSnapshotPrefix):
+			snapshot.Kind = backupentry.SnapshotKindDelta
+		default:
+			continue
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].Time.Before(snapshots[j].Time)
+	})
+	return snapshots
+}
+
+// snapshotTime returns the time the snapshot with the given name has been taken. The etcd backup-restore sidecar
+// appends it in Unix time to the names of the snapshots, e.g. `Full-00000000-00000100-1569917000`. The modification
+// time of the object is only used if the name does not contain it, as it changes when the object is copied.
+func snapshotTime(name string, lastModified time.Time) time.Time {
+	parts := strings.Split(strings.SplitN(name, ".", 2)[0], "-")
+	if len(parts) == 4 {
+		if seconds, err := strconv.ParseInt(parts[3], 10, 64); err == nil && seconds > 0 {
+			return time.Unix(seconds, 0).UTC()
+		}
+	}
+	return lastModified
+}
+
+// LatestSnapshots returns the latest full and delta snapshots among the given objects. Objects are identified as
+// snapshots by the name prefixes used by the etcd backup-restore sidecar.
+func LatestSnapshots(objects map[string]time.Time) *backupentry.Snapshots {
+	snapshots, _ := latestSnapshots(objects)
+	return snapshots
+}
+
+// latestSnapshots returns the latest full and delta snapshots among the given objects together with the key of the
+// latest full snapshot.
+func latestSnapshots(objects map[string]time.Time) (*backupentry.Snapshots, string) {
+	var (
+		snapshots     = &backupentry.Snapshots{}
+		latestFullKey string
+	)
+	for key, lastModified := range objects {
+		lastModified := lastModified
+		switch name := path.Base(key); {
+		case strings.HasPrefix(name, fullSnapshotPrefix):
+			if snapshots.LatestFull == nil || lastModified.After(*snapshots.LatestFull) {
+				snapshots.LatestFull = &lastModified
+				latestFullKey = key
+			}
+		case strings.HasPrefix(name, deltaSnapshotPrefix):
+			if snapshots.LatestDelta == nil || lastModified.After(*snapshots.LatestDelta) {
+				snapshots.LatestDelta = &lastModified
+			}
+		}
+	}
+	return snapshots, latestFullKey
+}
+
+// latestSnapshotTime returns the time of the latest full or delta snapshot. The latest full snapshot must not be nil.
+func latestSnapshotTime(snapshots *backupentry.Snapshots) time.Time {
+	if snapshots.LatestDelta != nil && snapshots.LatestDelta.After(*snapshots.LatestFull) {
+		return *snapshots.LatestDelta
+	}
+	return *snapshots.LatestFull
+}
+
+func (a *actuator) getSnapshotDirectory(name string) (snapshotDirectory, bool) {
+	a.snapshotDirectoriesLock.Lock()
+	defer a.snapshotDirectoriesLock.Unlock()
+
+	directory, ok := a.snapshotDirectories[name]
+	return directory, ok
+}
+
+func (a *actuator) setSnapshotDirectory(name string, directory snapshotDirectory) {
+	a.snapshotDirectoriesLock.Lock()
+	defer a.snapshotDirectoriesLock.Unlock()
+
+	a.snapshotDirectories[name] = directory
+}
+
+func (a *actuator) forgetSnapshotDirectory(name string) {
+	a.snapshotDirectoriesLock.Lock()
+	defer a.snapshotDirectoriesLock.Unlock()
+
+	delete(a.snapshotDirectories, name)
+}
+
+func (a *actuator) seedNamespaceExists(ctx context.Context, name string) (bool, error) {
+	namespace := &corev1.Namespace{}
+	if err := a.client.Get(ctx, kutil.Key(name), namespace); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		a.logger.Error(err, "failed to get seed namespace")
+		return false, err
+	}
+	return namespace.DeletionTimestamp == nil, nil
+}
+
+func (a *actuator) isHibernated(ctx context.Context, namespace string) (bool, error) {
+	cluster, err := extensionscontroller.GetCluster(ctx, a.client, namespace)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		a.logger.Error(err, "failed to get cluster")
+		return false, err
+	}
+	return extensionscontroller.IsHibernated(cluster), nil
+}
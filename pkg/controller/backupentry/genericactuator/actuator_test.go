@@ -16,19 +16,24 @@ package genericactuator_test
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	"k8s.io/client-go/kubernetes/scheme"
 
 	"github.com/gardener/gardener-extensions/pkg/controller/backupentry"
 	"github.com/gardener/gardener-extensions/pkg/controller/backupentry/genericactuator"
 	mockgenericactuator "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/controller/backupentry/genericactuator"
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
@@ -165,4 +170,149 @@ var _ = Describe("Actuator", func() {
 			})
 		})
 	})
+
+	Describe("#GetLatestSnapshots", func() {
+		var (
+			now     = time.Now()
+			objects = map[string]time.Time{
+				be.Name + "/v1/Backup-1/Full-00000000-00000100-1":  now.Add(-3 * time.Hour),
+				be.Name + "/v1/Backup-1/Incr-00000101-00000200-2":  now.Add(-2 * time.Hour),
+				be.Name + "/v1/Backup-2/Full-00000000-00000300-3":  now.Add(-time.Hour),
+				be.Name + "/v1/Backup-2/Incr-00000301-00000400-4":  now.Add(-10 * time.Minute),
+				be.Name + "/v1/Backup-2/Incr-00000401-00000500-5":  now.Add(-5 * time.Minute),
+				be.Name + "/v1/Backup-2/Other-00000501-00000600-6": now,
+			}
+
+			s                   *runtime.Scheme
+			backupEntryDelegate *mockgenericactuator.MockBackupEntryDelegate
+			a                   backupentry.Actuator
+		)
+
+		newCluster := func(hibernated bool) *extensionsv1alpha1.Cluster {
+			shoot := &gardencorev1alpha1.Shoot{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: gardencorev1alpha1.SchemeGroupVersion.String(),
+					Kind:       "Shoot",
+				},
+				Spec: gardencorev1alpha1.ShootSpec{
+					Hibernation: &gardencorev1alpha1.Hibernation{Enabled: &hibernated},
+				},
+			}
+			raw, err := json.Marshal(shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			return &extensionsv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: shootTechnicalID},
+				Spec:       extensionsv1alpha1.ClusterSpec{Shoot: runtime.RawExtension{Raw: raw}},
+			}
+		}
+
+		newActuator := func(objects ...runtime.Object) {
+			a = genericactuator.NewActuator(backupEntryDelegate, logger)
+			Expect(a.(inject.Client).InjectClient(fakeclient.NewFakeClientWithScheme(s, objects...))).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			s = runtime.NewScheme()
+			Expect(scheme.AddToScheme(s)).To(Succeed())
+			Expect(extensionsv1alpha1.AddToScheme(s)).To(Succeed())
+
+			backupEntryDelegate = mockgenericactuator.NewMockBackupEntryDelegate(ctrl)
+		})
+
+		It("should return the latest full and delta snapshots", func() {
+			backupEntryDelegate.EXPECT().ListObjects(context.TODO(), be, "").Return(objects, nil)
+			newActuator(seedNamespace, newCluster(false))
+
+			snapshots, err := a.(backupentry.SnapshotChecker).GetLatestSnapshots(context.TODO(), be)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshots.LatestFull).To(PointTo(Equal(now.Add(-time.Hour))))
+			Expect(snapshots.LatestDelta).To(PointTo(Equal(now.Add(-5 * time.Minute))))
+		})
+
+		It("should only list the directory of the latest full snapshot as long as new snapshots are uploaded to it", func() {
+			newObjects := map[string]time.Time{
+				be.Name + "/v1/Backup-2/Full-00000000-00000300-3": now.Add(-time.Hour),
+				be.Name + "/v1/Backup-2/Incr-00000501-00000600-6": now,
+			}
+			gomock.InOrder(
+				backupEntryDelegate.EXPECT().ListObjects(context.TODO(), be, "").Return(objects, nil),
+				backupEntryDelegate.EXPECT().ListObjects(context.TODO(), be, "v1/Backup-2/").Return(newObjects, nil),
+			)
+			newActuator(seedNamespace, newCluster(false))
+
+			_, err := a.(backupentry.SnapshotChecker).GetLatestSnapshots(context.TODO(), be)
+			Expect(err).NotTo(HaveOccurred())
+			snapshots, err := a.(backupentry.SnapshotChecker).GetLatestSnapshots(context.TODO(), be)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshots.LatestFull).To(PointTo(Equal(now.Add(-time.Hour))))
+			Expect(snapshots.LatestDelta).To(PointTo(Equal(now)))
+		})
+
+		It("should list all objects if there is no new snapshot in the directory of the latest full snapshot", func() {
+			newObjects := map[string]time.Time{
+				be.Name + "/v1/Backup-2/Full-00000000-00000300-3": now.Add(-time.Hour),
+				be.Name + "/v1/Backup-3/Full-00000000-00000600-6": now,
+			}
+			gomock.InOrder(
+				backupEntryDelegate.EXPECT().ListObjects(context.TODO(), be, "").Return(objects, nil),
+				backupEntryDelegate.EXPECT().ListObjects(context.TODO(), be, "v1/Backup-2/").Return(objects, nil),
+				backupEntryDelegate.EXPECT().ListObjects(context.TODO(), be, "").Return(newObjects, nil),
+			)
+			newActuator(seedNamespace, newCluster(false))
+
+			_, err := a.(backupentry.SnapshotChecker).GetLatestSnapshots(context.TODO(), be)
+			Expect(err).NotTo(HaveOccurred())
+			snapshots, err := a.(backupentry.SnapshotChecker).GetLatestSnapshots(context.TODO(), be)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshots.LatestFull).To(PointTo(Equal(now)))
+		})
+
+		It("should return no snapshots if the seed namespace does not exist", func() {
+			newActuator()
+
+			snapshots, err := a.(backupentry.SnapshotChecker).GetLatestSnapshots(context.TODO(), be)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshots).To(BeNil())
+		})
+
+		It("should return no snapshots if the shoot is hibernated", func() {
+			newActuator(seedNamespace, newCluster(true))
+
+			snapshots, err := a.(backupentry.SnapshotChecker).GetLatestSnapshots(context.TODO(), be)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshots).To(BeNil())
+		})
+
+		It("should return empty snapshots if there are no objects", func() {
+			Expect(genericactuator.LatestSnapshots(nil)).To(Equal(&backupentry.Snapshots{}))
+		})
+	})
+
+	Describe("#ListSnapshots", func() {
+		var (
+			now     = time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+			objects = map[string]time.Time{
+				be.Name + "/etcd-main/v1/Backup-1569920400/Incr-00000101-00000200-1569924000":  now,
+				be.Name + "/etcd-main/v1/Backup-1569920400/Full-00000000-00000100-1569920400":  now,
+				be.Name + "/etcd-main/v1/Backup-1569920400/Incr-00000201-00000300":             now.Add(-time.Hour),
+				be.Name + "/etcd-main/v1/Backup-1569920400/Other-00000301-00000400-1569931200": now,
+			}
+		)
+
+		It("should return the snapshots sorted by the time they have been taken", func() {
+			backupEntryDelegate := mockgenericactuator.NewMockBackupEntryDelegate(ctrl)
+			backupEntryDelegate.EXPECT().ListObjects(context.TODO(), be, "").Return(objects, nil)
+
+			a := genericactuator.NewActuator(backupEntryDelegate, logger)
+
+			snapshots, err := a.(backupentry.SnapshotLister).ListSnapshots(context.TODO(), be)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshots).To(Equal([]backupentry.Snapshot{
+				{Name: "Full-00000000-00000100-1569920400", Key: be.Name + "/etcd-main/v1/Backup-1569920400/Full-00000000-00000100-1569920400", Kind: backupentry.SnapshotKindFull, Time: now.Add(-3 * time.Hour)},
+				{Name: "Incr-00000101-00000200-1569924000", Key: be.Name + "/etcd-main/v1/Backup-1569920400/Incr-00000101-00000200-1569924000", Kind: backupentry.SnapshotKindDelta, Time: now.Add(-2 * time.Hour)},
+				{Name: "Incr-00000201-00000300", Key: be.Name + "/etcd-main/v1/Backup-1569920400/Incr-00000201-00000300", Kind: backupentry.SnapshotKindDelta, Time: now.Add(-time.Hour)},
+			}))
+		})
+	})
 })
@@ -16,6 +16,7 @@ package genericactuator
 
 import (
 	"context"
+	"time"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 )
@@ -25,6 +26,9 @@ const (
 	EtcdBackupSecretName string = "etcd-backup"
 
 	backupBucketName string = "bucketName"
+
+	fullSnapshotPrefix  = "Full-"
+	deltaSnapshotPrefix = "Incr-"
 )
 
 // BackupEntryDelegate preforms provider specific operation with BackupBucket resources.
@@ -33,4 +37,7 @@ type BackupEntryDelegate interface {
 	Delete(context.Context, *extensionsv1alpha1.BackupEntry) error
 	// GetETCDSecretData returns the updated secret data as per provider requirement.
 	GetETCDSecretData(context.Context, *extensionsv1alpha1.BackupEntry, map[string][]byte) (map[string][]byte, error)
+	// ListObjects returns the keys of the objects stored for the BackupEntry whose keys relative to the directory of
+	// the BackupEntry start with the given prefix together with their last modification time.
+	ListObjects(context.Context, *extensionsv1alpha1.BackupEntry, string) (map[string]time.Time, error)
 }
@@ -45,12 +45,15 @@ func (m *secretToBackupEntryMapper) Map(obj handler.MapObject) []reconcile.Reque
 	}
 
 	backupEntryList := &extensionsv1alpha1.BackupEntryList{}
-	if err := m.client.List(context.TODO(), backupEntryList, client.MatchingField("spec.secretRef.name", secret.Name), client.MatchingField("spec.secretRef.namespace", secret.Namespace)); err != nil {
+	if err := m.client.List(context.TODO(), backupEntryList); err != nil {
 		return nil
 	}
 
 	var requests []reconcile.Request
 	for _, backupEntry := range backupEntryList.Items {
+		if backupEntry.Spec.SecretRef.Name != secret.Name || backupEntry.Spec.SecretRef.Namespace != secret.Namespace {
+			continue
+		}
 		if !extensionspredicate.EvalGeneric(&backupEntry, m.predicates...) {
 			continue
 		}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
 	"github.com/gardener/gardener-extensions/pkg/util"
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
@@ -44,8 +45,10 @@ const (
 )
 
 type reconciler struct {
-	logger   logr.Logger
-	actuator Actuator
+	logger      logr.Logger
+	actuator    Actuator
+	objectStore objectstore.FactoryFunc
+	deletion    DeletionConfig
 
 	ctx      context.Context
 	client   client.Client
@@ -54,13 +57,17 @@ type reconciler struct {
 
 // NewReconciler creates a new reconcile.Reconciler that reconciles
 // backupentry resources of Gardener's `extensions.gardener.cloud` API group.
-func NewReconciler(mgr manager.Manager, actuator Actuator) reconcile.Reconciler {
+// If the deletion grace period is set, the backups of deleted backupentries are
+// only marked as deleted in the object store created by the given factory.
+func NewReconciler(mgr manager.Manager, actuator Actuator, objectStore objectstore.FactoryFunc, deletion DeletionConfig) reconcile.Reconciler {
 	return extensionscontroller.OperationAnnotationWrapper(
 		&extensionsv1alpha1.BackupEntry{},
 		&reconciler{
-			logger:   log.Log.WithName(ControllerName),
-			actuator: actuator,
-			recorder: mgr.GetEventRecorderFor(ControllerName),
+			logger:      log.Log.WithName(ControllerName),
+			actuator:    actuator,
+			objectStore: objectStore,
+			deletion:    deletion,
+			recorder:    mgr.GetEventRecorderFor(ControllerName),
 		})
 }
 
@@ -122,6 +129,15 @@ func (r *reconciler) reconcile(ctx context.Context, be *extensionsv1alpha1.Backu
 		return extensionscontroller.ReconcileErr(err)
 	}
 
+	if r.deletion.GracePeriod > 0 {
+		if err := r.unmarkDeleted(ctx, be); err != nil {
+			msg := "Error removing deletion marker of backupentry"
+			_ = r.updateStatusError(ctx, err, be, operationType, msg)
+			r.logger.Error(err, msg, "backupentry", be.Name)
+			return reconcile.Result{}, err
+		}
+	}
+
 	msg := "Successfully reconciled backupentry"
 	r.logger.Info(msg, "backupentry", be.Name)
 	r.recorder.Event(be, corev1.EventTypeNormal, EventBackupEntryReconciliation, msg)
@@ -144,13 +160,25 @@ func (r *reconciler) delete(ctx context.Context, be *extensionsv1alpha1.BackupEn
 	}
 
 	operationType := gardencorev1alpha1helper.ComputeOperationType(be.ObjectMeta, be.Status.LastOperation)
+
+	if IsDeletionProtected(be) {
+		// Removing the annotation does not change the generation, hence the deletion is retried periodically.
+		msg := deletionProtectedMessage(be)
+		r.logger.Info(msg, "backupentry", be.Name)
+		r.recorder.Event(be, corev1.EventTypeWarning, EventBackupEntryDeletion, msg)
+		if err := r.updateStatusProcessing(ctx, be, operationType, msg); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{RequeueAfter: DeletionProtectedRequeuePeriod}, nil
+	}
+
 	if err := r.updateStatusProcessing(ctx, be, operationType, "Deleting the backupentry"); err != nil {
 		return reconcile.Result{}, err
 	}
 
 	r.logger.Info("Starting the deletion of backupentry", "backupentry", be.Name)
 	r.recorder.Event(be, corev1.EventTypeNormal, EventBackupEntryDeletion, "Deleting the backupentry")
-	if err := r.actuator.Delete(r.ctx, be); err != nil {
+	if err := r.deleteBackups(r.ctx, be); err != nil {
 		msg := "Error deleting backupentry"
 		r.recorder.Eventf(be, corev1.EventTypeWarning, EventBackupEntryDeletion, "%s: %+v", msg, err)
 		_ = r.updateStatusError(ctx, extensionscontroller.ReconcileErrCauseOrErr(err), be, operationType, msg)
@@ -184,6 +212,29 @@ func (r *reconciler) delete(ctx context.Context, be *extensionsv1alpha1.BackupEn
 	return reconcile.Result{}, nil
 }
 
+// deleteBackups deletes the backups of the given backupentry. If a deletion grace period is configured, they are only
+// marked as deleted and purged by the garbage collector once the grace period has expired.
+func (r *reconciler) deleteBackups(ctx context.Context, be *extensionsv1alpha1.BackupEntry) error {
+	if r.deletion.GracePeriod <= 0 {
+		return r.actuator.Delete(ctx, be)
+	}
+
+	store, err := r.objectStore(ctx, r.client, be.Spec.SecretRef, be.Spec.Region)
+	if err != nil {
+		return err
+	}
+	r.logger.Info("Marking backups of backupentry as deleted", "backupentry", be.Name, "gracePeriod", r.deletion.GracePeriod)
+	return MarkDeleted(ctx, store, be.Spec.BucketName, be.Name)
+}
+
+func (r *reconciler) unmarkDeleted(ctx context.Context, be *extensionsv1alpha1.BackupEntry) error {
+	store, err := r.objectStore(ctx, r.client, be.Spec.SecretRef, be.Spec.Region)
+	if err != nil {
+		return err
+	}
+	return UnmarkDeleted(ctx, store, be.Spec.BucketName, be.Name)
+}
+
 func (r *reconciler) updateStatusProcessing(ctx context.Context, be *extensionsv1alpha1.BackupEntry, lastOperationType gardencorev1alpha1.LastOperationType, description string) error {
 	return extensionscontroller.TryUpdateStatus(ctx, retry.DefaultBackoff, r.client, be, func() error {
 		be.Status.LastOperation = extensionscontroller.LastOperation(lastOperationType, gardencorev1alpha1.LastOperationStateProcessing, 1, description)
@@ -0,0 +1,516 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupentry
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
+	"github.com/gardener/gardener-extensions/pkg/util"
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/operation/common"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/go-logr/logr"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+// Restores are requested and rolled back by operators with access to the BackupEntry resources of the seed, which are
+// not accessible to shoot owners, as follows:
+//
+//  1. The BackupEntry is annotated with either AnnotationRestoreSnapshot or AnnotationRestoreTimestamp. The restore
+//     controller validates the request against the snapshots in the bucket and stages the needed snapshots in a new
+//     store prefix recorded in the AnnotationStagedStorePrefix annotation. The etcd backup-restore sidecar keeps
+//     using its current store prefix, so a staged restore can be cancelled by removing the request annotation.
+//  2. The operator scales the etcd-main StatefulSet of the shoot down and deletes its data volume. The restore
+//     controller then activates the staged store prefix by moving it to the AnnotationStorePrefix annotation.
+//  3. Once the etcd-main StatefulSet is reconciled and scaled up again, the backup-restore sidecar uses the activated
+//     store prefix. As the data directory of etcd does not exist, etcd-backup-restore restores etcd from the latest
+//     snapshots stored under it, i.e. the staged snapshots. Later backups are stored under the same prefix.
+//
+// A restore is rolled back by restoring the latest snapshot taken before it. Its snapshots are kept under the previous
+// store prefix and are requested by their name in the same way.
+const (
+	// RestoreControllerName is the name of the controller handling the etcd restore requests of BackupEntry
+	// resources.
+	RestoreControllerName = "backupentry_restore_controller"
+
+	// AnnotationRestoreSnapshot is the annotation of BackupEntry resources requesting to restore etcd from the snapshot
+	// with the given name.
+	AnnotationRestoreSnapshot = "backupentry.extensions.gardener.cloud/restore-snapshot"
+	// AnnotationRestoreTimestamp is the annotation of BackupEntry resources requesting to restore etcd to the given
+	// point in time in RFC 3339 format.
+	AnnotationRestoreTimestamp = "backupentry.extensions.gardener.cloud/restore-timestamp"
+	// AnnotationRestorePoint is the annotation of BackupEntry resources carrying the restore request whose snapshots
+	// have been staged. It is maintained by the restore controller and must not be set manually.
+	AnnotationRestorePoint = "backupentry.extensions.gardener.cloud/restore-point"
+	// AnnotationStagedStorePrefix is the annotation of BackupEntry resources carrying the store prefix the snapshots of
+	// the restore point have been staged in as long as it has not been activated. It is maintained by the restore
+	// controller and must not be set manually.
+	AnnotationStagedStorePrefix = "backupentry.extensions.gardener.cloud/staged-store-prefix"
+	// AnnotationStorePrefix is the annotation of BackupEntry resources carrying the store prefix of the etcd backups
+	// relative to the name of the BackupEntry. It is set by the restore controller to the staged store prefix once the
+	// data volume of etcd has been deleted and must not be set manually.
+	AnnotationStorePrefix = "backupentry.extensions.gardener.cloud/store-prefix"
+
+	// RestoreStorePrefixPrefix is the prefix of the store prefixes the snapshots of restore requests are staged in.
+	RestoreStorePrefixPrefix = "restore-"
+
+	// RestoreActivationRequeuePeriod is the period after which it is checked again whether the data volume of etcd
+	// has been deleted, so that the staged store prefix can be activated.
+	RestoreActivationRequeuePeriod = time.Minute
+
+	// ConditionTypeRestorePointAvailable is the type of the BackupEntry condition indicating whether the snapshots
+	// needed for the requested restore exist.
+	ConditionTypeRestorePointAvailable gardencorev1alpha1.ConditionType = "RestorePointAvailable"
+
+	// etcdMainVolumeClaimName is the name of the PersistentVolumeClaim of the data volume of the etcd-main StatefulSet.
+	etcdMainVolumeClaimName = "main-etcd-etcd-main-0"
+)
+
+// RestoreRequest is a request to restore etcd either from a snapshot or to a point in time.
+type RestoreRequest struct {
+	// Snapshot is the name of the snapshot to restore from.
+	Snapshot string
+	// Timestamp is the point in time to restore to.
+	Timestamp *time.Time
+}
+
+// String returns the snapshot name or the timestamp in RFC 3339 format of the RestoreRequest.
+func (r *RestoreRequest) String() string {
+	if r.Timestamp != nil {
+		return r.Timestamp.UTC().Format(time.RFC3339)
+	}
+	return r.Snapshot
+}
+
+// GetRestoreRequest returns the restore request of the given BackupEntry. It returns nil if no restore is requested.
+func GetRestoreRequest(be *extensionsv1alpha1.BackupEntry) (*RestoreRequest, error) {
+	snapshot, hasSnapshot := be.Annotations[AnnotationRestoreSnapshot]
+	timestamp, hasTimestamp := be.Annotations[AnnotationRestoreTimestamp]
+
+	switch {
+	case hasSnapshot && hasTimestamp:
+		return nil, fmt.Errorf("only one of the annotations %s and %s may be set", AnnotationRestoreSnapshot, AnnotationRestoreTimestamp)
+	case hasSnapshot:
+		if len(snapshot) == 0 {
+			return nil, fmt.Errorf("annotation %s must not be empty", AnnotationRestoreSnapshot)
+		}
+		return &RestoreRequest{Snapshot: snapshot}, nil
+	case hasTimestamp:
+		t, err := time.Parse(time.RFC3339, timestamp)
+		if err != nil {
+			return nil, fmt.Errorf("annotation %s must be a timestamp in RFC 3339 format: %v", AnnotationRestoreTimestamp, err)
+		}
+		return &RestoreRequest{Timestamp: &t}, nil
+	}
+	return nil, nil
+}
+
+// GetRestorePoint returns the restore request of the given BackupEntry whose snapshots have been staged. It returns nil
+// if no snapshots have been staged for the current restore request.
+func GetRestorePoint(be *extensionsv1alpha1.BackupEntry) *RestoreRequest {
+	restorePoint, ok := be.Annotations[AnnotationRestorePoint]
+	if !ok || len(restorePoint) == 0 {
+		return nil
+	}
+	if t, err := time.Parse(time.RFC3339, restorePoint); err == nil {
+		return &RestoreRequest{Timestamp: &t}
+	}
+	return &RestoreRequest{Snapshot: restorePoint}
+}
+
+// ValidateRestoreRequest validates that the given snapshots contain the snapshots needed for the given restore
+// request. See GetRestoreSnapshots for the requirements.
+func ValidateRestoreRequest(request *RestoreRequest, snapshots []Snapshot, now time.Time) error {
+	_, err := GetRestoreSnapshots(request, snapshots, now)
+	return err
+}
+
+// GetRestoreSnapshots returns the snapshots needed for the given restore request, i.e. a full snapshot taken before the
+// restore point and the delta snapshots taken after it up to the restore point. The backup-restore sidecar stores every
+// full snapshot together with its delta snapshots, hence only delta snapshots stored next to the full snapshot are
+// considered. A point in time can only be restored if the delta snapshots reach it, otherwise the changes made between
+// the last delta snapshot and the point in time would be lost.
+func GetRestoreSnapshots(request *RestoreRequest, snapshots []Snapshot, now time.Time) ([]Snapshot, error) {
+	if request.Timestamp == nil {
+		snapshot := findSnapshot(snapshots, request.Snapshot)
+		if snapshot == nil {
+			return nil, fmt.Errorf("snapshot %s does not exist", request.Snapshot)
+		}
+		for i := len(snapshots) - 1; i >= 0; i-- {
+			if full := snapshots[i]; isBaseSnapshot(full, path.Dir(snapshot.Key), snapshot.Time) {
+				return snapshotsUntil(snapshots, full, snapshot.Time), nil
+			}
+		}
+		return nil, fmt.Errorf("no full snapshot exists before %s", request.Snapshot)
+	}
+
+	until := *request.Timestamp
+	if until.After(now) {
+		return nil, fmt.Errorf("restore timestamp %s is in the future", request)
+	}
+
+	var latest *Snapshot
+	for i := len(snapshots) - 1; i >= 0; i-- {
+		full := snapshots[i]
+		if !isBaseSnapshot(full, "", until) {
+			continue
+		}
+		if covers(snapshots, full, until) {
+			return snapshotsUntil(snapshots, full, until), nil
+		}
+		if latest == nil {
+			latest = lastSnapshotOf(snapshots, full)
+		}
+	}
+	if latest == nil {
+		return nil, fmt.Errorf("no full snapshot exists before %s", request)
+	}
+	return nil, fmt.Errorf("the delta snapshots only cover the time up to %s, not %s", latest.Time.UTC().Format(time.RFC3339), request)
+}
+
+func findSnapshot(snapshots []Snapshot, name string) *Snapshot {
+	for _, snapshot := range snapshots {
+		if snapshot.Name == name {
+			return &snapshot
+		}
+	}
+	return nil
+}
+
+// isBaseSnapshot returns true if the given snapshot is a full snapshot taken not after the given time. If dir is not
+// empty, the snapshot must be stored in this directory.
+func isBaseSnapshot(snapshot Snapshot, dir string, until time.Time) bool {
+	return snapshot.Kind == SnapshotKindFull && !snapshot.Time.After(until) && (len(dir) == 0 || path.Dir(snapshot.Key) == dir)
+}
+
+// snapshotsUntil returns the given full snapshot and the delta snapshots based on it up to the given time.
+func snapshotsUntil(snapshots []Snapshot, full Snapshot, until time.Time) []Snapshot {
+	var result []Snapshot
+	for _, snapshot := range chainOf(snapshots, full) {
+		if !snapshot.Time.After(until) {
+			result = append(result, snapshot)
+		}
+	}
+	return result
+}
+
+// covers returns true if the given full snapshot or a delta snapshot based on it has been taken at or after the given
+// time.
+func covers(snapshots []Snapshot, full Snapshot, until time.Time) bool {
+	return !lastSnapshotOf(snapshots, full).Time.Before(until)
+}
+
+// lastSnapshotOf returns the last snapshot of the given full snapshot and the delta snapshots based on it.
+func lastSnapshotOf(snapshots []Snapshot, full Snapshot) *Snapshot {
+	chain := chainOf(snapshots, full)
+	return &chain[len(chain)-1]
+}
+
+// chainOf returns the given full snapshot and the delta snapshots based on it, i.e. the delta snapshots stored next to
+// it that have been taken after it and before the next full snapshot stored next to it.
+func chainOf(snapshots []Snapshot, full Snapshot) []Snapshot {
+	var (
+		dir   = path.Dir(full.Key)
+		chain = []Snapshot{full}
+		next  *time.Time
+	)
+	for _, snapshot := range snapshots {
+		if snapshot.Kind == SnapshotKindFull && path.Dir(snapshot.Key) == dir && snapshot.Time.After(full.Time) && (next == nil || snapshot.Time.Before(*next)) {
+			t := snapshot.Time
+			next = &t
+		}
+	}
+	for _, snapshot := range snapshots {
+		if snapshot.Kind == SnapshotKindDelta && path.Dir(snapshot.Key) == dir && snapshot.Time.After(full.Time) && (next == nil || snapshot.Time.Before(*next)) {
+			chain = append(chain, snapshot)
+		}
+	}
+	sort.SliceStable(chain, func(i, j int) bool { return chain[i].Time.Before(chain[j].Time) })
+	return chain
+}
+
+// NewRestoreStorePrefix returns a new store prefix for the snapshots of a restore staged at the given time.
+func NewRestoreStorePrefix(now time.Time) string {
+	return RestoreStorePrefixPrefix + now.UTC().Format("20060102150405")
+}
+
+// GetStorePrefix returns the prefix of the keys of the etcd backups of the given BackupEntry. It is the name of the
+// BackupEntry, followed by the store prefix of the last restore if etcd has been restored.
+func GetStorePrefix(be *extensionsv1alpha1.BackupEntry) string {
+	return path.Join(be.Name, be.Annotations[AnnotationStorePrefix])
+}
+
+// GetStorePrefixByName returns the prefix of the keys of the etcd backups of the BackupEntry with the given name (see
+// GetStorePrefix). If the BackupEntry does not exist, it returns its name.
+func GetStorePrefixByName(ctx context.Context, c client.Client, name string) (string, error) {
+	be := &extensionsv1alpha1.BackupEntry{}
+	if err := c.Get(ctx, kutil.Key(name), be); err != nil {
+		if errors.IsNotFound(err) {
+			return name, nil
+		}
+		return "", fmt.Errorf("could not get backupentry '%s': %v", name, err)
+	}
+	return GetStorePrefix(be), nil
+}
+
+// StageRestore copies the given snapshots of the BackupEntry with the given name in the bucket to the given store
+// prefix. etcd-backup-restore restores etcd from the latest snapshots stored under its store prefix if the data
+// directory of etcd does not exist. The keys of the snapshots relative to their store prefix are kept.
+func StageRestore(ctx context.Context, store objectstore.ObjectStore, bucket, name, storePrefix string, snapshots []Snapshot) error {
+	for _, snapshot := range snapshots {
+		key := path.Join(name, storePrefix, relativeKey(name, snapshot.Key))
+		if err := objectstore.CopyObject(ctx, store, bucket, snapshot.Key, store, bucket, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// relativeKey returns the given key relative to the store prefix it has been stored with.
+func relativeKey(name, key string) string {
+	key = strings.TrimPrefix(key, name+"/")
+	if parts := strings.SplitN(key, "/", 2); len(parts) == 2 && strings.HasPrefix(parts[0], RestoreStorePrefixPrefix) {
+		return parts[1]
+	}
+	return key
+}
+
+// RestoreAnnotationsChanged returns a predicate that matches created BackupEntry resources and updates changing the
+// restore annotations. Changes of annotations do not change the generation of the BackupEntry.
+func RestoreAnnotationsChanged() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool {
+			return true
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			for _, key := range []string{AnnotationRestoreSnapshot, AnnotationRestoreTimestamp, AnnotationRestorePoint, AnnotationStagedStorePrefix} {
+				if e.MetaOld.GetAnnotations()[key] != e.MetaNew.GetAnnotations()[key] {
+					return true
+				}
+			}
+			return false
+		},
+		DeleteFunc: func(event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
+type restoreReconciler struct {
+	logger      logr.Logger
+	lister      SnapshotLister
+	objectStore objectstore.FactoryFunc
+
+	ctx    context.Context
+	client client.Client
+}
+
+// NewRestoreReconciler creates a new reconcile.Reconciler that handles the etcd restore requests of backupentry
+// resources of Gardener's `extensions.gardener.cloud` API group. The snapshots of valid requests are staged in a new
+// store prefix that is activated once the data volume of etcd has been deleted (see AnnotationRestoreSnapshot).
+func NewRestoreReconciler(lister SnapshotLister, objectStore objectstore.FactoryFunc) reconcile.Reconciler {
+	return &restoreReconciler{
+		logger:      log.Log.WithName(RestoreControllerName),
+		lister:      lister,
+		objectStore: objectStore,
+	}
+}
+
+func (r *restoreReconciler) InjectFunc(f inject.Func) error {
+	return f(r.lister)
+}
+
+func (r *restoreReconciler) InjectClient(client client.Client) error {
+	r.client = client
+	return nil
+}
+
+func (r *restoreReconciler) InjectStopChannel(stopCh <-chan struct{}) error {
+	r.ctx = util.ContextFromStopChannel(stopCh)
+	return nil
+}
+
+func (r *restoreReconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	be := &extensionsv1alpha1.BackupEntry{}
+	if err := r.client.Get(r.ctx, request.NamespacedName, be); err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if be.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	restoreRequest, err := GetRestoreRequest(be)
+	if restoreRequest == nil && err == nil {
+		return reconcile.Result{}, r.removeRestorePoint(be)
+	}
+
+	condition := gardencorev1alpha1helper.GetOrInitCondition(be.Status.Conditions, ConditionTypeRestorePointAvailable)
+	if err == nil && be.Annotations[AnnotationRestorePoint] == restoreRequest.String() {
+		// The snapshots of this request have already been staged. Staging them again would restore etcd once more.
+		if _, ok := be.Annotations[AnnotationStagedStorePrefix]; !ok {
+			return reconcile.Result{}, nil
+		}
+		return r.activateRestore(be, condition)
+	}
+
+	if err != nil {
+		condition = gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "InvalidRestoreRequest", err.Error())
+		return reconcile.Result{}, r.rejectRestoreRequest(be, condition)
+	}
+
+	snapshots, err := r.lister.ListSnapshots(r.ctx, be)
+	if err != nil {
+		r.logger.Error(err, "Could not list the snapshots of backupentry", "backupentry", be.Name)
+		_ = r.updateCondition(be, gardencorev1alpha1helper.UpdatedConditionUnknownError(condition, err))
+		return reconcile.Result{}, err
+	}
+
+	restoreSnapshots, err := GetRestoreSnapshots(restoreRequest, snapshots, time.Now())
+	if err != nil {
+		condition = gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "RestorePointUnavailable", err.Error())
+		return reconcile.Result{}, r.rejectRestoreRequest(be, condition)
+	}
+
+	storePrefix := NewRestoreStorePrefix(time.Now())
+	if err := r.stageRestore(be, storePrefix, restoreSnapshots); err != nil {
+		r.logger.Error(err, "Could not stage the snapshots of backupentry", "backupentry", be.Name)
+		_ = r.updateCondition(be, gardencorev1alpha1helper.UpdatedConditionUnknownError(condition, err))
+		return reconcile.Result{}, err
+	}
+
+	r.logger.Info("Staged snapshots of backupentry for restore", "backupentry", be.Name, "restorePoint", restoreRequest.String(), "storePrefix", storePrefix)
+	if err := extensionscontroller.TryUpdate(r.ctx, retry.DefaultBackoff, r.client, be, func() error {
+		if be.Annotations == nil {
+			be.Annotations = map[string]string{}
+		}
+		be.Annotations[AnnotationRestorePoint] = restoreRequest.String()
+		be.Annotations[AnnotationStagedStorePrefix] = storePrefix
+		return nil
+	}); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return r.activateRestore(be, condition)
+}
+
+// activateRestore activates the staged store prefix of the given BackupEntry once the data volume of etcd has been
+// deleted. Otherwise, the backup-restore sidecar would store the snapshots of the current data in the staged store
+// prefix instead of restoring etcd from it.
+func (r *restoreReconciler) activateRestore(be *extensionsv1alpha1.BackupEntry, condition gardencorev1alpha1.Condition) (reconcile.Result, error) {
+	storePrefix := be.Annotations[AnnotationStagedStorePrefix]
+	restorePoint := be.Annotations[AnnotationRestorePoint]
+
+	namespace, _ := common.ExtractShootDetailsFromBackupEntryName(be.Name)
+	deleted, err := r.isVolumeDeleted(namespace)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if !deleted {
+		message := fmt.Sprintf("The snapshots up to %s have been staged in %s. To restore etcd from them, scale the StatefulSet %s in namespace %s down and delete the PersistentVolumeClaim %s.",
+			restorePoint, storePrefix, v1alpha1constants.StatefulSetNameETCDMain, namespace, etcdMainVolumeClaimName)
+		condition = gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "RestorePointStaged", message)
+		return reconcile.Result{RequeueAfter: RestoreActivationRequeuePeriod}, r.updateCondition(be, condition)
+	}
+
+	r.logger.Info("Activated staged snapshots of backupentry", "backupentry", be.Name, "restorePoint", restorePoint, "storePrefix", storePrefix)
+	if err := extensionscontroller.TryUpdate(r.ctx, retry.DefaultBackoff, r.client, be, func() error {
+		be.Annotations[AnnotationStorePrefix] = storePrefix
+		delete(be.Annotations, AnnotationStagedStorePrefix)
+		return nil
+	}); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	message := fmt.Sprintf("The snapshots up to %s in %s have been activated. etcd is restored from them when the StatefulSet %s is reconciled and scaled up.", restorePoint, storePrefix, v1alpha1constants.StatefulSetNameETCDMain)
+	condition = gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "RestorePointActivated", message)
+	return reconcile.Result{}, r.updateCondition(be, condition)
+}
+
+// isVolumeDeleted returns true if the PersistentVolumeClaim of the data volume of etcd in the given namespace does not
+// exist.
+func (r *restoreReconciler) isVolumeDeleted(namespace string) (bool, error) {
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := r.client.Get(r.ctx, kutil.Key(namespace, etcdMainVolumeClaimName), pvc); err != nil {
+		if errors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	return false, nil
+}
+
+func (r *restoreReconciler) stageRestore(be *extensionsv1alpha1.BackupEntry, storePrefix string, snapshots []Snapshot) error {
+	store, err := r.objectStore(r.ctx, r.client, be.Spec.SecretRef, be.Spec.Region)
+	if err != nil {
+		return err
+	}
+	return StageRestore(r.ctx, store, be.Spec.BucketName, be.Name, storePrefix, snapshots)
+}
+
+func (r *restoreReconciler) rejectRestoreRequest(be *extensionsv1alpha1.BackupEntry, condition gardencorev1alpha1.Condition) error {
+	r.logger.Info("Rejected restore request of backupentry", "backupentry", be.Name, "reason", condition.Reason, "message", condition.Message)
+	if err := r.removeRestorePoint(be); err != nil {
+		return err
+	}
+	return r.updateCondition(be, condition)
+}
+
+// removeRestorePoint removes the restore point of the given BackupEntry. A staged store prefix that has not been
+// activated yet is discarded.
+func (r *restoreReconciler) removeRestorePoint(be *extensionsv1alpha1.BackupEntry) error {
+	_, hasRestorePoint := be.Annotations[AnnotationRestorePoint]
+	_, hasStagedStorePrefix := be.Annotations[AnnotationStagedStorePrefix]
+	if !hasRestorePoint && !hasStagedStorePrefix {
+		return nil
+	}
+	return extensionscontroller.TryUpdate(r.ctx, retry.DefaultBackoff, r.client, be, func() error {
+		delete(be.Annotations, AnnotationRestorePoint)
+		delete(be.Annotations, AnnotationStagedStorePrefix)
+		return nil
+	})
+}
+
+func (r *restoreReconciler) updateCondition(be *extensionsv1alpha1.BackupEntry, condition gardencorev1alpha1.Condition) error {
+	return extensionscontroller.TryUpdateStatus(r.ctx, retry.DefaultBackoff, r.client, be, func() error {
+		be.Status.Conditions = gardencorev1alpha1helper.MergeConditions(be.Status.Conditions, condition)
+		return nil
+	})
+}
@@ -0,0 +1,319 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupentry_test
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	. "github.com/gardener/gardener-extensions/pkg/controller/backupentry"
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+)
+
+type fakeSnapshotLister []Snapshot
+
+func (l fakeSnapshotLister) ListSnapshots(context.Context, *extensionsv1alpha1.BackupEntry) ([]Snapshot, error) {
+	return l, nil
+}
+
+var _ = Describe("Restore", func() {
+	const (
+		name   = "shoot--foo--bar--uid"
+		bucket = "bucket"
+		dir    = name + "/etcd-main/v1/Backup-1569909600/"
+	)
+
+	var (
+		now       = time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+		timestamp = func(d time.Duration) *time.Time {
+			t := now.Add(-d)
+			return &t
+		}
+
+		snapshots = fakeSnapshotLister{
+			{Name: "Full-00000000-00000100-1569909600", Key: dir + "Full-00000000-00000100-1569909600", Kind: SnapshotKindFull, Time: *timestamp(3 * time.Hour)},
+			{Name: "Incr-00000101-00000200-1569913200", Key: dir + "Incr-00000101-00000200-1569913200", Kind: SnapshotKindDelta, Time: *timestamp(2 * time.Hour)},
+			{Name: "Incr-00000201-00000300-1569916800", Key: dir + "Incr-00000201-00000300-1569916800", Kind: SnapshotKindDelta, Time: *timestamp(time.Hour)},
+			{Name: "Incr-00000301-00000400-1569918600", Key: name + "/etcd-main/v1/Backup-1569918000/Incr-00000301-00000400-1569918600", Kind: SnapshotKindDelta, Time: *timestamp(30 * time.Minute)},
+		}
+		backupEntry = func(annotations map[string]string) *extensionsv1alpha1.BackupEntry {
+			return &extensionsv1alpha1.BackupEntry{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: annotations},
+				Spec:       extensionsv1alpha1.BackupEntrySpec{BucketName: bucket},
+			}
+		}
+	)
+
+	DescribeTable("#GetRestoreRequest",
+		func(annotations map[string]string, expected *RestoreRequest, expectErr bool) {
+			request, err := GetRestoreRequest(backupEntry(annotations))
+			if expectErr {
+				Expect(err).To(HaveOccurred())
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+			Expect(request).To(Equal(expected))
+		},
+		Entry("no request", nil, nil, false),
+		Entry("snapshot", map[string]string{AnnotationRestoreSnapshot: "Full-00000000-00000100-1569909600"}, &RestoreRequest{Snapshot: "Full-00000000-00000100-1569909600"}, false),
+		Entry("timestamp", map[string]string{AnnotationRestoreTimestamp: "2019-10-01T11:00:00Z"}, &RestoreRequest{Timestamp: timestamp(time.Hour)}, false),
+		Entry("empty snapshot", map[string]string{AnnotationRestoreSnapshot: ""}, nil, true),
+		Entry("invalid timestamp", map[string]string{AnnotationRestoreTimestamp: "yesterday"}, nil, true),
+		Entry("snapshot and timestamp", map[string]string{AnnotationRestoreSnapshot: "Full-00000000-00000100-1569909600", AnnotationRestoreTimestamp: "2019-10-01T11:00:00Z"}, nil, true),
+	)
+
+	DescribeTable("#GetRestorePoint",
+		func(annotations map[string]string, expected *RestoreRequest) {
+			Expect(GetRestorePoint(backupEntry(annotations))).To(Equal(expected))
+		},
+		Entry("no restore point", nil, nil),
+		Entry("snapshot", map[string]string{AnnotationRestorePoint: "Incr-00000101-00000200-1569913200"}, &RestoreRequest{Snapshot: "Incr-00000101-00000200-1569913200"}),
+		Entry("timestamp", map[string]string{AnnotationRestorePoint: "2019-10-01T11:00:00Z"}, &RestoreRequest{Timestamp: timestamp(time.Hour)}),
+	)
+
+	DescribeTable("#ValidateRestoreRequest",
+		func(request *RestoreRequest, matcher OmegaMatcher) {
+			Expect(ValidateRestoreRequest(request, snapshots, now)).To(matcher)
+		},
+		Entry("existing full snapshot", &RestoreRequest{Snapshot: "Full-00000000-00000100-1569909600"}, Succeed()),
+		Entry("existing delta snapshot", &RestoreRequest{Snapshot: "Incr-00000201-00000300-1569916800"}, Succeed()),
+		Entry("delta snapshot without full snapshot", &RestoreRequest{Snapshot: "Incr-00000301-00000400-1569918600"}, HaveOccurred()),
+		Entry("missing snapshot", &RestoreRequest{Snapshot: "Full-00000000-00000100-1569900000"}, HaveOccurred()),
+		Entry("timestamp covered by delta snapshots", &RestoreRequest{Timestamp: timestamp(90 * time.Minute)}, Succeed()),
+		Entry("timestamp of the last delta snapshot", &RestoreRequest{Timestamp: timestamp(time.Hour)}, Succeed()),
+		Entry("timestamp of a full snapshot", &RestoreRequest{Timestamp: timestamp(3 * time.Hour)}, Succeed()),
+		Entry("timestamp after the last delta snapshot", &RestoreRequest{Timestamp: timestamp(45 * time.Minute)}, HaveOccurred()),
+		Entry("timestamp before all full snapshots", &RestoreRequest{Timestamp: timestamp(4 * time.Hour)}, HaveOccurred()),
+		Entry("timestamp in the future", &RestoreRequest{Timestamp: timestamp(-time.Hour)}, HaveOccurred()),
+	)
+
+	DescribeTable("#GetRestoreSnapshots",
+		func(request *RestoreRequest, expected []Snapshot) {
+			Expect(GetRestoreSnapshots(request, snapshots, now)).To(Equal(expected))
+		},
+		Entry("full snapshot", &RestoreRequest{Snapshot: "Full-00000000-00000100-1569909600"}, []Snapshot{snapshots[0]}),
+		Entry("delta snapshot", &RestoreRequest{Snapshot: "Incr-00000101-00000200-1569913200"}, []Snapshot{snapshots[0], snapshots[1]}),
+		Entry("timestamp", &RestoreRequest{Timestamp: timestamp(90 * time.Minute)}, []Snapshot{snapshots[0], snapshots[1]}),
+	)
+
+	Describe("#GetRestoreSnapshots with full and delta snapshots stored in the same directory", func() {
+		var (
+			flatDir       = name + "/etcd-main/v2/"
+			flatSnapshots = []Snapshot{
+				{Name: "Full-00000000-00000100-1569909600", Key: flatDir + "Full-00000000-00000100-1569909600", Kind: SnapshotKindFull, Time: *timestamp(3 * time.Hour)},
+				{Name: "Incr-00000101-00000200-1569913200", Key: flatDir + "Incr-00000101-00000200-1569913200", Kind: SnapshotKindDelta, Time: *timestamp(2 * time.Hour)},
+				{Name: "Full-00000000-00000300-1569920400", Key: flatDir + "Full-00000000-00000300-1569920400", Kind: SnapshotKindFull, Time: *timestamp(0)},
+			}
+		)
+
+		It("should not consider the snapshots after the next full snapshot", func() {
+			Expect(GetRestoreSnapshots(&RestoreRequest{Snapshot: "Incr-00000101-00000200-1569913200"}, flatSnapshots, now)).To(Equal(flatSnapshots[:2]))
+		})
+
+		It("should fail if the delta snapshots end before the timestamp although a later full snapshot exists", func() {
+			_, err := GetRestoreSnapshots(&RestoreRequest{Timestamp: timestamp(90 * time.Minute)}, flatSnapshots, now)
+			Expect(err).To(MatchError(ContainSubstring("only cover the time up to 2019-10-01T10:00:00Z")))
+		})
+
+		It("should restore the later full snapshot at its timestamp", func() {
+			Expect(GetRestoreSnapshots(&RestoreRequest{Timestamp: timestamp(0)}, flatSnapshots, now)).To(Equal(flatSnapshots[2:]))
+		})
+	})
+
+	Describe("#GetStorePrefixByName", func() {
+		var ctx = context.TODO()
+
+		It("should return the name of the backupentry if it does not exist", func() {
+			c := fakeclient.NewFakeClientWithScheme(extensionscontroller.ExtensionsScheme)
+			Expect(GetStorePrefixByName(ctx, c, name)).To(Equal(name))
+		})
+
+		It("should return the name of the backupentry if etcd has not been restored", func() {
+			c := fakeclient.NewFakeClientWithScheme(extensionscontroller.ExtensionsScheme, backupEntry(nil))
+			Expect(GetStorePrefixByName(ctx, c, name)).To(Equal(name))
+		})
+
+		It("should return the activated store prefix but not a staged one", func() {
+			c := fakeclient.NewFakeClientWithScheme(extensionscontroller.ExtensionsScheme, backupEntry(map[string]string{
+				AnnotationStorePrefix:       "restore-20191001100000",
+				AnnotationStagedStorePrefix: "restore-20191001120000",
+			}))
+			Expect(GetStorePrefixByName(ctx, c, name)).To(Equal(name + "/restore-20191001100000"))
+		})
+	})
+
+	Describe("#StageRestore", func() {
+		It("should copy the snapshots to the store prefix keeping their relative keys", func() {
+			ctx := context.TODO()
+			store := objectstore.NewInMemoryObjectStore()
+			Expect(store.CreateBucketIfNotExists(ctx, bucket)).To(Succeed())
+			staged := Snapshot{Key: name + "/restore-20191001100000/etcd-main/v1/Backup-1569909600/Full-00000000-00000100-1569909600"}
+			for _, key := range []string{staged.Key, snapshots[1].Key} {
+				Expect(store.PutObject(ctx, bucket, key, bytes.NewReader([]byte(key)))).To(Succeed())
+			}
+
+			Expect(StageRestore(ctx, store, bucket, name, "restore-20191001120000", []Snapshot{staged, snapshots[1]})).To(Succeed())
+
+			objects, err := store.ListObjectsWithPrefix(ctx, bucket, name+"/restore-20191001120000/")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(objects).To(HaveLen(2))
+			Expect(objects).To(HaveKey(name + "/restore-20191001120000/etcd-main/v1/Backup-1569909600/Full-00000000-00000100-1569909600"))
+			Expect(objects).To(HaveKey(name + "/restore-20191001120000/etcd-main/v1/Backup-1569909600/Incr-00000101-00000200-1569913200"))
+		})
+	})
+
+	Describe("#NewRestoreReconciler", func() {
+		var (
+			ctx        = context.TODO()
+			c          client.Client
+			store      *objectstore.InMemoryObjectStore
+			reconciler reconcile.Reconciler
+		)
+
+		BeforeEach(func() {
+			store = objectstore.NewInMemoryObjectStore()
+			Expect(store.CreateBucketIfNotExists(ctx, bucket)).To(Succeed())
+			for _, snapshot := range snapshots {
+				Expect(store.PutObject(ctx, bucket, snapshot.Key, bytes.NewReader([]byte(snapshot.Name)))).To(Succeed())
+			}
+		})
+
+		volume := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "main-etcd-etcd-main-0", Namespace: "shoot--foo--bar"}}
+
+		reconcileWith := func(annotations map[string]string, expectedResult reconcile.Result, objects ...runtime.Object) *extensionsv1alpha1.BackupEntry {
+			be := backupEntry(annotations)
+			c = fakeclient.NewFakeClientWithScheme(extensionscontroller.ExtensionsScheme, append(objects, be.DeepCopy())...)
+			reconciler = NewRestoreReconciler(snapshots, func(context.Context, client.Client, corev1.SecretReference, string) (objectstore.ObjectStore, error) {
+				return store, nil
+			})
+			Expect(inject.ClientInto(c, reconciler)).To(BeTrue())
+			Expect(inject.StopChannelInto(make(chan struct{}), reconciler)).To(BeTrue())
+
+			Expect(reconciler.Reconcile(reconcile.Request{NamespacedName: kutil.Key(be.Name)})).To(Equal(expectedResult))
+			result := &extensionsv1alpha1.BackupEntry{}
+			Expect(c.Get(ctx, kutil.Key(be.Name), result)).To(Succeed())
+			return result
+		}
+
+		expectCondition := func(be *extensionsv1alpha1.BackupEntry, status gardencorev1alpha1.ConditionStatus, reason string) {
+			condition := gardencorev1alpha1helper.GetCondition(be.Status.Conditions, ConditionTypeRestorePointAvailable)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(status))
+			Expect(condition.Reason).To(Equal(reason))
+		}
+
+		stagedObjects := func() map[string]time.Time {
+			objects, err := store.ListObjectsWithPrefix(ctx, bucket, name+"/"+RestoreStorePrefixPrefix)
+			Expect(err).NotTo(HaveOccurred())
+			return objects
+		}
+
+		It("should stage the snapshots of a valid request but not activate them while the data volume exists", func() {
+			be := reconcileWith(map[string]string{AnnotationRestoreSnapshot: "Incr-00000101-00000200-1569913200"}, reconcile.Result{RequeueAfter: RestoreActivationRequeuePeriod}, volume.DeepCopy())
+
+			Expect(be.Annotations).To(HaveKeyWithValue(AnnotationRestorePoint, "Incr-00000101-00000200-1569913200"))
+			Expect(be.Annotations).To(HaveKeyWithValue(AnnotationStagedStorePrefix, HavePrefix(RestoreStorePrefixPrefix)))
+			Expect(be.Annotations).NotTo(HaveKey(AnnotationStorePrefix))
+			Expect(stagedObjects()).To(HaveLen(2))
+			Expect(stagedObjects()).To(HaveKey(name + "/" + be.Annotations[AnnotationStagedStorePrefix] + "/etcd-main/v1/Backup-1569909600/Incr-00000101-00000200-1569913200"))
+			expectCondition(be, gardencorev1alpha1.ConditionTrue, "RestorePointStaged")
+		})
+
+		It("should stage and activate the snapshots of a valid request if the data volume has been deleted", func() {
+			be := reconcileWith(map[string]string{AnnotationRestoreSnapshot: "Incr-00000101-00000200-1569913200"}, reconcile.Result{})
+
+			Expect(be.Annotations).To(HaveKeyWithValue(AnnotationStorePrefix, HavePrefix(RestoreStorePrefixPrefix)))
+			Expect(be.Annotations).NotTo(HaveKey(AnnotationStagedStorePrefix))
+			Expect(stagedObjects()).To(HaveKey(GetStorePrefix(be) + "/etcd-main/v1/Backup-1569909600/Incr-00000101-00000200-1569913200"))
+			expectCondition(be, gardencorev1alpha1.ConditionTrue, "RestorePointActivated")
+		})
+
+		It("should activate the staged snapshots once the data volume has been deleted", func() {
+			be := reconcileWith(map[string]string{
+				AnnotationRestoreSnapshot:   "Incr-00000101-00000200-1569913200",
+				AnnotationRestorePoint:      "Incr-00000101-00000200-1569913200",
+				AnnotationStagedStorePrefix: "restore-20191001100000",
+			}, reconcile.Result{})
+
+			Expect(be.Annotations).To(HaveKeyWithValue(AnnotationStorePrefix, "restore-20191001100000"))
+			Expect(be.Annotations).NotTo(HaveKey(AnnotationStagedStorePrefix))
+			Expect(stagedObjects()).To(BeEmpty())
+		})
+
+		It("should discard the staged snapshots once the request has been removed", func() {
+			be := reconcileWith(map[string]string{
+				AnnotationRestorePoint:      "Incr-00000101-00000200-1569913200",
+				AnnotationStagedStorePrefix: "restore-20191001120000",
+				AnnotationStorePrefix:       "restore-20191001100000",
+			}, reconcile.Result{}, volume.DeepCopy())
+
+			Expect(be.Annotations).NotTo(HaveKey(AnnotationRestorePoint))
+			Expect(be.Annotations).NotTo(HaveKey(AnnotationStagedStorePrefix))
+			Expect(be.Annotations).To(HaveKeyWithValue(AnnotationStorePrefix, "restore-20191001100000"))
+		})
+
+		It("should not stage the snapshots of a request again", func() {
+			be := reconcileWith(map[string]string{
+				AnnotationRestoreSnapshot: "Incr-00000101-00000200-1569913200",
+				AnnotationRestorePoint:    "Incr-00000101-00000200-1569913200",
+				AnnotationStorePrefix:     "restore-20191001100000",
+			}, reconcile.Result{})
+
+			Expect(be.Annotations).To(HaveKeyWithValue(AnnotationStorePrefix, "restore-20191001100000"))
+			Expect(stagedObjects()).To(BeEmpty())
+		})
+
+		It("should reject a request not covered by the snapshots but keep the store prefix", func() {
+			be := reconcileWith(map[string]string{
+				AnnotationRestoreTimestamp: "2019-10-01T11:15:00Z",
+				AnnotationRestorePoint:     "Incr-00000101-00000200-1569913200",
+				AnnotationStorePrefix:      "restore-20191001100000",
+			}, reconcile.Result{})
+
+			Expect(be.Annotations).NotTo(HaveKey(AnnotationRestorePoint))
+			Expect(be.Annotations).To(HaveKeyWithValue(AnnotationStorePrefix, "restore-20191001100000"))
+			Expect(stagedObjects()).To(BeEmpty())
+			expectCondition(be, gardencorev1alpha1.ConditionFalse, "RestorePointUnavailable")
+		})
+
+		It("should remove the restore point once the request has been removed", func() {
+			be := reconcileWith(map[string]string{
+				AnnotationRestorePoint: "Incr-00000101-00000200-1569913200",
+				AnnotationStorePrefix:  "restore-20191001100000",
+			}, reconcile.Result{})
+
+			Expect(be.Annotations).NotTo(HaveKey(AnnotationRestorePoint))
+			Expect(be.Annotations).To(HaveKeyWithValue(AnnotationStorePrefix, "restore-20191001100000"))
+		})
+	})
+})
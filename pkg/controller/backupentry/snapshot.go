@@ -0,0 +1,199 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupentry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/util"
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+const (
+	// SnapshotControllerName is the name of the controller checking the etcd snapshots of BackupEntry resources.
+	SnapshotControllerName = "backupentry_snapshot_controller"
+
+	// ConditionTypeSnapshotsUpToDate is the type of the BackupEntry condition indicating whether the latest etcd
+	// snapshots are recent enough.
+	ConditionTypeSnapshotsUpToDate gardencorev1alpha1.ConditionType = "SnapshotsUpToDate"
+
+	// SnapshotKindFull is the kind of full etcd snapshots.
+	SnapshotKindFull = "full"
+	// SnapshotKindDelta is the kind of delta etcd snapshots.
+	SnapshotKindDelta = "delta"
+)
+
+var (
+	// SnapshotAge is the metric containing the age of the latest etcd snapshots of BackupEntry resources.
+	SnapshotAge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "backupentry_latest_snapshot_age_seconds",
+			Help: "Age of the latest etcd snapshot of a backup entry in seconds.",
+		},
+		[]string{"backupentry", "kind"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(SnapshotAge)
+}
+
+// SnapshotCheckConfig contains the settings of the etcd snapshot check of BackupEntry resources.
+type SnapshotCheckConfig struct {
+	// Period is the period after which the snapshots of a BackupEntry are checked again.
+	Period time.Duration
+	// MaxFullSnapshotAge is the maximum age of the latest full snapshot.
+	MaxFullSnapshotAge time.Duration
+	// MaxDeltaSnapshotAge is the maximum age of the latest snapshot of any kind.
+	MaxDeltaSnapshotAge time.Duration
+}
+
+// DefaultSnapshotCheckConfig returns the default settings of the etcd snapshot check. They match the daily full
+// snapshots and the delta snapshot period of the etcd backup-restore sidecar.
+func DefaultSnapshotCheckConfig() *SnapshotCheckConfig {
+	return &SnapshotCheckConfig{
+		Period:              5 * time.Minute,
+		MaxFullSnapshotAge:  25 * time.Hour,
+		MaxDeltaSnapshotAge: 30 * time.Minute,
+	}
+}
+
+// ComputeSnapshotsCondition computes the SnapshotsUpToDate condition of the BackupEntry with the given creation time
+// from the given snapshots.
+func ComputeSnapshotsCondition(condition gardencorev1alpha1.Condition, snapshots Snapshots, created, now time.Time, config *SnapshotCheckConfig) gardencorev1alpha1.Condition {
+	if snapshots.LatestFull == nil {
+		if now.Sub(created) < config.MaxFullSnapshotAge {
+			return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionProgressing, "AwaitingFullSnapshot", "No full snapshot has been uploaded yet.")
+		}
+		return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "FullSnapshotMissing", fmt.Sprintf("No full snapshot has been uploaded within %s.", config.MaxFullSnapshotAge))
+	}
+
+	if age := now.Sub(*snapshots.LatestFull); age > config.MaxFullSnapshotAge {
+		return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "FullSnapshotOutdated", fmt.Sprintf("The latest full snapshot is %s old, exceeding the maximum age of %s.", age.Round(time.Second), config.MaxFullSnapshotAge))
+	}
+
+	latest := *snapshots.LatestFull
+	if snapshots.LatestDelta != nil && snapshots.LatestDelta.After(latest) {
+		latest = *snapshots.LatestDelta
+	}
+	if age := now.Sub(latest); age > config.MaxDeltaSnapshotAge {
+		return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "DeltaSnapshotOutdated", fmt.Sprintf("The latest snapshot is %s old, exceeding the maximum age of %s.", age.Round(time.Second), config.MaxDeltaSnapshotAge))
+	}
+
+	return gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "SnapshotsUpToDate", "The latest snapshots are up to date.")
+}
+
+type snapshotReconciler struct {
+	logger  logr.Logger
+	checker SnapshotChecker
+	config  *SnapshotCheckConfig
+
+	ctx    context.Context
+	client client.Client
+}
+
+// NewSnapshotReconciler creates a new reconcile.Reconciler that periodically checks the etcd snapshots of
+// backupentry resources of Gardener's `extensions.gardener.cloud` API group.
+func NewSnapshotReconciler(checker SnapshotChecker, config *SnapshotCheckConfig) reconcile.Reconciler {
+	return &snapshotReconciler{
+		logger:  log.Log.WithName(SnapshotControllerName),
+		checker: checker,
+		config:  config,
+	}
+}
+
+func (r *snapshotReconciler) InjectFunc(f inject.Func) error {
+	return f(r.checker)
+}
+
+func (r *snapshotReconciler) InjectClient(client client.Client) error {
+	r.client = client
+	return nil
+}
+
+func (r *snapshotReconciler) InjectStopChannel(stopCh <-chan struct{}) error {
+	r.ctx = util.ContextFromStopChannel(stopCh)
+	return nil
+}
+
+func (r *snapshotReconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	be := &extensionsv1alpha1.BackupEntry{}
+	if err := r.client.Get(r.ctx, request.NamespacedName, be); err != nil {
+		if errors.IsNotFound(err) {
+			deleteSnapshotAgeMetrics(request.Name)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if be.DeletionTimestamp != nil {
+		deleteSnapshotAgeMetrics(be.Name)
+		return reconcile.Result{}, nil
+	}
+
+	condition := gardencorev1alpha1helper.GetOrInitCondition(be.Status.Conditions, ConditionTypeSnapshotsUpToDate)
+
+	snapshots, err := r.checker.GetLatestSnapshots(r.ctx, be)
+	switch {
+	case err != nil:
+		r.logger.Error(err, "Could not check the snapshots of backupentry", "backupentry", be.Name)
+		condition = gardencorev1alpha1helper.UpdatedConditionUnknownError(condition, err)
+	case snapshots == nil:
+		deleteSnapshotAgeMetrics(be.Name)
+		return reconcile.Result{RequeueAfter: r.config.Period}, nil
+	default:
+		currentTime := time.Now()
+		setSnapshotAgeMetric(be.Name, SnapshotKindFull, snapshots.LatestFull, currentTime)
+		setSnapshotAgeMetric(be.Name, SnapshotKindDelta, snapshots.LatestDelta, currentTime)
+		condition = ComputeSnapshotsCondition(condition, *snapshots, be.CreationTimestamp.Time, currentTime, r.config)
+	}
+
+	if err := extensionscontroller.TryUpdateStatus(r.ctx, retry.DefaultBackoff, r.client, be, func() error {
+		be.Status.Conditions = gardencorev1alpha1helper.MergeConditions(be.Status.Conditions, condition)
+		return nil
+	}); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.config.Period}, nil
+}
+
+func setSnapshotAgeMetric(name, kind string, snapshot *time.Time, now time.Time) {
+	if snapshot == nil {
+		SnapshotAge.DeleteLabelValues(name, kind)
+		return
+	}
+	SnapshotAge.WithLabelValues(name, kind).Set(now.Sub(*snapshot).Seconds())
+}
+
+func deleteSnapshotAgeMetrics(name string) {
+	SnapshotAge.DeleteLabelValues(name, SnapshotKindFull)
+	SnapshotAge.DeleteLabelValues(name, SnapshotKindDelta)
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupentry_test
+
+import (
+	"time"
+
+	. "github.com/gardener/gardener-extensions/pkg/controller/backupentry"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Snapshot", func() {
+	var (
+		now     = time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+		config  = DefaultSnapshotCheckConfig()
+		timeAgo = func(d time.Duration) *time.Time {
+			t := now.Add(-d)
+			return &t
+		}
+	)
+
+	DescribeTable("#ComputeSnapshotsCondition",
+		func(snapshots Snapshots, created time.Time, expectedStatus gardencorev1alpha1.ConditionStatus, expectedReason string) {
+			condition := ComputeSnapshotsCondition(gardencorev1alpha1.Condition{Type: ConditionTypeSnapshotsUpToDate}, snapshots, created, now, config)
+
+			Expect(condition.Type).To(Equal(ConditionTypeSnapshotsUpToDate))
+			Expect(condition.Status).To(Equal(expectedStatus))
+			Expect(condition.Reason).To(Equal(expectedReason))
+		},
+		Entry("no full snapshot for a new backup entry",
+			Snapshots{}, *timeAgo(time.Hour), gardencorev1alpha1.ConditionProgressing, "AwaitingFullSnapshot"),
+		Entry("no full snapshot for an old backup entry",
+			Snapshots{}, *timeAgo(48 * time.Hour), gardencorev1alpha1.ConditionFalse, "FullSnapshotMissing"),
+		Entry("outdated full snapshot",
+			Snapshots{LatestFull: timeAgo(26 * time.Hour), LatestDelta: timeAgo(time.Minute)}, *timeAgo(48 * time.Hour), gardencorev1alpha1.ConditionFalse, "FullSnapshotOutdated"),
+		Entry("outdated delta snapshot",
+			Snapshots{LatestFull: timeAgo(2 * time.Hour), LatestDelta: timeAgo(time.Hour)}, *timeAgo(48 * time.Hour), gardencorev1alpha1.ConditionFalse, "DeltaSnapshotOutdated"),
+		Entry("recent full snapshot without delta snapshot",
+			Snapshots{LatestFull: timeAgo(time.Minute)}, *timeAgo(48 * time.Hour), gardencorev1alpha1.ConditionTrue, "SnapshotsUpToDate"),
+		Entry("recent full and delta snapshots",
+			Snapshots{LatestFull: timeAgo(10 * time.Hour), LatestDelta: timeAgo(5 * time.Minute)}, *timeAgo(48 * time.Hour), gardencorev1alpha1.ConditionTrue, "SnapshotsUpToDate"),
+	)
+})
@@ -0,0 +1,87 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	// DeletionGracePeriodFlag is the name of the command line flag to specify the period for which the backups of
+	// deleted backup entries are kept.
+	DeletionGracePeriodFlag = "deletion-grace-period"
+	// GarbageCollectionPeriodFlag is the name of the command line flag to specify the period after which the backups
+	// of deleted backup entries are checked for expiry.
+	GarbageCollectionPeriodFlag = "garbage-collection-period"
+
+	// DefaultGarbageCollectionPeriod is the default period after which the backups of deleted backup entries are
+	// checked for expiry.
+	DefaultGarbageCollectionPeriod = time.Hour
+)
+
+// DeletionOptions are command line options for deferring the deletion of backups.
+type DeletionOptions struct {
+	// GracePeriod is the period for which the backups of deleted backup entries are kept. If it is zero, the
+	// backups are deleted immediately.
+	GracePeriod time.Duration
+	// GarbageCollectionPeriod is the period after which the backups of deleted backup entries are checked for expiry.
+	GarbageCollectionPeriod time.Duration
+
+	config *DeletionConfig
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (d *DeletionOptions) AddFlags(fs *pflag.FlagSet) {
+	if d.GarbageCollectionPeriod == 0 {
+		d.GarbageCollectionPeriod = DefaultGarbageCollectionPeriod
+	}
+	fs.DurationVar(&d.GracePeriod, DeletionGracePeriodFlag, d.GracePeriod, "The period for which the backups of deleted backup entries are kept before they are purged. If zero, backups are deleted immediately.")
+	fs.DurationVar(&d.GarbageCollectionPeriod, GarbageCollectionPeriodFlag, d.GarbageCollectionPeriod, "The period after which the backups of deleted backup entries are checked for expiry.")
+}
+
+// Complete implements Completer.Complete.
+func (d *DeletionOptions) Complete() error {
+	if d.GracePeriod < 0 {
+		return fmt.Errorf("%s must not be negative: %s", DeletionGracePeriodFlag, d.GracePeriod)
+	}
+	if d.GracePeriod > 0 && d.GarbageCollectionPeriod <= 0 {
+		return fmt.Errorf("%s must be positive: %s", GarbageCollectionPeriodFlag, d.GarbageCollectionPeriod)
+	}
+
+	d.config = &DeletionConfig{d.GracePeriod, d.GarbageCollectionPeriod}
+	return nil
+}
+
+// Completed returns the completed DeletionConfig. Only call this if `Complete` was successful.
+func (d *DeletionOptions) Completed() *DeletionConfig {
+	return d.config
+}
+
+// DeletionConfig is a completed deletion configuration.
+type DeletionConfig struct {
+	// GracePeriod is the period for which the backups of deleted backup entries are kept.
+	GracePeriod time.Duration
+	// GarbageCollectionPeriod is the period after which the backups of deleted backup entries are checked for expiry.
+	GarbageCollectionPeriod time.Duration
+}
+
+// Apply sets the values of this DeletionConfig in the given durations.
+func (d *DeletionConfig) Apply(gracePeriod, garbageCollectionPeriod *time.Duration) {
+	*gracePeriod = d.GracePeriod
+	*garbageCollectionPeriod = d.GarbageCollectionPeriod
+}
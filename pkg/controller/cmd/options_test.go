@@ -17,6 +17,7 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	mockcontroller "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/controller"
 	mockcmd "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/controller/cmd"
@@ -645,4 +646,57 @@ var _ = Describe("Options", func() {
 			})
 		})
 	})
+
+	Context("DeletionOptions", func() {
+		const name = "foo"
+		command := test.NewCommandBuilder(name).
+			Flags(test.StringFlag(DeletionGracePeriodFlag, "72h")).
+			Command().
+			Slice()
+
+		Describe("#AddFlags", func() {
+			It("should add all flags", func() {
+				fs := pflag.NewFlagSet(name, pflag.ExitOnError)
+				opts := DeletionOptions{}
+
+				opts.AddFlags(fs)
+
+				Expect(fs.Parse(command)).NotTo(HaveOccurred())
+				Expect(opts).To(Equal(DeletionOptions{
+					GracePeriod:             72 * time.Hour,
+					GarbageCollectionPeriod: DefaultGarbageCollectionPeriod,
+				}))
+			})
+		})
+
+		Describe("#Complete", func() {
+			It("should fail for a negative grace period", func() {
+				opts := DeletionOptions{GracePeriod: -time.Hour}
+
+				Expect(opts.Complete()).To(HaveOccurred())
+			})
+
+			It("should fail for a grace period without garbage collection period", func() {
+				opts := DeletionOptions{GracePeriod: time.Hour}
+
+				Expect(opts.Complete()).To(HaveOccurred())
+			})
+		})
+
+		Describe("#Completed", func() {
+			It("should yield a correct DeletionConfig after completion", func() {
+				fs := pflag.NewFlagSet(name, pflag.ExitOnError)
+				opts := DeletionOptions{}
+
+				opts.AddFlags(fs)
+
+				Expect(fs.Parse(command)).NotTo(HaveOccurred())
+				Expect(opts.Complete()).NotTo(HaveOccurred())
+				Expect(opts.Completed()).To(Equal(&DeletionConfig{
+					GracePeriod:             72 * time.Hour,
+					GarbageCollectionPeriod: DefaultGarbageCollectionPeriod,
+				}))
+			})
+		})
+	})
 })
@@ -19,6 +19,7 @@ import (
 	extensionspredicate "github.com/gardener/gardener-extensions/pkg/predicate"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -84,7 +85,13 @@ func Add(mgr manager.Manager, args AddArgs) error {
 		return err
 	}
 
-	return ctrl.Watch(&source.Kind{Type: &extensionsv1alpha1.Cluster{}}, &extensionshandler.EnqueueRequestsFromMapFunc{
+	if err := ctrl.Watch(&source.Kind{Type: &extensionsv1alpha1.Cluster{}}, &extensionshandler.EnqueueRequestsFromMapFunc{
 		ToRequests: extensionshandler.SimpleMapper(ClusterToControlPlaneMapper(args.Predicates), extensionshandler.UpdateWithNew),
+	}); err != nil {
+		return err
+	}
+
+	return ctrl.Watch(&source.Kind{Type: &corev1.Secret{}}, &extensionshandler.EnqueueRequestsFromMapFunc{
+		ToRequests: extensionshandler.SimpleMapper(SecretToControlPlaneMapper(args.Predicates), extensionshandler.UpdateWithNew),
 	})
 }
@@ -40,6 +40,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
 )
 
@@ -55,6 +56,8 @@ type ValuesProvider interface {
 	GetStorageClassesChartValues(context.Context, *extensionsv1alpha1.ControlPlane, *extensionscontroller.Cluster) (map[string]interface{}, error)
 	// GetControlPlaneExposureChartValues returns the values for the control plane exposure chart applied by this actuator.
 	GetControlPlaneExposureChartValues(context.Context, *extensionsv1alpha1.ControlPlane, *extensionscontroller.Cluster, map[string]string) (map[string]interface{}, error)
+	// GetMonitoringConfig returns the monitoring configuration deployed by this actuator.
+	GetMonitoringConfig(context.Context, *extensionsv1alpha1.ControlPlane, *extensionscontroller.Cluster) (*controlplane.MonitoringConfig, error)
 }
 
 // NewActuator creates a new Actuator that acts upon and updates the status of ControlPlane resources.
@@ -154,6 +157,7 @@ const (
 	controlPlaneShootChartResourceName = "extension-controlplane-shoot"
 	storageClassesChartResourceName    = "extension-controlplane-storageclasses"
 	shootWebhooksResourceName          = "extension-controlplane-shoot-webhooks"
+	monitoringConfigName               = "extension-controlplane-monitoring-config"
 )
 
 // Reconcile reconciles the given controlplane and cluster, creating or updating the additional Shoot
@@ -319,6 +323,11 @@ func (a *actuator) reconcileControlPlane(
 		return false, errors.Wrapf(err, "could not apply control plane chart for controlplane '%s'", util.ObjectName(cp))
 	}
 
+	// Deploy monitoring configuration
+	if err := a.reconcileMonitoringConfig(ctx, cp, cluster); err != nil {
+		return false, err
+	}
+
 	// Create shoot chart renderer
 	chartRenderer, err := a.chartRendererFactory.NewChartRendererForShoot(version)
 	if err != nil {
@@ -414,6 +423,12 @@ func (a *actuator) deleteControlPlane(
 		return errors.Wrapf(err, "error while waiting for managed resource containing shoot chart for controlplane '%s' to be deleted", util.ObjectName(cp))
 	}
 
+	// Delete monitoring configuration
+	a.logger.Info("Deleting monitoring configuration", "controlplane", util.ObjectName(cp))
+	if err := a.deleteMonitoringConfig(ctx, cp.Namespace); err != nil {
+		return errors.Wrapf(err, "could not delete monitoring configuration for controlplane '%s'", util.ObjectName(cp))
+	}
+
 	// Delete control plane objects
 	a.logger.Info("Deleting control plane objects", "controlplane", util.ObjectName(cp))
 	if err := a.controlPlaneChart.Delete(ctx, a.client, cp.Namespace); client.IgnoreNotFound(err) != nil {
@@ -486,6 +501,50 @@ func (a *actuator) computeChecksums(
 	return controlplane.ComputeChecksums(csSecrets, csConfigMaps), nil
 }
 
+// reconcileMonitoringConfig creates or updates the monitoring configuration provided by the values provider. If the
+// values provider does not provide any monitoring configuration, a previously deployed one is deleted.
+func (a *actuator) reconcileMonitoringConfig(
+	ctx context.Context,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+) error {
+	monitoringConfig, err := a.vp.GetMonitoringConfig(ctx, cp, cluster)
+	if err != nil {
+		return err
+	}
+
+	if monitoringConfig == nil {
+		if err := a.deleteMonitoringConfig(ctx, cp.Namespace); err != nil {
+			return errors.Wrapf(err, "could not delete monitoring configuration for controlplane '%s'", util.ObjectName(cp))
+		}
+		return nil
+	}
+
+	data, err := monitoringConfig.ConfigMapData()
+	if err != nil {
+		return errors.Wrapf(err, "could not compute monitoring configuration for controlplane '%s'", util.ObjectName(cp))
+	}
+
+	a.logger.Info("Deploying monitoring configuration", "controlplane", util.ObjectName(cp))
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: cp.Namespace, Name: monitoringConfigName}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, a.client, configMap, func() error {
+		if configMap.Labels == nil {
+			configMap.Labels = make(map[string]string)
+		}
+		configMap.Labels[controlplane.MonitoringConfigurationLabel] = controlplane.MonitoringConfigurationLabelValue
+		configMap.Data = data
+		return nil
+	}); err != nil {
+		return errors.Wrapf(err, "could not create or update configmap '%s/%s'", cp.Namespace, monitoringConfigName)
+	}
+
+	return nil
+}
+
+func (a *actuator) deleteMonitoringConfig(ctx context.Context, namespace string) error {
+	return client.IgnoreNotFound(a.client.Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: monitoringConfigName}}))
+}
+
 func marshalWebhooks(webhooks []admissionregistrationv1beta1.Webhook, name string) ([]byte, error) {
 	var (
 		buf     = new(bytes.Buffer)
@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/controlplane"
 	mockclient "github.com/gardener/gardener-extensions/pkg/mock/controller-runtime/client"
 	mockextensionscontroller "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/controller"
 	mockgenericactuator "github.com/gardener/gardener-extensions/pkg/mock/gardener-extensions/controller/controlplane/genericactuator"
@@ -229,6 +230,22 @@ var _ = Describe("Actuator", func() {
 			ObjectMeta: metav1.ObjectMeta{Name: shootWebhooksResourceName, Namespace: namespace},
 		}
 
+		resourceKeyMonitoringConfig = client.ObjectKey{Namespace: namespace, Name: monitoringConfigName}
+		monitoringConfig            = &controlplane.MonitoringConfig{
+			AlertingRules: map[string]string{"test.rules.yaml": "groups: []\n"},
+		}
+		createdMonitoringConfigMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      monitoringConfigName,
+				Namespace: namespace,
+				Labels:    map[string]string{controlplane.MonitoringConfigurationLabel: controlplane.MonitoringConfigurationLabelValue},
+			},
+			Data: map[string]string{controlplane.MonitoringConfigKeyAlertingRules: "test.rules.yaml: |\n  groups: []\n"},
+		}
+		deletedMonitoringConfigMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: monitoringConfigName, Namespace: namespace},
+		}
+
 		imageVector = imagevector.ImageVector([]*imagevector.ImageSource{})
 
 		checksums = map[string]string{
@@ -304,6 +321,9 @@ var _ = Describe("Actuator", func() {
 				client.EXPECT().Get(ctx, cpConfigMapKey, &corev1.ConfigMap{}).DoAndReturn(clientGet(cpConfigMap))
 			}
 
+			client.EXPECT().Get(ctx, resourceKeyMonitoringConfig, gomock.AssignableToTypeOf(&corev1.ConfigMap{})).Return(errNotFound)
+			client.EXPECT().Create(ctx, createdMonitoringConfigMap).Return(nil)
+
 			client.EXPECT().Get(ctx, resourceKeyCPShootChart, gomock.AssignableToTypeOf(&corev1.Secret{})).Return(errNotFound)
 			client.EXPECT().Create(ctx, createdMRSecretForCPShootChart).Return(nil)
 			client.EXPECT().Get(ctx, resourceKeyCPShootChart, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})).Return(errNotFound)
@@ -346,6 +366,7 @@ var _ = Describe("Actuator", func() {
 				vp.EXPECT().GetConfigChartValues(ctx, cp, cluster).Return(configChartValues, nil)
 			}
 			vp.EXPECT().GetControlPlaneChartValues(ctx, cp, cluster, checksums, false).Return(controlPlaneChartValues, nil)
+			vp.EXPECT().GetMonitoringConfig(ctx, cp, cluster).Return(monitoringConfig, nil)
 			vp.EXPECT().GetControlPlaneShootChartValues(ctx, cp, cluster).Return(controlPlaneShootChartValues, nil)
 			vp.EXPECT().GetStorageClassesChartValues(ctx, cp, cluster).Return(storageClassesChartValues, nil)
 
@@ -382,6 +403,8 @@ var _ = Describe("Actuator", func() {
 			client.EXPECT().Get(gomock.Any(), resourceKeyStorageClassesChart, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})).Return(errors.NewNotFound(schema.GroupResource{}, deleteMRForStorageClassesChart.Name))
 			client.EXPECT().Get(gomock.Any(), resourceKeyCPShootChart, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})).Return(errors.NewNotFound(schema.GroupResource{}, deleteMRForCPShootChart.Name))
 
+			client.EXPECT().Delete(ctx, deletedMonitoringConfigMap).Return(nil)
+
 			// Create mock secrets and charts
 			secrets := mockutil.NewMockSecrets(ctrl)
 			secrets.EXPECT().Delete(gomock.Any(), namespace).Return(nil)
@@ -17,6 +17,7 @@ package genericactuator
 import (
 	"context"
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/controller/controlplane"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 )
 
@@ -47,3 +48,9 @@ func (vp *NoopValuesProvider) GetStorageClassesChartValues(context.Context, *ext
 func (vp *NoopValuesProvider) GetControlPlaneExposureChartValues(context.Context, *extensionsv1alpha1.ControlPlane, *extensionscontroller.Cluster, map[string]string) (map[string]interface{}, error) {
 	return nil, nil
 }
+
+// GetMonitoringConfig returns the monitoring configuration deployed by this actuator. It defaults to the monitoring
+// configuration for the control plane components of all providers.
+func (vp *NoopValuesProvider) GetMonitoringConfig(context.Context, *extensionsv1alpha1.ControlPlane, *extensionscontroller.Cluster) (*controlplane.MonitoringConfig, error) {
+	return controlplane.DefaultMonitoringConfig(), nil
+}
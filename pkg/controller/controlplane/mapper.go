@@ -18,6 +18,7 @@ import (
 	extensionshandler "github.com/gardener/gardener-extensions/pkg/handler"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -28,3 +29,19 @@ import (
 func ClusterToControlPlaneMapper(predicates []predicate.Predicate) handler.Mapper {
 	return extensionshandler.ClusterToObjectMapper(func() runtime.Object { return &extensionsv1alpha1.ControlPlaneList{} }, predicates)
 }
+
+// SecretToControlPlaneMapper returns a mapper that returns requests for ControlPlanes whose
+// referenced secrets have been modified.
+func SecretToControlPlaneMapper(predicates []predicate.Predicate) handler.Mapper {
+	return extensionshandler.SecretToObjectMapper(
+		func() runtime.Object { return &extensionsv1alpha1.ControlPlaneList{} },
+		func(obj runtime.Object) *corev1.SecretReference {
+			controlPlane, ok := obj.(*extensionsv1alpha1.ControlPlane)
+			if !ok {
+				return nil
+			}
+			return &controlPlane.Spec.SecretRef
+		},
+		predicates,
+	)
+}
@@ -0,0 +1,363 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+const (
+	// MonitoringConfigurationLabel is the label of ConfigMaps containing monitoring configuration that is picked up
+	// by the Prometheus and Grafana of the seed.
+	MonitoringConfigurationLabel = "extensions.gardener.cloud/configuration"
+	// MonitoringConfigurationLabelValue is the value of the MonitoringConfigurationLabel.
+	MonitoringConfigurationLabelValue = "monitoring"
+
+	// MonitoringConfigKeyAlertingRules is the ConfigMap key of the Prometheus alerting rules.
+	MonitoringConfigKeyAlertingRules = "alerting_rules"
+	// MonitoringConfigKeyScrapeConfig is the ConfigMap key of the Prometheus scrape configs.
+	MonitoringConfigKeyScrapeConfig = "scrape_config"
+	// MonitoringConfigKeyOperatorDashboards is the ConfigMap key of the Grafana dashboards for operators.
+	MonitoringConfigKeyOperatorDashboards = "dashboard_operators"
+	// MonitoringConfigKeyUserDashboards is the ConfigMap key of the Grafana dashboards for users.
+	MonitoringConfigKeyUserDashboards = "dashboard_users"
+)
+
+// MonitoringConfig is the monitoring configuration for provider-specific control plane components.
+type MonitoringConfig struct {
+	// AlertingRules maps the names of Prometheus rule files to their contents.
+	AlertingRules map[string]string
+	// ScrapeConfigs are Prometheus scrape configs, each being a YAML list of jobs.
+	ScrapeConfigs []string
+	// OperatorDashboards maps the names of Grafana dashboards for operators to their JSON definitions.
+	OperatorDashboards map[string]string
+	// UserDashboards maps the names of Grafana dashboards for users to their JSON definitions.
+	UserDashboards map[string]string
+}
+
+// ConfigMapData returns the data of a monitoring ConfigMap containing the given monitoring configuration.
+func (c *MonitoringConfig) ConfigMapData() (map[string]string, error) {
+	data := make(map[string]string)
+
+	for key, files := range map[string]map[string]string{
+		MonitoringConfigKeyAlertingRules:      c.AlertingRules,
+		MonitoringConfigKeyOperatorDashboards: c.OperatorDashboards,
+		MonitoringConfigKeyUserDashboards:     c.UserDashboards,
+	} {
+		if len(files) == 0 {
+			continue
+		}
+
+		out, err := yaml.Marshal(files)
+		if err != nil {
+			return nil, err
+		}
+		data[key] = string(out)
+	}
+
+	if len(c.ScrapeConfigs) > 0 {
+		var scrapeConfigs []string
+		for _, scrapeConfig := range c.ScrapeConfigs {
+			scrapeConfigs = append(scrapeConfigs, strings.TrimSuffix(scrapeConfig, "\n"))
+		}
+		data[MonitoringConfigKeyScrapeConfig] = strings.Join(scrapeConfigs, "\n") + "\n"
+	}
+
+	return data, nil
+}
+
+const (
+	// MachineControllerManagerMachinesAlertingRules are alerting rules for machines that the machine-controller-manager
+	// fails to create or maintain.
+	MachineControllerManagerMachinesAlertingRules = `groups:
+- name: machine-controller-manager-machines.rules
+  rules:
+  - alert: MachineControllerManagerFailedMachines
+    expr: count(mcm_machine_deployment_failed_machines{job="machine-controller-manager"}) > 0
+    for: 30m
+    labels:
+      service: machine-controller-manager
+      severity: warning
+      type: seed
+      visibility: operator
+    annotations:
+      description: The machine controller manager has been failing to create or maintain machines for 30 minutes. Shoot nodes might be missing.
+      summary: Machine controller manager fails to create machines.
+`
+
+	// VolumeAttachmentAlertingRules are alerting rules for volumes that cannot be attached by the CSI driver.
+	VolumeAttachmentAlertingRules = `groups:
+- name: volume-attachment.rules
+  rules:
+  - alert: VolumeAttachmentErrors
+    expr: sum(rate(storage_operation_errors_total{job="kube-controller-manager", operation_name="volume_attach"}[10m])) > 0
+    for: 15m
+    labels:
+      service: csi-driver-controller
+      severity: warning
+      type: seed
+      visibility: all
+    annotations:
+      description: Volumes have been failing to attach to the shoot nodes for 15 minutes. Pods using persistent volumes cannot be started.
+      summary: Volumes cannot be attached.
+`
+	// VolumeAttachmentDashboard is a Grafana dashboard showing the errors and the duration of volume attachments.
+	VolumeAttachmentDashboard = `{
+  "description": "Information about the attachment of persistent volumes to the shoot nodes",
+  "editable": false,
+  "gnetId": null,
+  "graphTooltip": 0,
+  "links": [],
+  "panels": [
+    {
+      "aliasColors": {},
+      "bars": false,
+      "dashLength": 10,
+      "dashes": false,
+      "description": "Rate of failed volume attachments per volume plugin.",
+      "fill": 1,
+      "gridPos": {
+        "h": 7,
+        "w": 24,
+        "x": 0,
+        "y": 0
+      },
+      "id": 1,
+      "legend": {
+        "alignAsTable": true,
+        "avg": false,
+        "current": false,
+        "max": false,
+        "min": false,
+        "rightSide": true,
+        "show": true,
+        "total": false,
+        "values": false
+      },
+      "lines": true,
+      "linewidth": 1,
+      "links": [],
+      "nullPointMode": "null",
+      "percentage": false,
+      "pointradius": 2,
+      "points": false,
+      "renderer": "flot",
+      "seriesOverrides": [],
+      "spaceLength": 10,
+      "stack": false,
+      "steppedLine": false,
+      "targets": [
+        {
+          "expr": "sum(rate(storage_operation_errors_total{job=\"kube-controller-manager\", operation_name=\"volume_attach\"}[5m])) by (volume_plugin)",
+          "format": "time_series",
+          "intervalFactor": 1,
+          "legendFormat": "{{volume_plugin}}",
+          "refId": "A"
+        }
+      ],
+      "thresholds": [],
+      "timeFrom": null,
+      "timeRegions": [],
+      "timeShift": null,
+      "title": "Volume Attachment Errors",
+      "tooltip": {
+        "shared": true,
+        "sort": 0,
+        "value_type": "individual"
+      },
+      "type": "graph",
+      "xaxis": {
+        "buckets": null,
+        "mode": "time",
+        "name": null,
+        "show": true,
+        "values": []
+      },
+      "yaxes": [
+        {
+          "format": "ops",
+          "label": null,
+          "logBase": 1,
+          "max": null,
+          "min": "0",
+          "show": true
+        },
+        {
+          "format": "short",
+          "label": null,
+          "logBase": 1,
+          "max": null,
+          "min": null,
+          "show": false
+        }
+      ],
+      "yaxis": {
+        "align": false,
+        "alignLevel": null
+      }
+    },
+    {
+      "aliasColors": {},
+      "bars": false,
+      "dashLength": 10,
+      "dashes": false,
+      "description": "99th percentile of the duration of volume attachments per volume plugin.",
+      "fill": 1,
+      "gridPos": {
+        "h": 7,
+        "w": 24,
+        "x": 0,
+        "y": 7
+      },
+      "id": 2,
+      "legend": {
+        "alignAsTable": true,
+        "avg": false,
+        "current": false,
+        "max": false,
+        "min": false,
+        "rightSide": true,
+        "show": true,
+        "total": false,
+        "values": false
+      },
+      "lines": true,
+      "linewidth": 1,
+      "links": [],
+      "nullPointMode": "null",
+      "percentage": false,
+      "pointradius": 2,
+      "points": false,
+      "renderer": "flot",
+      "seriesOverrides": [],
+      "spaceLength": 10,
+      "stack": false,
+      "steppedLine": false,
+      "targets": [
+        {
+          "expr": "histogram_quantile(0.99, sum(rate(storage_operation_duration_seconds_bucket{job=\"kube-controller-manager\", operation_name=\"volume_attach\"}[5m])) by (volume_plugin, le))",
+          "format": "time_series",
+          "intervalFactor": 1,
+          "legendFormat": "{{volume_plugin}}",
+          "refId": "A"
+        }
+      ],
+      "thresholds": [],
+      "timeFrom": null,
+      "timeRegions": [],
+      "timeShift": null,
+      "title": "Volume Attachment Duration",
+      "tooltip": {
+        "shared": true,
+        "sort": 0,
+        "value_type": "individual"
+      },
+      "type": "graph",
+      "xaxis": {
+        "buckets": null,
+        "mode": "time",
+        "name": null,
+        "show": true,
+        "values": []
+      },
+      "yaxes": [
+        {
+          "format": "s",
+          "label": null,
+          "logBase": 1,
+          "max": null,
+          "min": "0",
+          "show": true
+        },
+        {
+          "format": "short",
+          "label": null,
+          "logBase": 1,
+          "max": null,
+          "min": null,
+          "show": false
+        }
+      ],
+      "yaxis": {
+        "align": false,
+        "alignLevel": null
+      }
+    }
+  ],
+  "refresh": "30s",
+  "schemaVersion": 18,
+  "style": "dark",
+  "tags": [
+    "controlplane",
+    "seed"
+  ],
+  "templating": {
+    "list": []
+  },
+  "time": {
+    "from": "now-3h",
+    "to": "now"
+  },
+  "timepicker": {
+    "refresh_intervals": [
+      "5s",
+      "10s",
+      "30s",
+      "1m",
+      "5m",
+      "15m",
+      "30m",
+      "1h",
+      "2h",
+      "1d"
+    ],
+    "time_options": [
+      "5m",
+      "15m",
+      "1h",
+      "6h",
+      "12h",
+      "24h",
+      "2d",
+      "7d",
+      "14d"
+    ]
+  },
+  "timezone": "browser",
+  "title": "Volume Attachment",
+  "uid": "volume-attachment",
+  "version": 1
+}
+`
+)
+
+// DefaultMonitoringConfig returns the monitoring configuration for the control plane components every provider
+// deploys, i.e. the machine-controller-manager and the CSI driver.
+func DefaultMonitoringConfig() *MonitoringConfig {
+	return &MonitoringConfig{
+		AlertingRules: map[string]string{
+			"machine-controller-manager-machines.rules.yaml": MachineControllerManagerMachinesAlertingRules,
+			"volume-attachment.rules.yaml":                   VolumeAttachmentAlertingRules,
+		},
+		OperatorDashboards: map[string]string{
+			"volume-attachment-dashboard.json": VolumeAttachmentDashboard,
+		},
+		UserDashboards: map[string]string{
+			"volume-attachment-dashboard.json": VolumeAttachmentDashboard,
+		},
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Monitoring", func() {
+	Describe("#ConfigMapData", func() {
+		It("should return empty data for an empty configuration", func() {
+			data, err := (&MonitoringConfig{}).ConfigMapData()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(BeEmpty())
+		})
+
+		It("should render the configuration in the format of the monitoring configmaps", func() {
+			data, err := (&MonitoringConfig{
+				AlertingRules:  map[string]string{"foo.rules.yaml": "groups:\n- name: foo.rules\n"},
+				ScrapeConfigs:  []string{"- job_name: foo\n", "- job_name: bar"},
+				UserDashboards: map[string]string{"foo-dashboard.json": "{}"},
+			}).ConfigMapData()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(Equal(map[string]string{
+				MonitoringConfigKeyAlertingRules:  "foo.rules.yaml: |\n  groups:\n  - name: foo.rules\n",
+				MonitoringConfigKeyScrapeConfig:   "- job_name: foo\n- job_name: bar\n",
+				MonitoringConfigKeyUserDashboards: "foo-dashboard.json: '{}'\n",
+			}))
+		})
+	})
+	Describe("#DefaultMonitoringConfig", func() {
+		It("should contain the alerting rules and valid dashboards", func() {
+			config := DefaultMonitoringConfig()
+
+			Expect(config.AlertingRules).To(HaveKeyWithValue("machine-controller-manager-machines.rules.yaml", MachineControllerManagerMachinesAlertingRules))
+			Expect(config.AlertingRules).To(HaveKeyWithValue("volume-attachment.rules.yaml", VolumeAttachmentAlertingRules))
+			Expect(config.OperatorDashboards).To(HaveKeyWithValue("volume-attachment-dashboard.json", VolumeAttachmentDashboard))
+			Expect(config.UserDashboards).To(HaveKeyWithValue("volume-attachment-dashboard.json", VolumeAttachmentDashboard))
+			Expect(json.Valid([]byte(VolumeAttachmentDashboard))).To(BeTrue())
+		})
+	})
+})
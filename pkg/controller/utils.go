@@ -34,6 +34,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apimachinery/pkg/util/wait"
 	autoscalingv1beta2 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1beta2"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -322,3 +323,82 @@ func GetVerticalPodAutoscalerObject() *unstructured.Unstructured {
 	obj.SetKind("VerticalPodAutoscaler")
 	return obj
 }
+
+const (
+	// VPAUpdateModeAuto is the default update mode of VerticalPodAutoscalers for control plane components.
+	VPAUpdateModeAuto = string(autoscalingv1beta2.UpdateModeAuto)
+	// DefaultControlPlaneComponentReplicas is the default number of replicas of control plane components.
+	DefaultControlPlaneComponentReplicas = 1
+)
+
+var supportedVPAUpdateModes = sets.NewString(
+	string(autoscalingv1beta2.UpdateModeOff),
+	string(autoscalingv1beta2.UpdateModeInitial),
+	string(autoscalingv1beta2.UpdateModeRecreate),
+	string(autoscalingv1beta2.UpdateModeAuto),
+)
+
+// GetControlPlaneComponentReplicas returns the configured number of replicas of a control plane component, or
+// DefaultControlPlaneComponentReplicas if it is not configured.
+func GetControlPlaneComponentReplicas(replicas *int32) int {
+	if replicas == nil {
+		return DefaultControlPlaneComponentReplicas
+	}
+	return int(*replicas)
+}
+
+// ValidateControlPlaneComponents validates the VerticalPodAutoscaler update mode and the number of replicas of
+// the control plane components.
+func ValidateControlPlaneComponents(vpaUpdateMode *string, replicas *int32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if vpaUpdateMode != nil && !supportedVPAUpdateModes.Has(*vpaUpdateMode) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("vpa", "updateMode"), *vpaUpdateMode, supportedVPAUpdateModes.List()))
+	}
+	if replicas != nil && *replicas < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("replicas"), *replicas, "must be greater than or equal to 1"))
+	}
+
+	return allErrs
+}
+
+// GetControlPlaneComponentChartValues returns the chart values for the vertical pod autoscaling, PodDisruptionBudget
+// and pod anti-affinity settings of a control plane component. Unset settings default to an enabled
+// VerticalPodAutoscaler in update mode Auto, and to neither a PodDisruptionBudget nor pod anti-affinity.
+// The charts only deploy the PodDisruptionBudget and the pod anti-affinity if the component runs more than one replica.
+func GetControlPlaneComponentChartValues(vpaEnabled *bool, vpaUpdateMode *string, podDisruptionBudget, podAntiAffinity *bool) map[string]interface{} {
+	var (
+		enableVPA          = true
+		updateMode         = VPAUpdateModeAuto
+		enablePDB          = false
+		enableAntiAffinity = false
+	)
+
+	if vpaEnabled != nil {
+		enableVPA = *vpaEnabled
+	}
+	if vpaUpdateMode != nil {
+		updateMode = *vpaUpdateMode
+	}
+	if podDisruptionBudget != nil {
+		enablePDB = *podDisruptionBudget
+	}
+	if podAntiAffinity != nil {
+		enableAntiAffinity = *podAntiAffinity
+	}
+
+	return map[string]interface{}{
+		"vpa": map[string]interface{}{
+			"enabled": enableVPA,
+			"updatePolicy": map[string]interface{}{
+				"updateMode": updateMode,
+			},
+		},
+		"podDisruptionBudget": map[string]interface{}{
+			"enabled": enablePDB,
+		},
+		"podAntiAffinity": map[string]interface{}{
+			"enabled": enableAntiAffinity,
+		},
+	}
+}
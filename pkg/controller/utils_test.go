@@ -27,6 +27,7 @@ import (
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -102,4 +103,76 @@ var _ = Describe("Utils", func() {
 			Expect(secret).To(BeNil())
 		})
 	})
+
+	Describe("#GetControlPlaneComponentChartValues", func() {
+		It("should return the default values if nothing is configured", func() {
+			Expect(controller.GetControlPlaneComponentChartValues(nil, nil, nil, nil)).To(Equal(map[string]interface{}{
+				"vpa": map[string]interface{}{
+					"enabled":      true,
+					"updatePolicy": map[string]interface{}{"updateMode": "Auto"},
+				},
+				"podDisruptionBudget": map[string]interface{}{"enabled": false},
+				"podAntiAffinity":     map[string]interface{}{"enabled": false},
+			}))
+		})
+
+		It("should return the configured values", func() {
+			var (
+				vFalse     = false
+				vTrue      = true
+				updateMode = "Initial"
+			)
+
+			Expect(controller.GetControlPlaneComponentChartValues(&vFalse, &updateMode, &vTrue, &vTrue)).To(Equal(map[string]interface{}{
+				"vpa": map[string]interface{}{
+					"enabled":      false,
+					"updatePolicy": map[string]interface{}{"updateMode": "Initial"},
+				},
+				"podDisruptionBudget": map[string]interface{}{"enabled": true},
+				"podAntiAffinity":     map[string]interface{}{"enabled": true},
+			}))
+		})
+	})
+
+	Describe("#GetControlPlaneComponentReplicas", func() {
+		It("should default to one replica", func() {
+			Expect(controller.GetControlPlaneComponentReplicas(nil)).To(Equal(1))
+		})
+
+		It("should return the configured replicas", func() {
+			replicas := int32(3)
+			Expect(controller.GetControlPlaneComponentReplicas(&replicas)).To(Equal(3))
+		})
+	})
+
+	Describe("#ValidateControlPlaneComponents", func() {
+		fldPath := field.NewPath("controlPlaneComponents")
+
+		It("should allow unset settings", func() {
+			Expect(controller.ValidateControlPlaneComponents(nil, nil, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow valid settings", func() {
+			var (
+				updateMode = "Recreate"
+				replicas   = int32(2)
+			)
+
+			Expect(controller.ValidateControlPlaneComponents(&updateMode, &replicas, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid an unsupported update mode and less than one replica", func() {
+			var (
+				updateMode = "auto"
+				replicas   = int32(0)
+			)
+
+			errs := controller.ValidateControlPlaneComponents(&updateMode, &replicas, fldPath)
+			Expect(errs).To(HaveLen(2))
+			Expect(errs[0].Type).To(Equal(field.ErrorTypeNotSupported))
+			Expect(errs[0].Field).To(Equal("controlPlaneComponents.vpa.updateMode"))
+			Expect(errs[1].Type).To(Equal(field.ErrorTypeInvalid))
+			Expect(errs[1].Field).To(Equal("controlPlaneComponents.replicas"))
+		})
+	})
 })
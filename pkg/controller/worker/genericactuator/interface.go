@@ -27,7 +27,7 @@ import (
 // WorkerDelegate is used for the Worker reconciliation.
 type WorkerDelegate interface {
 	// GetMachineControllerManagerChart should return the the chart and the values for the machine-controller-manager
-	// deployment.
+	// deployment. The values may contain the desired number of replicas.
 	GetMachineControllerManagerChartValues(context.Context) (map[string]interface{}, error)
 	// GetMachineControllerManagerShootChart should return the values to render the chart containing resources
 	// that are required by the machine-controller-manager inside the shoot cluster itself.
@@ -49,11 +49,14 @@ func (a *genericActuator) deployMachineControllerManager(ctx context.Context, wo
 	}
 	injectPodAnnotation(mcmValues, "checksum/secret-machine-controller-manager", util.ComputeChecksum(mcmKubeconfigSecret.Data))
 
-	replicaCount, err := replicas()
-	if err != nil {
-		return err
+	// The worker delegate may configure the number of replicas in the values. It is overridden while the shoot is hibernated.
+	if _, ok := mcmValues["replicas"]; !ok || controller.IsHibernated(cluster) {
+		replicaCount, err := replicas()
+		if err != nil {
+			return err
+		}
+		mcmValues["replicas"] = replicaCount
 	}
-	mcmValues["replicas"] = replicaCount
 
 	if err := a.mcmSeedChart.Apply(ctx, a.chartApplier, workerObj.Namespace,
 		a.imageVector, a.gardenerClientset.Version(), extensionscontroller.GetKubernetesVersion(cluster), mcmValues); err != nil {
@@ -18,6 +18,7 @@ import (
 	extensionshandler "github.com/gardener/gardener-extensions/pkg/handler"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -28,3 +29,19 @@ import (
 func ClusterToWorkerMapper(predicates []predicate.Predicate) handler.Mapper {
 	return extensionshandler.ClusterToObjectMapper(func() runtime.Object { return &extensionsv1alpha1.WorkerList{} }, predicates)
 }
+
+// SecretToWorkerMapper returns a mapper that returns requests for Workers whose
+// referenced secrets have been modified.
+func SecretToWorkerMapper(predicates []predicate.Predicate) handler.Mapper {
+	return extensionshandler.SecretToObjectMapper(
+		func() runtime.Object { return &extensionsv1alpha1.WorkerList{} },
+		func(obj runtime.Object) *corev1.SecretReference {
+			worker, ok := obj.(*extensionsv1alpha1.Worker)
+			if !ok {
+				return nil
+			}
+			return &worker.Spec.SecretRef
+		},
+		predicates,
+	)
+}
@@ -20,6 +20,7 @@ import (
 	extensionspredicate "github.com/gardener/gardener-extensions/pkg/predicate"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -96,3 +97,76 @@ func (m *clusterToObjectMapper) Map(obj handler.MapObject) []reconcile.Request {
 func ClusterToObjectMapper(newObjListFunc func() runtime.Object, predicates []predicate.Predicate) handler.Mapper {
 	return &clusterToObjectMapper{newObjListFunc: newObjListFunc, predicates: predicates}
 }
+
+type secretToObjectMapper struct {
+	client         client.Client
+	newObjListFunc func() runtime.Object
+	secretRefFunc  func(runtime.Object) *corev1.SecretReference
+	predicates     []predicate.Predicate
+}
+
+func (m *secretToObjectMapper) InjectClient(c client.Client) error {
+	m.client = c
+	return nil
+}
+
+func (m *secretToObjectMapper) InjectFunc(f inject.Func) error {
+	for _, p := range m.predicates {
+		if err := f(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *secretToObjectMapper) Map(obj handler.MapObject) []reconcile.Request {
+	ctx := context.TODO()
+
+	if obj.Object == nil {
+		return nil
+	}
+
+	secret, ok := obj.Object.(*corev1.Secret)
+	if !ok {
+		return nil
+	}
+
+	objList := m.newObjListFunc()
+	if err := m.client.List(ctx, objList, client.InNamespace(secret.Namespace)); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+
+	utilruntime.HandleError(meta.EachListItem(objList, func(obj runtime.Object) error {
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			return err
+		}
+
+		secretRef := m.secretRefFunc(obj)
+		if secretRef == nil || secretRef.Name != secret.Name || secretRef.Namespace != secret.Namespace {
+			return nil
+		}
+
+		if !extensionspredicate.EvalGeneric(obj, m.predicates...) {
+			return nil
+		}
+
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Namespace: accessor.GetNamespace(),
+				Name:      accessor.GetName(),
+			},
+		})
+		return nil
+	}))
+	return requests
+}
+
+// SecretToObjectMapper returns a mapper that returns requests for objects whose
+// referenced secrets have been modified. The secret reference of an object is
+// determined by the given secretRefFunc.
+func SecretToObjectMapper(newObjListFunc func() runtime.Object, secretRefFunc func(runtime.Object) *corev1.SecretReference, predicates []predicate.Predicate) handler.Mapper {
+	return &secretToObjectMapper{newObjListFunc: newObjListFunc, secretRefFunc: secretRefFunc, predicates: predicates}
+}
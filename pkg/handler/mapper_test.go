@@ -24,6 +24,7 @@ import (
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -183,6 +184,107 @@ var _ = Describe("Controller Mapper", func() {
 			Expect(result).To(BeNil())
 		})
 	})
+
+	Describe("#SecretToObjectMapper", func() {
+		var (
+			namespace = "shoot"
+
+			newObjListFunc = func() runtime.Object { return &extensionsv1alpha1.WorkerList{} }
+			secretRefFunc  = func(obj runtime.Object) *corev1.SecretReference {
+				return &obj.(*extensionsv1alpha1.Worker).Spec.SecretRef
+			}
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cloudprovider",
+					Namespace: namespace,
+				},
+			}
+			newWorker = func(name, secretName string) extensionsv1alpha1.Worker {
+				return extensionsv1alpha1.Worker{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      name,
+						Namespace: namespace,
+					},
+					Spec: extensionsv1alpha1.WorkerSpec{
+						SecretRef: corev1.SecretReference{
+							Name:      secretName,
+							Namespace: namespace,
+						},
+					},
+				}
+			}
+		)
+
+		It("should find all objects referencing the passed secret", func() {
+			mapper := SecretToObjectMapper(newObjListFunc, secretRefFunc, nil)
+			ExpectInject(inject.ClientInto(c, mapper))
+
+			c.EXPECT().
+				List(
+					gomock.AssignableToTypeOf(context.TODO()),
+					gomock.AssignableToTypeOf(&extensionsv1alpha1.WorkerList{}),
+					gomock.AssignableToTypeOf(client.InNamespace(namespace)),
+				).
+				DoAndReturn(func(_ context.Context, actual *extensionsv1alpha1.WorkerList, _ ...client.ListOptionFunc) error {
+					*actual = extensionsv1alpha1.WorkerList{
+						Items: []extensionsv1alpha1.Worker{
+							newWorker("worker", "cloudprovider"),
+							newWorker("other", "other-secret"),
+						},
+					}
+					return nil
+				})
+
+			result := mapper.Map(handler.MapObject{Object: secret})
+
+			Expect(result).To(ConsistOf(reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      "worker",
+					Namespace: namespace,
+				},
+			}))
+		})
+
+		It("should find no objects for the passed secret because predicates do not match", func() {
+			var (
+				predicates = []predicate.Predicate{
+					predicate.Funcs{
+						GenericFunc: func(event event.GenericEvent) bool {
+							return false
+						},
+					},
+				}
+				mapper = SecretToObjectMapper(newObjListFunc, secretRefFunc, predicates)
+			)
+			ExpectInject(inject.ClientInto(c, mapper))
+
+			c.EXPECT().
+				List(
+					gomock.AssignableToTypeOf(context.TODO()),
+					gomock.AssignableToTypeOf(&extensionsv1alpha1.WorkerList{}),
+					gomock.AssignableToTypeOf(client.InNamespace(namespace)),
+				).
+				DoAndReturn(func(_ context.Context, actual *extensionsv1alpha1.WorkerList, _ ...client.ListOptionFunc) error {
+					*actual = extensionsv1alpha1.WorkerList{
+						Items: []extensionsv1alpha1.Worker{newWorker("worker", "cloudprovider")},
+					}
+					return nil
+				})
+
+			result := mapper.Map(handler.MapObject{Object: secret})
+
+			Expect(result).To(BeEmpty())
+		})
+
+		It("should find no objects because the passed object is no secret", func() {
+			mapper := SecretToObjectMapper(newObjListFunc, secretRefFunc, nil)
+			ExpectInject(inject.ClientInto(c, mapper))
+			result := mapper.Map(handler.MapObject{
+				Object: &extensionsv1alpha1.Cluster{},
+			})
+			Expect(result).To(BeNil())
+		})
+	})
 })
 
 func ExpectInject(ok bool, err error) {
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/gardener/gardener-extensions/pkg/controller/backupbucket/genericactuator (interfaces: BackupBucketDelegate)
+
+// Package genericactuator is a generated GoMock package.
+package genericactuator
+
+import (
+	context "context"
+	objectstore "github.com/gardener/gardener-extensions/pkg/objectstore"
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockBackupBucketDelegate is a mock of BackupBucketDelegate interface
+type MockBackupBucketDelegate struct {
+	ctrl     *gomock.Controller
+	recorder *MockBackupBucketDelegateMockRecorder
+}
+
+// MockBackupBucketDelegateMockRecorder is the mock recorder for MockBackupBucketDelegate
+type MockBackupBucketDelegateMockRecorder struct {
+	mock *MockBackupBucketDelegate
+}
+
+// NewMockBackupBucketDelegate creates a new mock instance
+func NewMockBackupBucketDelegate(ctrl *gomock.Controller) *MockBackupBucketDelegate {
+	mock := &MockBackupBucketDelegate{ctrl: ctrl}
+	mock.recorder = &MockBackupBucketDelegateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBackupBucketDelegate) EXPECT() *MockBackupBucketDelegateMockRecorder {
+	return m.recorder
+}
+
+// GetObjectStore mocks base method
+func (m *MockBackupBucketDelegate) GetObjectStore(arg0 context.Context, arg1 *v1alpha1.BackupBucket) (objectstore.ObjectStore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetObjectStore", arg0, arg1)
+	ret0, _ := ret[0].(objectstore.ObjectStore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetObjectStore indicates an expected call of GetObjectStore
+func (mr *MockBackupBucketDelegateMockRecorder) GetObjectStore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectStore", reflect.TypeOf((*MockBackupBucketDelegate)(nil).GetObjectStore), arg0, arg1)
+}
+
+// ReconcileBucketConfig mocks base method
+func (m *MockBackupBucketDelegate) ReconcileBucketConfig(arg0 context.Context, arg1 *v1alpha1.BackupBucket) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileBucketConfig", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileBucketConfig indicates an expected call of ReconcileBucketConfig
+func (mr *MockBackupBucketDelegateMockRecorder) ReconcileBucketConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileBucketConfig", reflect.TypeOf((*MockBackupBucketDelegate)(nil).ReconcileBucketConfig), arg0, arg1)
+}
@@ -9,6 +9,7 @@ import (
 	v1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
+	time "time"
 )
 
 // MockBackupEntryDelegate is a mock of BackupEntryDelegate interface
@@ -62,3 +63,18 @@ func (mr *MockBackupEntryDelegateMockRecorder) GetETCDSecretData(arg0, arg1, arg
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetETCDSecretData", reflect.TypeOf((*MockBackupEntryDelegate)(nil).GetETCDSecretData), arg0, arg1, arg2)
 }
+
+// ListObjects mocks base method
+func (m *MockBackupEntryDelegate) ListObjects(arg0 context.Context, arg1 *v1alpha1.BackupEntry, arg2 string) (map[string]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjects", arg0, arg1, arg2)
+	ret0, _ := ret[0].(map[string]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjects indicates an expected call of ListObjects
+func (mr *MockBackupEntryDelegateMockRecorder) ListObjects(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjects", reflect.TypeOf((*MockBackupEntryDelegate)(nil).ListObjects), arg0, arg1, arg2)
+}
@@ -7,6 +7,7 @@ package genericactuator
 import (
 	context "context"
 	controller "github.com/gardener/gardener-extensions/pkg/controller"
+	controlplane "github.com/gardener/gardener-extensions/pkg/controller/controlplane"
 	v1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
@@ -95,6 +96,21 @@ func (mr *MockValuesProviderMockRecorder) GetControlPlaneShootChartValues(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetControlPlaneShootChartValues", reflect.TypeOf((*MockValuesProvider)(nil).GetControlPlaneShootChartValues), arg0, arg1, arg2)
 }
 
+// GetMonitoringConfig mocks base method
+func (m *MockValuesProvider) GetMonitoringConfig(arg0 context.Context, arg1 *v1alpha1.ControlPlane, arg2 *controller.Cluster) (*controlplane.MonitoringConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMonitoringConfig", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*controlplane.MonitoringConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMonitoringConfig indicates an expected call of GetMonitoringConfig
+func (mr *MockValuesProviderMockRecorder) GetMonitoringConfig(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMonitoringConfig", reflect.TypeOf((*MockValuesProvider)(nil).GetMonitoringConfig), arg0, arg1, arg2)
+}
+
 // GetStorageClassesChartValues mocks base method
 func (m *MockValuesProvider) GetStorageClassesChartValues(arg0 context.Context, arg1 *v1alpha1.ControlPlane, arg2 *controller.Cluster) (map[string]interface{}, error) {
 	m.ctrl.T.Helper()
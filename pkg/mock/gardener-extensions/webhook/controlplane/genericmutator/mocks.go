@@ -82,45 +82,45 @@ func (mr *MockEnsurerMockRecorder) EnsureETCDStatefulSet(arg0, arg1, arg2 interf
 }
 
 // EnsureKubeAPIServerDeployment mocks base method
-func (m *MockEnsurer) EnsureKubeAPIServerDeployment(arg0 context.Context, arg1 *v1.Deployment) error {
+func (m *MockEnsurer) EnsureKubeAPIServerDeployment(arg0 context.Context, arg1 *v1.Deployment, arg2 *controller.Cluster) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnsureKubeAPIServerDeployment", arg0, arg1)
+	ret := m.ctrl.Call(m, "EnsureKubeAPIServerDeployment", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // EnsureKubeAPIServerDeployment indicates an expected call of EnsureKubeAPIServerDeployment
-func (mr *MockEnsurerMockRecorder) EnsureKubeAPIServerDeployment(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockEnsurerMockRecorder) EnsureKubeAPIServerDeployment(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureKubeAPIServerDeployment", reflect.TypeOf((*MockEnsurer)(nil).EnsureKubeAPIServerDeployment), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureKubeAPIServerDeployment", reflect.TypeOf((*MockEnsurer)(nil).EnsureKubeAPIServerDeployment), arg0, arg1, arg2)
 }
 
 // EnsureKubeAPIServerService mocks base method
-func (m *MockEnsurer) EnsureKubeAPIServerService(arg0 context.Context, arg1 *v10.Service) error {
+func (m *MockEnsurer) EnsureKubeAPIServerService(arg0 context.Context, arg1 *v10.Service, arg2 *controller.Cluster) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnsureKubeAPIServerService", arg0, arg1)
+	ret := m.ctrl.Call(m, "EnsureKubeAPIServerService", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // EnsureKubeAPIServerService indicates an expected call of EnsureKubeAPIServerService
-func (mr *MockEnsurerMockRecorder) EnsureKubeAPIServerService(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockEnsurerMockRecorder) EnsureKubeAPIServerService(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureKubeAPIServerService", reflect.TypeOf((*MockEnsurer)(nil).EnsureKubeAPIServerService), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureKubeAPIServerService", reflect.TypeOf((*MockEnsurer)(nil).EnsureKubeAPIServerService), arg0, arg1, arg2)
 }
 
 // EnsureKubeControllerManagerDeployment mocks base method
-func (m *MockEnsurer) EnsureKubeControllerManagerDeployment(arg0 context.Context, arg1 *v1.Deployment) error {
+func (m *MockEnsurer) EnsureKubeControllerManagerDeployment(arg0 context.Context, arg1 *v1.Deployment, arg2 *controller.Cluster) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnsureKubeControllerManagerDeployment", arg0, arg1)
+	ret := m.ctrl.Call(m, "EnsureKubeControllerManagerDeployment", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // EnsureKubeControllerManagerDeployment indicates an expected call of EnsureKubeControllerManagerDeployment
-func (mr *MockEnsurerMockRecorder) EnsureKubeControllerManagerDeployment(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockEnsurerMockRecorder) EnsureKubeControllerManagerDeployment(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureKubeControllerManagerDeployment", reflect.TypeOf((*MockEnsurer)(nil).EnsureKubeControllerManagerDeployment), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureKubeControllerManagerDeployment", reflect.TypeOf((*MockEnsurer)(nil).EnsureKubeControllerManagerDeployment), arg0, arg1, arg2)
 }
 
 // EnsureKubeSchedulerDeployment mocks base method
@@ -152,17 +152,17 @@ func (mr *MockEnsurerMockRecorder) EnsureKubeletCloudProviderConfig(arg0, arg1,
 }
 
 // EnsureKubeletConfiguration mocks base method
-func (m *MockEnsurer) EnsureKubeletConfiguration(arg0 context.Context, arg1 *v1beta1.KubeletConfiguration) error {
+func (m *MockEnsurer) EnsureKubeletConfiguration(arg0 context.Context, arg1 *v1beta1.KubeletConfiguration, arg2 *controller.Cluster) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnsureKubeletConfiguration", arg0, arg1)
+	ret := m.ctrl.Call(m, "EnsureKubeletConfiguration", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // EnsureKubeletConfiguration indicates an expected call of EnsureKubeletConfiguration
-func (mr *MockEnsurerMockRecorder) EnsureKubeletConfiguration(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockEnsurerMockRecorder) EnsureKubeletConfiguration(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureKubeletConfiguration", reflect.TypeOf((*MockEnsurer)(nil).EnsureKubeletConfiguration), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureKubeletConfiguration", reflect.TypeOf((*MockEnsurer)(nil).EnsureKubeletConfiguration), arg0, arg1, arg2)
 }
 
 // EnsureKubeletServiceUnitOptions mocks base method
@@ -0,0 +1,105 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+)
+
+// CopyObjectsWithPrefix copies all objects with the given source prefix in the source bucket to the target bucket,
+// replacing the source prefix of their keys by the target prefix. Every copied object is verified by comparing the
+// SHA-256 checksums of the source and the target object. It returns the keys of the copied objects in the target bucket.
+func CopyObjectsWithPrefix(ctx context.Context, source ObjectStore, sourceBucket, sourcePrefix string, target ObjectStore, targetBucket, targetPrefix string) ([]string, error) {
+	objects, err := source.ListObjectsWithPrefix(ctx, sourceBucket, sourcePrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceKeys := make([]string, 0, len(objects))
+	for key := range objects {
+		sourceKeys = append(sourceKeys, key)
+	}
+	sort.Strings(sourceKeys)
+
+	targetKeys := make([]string, 0, len(sourceKeys))
+	for _, sourceKey := range sourceKeys {
+		targetKey := targetPrefix + strings.TrimPrefix(sourceKey, sourcePrefix)
+		if err := CopyObject(ctx, source, sourceBucket, sourceKey, target, targetBucket, targetKey); err != nil {
+			return targetKeys, err
+		}
+		targetKeys = append(targetKeys, targetKey)
+	}
+	return targetKeys, nil
+}
+
+// CopyObject copies the object with the given source key in the source bucket to the object with the given target key
+// in the target bucket. The object is buffered in a temporary file, and the copy is verified by comparing the SHA-256
+// checksums of the source and the target object.
+func CopyObject(ctx context.Context, source ObjectStore, sourceBucket, sourceKey string, target ObjectStore, targetBucket, targetKey string) error {
+	file, err := ioutil.TempFile("", "objectstore-copy-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	body, err := source.GetObject(ctx, sourceBucket, sourceKey)
+	if err != nil {
+		return fmt.Errorf("could not get object %s/%s: %v", sourceBucket, sourceKey, err)
+	}
+	sourceChecksum, err := checksum(io.TeeReader(body, file))
+	body.Close()
+	if err != nil {
+		return fmt.Errorf("could not read object %s/%s: %v", sourceBucket, sourceKey, err)
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if err := target.PutObject(ctx, targetBucket, targetKey, file); err != nil {
+		return fmt.Errorf("could not put object %s/%s: %v", targetBucket, targetKey, err)
+	}
+
+	body, err = target.GetObject(ctx, targetBucket, targetKey)
+	if err != nil {
+		return fmt.Errorf("could not get copied object %s/%s: %v", targetBucket, targetKey, err)
+	}
+	defer body.Close()
+	targetChecksum, err := checksum(body)
+	if err != nil {
+		return fmt.Errorf("could not read copied object %s/%s: %v", targetBucket, targetKey, err)
+	}
+
+	if !bytes.Equal(sourceChecksum, targetChecksum) {
+		return fmt.Errorf("checksum %x of copied object %s/%s does not match checksum %x of object %s/%s", targetChecksum, targetBucket, targetKey, sourceChecksum, sourceBucket, sourceKey)
+	}
+	return nil
+}
+
+func checksum(r io.Reader) ([]byte, error) {
+	hash := sha256.New()
+	if _, err := io.Copy(hash, r); err != nil {
+		return nil, err
+	}
+	return hash.Sum(nil), nil
+}
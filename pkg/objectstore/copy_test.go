@@ -0,0 +1,98 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstore_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+
+	. "github.com/gardener/gardener-extensions/pkg/objectstore"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// corruptingObjectStore is an ObjectStore that appends a byte to every stored object.
+type corruptingObjectStore struct {
+	*InMemoryObjectStore
+}
+
+func (s *corruptingObjectStore) PutObject(ctx context.Context, bucket, key string, body io.ReadSeeker) error {
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	return s.InMemoryObjectStore.PutObject(ctx, bucket, key, bytes.NewReader(append(data, '!')))
+}
+
+var _ = Describe("Copy", func() {
+	var (
+		ctx    = context.TODO()
+		source *InMemoryObjectStore
+		target *InMemoryObjectStore
+	)
+
+	BeforeEach(func() {
+		source = NewInMemoryObjectStore()
+		Expect(source.CreateBucketIfNotExists(ctx, "source")).To(Succeed())
+		for key, data := range map[string]string{
+			"entry/v1/Backup-1/Full-1": "full",
+			"entry/v1/Backup-1/Incr-2": "delta",
+			"other/v1/Backup-1/Full-1": "other",
+		} {
+			Expect(source.PutObject(ctx, "source", key, bytes.NewReader([]byte(data)))).To(Succeed())
+		}
+
+		target = NewInMemoryObjectStore()
+		Expect(target.CreateBucketIfNotExists(ctx, "target")).To(Succeed())
+	})
+
+	Describe("#CopyObjectsWithPrefix", func() {
+		It("should copy all objects with the prefix", func() {
+			keys, err := CopyObjectsWithPrefix(ctx, source, "source", "entry/", target, "target", "new-entry/")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(keys).To(Equal([]string{"new-entry/v1/Backup-1/Full-1", "new-entry/v1/Backup-1/Incr-2"}))
+			Expect(target.ListObjectsWithPrefix(ctx, "target", "")).To(HaveLen(2))
+			Expect(readObject(target, "target", "new-entry/v1/Backup-1/Full-1")).To(Equal("full"))
+			Expect(readObject(target, "target", "new-entry/v1/Backup-1/Incr-2")).To(Equal("delta"))
+		})
+
+		It("should fail if the checksum of a copied object does not match", func() {
+			keys, err := CopyObjectsWithPrefix(ctx, source, "source", "entry/", &corruptingObjectStore{target}, "target", "new-entry/")
+
+			Expect(err).To(HaveOccurred())
+			Expect(keys).To(BeEmpty())
+		})
+
+		It("should fail if the target bucket does not exist", func() {
+			_, err := CopyObjectsWithPrefix(ctx, source, "source", "entry/", target, "missing", "new-entry/")
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
+
+func readObject(store ObjectStore, bucket, key string) string {
+	body, err := store.GetObject(context.TODO(), bucket, key)
+	Expect(err).NotTo(HaveOccurred())
+	defer body.Close()
+
+	data, err := ioutil.ReadAll(body)
+	Expect(err).NotTo(HaveOccurred())
+	return string(data)
+}
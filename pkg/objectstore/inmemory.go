@@ -0,0 +1,172 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"time"
+)
+
+type inMemoryObject struct {
+	data         []byte
+	lastModified time.Time
+}
+
+// InMemoryObjectStore is an ObjectStore keeping all buckets and objects in memory. It is meant to be used in tests.
+type InMemoryObjectStore struct {
+	lock    sync.RWMutex
+	buckets map[string]map[string]inMemoryObject
+
+	// Now returns the current time. It is used as last modification time of stored objects.
+	Now func() time.Time
+}
+
+// NewInMemoryObjectStore creates a new empty InMemoryObjectStore.
+func NewInMemoryObjectStore() *InMemoryObjectStore {
+	return &InMemoryObjectStore{
+		buckets: make(map[string]map[string]inMemoryObject),
+		Now:     time.Now,
+	}
+}
+
+// BucketExists returns whether the bucket with the given name exists.
+func (s *InMemoryObjectStore) BucketExists(bucket string) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	_, ok := s.buckets[bucket]
+	return ok
+}
+
+// CreateBucketIfNotExists implements ObjectStore.
+func (s *InMemoryObjectStore) CreateBucketIfNotExists(_ context.Context, bucket string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.buckets[bucket]; !ok {
+		s.buckets[bucket] = make(map[string]inMemoryObject)
+	}
+	return nil
+}
+
+// DeleteBucketIfExists implements ObjectStore.
+func (s *InMemoryObjectStore) DeleteBucketIfExists(_ context.Context, bucket string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.buckets, bucket)
+	return nil
+}
+
+// ListObjectsWithPrefix implements ObjectStore.
+func (s *InMemoryObjectStore) ListObjectsWithPrefix(_ context.Context, bucket, prefix string) (map[string]time.Time, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	objects, err := s.bucket(bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]time.Time)
+	for key, object := range objects {
+		if strings.HasPrefix(key, prefix) {
+			result[key] = object.lastModified
+		}
+	}
+	return result, nil
+}
+
+// GetObject implements ObjectStore.
+func (s *InMemoryObjectStore) GetObject(_ context.Context, bucket, key string) (io.ReadCloser, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	objects, err := s.bucket(bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	object, ok := objects[key]
+	if !ok {
+		return nil, fmt.Errorf("object %s/%s does not exist", bucket, key)
+	}
+	return ioutil.NopCloser(bytes.NewReader(object.data)), nil
+}
+
+// PutObject implements ObjectStore.
+func (s *InMemoryObjectStore) PutObject(_ context.Context, bucket, key string, body io.ReadSeeker) error {
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	objects, err := s.bucket(bucket)
+	if err != nil {
+		return err
+	}
+
+	objects[key] = inMemoryObject{data: data, lastModified: s.Now()}
+	return nil
+}
+
+// DeleteObject implements ObjectStore.
+func (s *InMemoryObjectStore) DeleteObject(_ context.Context, bucket, key string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	objects, err := s.bucket(bucket)
+	if err != nil {
+		return err
+	}
+
+	delete(objects, key)
+	return nil
+}
+
+// DeleteObjectsWithPrefix implements ObjectStore.
+func (s *InMemoryObjectStore) DeleteObjectsWithPrefix(_ context.Context, bucket, prefix string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	objects, err := s.bucket(bucket)
+	if err != nil {
+		return err
+	}
+
+	for key := range objects {
+		if strings.HasPrefix(key, prefix) {
+			delete(objects, key)
+		}
+	}
+	return nil
+}
+
+func (s *InMemoryObjectStore) bucket(bucket string) (map[string]inMemoryObject, error) {
+	objects, ok := s.buckets[bucket]
+	if !ok {
+		return nil, fmt.Errorf("bucket %s does not exist", bucket)
+	}
+	return objects, nil
+}
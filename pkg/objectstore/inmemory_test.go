@@ -0,0 +1,71 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstore_test
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	. "github.com/gardener/gardener-extensions/pkg/objectstore"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("InMemoryObjectStore", func() {
+	var (
+		ctx   = context.TODO()
+		now   = time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+		store *InMemoryObjectStore
+	)
+
+	BeforeEach(func() {
+		store = NewInMemoryObjectStore()
+		store.Now = func() time.Time { return now }
+		Expect(store.CreateBucketIfNotExists(ctx, "bucket")).To(Succeed())
+	})
+
+	It("should manage buckets", func() {
+		Expect(store.BucketExists("bucket")).To(BeTrue())
+		Expect(store.CreateBucketIfNotExists(ctx, "bucket")).To(Succeed())
+
+		Expect(store.DeleteBucketIfExists(ctx, "bucket")).To(Succeed())
+		Expect(store.BucketExists("bucket")).To(BeFalse())
+		Expect(store.DeleteBucketIfExists(ctx, "bucket")).To(Succeed())
+	})
+
+	It("should manage objects", func() {
+		Expect(store.PutObject(ctx, "bucket", "foo/1", bytes.NewReader([]byte("1")))).To(Succeed())
+		Expect(store.PutObject(ctx, "bucket", "foo/2", bytes.NewReader([]byte("2")))).To(Succeed())
+		Expect(store.PutObject(ctx, "bucket", "bar/1", bytes.NewReader([]byte("3")))).To(Succeed())
+
+		Expect(store.ListObjectsWithPrefix(ctx, "bucket", "foo/")).To(Equal(map[string]time.Time{"foo/1": now, "foo/2": now}))
+		Expect(readObject(store, "bucket", "foo/2")).To(Equal("2"))
+
+		Expect(store.DeleteObject(ctx, "bucket", "foo/1")).To(Succeed())
+		Expect(store.DeleteObject(ctx, "bucket", "foo/1")).To(Succeed())
+		Expect(store.ListObjectsWithPrefix(ctx, "bucket", "")).To(HaveLen(2))
+
+		Expect(store.DeleteObjectsWithPrefix(ctx, "bucket", "foo/")).To(Succeed())
+		Expect(store.ListObjectsWithPrefix(ctx, "bucket", "")).To(Equal(map[string]time.Time{"bar/1": now}))
+	})
+
+	It("should fail to access objects of missing buckets", func() {
+		_, err := store.GetObject(ctx, "missing", "foo")
+		Expect(err).To(HaveOccurred())
+		Expect(store.PutObject(ctx, "missing", "foo", bytes.NewReader(nil))).NotTo(Succeed())
+	})
+})
@@ -0,0 +1,105 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package s3compat
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// StorageProviderName is the name of the etcd-backup-restore storage provider for S3-compatible object storages.
+	StorageProviderName = "S3"
+
+	// credentialsMountPath is the path the etcd backup secret is mounted to in the backup-restore container.
+	credentialsMountPath = "/root/.s3"
+)
+
+// durationFlags maps the etcd-backup-restore flags taking a number of seconds to the flags taking a duration that
+// replace them in etcd-backup-restore v0.15.
+var durationFlags = map[string]string{
+	"delta-snapshot-period-seconds":     "delta-snapshot-period",
+	"garbage-collection-period-seconds": "garbage-collection-period",
+	"etcd-connection-timeout":           "etcd-connection-timeout",
+}
+
+// GetBackupRestoreEnv returns the env variables of the etcd-backup-restore container for storing backups in an
+// S3-compatible object storage. The settings are read from the etcd backup secret with the given name that is
+// mounted as a volume (see GetBackupRestoreVolume and GetBackupRestoreVolumeMounts).
+func GetBackupRestoreEnv(secretName string) []corev1.EnvVar {
+	return []corev1.EnvVar{
+		{
+			Name: "STORAGE_CONTAINER",
+			// The bucket name is written to the backup secret by Gardener as a temporary solution.
+			// TODO In the future, the bucket name should come from a BackupBucket resource (see https://github.com/gardener/gardener/blob/master/docs/proposals/02-backupinfra.md)
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					Key:                  BucketName,
+					LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+				},
+			},
+		},
+		{
+			Name:  "AWS_APPLICATION_CREDENTIALS",
+			Value: credentialsMountPath,
+		},
+	}
+}
+
+// GetBackupRestoreVolumeMounts returns the volume mounts of the etcd-backup-restore container for the etcd backup
+// secret with the given name.
+func GetBackupRestoreVolumeMounts(secretName string) []corev1.VolumeMount {
+	return []corev1.VolumeMount{
+		{
+			Name:      secretName,
+			MountPath: credentialsMountPath,
+		},
+	}
+}
+
+// GetBackupRestoreVolume returns the volume of the etcd backup secret with the given name.
+func GetBackupRestoreVolume(secretName string) corev1.Volume {
+	return corev1.Volume{
+		Name: secretName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: secretName,
+			},
+		},
+	}
+}
+
+// EnsureBackupRestoreFlags converts the flags of the given etcd-backup-restore container that take a number of seconds
+// to the duration flags of etcd-backup-restore v0.15. Storing backups in an S3-compatible object storage requires this
+// version because earlier versions cannot use a custom endpoint.
+func EnsureBackupRestoreFlags(c *corev1.Container) {
+	for i, arg := range c.Command {
+		for secondsFlag, durationFlag := range durationFlags {
+			prefix := fmt.Sprintf("--%s=", secondsFlag)
+			if !strings.HasPrefix(arg, prefix) {
+				continue
+			}
+			seconds, err := strconv.ParseInt(strings.TrimPrefix(arg, prefix), 10, 64)
+			if err != nil {
+				continue
+			}
+			c.Command[i] = fmt.Sprintf("--%s=%s", durationFlag, time.Duration(seconds)*time.Second)
+		}
+	}
+}
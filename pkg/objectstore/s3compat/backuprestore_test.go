@@ -0,0 +1,52 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package s3compat_test
+
+import (
+	. "github.com/gardener/gardener-extensions/pkg/objectstore/s3compat"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("BackupRestore", func() {
+	Describe("#EnsureBackupRestoreFlags", func() {
+		It("should convert the flags taking seconds to duration flags", func() {
+			c := &corev1.Container{
+				Command: []string{
+					"etcdbrctl",
+					"server",
+					"--etcd-connection-timeout=300",
+					"--delta-snapshot-period-seconds=20",
+					"--garbage-collection-period-seconds=43200",
+					"--schedule=0 */24 * * *",
+				},
+			}
+
+			EnsureBackupRestoreFlags(c)
+			EnsureBackupRestoreFlags(c)
+
+			Expect(c.Command).To(Equal([]string{
+				"etcdbrctl",
+				"server",
+				"--etcd-connection-timeout=5m0s",
+				"--delta-snapshot-period=20s",
+				"--garbage-collection-period=12h0m0s",
+				"--schedule=0 */24 * * *",
+			}))
+		})
+	})
+})
@@ -0,0 +1,178 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package s3compat
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/pkg/errors"
+)
+
+// bucketLifecycleRuleID is the id of the lifecycle rule managed for backup buckets.
+const bucketLifecycleRuleID = "gardener-backup-expiry"
+
+// BucketConfig contains the settings applied to the buckets of an S3-compatible object storage. Settings that are
+// nil are left unchanged.
+type BucketConfig struct {
+	// Versioning indicates whether object versioning is enabled for the bucket.
+	Versioning *bool
+	// ObjectLock contains the default retention settings for objects in the bucket. Object lock can only be enabled
+	// while the bucket is created.
+	ObjectLock *ObjectLockConfig
+	// Encryption contains the server-side encryption settings for the bucket.
+	Encryption *EncryptionConfig
+	// Lifecycle contains the expiry rules for objects in the bucket.
+	Lifecycle *LifecycleConfig
+}
+
+// ObjectLockConfig contains the default retention settings for objects in the bucket.
+type ObjectLockConfig struct {
+	// Mode is the default retention mode, either GOVERNANCE or COMPLIANCE.
+	Mode string
+	// Days is the number of days objects are retained.
+	Days int64
+}
+
+// EncryptionConfig contains the server-side encryption settings for the bucket.
+type EncryptionConfig struct {
+	// KMSKeyID is the id of the key used for encrypting the objects. If it is nil, the objects are encrypted with
+	// keys managed by the object storage.
+	KMSKeyID *string
+}
+
+// LifecycleConfig contains the expiry rules for objects in the bucket. If both fields are nil, the lifecycle
+// configuration of the bucket is removed.
+type LifecycleConfig struct {
+	// ExpirationDays is the number of days after which objects expire.
+	ExpirationDays *int64
+	// NoncurrentVersionExpirationDays is the number of days after which noncurrent object versions expire.
+	NoncurrentVersionExpirationDays *int64
+}
+
+// BucketConfigReconciler applies the BucketConfig of the Config to the buckets of an S3-compatible object storage.
+type BucketConfigReconciler interface {
+	// ReconcileBucketConfig applies the BucketConfig to the bucket with name <bucket>. The settings are applied on
+	// every call to revert any changes that were made to the bucket outside of Gardener.
+	ReconcileBucketConfig(ctx context.Context, bucket string) error
+}
+
+// ReconcileBucketConfig applies the BucketConfig of the object store to the bucket with name <bucket>.
+func (o *objectStore) ReconcileBucketConfig(ctx context.Context, bucket string) error {
+	if o.bucketConfig == nil {
+		return nil
+	}
+
+	if o.bucketConfig.Versioning != nil {
+		if err := o.updateBucketVersioning(ctx, bucket, *o.bucketConfig.Versioning); err != nil {
+			return errors.Wrapf(err, "could not update versioning of bucket '%s'", bucket)
+		}
+	}
+
+	if objectLock := o.bucketConfig.ObjectLock; objectLock != nil {
+		if err := o.updateBucketObjectLock(ctx, bucket, objectLock); err != nil {
+			return errors.Wrapf(err, "could not update object lock of bucket '%s'", bucket)
+		}
+	}
+
+	if encryption := o.bucketConfig.Encryption; encryption != nil {
+		if err := o.updateBucketEncryption(ctx, bucket, encryption); err != nil {
+			return errors.Wrapf(err, "could not update encryption of bucket '%s'", bucket)
+		}
+	}
+
+	if lifecycle := o.bucketConfig.Lifecycle; lifecycle != nil {
+		if err := o.updateBucketLifecycle(ctx, bucket, lifecycle); err != nil {
+			return errors.Wrapf(err, "could not update lifecycle of bucket '%s'", bucket)
+		}
+	}
+
+	return nil
+}
+
+func (o *objectStore) updateBucketVersioning(ctx context.Context, bucket string, enabled bool) error {
+	status := s3.BucketVersioningStatusSuspended
+	if enabled {
+		status = s3.BucketVersioningStatusEnabled
+	}
+
+	_, err := o.s3.PutBucketVersioningWithContext(ctx, &s3.PutBucketVersioningInput{
+		Bucket:                  aws.String(bucket),
+		VersioningConfiguration: &s3.VersioningConfiguration{Status: aws.String(status)},
+	})
+	return err
+}
+
+func (o *objectStore) updateBucketObjectLock(ctx context.Context, bucket string, objectLock *ObjectLockConfig) error {
+	_, err := o.s3.PutObjectLockConfigurationWithContext(ctx, &s3.PutObjectLockConfigurationInput{
+		Bucket: aws.String(bucket),
+		ObjectLockConfiguration: &s3.ObjectLockConfiguration{
+			ObjectLockEnabled: aws.String(s3.ObjectLockEnabledEnabled),
+			Rule: &s3.ObjectLockRule{
+				DefaultRetention: &s3.DefaultRetention{
+					Mode: aws.String(objectLock.Mode),
+					Days: aws.Int64(objectLock.Days),
+				},
+			},
+		},
+	})
+	return err
+}
+
+func (o *objectStore) updateBucketEncryption(ctx context.Context, bucket string, encryption *EncryptionConfig) error {
+	encryptionByDefault := &s3.ServerSideEncryptionByDefault{SSEAlgorithm: aws.String(s3.ServerSideEncryptionAes256)}
+	if encryption.KMSKeyID != nil {
+		encryptionByDefault = &s3.ServerSideEncryptionByDefault{
+			SSEAlgorithm:   aws.String(s3.ServerSideEncryptionAwsKms),
+			KMSMasterKeyID: encryption.KMSKeyID,
+		}
+	}
+
+	_, err := o.s3.PutBucketEncryptionWithContext(ctx, &s3.PutBucketEncryptionInput{
+		Bucket: aws.String(bucket),
+		ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{
+			Rules: []*s3.ServerSideEncryptionRule{
+				{ApplyServerSideEncryptionByDefault: encryptionByDefault},
+			},
+		},
+	})
+	return err
+}
+
+func (o *objectStore) updateBucketLifecycle(ctx context.Context, bucket string, lifecycle *LifecycleConfig) error {
+	if lifecycle.ExpirationDays == nil && lifecycle.NoncurrentVersionExpirationDays == nil {
+		_, err := o.s3.DeleteBucketLifecycleWithContext(ctx, &s3.DeleteBucketLifecycleInput{Bucket: aws.String(bucket)})
+		return err
+	}
+
+	rule := &s3.LifecycleRule{
+		ID:     aws.String(bucketLifecycleRuleID),
+		Filter: &s3.LifecycleRuleFilter{Prefix: aws.String("")},
+		Status: aws.String(s3.ExpirationStatusEnabled),
+	}
+	if lifecycle.ExpirationDays != nil {
+		rule.Expiration = &s3.LifecycleExpiration{Days: lifecycle.ExpirationDays}
+	}
+	if lifecycle.NoncurrentVersionExpirationDays != nil {
+		rule.NoncurrentVersionExpiration = &s3.NoncurrentVersionExpiration{NoncurrentDays: lifecycle.NoncurrentVersionExpirationDays}
+	}
+
+	_, err := o.s3.PutBucketLifecycleConfigurationWithContext(ctx, &s3.PutBucketLifecycleConfigurationInput{
+		Bucket:                 aws.String(bucket),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{Rules: []*s3.LifecycleRule{rule}},
+	})
+	return err
+}
@@ -0,0 +1,304 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package s3compat
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+const (
+	// DefaultRegion is the region used for signing requests if neither the Config nor the backup bucket specify one.
+	DefaultRegion = "us-east-1"
+
+	// errCodeBucketNotEmpty is the error code returned when deleting a non-empty bucket.
+	errCodeBucketNotEmpty = "BucketNotEmpty"
+	// maxBucketEmptyingAttempts is the maximum number of attempts to empty a bucket before it is deleted.
+	maxBucketEmptyingAttempts = 3
+)
+
+// Config is the configuration of an S3-compatible object storage like MinIO or Ceph RGW.
+type Config struct {
+	// Endpoint is the URL of the S3-compatible endpoint, e.g. https://s3.example.com.
+	Endpoint string
+	// Region overrides the region of the backup buckets and entries if it is set. It is used for signing the requests
+	// and as location constraint of created buckets.
+	Region string
+	// ForcePathStyle specifies whether path-style addressing (https://s3.example.com/bucket/key) is used instead of
+	// virtual-hosted-style addressing (https://bucket.s3.example.com/key).
+	ForcePathStyle bool
+	// CABundle is a PEM-encoded bundle of CA certificates used to verify the certificate of the endpoint. If it is
+	// empty, the CA certificates of the system are used.
+	CABundle []byte
+	// BucketConfig contains the settings applied to the buckets. If it is nil, the buckets are created with the
+	// defaults of the object storage.
+	BucketConfig *BucketConfig
+}
+
+// GetRegion returns the region of the Config if it is set. Otherwise, the given <region> is returned, or DefaultRegion
+// if it is empty.
+func (c *Config) GetRegion(region string) string {
+	if len(c.Region) != 0 {
+		return c.Region
+	}
+	if len(region) != 0 {
+		return region
+	}
+	return DefaultRegion
+}
+
+// objectStore is an objectstore.ObjectStore for the buckets of an S3-compatible object storage.
+type objectStore struct {
+	s3           *s3.S3
+	region       string
+	bucketConfig *BucketConfig
+}
+
+// NewObjectStore creates a new objectstore.ObjectStore for the buckets of the S3-compatible object storage with the
+// given <config> using the given credentials <accessKeyID> and <secretAccessKey>. The region <region> of the backup
+// bucket or entry is used unless it is overridden by the config.
+func NewObjectStore(config *Config, region, accessKeyID, secretAccessKey string) (objectstore.ObjectStore, error) {
+	store, err := newObjectStore(config, region, accessKeyID, secretAccessKey)
+	if err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+func newObjectStore(config *Config, region, accessKeyID, secretAccessKey string) (*objectStore, error) {
+	region = config.GetRegion(region)
+	opts := session.Options{
+		Config: aws.Config{
+			Credentials:      credentials.NewStaticCredentials(accessKeyID, secretAccessKey, ""),
+			Endpoint:         aws.String(config.Endpoint),
+			Region:           aws.String(region),
+			S3ForcePathStyle: aws.Bool(config.ForcePathStyle),
+		},
+	}
+	// The CA bundle is passed as session option as it takes precedence over the AWS_CA_BUNDLE environment variable.
+	if len(config.CABundle) != 0 {
+		opts.CustomCABundle = bytes.NewReader(config.CABundle)
+	}
+
+	s, err := session.NewSessionWithOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &objectStore{
+		s3:           s3.New(s),
+		region:       region,
+		bucketConfig: config.BucketConfig,
+	}, nil
+}
+
+// CreateBucketIfNotExists creates the bucket with name <bucket> in the region of the object store. Object lock is
+// enabled for the bucket if the BucketConfig contains an object lock configuration. If it already exists, no error is
+// returned.
+func (o *objectStore) CreateBucketIfNotExists(ctx context.Context, bucket string) error {
+	in := &s3.CreateBucketInput{
+		Bucket: aws.String(bucket),
+		ACL:    aws.String(s3.BucketCannedACLPrivate),
+	}
+	if o.bucketConfig != nil && o.bucketConfig.ObjectLock != nil {
+		in.ObjectLockEnabledForBucket = aws.Bool(true)
+	}
+	if o.region != DefaultRegion {
+		in.CreateBucketConfiguration = &s3.CreateBucketConfiguration{
+			LocationConstraint: aws.String(o.region),
+		}
+	}
+
+	if _, err := o.s3.CreateBucketWithContext(ctx, in); err != nil {
+		if aerr, ok := err.(awserr.Error); ok && (aerr.Code() == s3.ErrCodeBucketAlreadyExists || aerr.Code() == s3.ErrCodeBucketAlreadyOwnedByYou) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// DeleteBucketIfExists deletes the bucket with name <bucket> together with all its object versions. If it does not
+// exist, no error is returned.
+func (o *objectStore) DeleteBucketIfExists(ctx context.Context, bucket string) error {
+	for attempt := 0; ; attempt++ {
+		_, err := o.s3.DeleteBucketWithContext(ctx, &s3.DeleteBucketInput{Bucket: aws.String(bucket)})
+		if err == nil {
+			return nil
+		}
+
+		aerr, ok := err.(awserr.Error)
+		if !ok {
+			return err
+		}
+		if aerr.Code() == s3.ErrCodeNoSuchBucket {
+			return nil
+		}
+		if aerr.Code() != errCodeBucketNotEmpty {
+			return err
+		}
+		if attempt == maxBucketEmptyingAttempts {
+			return fmt.Errorf("bucket '%s' could not be emptied after %d attempts", bucket, maxBucketEmptyingAttempts)
+		}
+
+		if err := o.deleteObjectVersions(ctx, bucket); err != nil {
+			return err
+		}
+	}
+}
+
+// ListObjectsWithPrefix returns the keys of the objects with the specific <prefix> in <bucket> together with their
+// last modification time.
+func (o *objectStore) ListObjectsWithPrefix(ctx context.Context, bucket, prefix string) (map[string]time.Time, error) {
+	in := &s3.ListObjectsInput{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	}
+	objects := make(map[string]time.Time)
+	if err := o.s3.ListObjectsPagesWithContext(ctx, in, func(page *s3.ListObjectsOutput, lastPage bool) bool {
+		for _, object := range page.Contents {
+			objects[aws.StringValue(object.Key)] = aws.TimeValue(object.LastModified)
+		}
+		return !lastPage
+	}); err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+// GetObject returns the contents of the object with the key <key> in <bucket>.
+func (o *objectStore) GetObject(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	out, err := o.s3.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.Body, nil
+}
+
+// PutObject stores the contents of <body> as the object with the key <key> in <bucket>.
+func (o *objectStore) PutObject(ctx context.Context, bucket, key string, body io.ReadSeeker) error {
+	_, err := o.s3.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Body:   body,
+	})
+	return err
+}
+
+// DeleteObject deletes the object with the key <key> from <bucket>. If it does not exist, no error is returned.
+func (o *objectStore) DeleteObject(ctx context.Context, bucket, key string) error {
+	if _, err := o.s3.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}); err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// DeleteObjectsWithPrefix deletes the objects with the specific <prefix> from <bucket>. The objects are deleted page
+// by page as the number of objects deleted at once is limited. If there are no such objects, no error is returned.
+func (o *objectStore) DeleteObjectsWithPrefix(ctx context.Context, bucket, prefix string) error {
+	in := &s3.ListObjectsInput{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	}
+
+	var deleteErr error
+	if err := o.s3.ListObjectsPagesWithContext(ctx, in, func(page *s3.ListObjectsOutput, lastPage bool) bool {
+		objectIDs := make([]*s3.ObjectIdentifier, 0, len(page.Contents))
+		for _, object := range page.Contents {
+			objectIDs = append(objectIDs, &s3.ObjectIdentifier{Key: object.Key})
+		}
+		if len(objectIDs) == 0 {
+			return !lastPage
+		}
+
+		if _, err := o.s3.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objectIDs,
+			},
+		}); err != nil {
+			if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != s3.ErrCodeNoSuchKey {
+				deleteErr = err
+				return false
+			}
+		}
+		return !lastPage
+	}); err != nil {
+		return err
+	}
+	return deleteErr
+}
+
+// deleteObjectVersions deletes all object versions and delete markers of <bucket>. Object versions under retention
+// are not deleted, hence the bucket cannot be deleted before their retention expired.
+func (o *objectStore) deleteObjectVersions(ctx context.Context, bucket string) error {
+	in := &s3.ListObjectVersionsInput{
+		Bucket: aws.String(bucket),
+	}
+
+	var deleteErr error
+	if err := o.s3.ListObjectVersionsPagesWithContext(ctx, in, func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
+		objectIDs := make([]*s3.ObjectIdentifier, 0, len(page.Versions)+len(page.DeleteMarkers))
+		for _, version := range page.Versions {
+			objectIDs = append(objectIDs, &s3.ObjectIdentifier{Key: version.Key, VersionId: version.VersionId})
+		}
+		for _, marker := range page.DeleteMarkers {
+			objectIDs = append(objectIDs, &s3.ObjectIdentifier{Key: marker.Key, VersionId: marker.VersionId})
+		}
+		if len(objectIDs) == 0 {
+			return !lastPage
+		}
+
+		out, err := o.s3.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objectIDs,
+			},
+		})
+		if err != nil {
+			deleteErr = err
+			return false
+		}
+		if len(out.Errors) > 0 {
+			deleteErr = fmt.Errorf("could not delete %d object versions of bucket '%s', first error for key '%s': %s",
+				len(out.Errors), bucket, aws.StringValue(out.Errors[0].Key), aws.StringValue(out.Errors[0].Message))
+			return false
+		}
+		return !lastPage
+	}); err != nil {
+		return err
+	}
+	return deleteErr
+}
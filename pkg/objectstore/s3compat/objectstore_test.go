@@ -0,0 +1,279 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package s3compat_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http/httptest"
+	"time"
+
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
+	. "github.com/gardener/gardener-extensions/pkg/objectstore/s3compat"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("ObjectStore", func() {
+	const (
+		bucket          = "bucket"
+		region          = "eu-1"
+		accessKeyID     = "access-key-id"
+		secretAccessKey = "secret-access-key"
+	)
+
+	var (
+		ctx      context.Context
+		now      time.Time
+		memStore *objectstore.InMemoryObjectStore
+		fake     *s3Server
+		server   *httptest.Server
+		config   *Config
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		now = time.Date(2019, 10, 18, 12, 0, 0, 0, time.UTC)
+
+		memStore = objectstore.NewInMemoryObjectStore()
+		memStore.Now = func() time.Time { return now }
+		fake = newS3Server(memStore)
+		server = httptest.NewTLSServer(fake)
+
+		config = &Config{
+			Endpoint:       server.URL,
+			ForcePathStyle: true,
+			CABundle:       pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}),
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	newObjectStore := func() objectstore.ObjectStore {
+		store, err := NewObjectStore(config, region, accessKeyID, secretAccessKey)
+		Expect(err).NotTo(HaveOccurred())
+		return store
+	}
+
+	Describe("#NewObjectStore", func() {
+		It("should fail if the CA bundle cannot be parsed", func() {
+			config.CABundle = []byte("invalid")
+
+			_, err := NewObjectStore(config, region, accessKeyID, secretAccessKey)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail to verify the endpoint without the CA bundle", func() {
+			config.CABundle = nil
+
+			Expect(newObjectStore().CreateBucketIfNotExists(ctx, bucket)).To(HaveOccurred())
+			Expect(memStore.BucketExists(bucket)).To(BeFalse())
+		})
+	})
+
+	Describe("#CreateBucketIfNotExists", func() {
+		It("should create the bucket in the given region", func() {
+			store := newObjectStore()
+
+			Expect(store.CreateBucketIfNotExists(ctx, bucket)).To(Succeed())
+			Expect(store.CreateBucketIfNotExists(ctx, bucket)).To(Succeed())
+			Expect(memStore.BucketExists(bucket)).To(BeTrue())
+			Expect(fake.LocationConstraint(bucket)).To(Equal(region))
+			Expect(fake.SigningRegions()).To(ConsistOf(region))
+		})
+
+		It("should create the bucket in the region of the config", func() {
+			config.Region = "override"
+
+			Expect(newObjectStore().CreateBucketIfNotExists(ctx, bucket)).To(Succeed())
+			Expect(fake.LocationConstraint(bucket)).To(Equal("override"))
+			Expect(fake.SigningRegions()).To(ConsistOf("override"))
+		})
+
+		It("should enable object lock if it is configured", func() {
+			config.BucketConfig = &BucketConfig{ObjectLock: &ObjectLockConfig{Mode: "COMPLIANCE", Days: 14}}
+
+			Expect(newObjectStore().CreateBucketIfNotExists(ctx, bucket)).To(Succeed())
+			Expect(fake.ObjectLockEnabled(bucket)).To(BeTrue())
+		})
+
+		It("should not enable object lock by default", func() {
+			Expect(newObjectStore().CreateBucketIfNotExists(ctx, bucket)).To(Succeed())
+			Expect(fake.ObjectLockEnabled(bucket)).To(BeFalse())
+		})
+
+		It("should not set a location constraint for the default region", func() {
+			store, err := NewObjectStore(config, "", accessKeyID, secretAccessKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(store.CreateBucketIfNotExists(ctx, bucket)).To(Succeed())
+			Expect(fake.LocationConstraint(bucket)).To(BeEmpty())
+			Expect(fake.SigningRegions()).To(ConsistOf(DefaultRegion))
+		})
+	})
+
+	Describe("#ReconcileBucketConfig", func() {
+		var (
+			c         client.Client
+			secretRef corev1.SecretReference
+		)
+
+		BeforeEach(func() {
+			c = fakeclient.NewFakeClient(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "backupprovider", Namespace: "garden"},
+				Data: map[string][]byte{
+					AccessKeyID:     []byte(accessKeyID),
+					SecretAccessKey: []byte(secretAccessKey),
+				},
+			})
+			secretRef = corev1.SecretReference{Name: "backupprovider", Namespace: "garden"}
+
+			Expect(newObjectStore().CreateBucketIfNotExists(ctx, bucket)).To(Succeed())
+		})
+
+		It("should apply the bucket config", func() {
+			var (
+				versioning     = true
+				kmsKeyID       = "key-id"
+				expirationDays = int64(30)
+			)
+			config.BucketConfig = &BucketConfig{
+				Versioning: &versioning,
+				ObjectLock: &ObjectLockConfig{Mode: "COMPLIANCE", Days: 14},
+				Encryption: &EncryptionConfig{KMSKeyID: &kmsKeyID},
+				Lifecycle:  &LifecycleConfig{ExpirationDays: &expirationDays},
+			}
+
+			reconciler, err := NewBucketConfigReconcilerFromSecretRef(ctx, c, secretRef, config, region)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(reconciler.ReconcileBucketConfig(ctx, bucket)).To(Succeed())
+			Expect(fake.Subresource(bucket, "versioning")).To(ContainSubstring("<Status>Enabled</Status>"))
+			Expect(fake.Subresource(bucket, "object-lock")).To(And(ContainSubstring("<Mode>COMPLIANCE</Mode>"), ContainSubstring("<Days>14</Days>")))
+			Expect(fake.Subresource(bucket, "encryption")).To(And(ContainSubstring("<SSEAlgorithm>aws:kms</SSEAlgorithm>"), ContainSubstring("<KMSMasterKeyID>key-id</KMSMasterKeyID>")))
+			Expect(fake.Subresource(bucket, "lifecycle")).To(ContainSubstring("<Expiration><Days>30</Days></Expiration>"))
+		})
+
+		It("should remove the lifecycle configuration if no expiry rule is set", func() {
+			expirationDays := int64(30)
+			config.BucketConfig = &BucketConfig{Lifecycle: &LifecycleConfig{ExpirationDays: &expirationDays}}
+			reconciler, err := NewBucketConfigReconcilerFromSecretRef(ctx, c, secretRef, config, region)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reconciler.ReconcileBucketConfig(ctx, bucket)).To(Succeed())
+
+			config.BucketConfig = &BucketConfig{Lifecycle: &LifecycleConfig{}}
+			reconciler, err = NewBucketConfigReconcilerFromSecretRef(ctx, c, secretRef, config, region)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(reconciler.ReconcileBucketConfig(ctx, bucket)).To(Succeed())
+			Expect(fake.Subresource(bucket, "lifecycle")).To(BeEmpty())
+		})
+
+		It("should not change the bucket without bucket config", func() {
+			reconciler, err := NewBucketConfigReconcilerFromSecretRef(ctx, c, secretRef, config, region)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(reconciler.ReconcileBucketConfig(ctx, bucket)).To(Succeed())
+			for _, subresource := range bucketSubresources {
+				Expect(fake.Subresource(bucket, subresource)).To(BeEmpty())
+			}
+		})
+	})
+
+	Describe("#DeleteBucketIfExists", func() {
+		It("should delete the bucket including its objects", func() {
+			store := newObjectStore()
+			Expect(store.CreateBucketIfNotExists(ctx, bucket)).To(Succeed())
+			Expect(store.PutObject(ctx, bucket, "foo", bytes.NewReader([]byte("foo")))).To(Succeed())
+
+			Expect(store.DeleteBucketIfExists(ctx, bucket)).To(Succeed())
+			Expect(memStore.BucketExists(bucket)).To(BeFalse())
+		})
+
+		It("should not fail if the bucket does not exist", func() {
+			Expect(newObjectStore().DeleteBucketIfExists(ctx, bucket)).To(Succeed())
+		})
+
+		It("should fail if the bucket cannot be emptied", func() {
+			store := newObjectStore()
+			Expect(store.CreateBucketIfNotExists(ctx, bucket)).To(Succeed())
+			Expect(store.PutObject(ctx, bucket, "foo", bytes.NewReader([]byte("foo")))).To(Succeed())
+			fake.KeepOnDelete("foo")
+
+			Expect(store.DeleteBucketIfExists(ctx, bucket)).To(MatchError(ContainSubstring("could not be emptied")))
+			Expect(memStore.BucketExists(bucket)).To(BeTrue())
+		})
+	})
+
+	Describe("objects", func() {
+		var store objectstore.ObjectStore
+
+		BeforeEach(func() {
+			store = newObjectStore()
+			Expect(store.CreateBucketIfNotExists(ctx, bucket)).To(Succeed())
+		})
+
+		It("should put, list, get and delete objects", func() {
+			Expect(store.PutObject(ctx, bucket, "foo/bar", bytes.NewReader([]byte("bar")))).To(Succeed())
+			Expect(store.PutObject(ctx, bucket, "baz", bytes.NewReader([]byte("baz")))).To(Succeed())
+
+			objects, err := store.ListObjectsWithPrefix(ctx, bucket, "foo/")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(objects).To(HaveLen(1))
+			Expect(objects["foo/bar"].Equal(now)).To(BeTrue())
+
+			body, err := store.GetObject(ctx, bucket, "foo/bar")
+			Expect(err).NotTo(HaveOccurred())
+			data, err := ioutil.ReadAll(body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(body.Close()).To(Succeed())
+			Expect(data).To(Equal([]byte("bar")))
+
+			Expect(store.DeleteObject(ctx, bucket, "foo/bar")).To(Succeed())
+			Expect(store.DeleteObject(ctx, bucket, "foo/bar")).To(Succeed())
+			Expect(memStore.ListObjectsWithPrefix(ctx, bucket, "")).To(ConsistOf(now))
+		})
+
+		It("should fail to get a non-existing object", func() {
+			_, err := store.GetObject(ctx, bucket, "foo")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should list and delete objects across multiple pages", func() {
+			fake.pageSize = 2
+			for i := 0; i < 5; i++ {
+				Expect(store.PutObject(ctx, bucket, fmt.Sprintf("foo/%d", i), bytes.NewReader(nil))).To(Succeed())
+			}
+			Expect(store.PutObject(ctx, bucket, "bar", bytes.NewReader(nil))).To(Succeed())
+
+			objects, err := store.ListObjectsWithPrefix(ctx, bucket, "foo/")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(objects).To(HaveLen(5))
+
+			Expect(store.DeleteObjectsWithPrefix(ctx, bucket, "foo/")).To(Succeed())
+			Expect(memStore.ListObjectsWithPrefix(ctx, bucket, "")).To(HaveLen(1))
+		})
+	})
+})
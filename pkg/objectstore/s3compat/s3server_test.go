@@ -0,0 +1,419 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package s3compat_test
+
+import (
+	"context"
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
+)
+
+// s3Server is an in-process stand-in for an S3-compatible object storage that only supports path-style addressing.
+// The buckets and objects are kept in an objectstore.InMemoryObjectStore.
+type s3Server struct {
+	store *objectstore.InMemoryObjectStore
+	// pageSize is the maximum number of objects returned per ListObjects call.
+	pageSize int
+
+	lock                sync.Mutex
+	locationConstraints map[string]string
+	objectLockEnabled   map[string]bool
+	subresources        map[string]map[string]string
+	signingRegions      map[string]struct{}
+	// undeletableKeys are the keys of objects that are reported as deleted but kept by DeleteObjects calls.
+	undeletableKeys map[string]struct{}
+}
+
+func newS3Server(store *objectstore.InMemoryObjectStore) *s3Server {
+	return &s3Server{
+		store:               store,
+		pageSize:            1000,
+		locationConstraints: make(map[string]string),
+		objectLockEnabled:   make(map[string]bool),
+		subresources:        make(map[string]map[string]string),
+		signingRegions:      make(map[string]struct{}),
+		undeletableKeys:     make(map[string]struct{}),
+	}
+}
+
+// bucketSubresources are the bucket subresources whose configuration is recorded by the s3Server.
+var bucketSubresources = []string{"versioning", "object-lock", "encryption", "lifecycle"}
+
+type createBucketConfiguration struct {
+	LocationConstraint string
+}
+
+type listBucketResult struct {
+	XMLName     xml.Name `xml:"ListBucketResult"`
+	Name        string
+	Prefix      string
+	Marker      string
+	MaxKeys     int
+	IsTruncated bool
+	NextMarker  string `xml:",omitempty"`
+	Contents    []listBucketObject
+}
+
+type listBucketObject struct {
+	Key          string
+	LastModified string
+}
+
+type listVersionsResult struct {
+	XMLName     xml.Name `xml:"ListVersionsResult"`
+	Name        string
+	IsTruncated bool
+	Version     []objectVersion
+}
+
+type objectVersion struct {
+	Key          string
+	VersionId    string
+	IsLatest     bool
+	LastModified string
+}
+
+type objectIdentifier struct {
+	Key string
+}
+
+type deleteRequest struct {
+	Objects []objectIdentifier `xml:"Object"`
+}
+
+type deleteResult struct {
+	XMLName xml.Name           `xml:"DeleteResult"`
+	Deleted []objectIdentifier `xml:"Deleted"`
+}
+
+type s3Error struct {
+	XMLName xml.Name `xml:"Error"`
+	Code    string
+	Message string
+}
+
+// ServeHTTP implements http.Handler.
+func (s *s3Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.recordSigningRegion(r)
+
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	bucket := parts[0]
+	if len(parts) == 1 || len(parts[1]) == 0 {
+		s.serveBucket(w, r, bucket)
+		return
+	}
+	s.serveObject(w, r, bucket, parts[1])
+}
+
+func (s *s3Server) serveBucket(w http.ResponseWriter, r *http.Request, bucket string) {
+	ctx := r.Context()
+	exists := s.store.BucketExists(bucket)
+
+	if subresource := getBucketSubresource(r); len(subresource) != 0 {
+		s.serveBucketSubresource(w, r, bucket, subresource, exists)
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodPut:
+		if exists {
+			writeError(w, http.StatusConflict, "BucketAlreadyOwnedByYou")
+			return
+		}
+		config := &createBucketConfiguration{}
+		if body, _ := ioutil.ReadAll(r.Body); len(body) > 0 {
+			if err := xml.Unmarshal(body, config); err != nil {
+				writeError(w, http.StatusBadRequest, "MalformedXML")
+				return
+			}
+		}
+		s.lock.Lock()
+		s.locationConstraints[bucket] = config.LocationConstraint
+		s.objectLockEnabled[bucket] = r.Header.Get("X-Amz-Bucket-Object-Lock-Enabled") == "true"
+		s.lock.Unlock()
+		_ = s.store.CreateBucketIfNotExists(ctx, bucket)
+
+	case !exists:
+		writeError(w, http.StatusNotFound, "NoSuchBucket")
+
+	case r.Method == http.MethodDelete:
+		if objects, _ := s.store.ListObjectsWithPrefix(ctx, bucket, ""); len(objects) > 0 {
+			writeError(w, http.StatusConflict, "BucketNotEmpty")
+			return
+		}
+		_ = s.store.DeleteBucketIfExists(ctx, bucket)
+		w.WriteHeader(http.StatusNoContent)
+
+	case r.Method == http.MethodGet && isListVersionsRequest(r):
+		s.listObjectVersions(w, r, bucket)
+
+	case r.Method == http.MethodGet:
+		s.listObjects(w, r, bucket)
+
+	case r.Method == http.MethodPost && isDeleteRequest(r):
+		req := &deleteRequest{}
+		body, _ := ioutil.ReadAll(r.Body)
+		if err := xml.Unmarshal(body, req); err != nil {
+			writeError(w, http.StatusBadRequest, "MalformedXML")
+			return
+		}
+		result := &deleteResult{}
+		for _, object := range req.Objects {
+			if !s.isUndeletable(object.Key) {
+				_ = s.store.DeleteObject(ctx, bucket, object.Key)
+			}
+			result.Deleted = append(result.Deleted, object)
+		}
+		writeXML(w, result)
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+func (s *s3Server) listObjects(w http.ResponseWriter, r *http.Request, bucket string) {
+	var (
+		prefix = r.URL.Query().Get("prefix")
+		marker = r.URL.Query().Get("marker")
+	)
+
+	objects, _ := s.store.ListObjectsWithPrefix(r.Context(), bucket, prefix)
+	keys := make([]string, 0, len(objects))
+	for key := range objects {
+		if key > marker {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	result := &listBucketResult{Name: bucket, Prefix: prefix, Marker: marker, MaxKeys: s.pageSize}
+	if len(keys) > s.pageSize {
+		keys = keys[:s.pageSize]
+		result.IsTruncated = true
+		result.NextMarker = keys[len(keys)-1]
+	}
+	for _, key := range keys {
+		result.Contents = append(result.Contents, listBucketObject{
+			Key:          key,
+			LastModified: objects[key].UTC().Format("2006-01-02T15:04:05.000Z"),
+		})
+	}
+	writeXML(w, result)
+}
+
+// listObjectVersions lists the objects of the bucket as their only version as the s3Server does not support
+// versioning.
+func (s *s3Server) listObjectVersions(w http.ResponseWriter, r *http.Request, bucket string) {
+	objects, _ := s.store.ListObjectsWithPrefix(r.Context(), bucket, "")
+	keys := make([]string, 0, len(objects))
+	for key := range objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result := &listVersionsResult{Name: bucket}
+	for _, key := range keys {
+		result.Version = append(result.Version, objectVersion{
+			Key:          key,
+			VersionId:    "null",
+			IsLatest:     true,
+			LastModified: objects[key].UTC().Format("2006-01-02T15:04:05.000Z"),
+		})
+	}
+	writeXML(w, result)
+}
+
+// serveBucketSubresource records the configuration of the bucket subresource without interpreting it.
+func (s *s3Server) serveBucketSubresource(w http.ResponseWriter, r *http.Request, bucket, subresource string, exists bool) {
+	if !exists {
+		writeError(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := ioutil.ReadAll(r.Body)
+		if s.subresources[bucket] == nil {
+			s.subresources[bucket] = make(map[string]string)
+		}
+		s.subresources[bucket][subresource] = string(body)
+
+	case http.MethodDelete:
+		delete(s.subresources[bucket], subresource)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+func (s *s3Server) serveObject(w http.ResponseWriter, r *http.Request, bucket, key string) {
+	ctx := r.Context()
+	if !s.store.BucketExists(bucket) {
+		writeError(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "IncompleteBody")
+			return
+		}
+		_ = s.store.PutObject(ctx, bucket, key, strings.NewReader(string(body)))
+
+	case http.MethodGet:
+		if !s.objectExists(ctx, bucket, key) {
+			writeError(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		body, _ := s.store.GetObject(ctx, bucket, key)
+		defer body.Close()
+		data, _ := ioutil.ReadAll(body)
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		_, _ = w.Write(data)
+
+	case http.MethodDelete:
+		_ = s.store.DeleteObject(ctx, bucket, key)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+func (s *s3Server) objectExists(ctx context.Context, bucket, key string) bool {
+	objects, _ := s.store.ListObjectsWithPrefix(ctx, bucket, key)
+	_, ok := objects[key]
+	return ok
+}
+
+// recordSigningRegion records the region of the AWS signature version 4 credential scope of the request, i.e.
+// <region> in `Credential=<access-key-id>/<date>/<region>/s3/aws4_request`.
+func (s *s3Server) recordSigningRegion(r *http.Request) {
+	authorization := r.Header.Get("Authorization")
+	i := strings.Index(authorization, "Credential=")
+	if i < 0 {
+		return
+	}
+	scope := strings.Split(strings.SplitN(authorization[i+len("Credential="):], ",", 2)[0], "/")
+	if len(scope) < 3 {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.signingRegions[scope[2]] = struct{}{}
+}
+
+// KeepOnDelete makes DeleteObjects calls report the object with the given key as deleted without deleting it.
+func (s *s3Server) KeepOnDelete(key string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.undeletableKeys[key] = struct{}{}
+}
+
+func (s *s3Server) isUndeletable(key string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	_, ok := s.undeletableKeys[key]
+	return ok
+}
+
+// ObjectLockEnabled returns whether object lock was enabled when the bucket with the given name was created.
+func (s *s3Server) ObjectLockEnabled(bucket string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.objectLockEnabled[bucket]
+}
+
+// Subresource returns the configuration of the given subresource of the bucket with the given name.
+func (s *s3Server) Subresource(bucket, subresource string) string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.subresources[bucket][subresource]
+}
+
+// SigningRegions returns the regions the requests received so far were signed for.
+func (s *s3Server) SigningRegions() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var regions []string
+	for region := range s.signingRegions {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	return regions
+}
+
+// LocationConstraint returns the location constraint the bucket with the given name was created with.
+func (s *s3Server) LocationConstraint(bucket string) string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.locationConstraints[bucket]
+}
+
+func getBucketSubresource(r *http.Request) string {
+	for _, subresource := range bucketSubresources {
+		if _, ok := r.URL.Query()[subresource]; ok {
+			return subresource
+		}
+	}
+	return ""
+}
+
+func isListVersionsRequest(r *http.Request) bool {
+	_, ok := r.URL.Query()["versions"]
+	return ok
+}
+
+func isDeleteRequest(r *http.Request) bool {
+	_, ok := r.URL.Query()["delete"]
+	return ok
+}
+
+func writeXML(w http.ResponseWriter, v interface{}) {
+	data, err := xml.Marshal(v)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "InternalError")
+		return
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	_, _ = w.Write(append([]byte(xml.Header), data...))
+}
+
+func writeError(w http.ResponseWriter, status int, code string) {
+	data, _ := xml.Marshal(&s3Error{Code: code, Message: code})
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_, _ = w.Write(append([]byte(xml.Header), data...))
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package s3compat
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/objectstore"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// AccessKeyID is a constant for the key in a backup secret that holds the access key id.
+	AccessKeyID = "accessKeyID"
+	// SecretAccessKey is a constant for the key in a backup secret that holds the secret access key.
+	SecretAccessKey = "secretAccessKey"
+	// Endpoint is a constant for the key in an etcd backup secret that holds the URL of the endpoint.
+	Endpoint = "endpoint"
+	// Region is a constant for the key in an etcd backup secret that holds the region.
+	Region = "region"
+	// S3ForcePathStyle is a constant for the key in an etcd backup secret that holds whether path-style addressing
+	// is used.
+	S3ForcePathStyle = "s3ForcePathStyle"
+	// TrustedCACert is a constant for the key in an etcd backup secret that holds the PEM-encoded CA bundle.
+	TrustedCACert = "trustedCaCert"
+	// BucketName is a constant for the key in an etcd backup secret that holds the bucket name.
+	// The bucket name is written to the backup secret by Gardener as a temporary solution.
+	// TODO In the future, the bucket name should come from a BackupBucket resource (see https://github.com/gardener/gardener/blob/master/docs/proposals/02-backupinfra.md)
+	BucketName = "bucketName"
+)
+
+// Credentials stores the credentials of an S3-compatible object storage.
+type Credentials struct {
+	AccessKeyID     []byte
+	SecretAccessKey []byte
+}
+
+// ReadCredentialsSecret reads a secret containing the credentials of an S3-compatible object storage.
+func ReadCredentialsSecret(secret *corev1.Secret) (*Credentials, error) {
+	if secret.Data == nil {
+		return nil, fmt.Errorf("secret does not contain any data")
+	}
+
+	accessKeyID, ok := secret.Data[AccessKeyID]
+	if !ok {
+		return nil, fmt.Errorf("missing %q field in secret", AccessKeyID)
+	}
+
+	secretAccessKey, ok := secret.Data[SecretAccessKey]
+	if !ok {
+		return nil, fmt.Errorf("missing %q field in secret", SecretAccessKey)
+	}
+
+	return &Credentials{
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: secretAccessKey,
+	}, nil
+}
+
+// NewObjectStoreFromSecretRef creates a new object store for the buckets of the S3-compatible object storage with
+// the given <config> accessible with the credentials from given k8s <secretRef>. The region <region> of the backup
+// bucket or entry is used unless it is overridden by the config.
+func NewObjectStoreFromSecretRef(ctx context.Context, client client.Client, secretRef corev1.SecretReference, config *Config, region string) (objectstore.ObjectStore, error) {
+	store, err := newObjectStoreFromSecretRef(ctx, client, secretRef, config, region)
+	if err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+// NewBucketConfigReconcilerFromSecretRef creates a new BucketConfigReconciler for the buckets of the S3-compatible
+// object storage with the given <config> accessible with the credentials from given k8s <secretRef>. The region
+// <region> of the backup bucket is used unless it is overridden by the config.
+func NewBucketConfigReconcilerFromSecretRef(ctx context.Context, client client.Client, secretRef corev1.SecretReference, config *Config, region string) (BucketConfigReconciler, error) {
+	store, err := newObjectStoreFromSecretRef(ctx, client, secretRef, config, region)
+	if err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+func newObjectStoreFromSecretRef(ctx context.Context, client client.Client, secretRef corev1.SecretReference, config *Config, region string) (*objectStore, error) {
+	secret, err := extensionscontroller.GetSecretByReference(ctx, client, &secretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	credentials, err := ReadCredentialsSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return newObjectStore(config, region, string(credentials.AccessKeyID), string(credentials.SecretAccessKey))
+}
+
+// ETCDSecretData adds the settings of the given <config> to the data of an etcd backup secret. The region <region> of
+// the backup entry is used unless it is overridden by the config. The resulting fields are read by etcd-backup-restore
+// from the directory the secret is mounted to (see GetBackupRestoreEnv).
+func ETCDSecretData(config *Config, region string, backupSecretData map[string][]byte) map[string][]byte {
+	backupSecretData[Endpoint] = []byte(config.Endpoint)
+	backupSecretData[Region] = []byte(config.GetRegion(region))
+	backupSecretData[S3ForcePathStyle] = []byte(strconv.FormatBool(config.ForcePathStyle))
+	if len(config.CABundle) != 0 {
+		backupSecretData[TrustedCACert] = config.CABundle
+	} else {
+		delete(backupSecretData, TrustedCACert)
+	}
+	return backupSecretData
+}
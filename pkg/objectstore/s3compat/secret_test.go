@@ -0,0 +1,109 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package s3compat_test
+
+import (
+	. "github.com/gardener/gardener-extensions/pkg/objectstore/s3compat"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("Secret", func() {
+	Describe("#ReadCredentialsSecret", func() {
+		It("should return an error if the secret does not contain data", func() {
+			_, err := ReadCredentialsSecret(&corev1.Secret{})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return an error if the access key id is missing", func() {
+			_, err := ReadCredentialsSecret(&corev1.Secret{Data: map[string][]byte{
+				SecretAccessKey: []byte("secret"),
+			}})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return an error if the secret access key is missing", func() {
+			_, err := ReadCredentialsSecret(&corev1.Secret{Data: map[string][]byte{
+				AccessKeyID: []byte("access"),
+			}})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return the credentials", func() {
+			credentials, err := ReadCredentialsSecret(&corev1.Secret{Data: map[string][]byte{
+				AccessKeyID:     []byte("access"),
+				SecretAccessKey: []byte("secret"),
+			}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(credentials).To(Equal(&Credentials{
+				AccessKeyID:     []byte("access"),
+				SecretAccessKey: []byte("secret"),
+			}))
+		})
+	})
+
+	Describe("#ETCDSecretData", func() {
+		var data map[string][]byte
+
+		BeforeEach(func() {
+			data = map[string][]byte{
+				AccessKeyID:     []byte("access"),
+				SecretAccessKey: []byte("secret"),
+				BucketName:      []byte("bucket"),
+			}
+		})
+
+		It("should add the settings of the config", func() {
+			config := &Config{
+				Endpoint:       "https://s3.example.com",
+				ForcePathStyle: true,
+				CABundle:       []byte("ca"),
+			}
+
+			Expect(ETCDSecretData(config, "eu-1", data)).To(Equal(map[string][]byte{
+				AccessKeyID:      []byte("access"),
+				SecretAccessKey:  []byte("secret"),
+				BucketName:       []byte("bucket"),
+				Endpoint:         []byte("https://s3.example.com"),
+				Region:           []byte("eu-1"),
+				S3ForcePathStyle: []byte("true"),
+				TrustedCACert:    []byte("ca"),
+			}))
+		})
+
+		It("should prefer the region of the config and omit an empty CA bundle", func() {
+			data[TrustedCACert] = []byte("stale")
+			config := &Config{
+				Endpoint: "https://s3.example.com",
+				Region:   "override",
+			}
+
+			Expect(ETCDSecretData(config, "eu-1", data)).To(Equal(map[string][]byte{
+				AccessKeyID:      []byte("access"),
+				SecretAccessKey:  []byte("secret"),
+				BucketName:       []byte("bucket"),
+				Endpoint:         []byte("https://s3.example.com"),
+				Region:           []byte("override"),
+				S3ForcePathStyle: []byte("false"),
+			}))
+		})
+
+		It("should fall back to the default region", func() {
+			Expect(ETCDSecretData(&Config{}, "", data)).To(HaveKeyWithValue(Region, []byte(DefaultRegion)))
+		})
+	})
+})
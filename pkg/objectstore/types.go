@@ -0,0 +1,49 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstore
+
+import (
+	"context"
+	"io"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ObjectStore is an interface which must be implemented by the object storage clients of the providers.
+type ObjectStore interface {
+	// CreateBucketIfNotExists creates the bucket. If it already exists, no error is returned.
+	CreateBucketIfNotExists(ctx context.Context, bucket string) error
+	// DeleteBucketIfExists deletes the bucket. If it does not exist, no error is returned.
+	DeleteBucketIfExists(ctx context.Context, bucket string) error
+
+	// ListObjectsWithPrefix returns the keys of the objects with the given prefix in the bucket together with their
+	// last modification time.
+	ListObjectsWithPrefix(ctx context.Context, bucket, prefix string) (map[string]time.Time, error)
+	// GetObject returns the contents of the object with the given key in the bucket. It must be closed by the caller.
+	GetObject(ctx context.Context, bucket, key string) (io.ReadCloser, error)
+	// PutObject stores the contents of body as the object with the given key in the bucket.
+	PutObject(ctx context.Context, bucket, key string, body io.ReadSeeker) error
+	// DeleteObject deletes the object with the given key in the bucket. If it does not exist, no error is returned.
+	DeleteObject(ctx context.Context, bucket, key string) error
+	// DeleteObjectsWithPrefix deletes all objects with the given prefix in the bucket page by page. If there are no
+	// such objects, no error is returned.
+	DeleteObjectsWithPrefix(ctx context.Context, bucket, prefix string) error
+}
+
+// FactoryFunc creates an ObjectStore using the credentials stored in the secret with the given reference. The region
+// is ignored by providers whose object storage is not regional.
+type FactoryFunc func(ctx context.Context, c client.Client, secretRef corev1.SecretReference, region string) (ObjectStore, error)
@@ -0,0 +1,99 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var (
+	supportedReclaimPolicies    = sets.NewString(string(corev1.PersistentVolumeReclaimDelete), string(corev1.PersistentVolumeReclaimRetain))
+	supportedVolumeBindingModes = sets.NewString(string(storagev1.VolumeBindingImmediate), string(storagev1.VolumeBindingWaitForFirstConsumer))
+)
+
+// StorageClass contains the provider independent settings of an additional storage class.
+type StorageClass struct {
+	// Name is the name of the storage class.
+	Name string
+	// ReclaimPolicy is the reclaim policy of the storage class.
+	ReclaimPolicy *corev1.PersistentVolumeReclaimPolicy
+	// VolumeBindingMode is the volume binding mode of the storage class.
+	VolumeBindingMode *storagev1.VolumeBindingMode
+	// Default indicates whether the storage class is the default storage class of the shoot cluster.
+	Default *bool
+}
+
+// ValidateStorageClasses validates the provider independent settings of the given additional storage classes.
+// Their names must be unique and must neither be one of the given reserved names nor end with one of the given
+// reserved suffixes. Provider specific settings like volume types have to be validated by the caller.
+func ValidateStorageClasses(storageClasses []StorageClass, reservedNames sets.String, reservedNameSuffixes []string, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs       = field.ErrorList{}
+		names         = sets.NewString()
+		defaultMarked bool
+	)
+
+	for i, sc := range storageClasses {
+		idxPath := fldPath.Index(i)
+
+		if len(sc.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else {
+			for _, msg := range utilvalidation.IsDNS1123Subdomain(sc.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), sc.Name, msg))
+			}
+			if reservedNames.Has(sc.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), sc.Name, "must not be the name of a storage class deployed by default"))
+			} else if suffix, ok := hasSuffix(sc.Name, reservedNameSuffixes); ok {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), sc.Name, fmt.Sprintf("must not end with %q", suffix)))
+			} else if names.Has(sc.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), sc.Name))
+			}
+			names.Insert(sc.Name)
+		}
+
+		if sc.ReclaimPolicy != nil && !supportedReclaimPolicies.Has(string(*sc.ReclaimPolicy)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("reclaimPolicy"), *sc.ReclaimPolicy, supportedReclaimPolicies.List()))
+		}
+		if sc.VolumeBindingMode != nil && !supportedVolumeBindingModes.Has(string(*sc.VolumeBindingMode)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("volumeBindingMode"), *sc.VolumeBindingMode, supportedVolumeBindingModes.List()))
+		}
+
+		if sc.Default != nil && *sc.Default {
+			if defaultMarked {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("default"), "only one storage class may be marked as default"))
+			}
+			defaultMarked = true
+		}
+	}
+
+	return allErrs
+}
+
+func hasSuffix(name string, suffixes []string) (string, bool) {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(name, suffix) {
+			return suffix, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	. "github.com/gardener/gardener-extensions/pkg/util/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("StorageClasses", func() {
+	Describe("#ValidateStorageClasses", func() {
+		var (
+			reservedNames        = sets.NewString("default")
+			reservedNameSuffixes = []string{"-csi"}
+			fldPath              = field.NewPath("storageClasses")
+		)
+
+		It("should allow valid storage classes", func() {
+			var (
+				retain          = corev1.PersistentVolumeReclaimRetain
+				waitForConsumer = storagev1.VolumeBindingWaitForFirstConsumer
+				isDefault       = true
+			)
+			storageClasses := []StorageClass{
+				{
+					Name:              "fast",
+					ReclaimPolicy:     &retain,
+					VolumeBindingMode: &waitForConsumer,
+					Default:           &isDefault,
+				},
+				{Name: "slow"},
+			}
+
+			Expect(ValidateStorageClasses(storageClasses, reservedNames, reservedNameSuffixes, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid invalid, duplicate and reserved names", func() {
+			storageClasses := []StorageClass{
+				{},
+				{Name: "Foo_Bar"},
+				{Name: "default"},
+				{Name: "slow"},
+				{Name: "slow"},
+				{Name: "slow-csi"},
+			}
+
+			errorList := ValidateStorageClasses(storageClasses, reservedNames, reservedNameSuffixes, fldPath)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("storageClasses[0].name"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("storageClasses[1].name"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("storageClasses[2].name"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("storageClasses[4].name"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("storageClasses[5].name"),
+			}))))
+		})
+
+		It("should forbid unsupported reclaim policies and volume binding modes", func() {
+			var (
+				recycle = corev1.PersistentVolumeReclaimRecycle
+				mode    = storagev1.VolumeBindingMode("Later")
+			)
+			storageClasses := []StorageClass{
+				{
+					Name:              "fast",
+					ReclaimPolicy:     &recycle,
+					VolumeBindingMode: &mode,
+				},
+			}
+
+			errorList := ValidateStorageClasses(storageClasses, reservedNames, reservedNameSuffixes, fldPath)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("storageClasses[0].reclaimPolicy"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("storageClasses[0].volumeBindingMode"),
+			}))))
+		})
+
+		It("should forbid more than one default storage class", func() {
+			isDefault := true
+			storageClasses := []StorageClass{
+				{Name: "fast", Default: &isDefault},
+				{Name: "slow", Default: &isDefault},
+			}
+
+			errorList := ValidateStorageClasses(storageClasses, reservedNames, reservedNameSuffixes, fldPath)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("storageClasses[1].default"),
+			}))))
+		})
+	})
+})
@@ -15,18 +15,26 @@
 package controlplane
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"path"
 	"strings"
+	"time"
 
 	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	"github.com/gardener/gardener-extensions/pkg/util"
 	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils"
+	"github.com/pkg/errors"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -36,16 +44,178 @@ const (
 	EtcdMainVolumeClaimTemplateName = "main-etcd"
 	// BackupRestoreContainerName is the name of the backup-restore sidecar injected in etcd StatefulSet.
 	BackupRestoreContainerName = "backup-restore"
+
+	// GarbageCollectionPolicyExponential is the garbage collection policy that keeps a decreasing number of full
+	// snapshots the older they get.
+	GarbageCollectionPolicyExponential = "Exponential"
+	// GarbageCollectionPolicyLimitBased is the garbage collection policy that keeps a fixed number of full snapshots.
+	GarbageCollectionPolicyLimitBased = "LimitBased"
+)
+
+var (
+	supportedGarbageCollectionPolicies = sets.NewString(GarbageCollectionPolicyExponential, GarbageCollectionPolicyLimitBased)
+
+	defaultDeltaSnapshotPeriod      = 5 * time.Minute
+	defaultDeltaSnapshotMemoryLimit = resource.MustParse("100Mi")
+	defaultGarbageCollectionPeriod  = 12 * time.Hour
+	defaultBackupRestoreResources   = corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("23m"),
+			corev1.ResourceMemory: resource.MustParse("128Mi"),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("1"),
+			corev1.ResourceMemory: resource.MustParse("10G"),
+		},
+	}
 )
 
+// BackupRestoreConfig contains the tunable parameters of the etcd backup-restore sidecar.
+// Unset fields are defaulted by GetBackupRestoreContainer.
+type BackupRestoreConfig struct {
+	// DeltaSnapshotPeriod is the period after which delta snapshots are taken.
+	DeltaSnapshotPeriod *metav1.Duration
+	// DeltaSnapshotMemoryLimit is the size of accumulated events after which a delta snapshot is taken
+	// before the delta snapshot period has passed.
+	DeltaSnapshotMemoryLimit *resource.Quantity
+	// GarbageCollectionPeriod is the period after which old snapshots are garbage collected.
+	GarbageCollectionPeriod *metav1.Duration
+	// GarbageCollectionPolicy is the policy for garbage collecting old snapshots.
+	GarbageCollectionPolicy *string
+	// MaxBackups is the maximum number of full snapshots kept by the LimitBased garbage collection policy.
+	MaxBackups *int32
+	// Resources are the compute resources of the backup-restore sidecar.
+	Resources *corev1.ResourceRequirements
+}
+
+// MergeBackupRestoreConfigs merges the given backup-restore configurations. Fields set in later configurations
+// take precedence over those set in earlier ones. Nil configurations are ignored.
+func MergeBackupRestoreConfigs(configs ...*BackupRestoreConfig) *BackupRestoreConfig {
+	merged := &BackupRestoreConfig{}
+	for _, config := range configs {
+		if config == nil {
+			continue
+		}
+		if config.DeltaSnapshotPeriod != nil {
+			merged.DeltaSnapshotPeriod = config.DeltaSnapshotPeriod
+		}
+		if config.DeltaSnapshotMemoryLimit != nil {
+			merged.DeltaSnapshotMemoryLimit = config.DeltaSnapshotMemoryLimit
+		}
+		if config.GarbageCollectionPeriod != nil {
+			merged.GarbageCollectionPeriod = config.GarbageCollectionPeriod
+		}
+		if config.GarbageCollectionPolicy != nil {
+			merged.GarbageCollectionPolicy = config.GarbageCollectionPolicy
+		}
+		if config.MaxBackups != nil {
+			merged.MaxBackups = config.MaxBackups
+		}
+		if config.Resources != nil {
+			merged.Resources = config.Resources
+		}
+	}
+	return merged
+}
+
+// ShootBackupRestoreConfigFunc returns the backup-restore configuration of a shoot found in the given providerConfig
+// of its ControlPlane.
+type ShootBackupRestoreConfigFunc func(providerConfig []byte) (*BackupRestoreConfig, error)
+
+// GetBackupRestoreConfig returns the backup-restore configuration for the shoot in the given namespace. The
+// configuration of the shoot is read from the providerConfig of its ControlPlane of the given type by the given
+// function and takes precedence over the given seed configuration.
+func GetBackupRestoreConfig(
+	ctx context.Context,
+	c client.Client,
+	namespace, controlPlaneType string,
+	seedConfig *BackupRestoreConfig,
+	shootConfigFunc ShootBackupRestoreConfigFunc,
+) (*BackupRestoreConfig, error) {
+	cpList := &extensionsv1alpha1.ControlPlaneList{}
+	if err := c.List(ctx, cpList, client.InNamespace(namespace)); err != nil {
+		return nil, errors.Wrapf(err, "could not list controlplanes in namespace '%s'", namespace)
+	}
+
+	var shootConfig *BackupRestoreConfig
+	for _, cp := range cpList.Items {
+		if cp.Spec.Type != controlPlaneType || (cp.Spec.Purpose != nil && *cp.Spec.Purpose != extensionsv1alpha1.Normal) {
+			continue
+		}
+		if cp.Spec.ProviderConfig != nil {
+			var err error
+			if shootConfig, err = shootConfigFunc(cp.Spec.ProviderConfig.Raw); err != nil {
+				return nil, errors.Wrapf(err, "could not decode providerConfig of controlplane '%s'", util.ObjectName(&cp))
+			}
+		}
+		break
+	}
+
+	return MergeBackupRestoreConfigs(seedConfig, shootConfig), nil
+}
+
+// ValidateBackupRestoreConfig validates the given backup-restore configuration.
+func ValidateBackupRestoreConfig(config *BackupRestoreConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config == nil {
+		return allErrs
+	}
+
+	if config.DeltaSnapshotPeriod != nil && config.DeltaSnapshotPeriod.Duration < time.Second {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("deltaSnapshotPeriod"), config.DeltaSnapshotPeriod.Duration.String(), "must be at least 1s"))
+	}
+	if config.DeltaSnapshotMemoryLimit != nil && config.DeltaSnapshotMemoryLimit.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("deltaSnapshotMemoryLimit"), config.DeltaSnapshotMemoryLimit.String(), "must be greater than 0"))
+	}
+	if config.GarbageCollectionPeriod != nil && config.GarbageCollectionPeriod.Duration < time.Second {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("garbageCollectionPeriod"), config.GarbageCollectionPeriod.Duration.String(), "must be at least 1s"))
+	}
+	if config.GarbageCollectionPolicy != nil && !supportedGarbageCollectionPolicies.Has(*config.GarbageCollectionPolicy) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("garbageCollectionPolicy"), *config.GarbageCollectionPolicy, supportedGarbageCollectionPolicies.List()))
+	}
+	if config.MaxBackups != nil {
+		if config.GarbageCollectionPolicy == nil || *config.GarbageCollectionPolicy != GarbageCollectionPolicyLimitBased {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("maxBackups"), "can only be set for the LimitBased garbage collection policy"))
+		} else if *config.MaxBackups < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxBackups"), *config.MaxBackups, "must be at least 1"))
+		}
+	}
+
+	return allErrs
+}
+
 // GetBackupRestoreContainer returns an etcd backup-restore container with the given name, schedule, provider, image,
-// and additional provider-specific command line args and env variables.
+// backup-restore configuration, and additional provider-specific command line args and env variables.
 func GetBackupRestoreContainer(
 	name, volumeClaimTemplateName, schedule, provider, prefix, image string,
+	config *BackupRestoreConfig,
 	args map[string]string,
 	env []corev1.EnvVar,
 	volumeMounts []corev1.VolumeMount,
 ) *corev1.Container {
+	var (
+		deltaSnapshotPeriod      = defaultDeltaSnapshotPeriod
+		deltaSnapshotMemoryLimit = defaultDeltaSnapshotMemoryLimit
+		garbageCollectionPeriod  = defaultGarbageCollectionPeriod
+		resources                = defaultBackupRestoreResources
+	)
+	if config == nil {
+		config = &BackupRestoreConfig{}
+	}
+	if config.DeltaSnapshotPeriod != nil {
+		deltaSnapshotPeriod = config.DeltaSnapshotPeriod.Duration
+	}
+	if config.DeltaSnapshotMemoryLimit != nil {
+		deltaSnapshotMemoryLimit = *config.DeltaSnapshotMemoryLimit
+	}
+	if config.GarbageCollectionPeriod != nil {
+		garbageCollectionPeriod = config.GarbageCollectionPeriod.Duration
+	}
+	if config.Resources != nil {
+		resources = *config.Resources
+	}
+
 	c := &corev1.Container{
 		Name: BackupRestoreContainerName,
 		Command: []string{
@@ -62,9 +232,9 @@ func GetBackupRestoreContainer(
 			"--insecure-skip-tls-verify=false",
 			fmt.Sprintf("--endpoints=https://%s-0:2379", name),
 			"--etcd-connection-timeout=300",
-			"--delta-snapshot-period-seconds=300",
-			"--delta-snapshot-memory-limit=104857600", // 100MB
-			"--garbage-collection-period-seconds=43200",
+			fmt.Sprintf("--delta-snapshot-period-seconds=%d", int64(deltaSnapshotPeriod.Seconds())),
+			fmt.Sprintf("--delta-snapshot-memory-limit=%d", deltaSnapshotMemoryLimit.Value()),
+			fmt.Sprintf("--garbage-collection-period-seconds=%d", int64(garbageCollectionPeriod.Seconds())),
 			"--snapstore-temp-directory=/var/etcd/data/temp",
 		},
 		Env:             []corev1.EnvVar{},
@@ -77,16 +247,7 @@ func GetBackupRestoreContainer(
 				Protocol:      corev1.ProtocolTCP,
 			},
 		},
-		Resources: corev1.ResourceRequirements{
-			Requests: corev1.ResourceList{
-				corev1.ResourceCPU:    resource.MustParse("23m"),
-				corev1.ResourceMemory: resource.MustParse("128Mi"),
-			},
-			Limits: corev1.ResourceList{
-				corev1.ResourceCPU:    resource.MustParse("1"),
-				corev1.ResourceMemory: resource.MustParse("10G"),
-			},
-		},
+		Resources: *resources.DeepCopy(),
 		VolumeMounts: []corev1.VolumeMount{
 			{
 				Name:      volumeClaimTemplateName,
@@ -103,6 +264,14 @@ func GetBackupRestoreContainer(
 		},
 	}
 
+	// Ensure the garbage collection policy
+	if config.GarbageCollectionPolicy != nil {
+		c.Command = append(c.Command, fmt.Sprintf("--garbage-collection-policy=%s", *config.GarbageCollectionPolicy))
+		if *config.GarbageCollectionPolicy == GarbageCollectionPolicyLimitBased && config.MaxBackups != nil {
+			c.Command = append(c.Command, fmt.Sprintf("--max-backups=%d", *config.MaxBackups))
+		}
+	}
+
 	// Ensure additional command line args
 	for k, v := range args {
 		c.Command = extensionswebhook.EnsureStringWithPrefix(c.Command, fmt.Sprintf("--%s=", k), v)
@@ -121,6 +290,24 @@ func GetBackupRestoreContainer(
 	return c
 }
 
+// GetStorePrefixFromContainer returns the store prefix of the etcd backups used by the given backup-restore container
+// of the etcd with the given name. It returns the given default prefix if the container does not exist or does not
+// specify a store prefix.
+func GetStorePrefixFromContainer(c *corev1.Container, name, defaultPrefix string) string {
+	if c == nil {
+		return defaultPrefix
+	}
+	i := extensionswebhook.StringWithPrefixIndex(c.Command, "--store-prefix=")
+	if i < 0 {
+		return defaultPrefix
+	}
+	storePrefix := strings.TrimPrefix(c.Command[i], "--store-prefix=")
+	if !strings.HasSuffix(storePrefix, "/"+name) {
+		return defaultPrefix
+	}
+	return strings.TrimSuffix(storePrefix, "/"+name)
+}
+
 // GetETCDVolumeClaimTemplate returns an etcd backup-restore container with the given name, storageClass and storageCapacity.
 func GetETCDVolumeClaimTemplate(name string, storageClassName *string, storageCapacity *resource.Quantity) *corev1.PersistentVolumeClaim {
 	// Determine the storage capacity
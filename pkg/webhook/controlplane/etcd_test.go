@@ -0,0 +1,184 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener-extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Etcd", func() {
+	Describe("#GetBackupRestoreContainer", func() {
+		It("should use the default parameters if no configuration is given", func() {
+			c := GetBackupRestoreContainer("etcd-main", "main-etcd", "0 */24 * * *", "S3", "prefix", "image", nil, nil, nil, nil)
+
+			Expect(c.Command).To(ContainElement("--delta-snapshot-period-seconds=300"))
+			Expect(c.Command).To(ContainElement("--delta-snapshot-memory-limit=104857600"))
+			Expect(c.Command).To(ContainElement("--garbage-collection-period-seconds=43200"))
+			Expect(c.Command).NotTo(ContainElement(HavePrefix("--garbage-collection-policy=")))
+			Expect(c.Resources.Requests.Cpu().String()).To(Equal("23m"))
+			Expect(c.Resources.Limits.Memory().String()).To(Equal("10G"))
+		})
+
+		It("should use the parameters of the given configuration", func() {
+			var (
+				memoryLimit = resource.MustParse("50Mi")
+				policy      = GarbageCollectionPolicyLimitBased
+				maxBackups  = int32(7)
+				resources   = corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+				}
+			)
+
+			c := GetBackupRestoreContainer("etcd-main", "main-etcd", "0 */24 * * *", "S3", "prefix", "image", &BackupRestoreConfig{
+				DeltaSnapshotPeriod:      &metav1.Duration{Duration: time.Minute},
+				DeltaSnapshotMemoryLimit: &memoryLimit,
+				GarbageCollectionPeriod:  &metav1.Duration{Duration: time.Hour},
+				GarbageCollectionPolicy:  &policy,
+				MaxBackups:               &maxBackups,
+				Resources:                &resources,
+			}, nil, nil, nil)
+
+			Expect(c.Command).To(ContainElement("--delta-snapshot-period-seconds=60"))
+			Expect(c.Command).To(ContainElement("--delta-snapshot-memory-limit=52428800"))
+			Expect(c.Command).To(ContainElement("--garbage-collection-period-seconds=3600"))
+			Expect(c.Command).To(ContainElement("--garbage-collection-policy=LimitBased"))
+			Expect(c.Command).To(ContainElement("--max-backups=7"))
+			Expect(c.Resources).To(Equal(resources))
+		})
+	})
+
+	Describe("#MergeBackupRestoreConfigs", func() {
+		It("should let later configurations take precedence", func() {
+			var (
+				exponential = GarbageCollectionPolicyExponential
+				limitBased  = GarbageCollectionPolicyLimitBased
+				seedPeriod  = &metav1.Duration{Duration: time.Minute}
+				shootPeriod = &metav1.Duration{Duration: 2 * time.Hour}
+			)
+
+			merged := MergeBackupRestoreConfigs(
+				&BackupRestoreConfig{DeltaSnapshotPeriod: seedPeriod, GarbageCollectionPolicy: &exponential},
+				nil,
+				&BackupRestoreConfig{GarbageCollectionPeriod: shootPeriod, GarbageCollectionPolicy: &limitBased},
+			)
+
+			Expect(merged).To(Equal(&BackupRestoreConfig{
+				DeltaSnapshotPeriod:     seedPeriod,
+				GarbageCollectionPeriod: shootPeriod,
+				GarbageCollectionPolicy: &limitBased,
+			}))
+		})
+	})
+
+	Describe("#GetBackupRestoreConfig", func() {
+		const namespace = "shoot--foo--bar"
+
+		var (
+			seedPeriod  = &metav1.Duration{Duration: time.Minute}
+			shootPeriod = &metav1.Duration{Duration: 2 * time.Hour}
+			shootConfig = func(providerConfig []byte) (*BackupRestoreConfig, error) {
+				return &BackupRestoreConfig{DeltaSnapshotPeriod: shootPeriod}, nil
+			}
+		)
+
+		It("should return the seed configuration if there is no controlplane of the given type", func() {
+			c := fakeclient.NewFakeClientWithScheme(extensionscontroller.ExtensionsScheme, &extensionsv1alpha1.ControlPlane{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: namespace},
+				Spec: extensionsv1alpha1.ControlPlaneSpec{
+					DefaultSpec:    extensionsv1alpha1.DefaultSpec{Type: "other"},
+					ProviderConfig: &runtime.RawExtension{Raw: []byte("{}")},
+				},
+			})
+
+			config, err := GetBackupRestoreConfig(context.TODO(), c, namespace, "test", &BackupRestoreConfig{DeltaSnapshotPeriod: seedPeriod}, shootConfig)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config).To(Equal(&BackupRestoreConfig{DeltaSnapshotPeriod: seedPeriod}))
+		})
+
+		It("should let the configuration of the shoot take precedence", func() {
+			c := fakeclient.NewFakeClientWithScheme(extensionscontroller.ExtensionsScheme, &extensionsv1alpha1.ControlPlane{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: namespace},
+				Spec: extensionsv1alpha1.ControlPlaneSpec{
+					DefaultSpec:    extensionsv1alpha1.DefaultSpec{Type: "test"},
+					ProviderConfig: &runtime.RawExtension{Raw: []byte("{}")},
+				},
+			})
+
+			config, err := GetBackupRestoreConfig(context.TODO(), c, namespace, "test", &BackupRestoreConfig{DeltaSnapshotPeriod: seedPeriod}, shootConfig)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config).To(Equal(&BackupRestoreConfig{DeltaSnapshotPeriod: shootPeriod}))
+		})
+	})
+
+	DescribeTable("#ValidateBackupRestoreConfig",
+		func(config *BackupRestoreConfig, matcher gomegatypes.GomegaMatcher) {
+			Expect(ValidateBackupRestoreConfig(config, field.NewPath("etcdBackup"))).To(matcher)
+		},
+		Entry("nil configuration", nil, BeEmpty()),
+		Entry("valid configuration", &BackupRestoreConfig{
+			DeltaSnapshotPeriod:     &metav1.Duration{Duration: time.Minute},
+			GarbageCollectionPolicy: strPtr(GarbageCollectionPolicyLimitBased),
+			MaxBackups:              int32Ptr(3),
+		}, BeEmpty()),
+		Entry("too short delta snapshot period", &BackupRestoreConfig{
+			DeltaSnapshotPeriod: &metav1.Duration{Duration: time.Millisecond},
+		}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("etcdBackup.deltaSnapshotPeriod"),
+		})))),
+		Entry("unsupported garbage collection policy", &BackupRestoreConfig{
+			GarbageCollectionPolicy: strPtr("foo"),
+		}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeNotSupported),
+			"Field": Equal("etcdBackup.garbageCollectionPolicy"),
+		})))),
+		Entry("max backups without limit based policy", &BackupRestoreConfig{
+			GarbageCollectionPolicy: strPtr(GarbageCollectionPolicyExponential),
+			MaxBackups:              int32Ptr(3),
+		}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeForbidden),
+			"Field": Equal("etcdBackup.maxBackups"),
+		})))),
+	)
+
+	DescribeTable("#GetStorePrefixFromContainer",
+		func(c *corev1.Container, expected string) {
+			Expect(GetStorePrefixFromContainer(c, "etcd-main", "shoot--foo--bar--uid")).To(Equal(expected))
+		},
+		Entry("no container", nil, "shoot--foo--bar--uid"),
+		Entry("no store prefix", &corev1.Container{Command: []string{"etcdbrctl", "server"}}, "shoot--foo--bar--uid"),
+		Entry("store prefix of another etcd", &corev1.Container{Command: []string{"--store-prefix=shoot--foo--bar--uid/etcd-events"}}, "shoot--foo--bar--uid"),
+		Entry("store prefix of a restore", &corev1.Container{Command: []string{"--store-prefix=shoot--foo--bar--uid/restore-20191001120000/etcd-main"}}, "shoot--foo--bar--uid/restore-20191001120000"),
+	)
+})
+
+func strPtr(s string) *string { return &s }
+
+func int32Ptr(i int32) *int32 { return &i }
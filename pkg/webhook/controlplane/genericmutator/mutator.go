@@ -41,11 +41,11 @@ import (
 // If they don't initially, they are mutated accordingly.
 type Ensurer interface {
 	// EnsureKubeAPIServerService ensures that the kube-apiserver service conforms to the provider requirements.
-	EnsureKubeAPIServerService(context.Context, *corev1.Service) error
+	EnsureKubeAPIServerService(context.Context, *corev1.Service, *extensionscontroller.Cluster) error
 	// EnsureKubeAPIServerDeployment ensures that the kube-apiserver deployment conforms to the provider requirements.
-	EnsureKubeAPIServerDeployment(context.Context, *appsv1.Deployment) error
+	EnsureKubeAPIServerDeployment(context.Context, *appsv1.Deployment, *extensionscontroller.Cluster) error
 	// EnsureKubeControllerManagerDeployment ensures that the kube-controller-manager deployment conforms to the provider requirements.
-	EnsureKubeControllerManagerDeployment(context.Context, *appsv1.Deployment) error
+	EnsureKubeControllerManagerDeployment(context.Context, *appsv1.Deployment, *extensionscontroller.Cluster) error
 	// EnsureKubeSchedulerDeployment ensures that the kube-scheduler deployment conforms to the provider requirements.
 	EnsureKubeSchedulerDeployment(context.Context, *appsv1.Deployment) error
 	// EnsureETCDStatefulSet ensures that the etcd stateful sets conform to the provider requirements.
@@ -53,7 +53,7 @@ type Ensurer interface {
 	// EnsureKubeletServiceUnitOptions ensures that the kubelet.service unit options conform to the provider requirements.
 	EnsureKubeletServiceUnitOptions(context.Context, []*unit.UnitOption) ([]*unit.UnitOption, error)
 	// EnsureKubeletConfiguration ensures that the kubelet configuration conforms to the provider requirements.
-	EnsureKubeletConfiguration(context.Context, *kubeletconfigv1beta1.KubeletConfiguration) error
+	EnsureKubeletConfiguration(context.Context, *kubeletconfigv1beta1.KubeletConfiguration, *extensionscontroller.Cluster) error
 	// EnsureKubernetesGeneralConfiguration ensures that the kubernetes general configuration conforms to the provider requirements.
 	EnsureKubernetesGeneralConfiguration(context.Context, *string) error
 	// ShouldProvisionKubeletCloudProviderConfig returns true if the cloud provider config file should be added to the kubelet configuration.
@@ -118,16 +118,34 @@ func (m *mutator) Mutate(ctx context.Context, obj runtime.Object) error {
 		switch x.Name {
 		case v1alpha1constants.DeploymentNameKubeAPIServer:
 			extensionswebhook.LogMutation(m.logger, x.Kind, x.Namespace, x.Name)
-			return m.ensurer.EnsureKubeAPIServerService(ctx, x)
+			// Get cluster info
+			cluster, err := extensionscontroller.GetCluster(ctx, m.client, x.Namespace)
+			if err != nil {
+				return errors.Wrapf(err, "could not get cluster for namespace '%s'", x.Namespace)
+			}
+
+			return m.ensurer.EnsureKubeAPIServerService(ctx, x, cluster)
 		}
 	case *appsv1.Deployment:
 		switch x.Name {
 		case v1alpha1constants.DeploymentNameKubeAPIServer:
 			extensionswebhook.LogMutation(m.logger, x.Kind, x.Namespace, x.Name)
-			return m.ensurer.EnsureKubeAPIServerDeployment(ctx, x)
+			// Get cluster info
+			cluster, err := extensionscontroller.GetCluster(ctx, m.client, x.Namespace)
+			if err != nil {
+				return errors.Wrapf(err, "could not get cluster for namespace '%s'", x.Namespace)
+			}
+
+			return m.ensurer.EnsureKubeAPIServerDeployment(ctx, x, cluster)
 		case v1alpha1constants.DeploymentNameKubeControllerManager:
 			extensionswebhook.LogMutation(m.logger, x.Kind, x.Namespace, x.Name)
-			return m.ensurer.EnsureKubeControllerManagerDeployment(ctx, x)
+			// Get cluster info
+			cluster, err := extensionscontroller.GetCluster(ctx, m.client, x.Namespace)
+			if err != nil {
+				return errors.Wrapf(err, "could not get cluster for namespace '%s'", x.Namespace)
+			}
+
+			return m.ensurer.EnsureKubeControllerManagerDeployment(ctx, x, cluster)
 		case v1alpha1constants.DeploymentNameKubeScheduler:
 			extensionswebhook.LogMutation(m.logger, x.Kind, x.Namespace, x.Name)
 			return m.ensurer.EnsureKubeSchedulerDeployment(ctx, x)
@@ -164,7 +182,13 @@ func (m *mutator) mutateOperatingSystemConfig(ctx context.Context, osc *extensio
 
 	// Mutate kubelet configuration file, if present
 	if f := extensionswebhook.FileWithPath(osc.Spec.Files, v1alpha1constants.OperatingSystemConfigFilePathKubeletConfig); f != nil && f.Content.Inline != nil {
-		if err := m.ensureKubeletConfigFileContent(ctx, f.Content.Inline); err != nil {
+		// Get cluster info
+		cluster, err := extensionscontroller.GetCluster(ctx, m.client, osc.Namespace)
+		if err != nil {
+			return errors.Wrapf(err, "could not get cluster for namespace '%s'", osc.Namespace)
+		}
+
+		if err := m.ensureKubeletConfigFileContent(ctx, f.Content.Inline, cluster); err != nil {
 			return err
 		}
 	}
@@ -215,7 +239,7 @@ func (m *mutator) ensureKubeletServiceUnitContent(ctx context.Context, content *
 	return nil
 }
 
-func (m *mutator) ensureKubeletConfigFileContent(ctx context.Context, fci *extensionsv1alpha1.FileContentInline) error {
+func (m *mutator) ensureKubeletConfigFileContent(ctx context.Context, fci *extensionsv1alpha1.FileContentInline, cluster *extensionscontroller.Cluster) error {
 	var kubeletConfig *kubeletconfigv1beta1.KubeletConfiguration
 	var err error
 
@@ -224,7 +248,7 @@ func (m *mutator) ensureKubeletConfigFileContent(ctx context.Context, fci *exten
 		return errors.Wrap(err, "could not decode kubelet configuration")
 	}
 
-	if err = m.ensurer.EnsureKubeletConfiguration(ctx, kubeletConfig); err != nil {
+	if err = m.ensurer.EnsureKubeletConfiguration(ctx, kubeletConfig, cluster); err != nil {
 		return err
 	}
 
@@ -112,19 +112,25 @@ var _ = Describe("Mutator", func() {
 		It("should invoke ensurer.EnsureKubeAPIServerService with a kube-apiserver service", func() {
 			var (
 				svc = &corev1.Service{
-					ObjectMeta: metav1.ObjectMeta{Name: v1alpha1constants.DeploymentNameKubeAPIServer},
+					ObjectMeta: metav1.ObjectMeta{Name: v1alpha1constants.DeploymentNameKubeAPIServer, Namespace: namespace},
 				}
 			)
 
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), clusterKey, &extensionsv1alpha1.Cluster{}).DoAndReturn(clientGet(clusterObject(cluster)))
+
 			// Create mock ensurer
 			ensurer := mockgenericmutator.NewMockEnsurer(ctrl)
-			ensurer.EXPECT().EnsureKubeAPIServerService(context.TODO(), svc).Return(nil)
+			ensurer.EXPECT().EnsureKubeAPIServerService(context.TODO(), svc, cluster).Return(nil)
 
 			// Create mutator
 			mutator := NewMutator(ensurer, nil, nil, nil, logger)
+			err := mutator.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
 
 			// Call Mutate method and check the result
-			err := mutator.Mutate(context.TODO(), svc)
+			err = mutator.Mutate(context.TODO(), svc)
 			Expect(err).To(Not(HaveOccurred()))
 		})
 
@@ -146,38 +152,50 @@ var _ = Describe("Mutator", func() {
 		It("should invoke ensurer.EnsureKubeAPIServerDeployment with a kube-apiserver deployment", func() {
 			var (
 				dep = &appsv1.Deployment{
-					ObjectMeta: metav1.ObjectMeta{Name: v1alpha1constants.DeploymentNameKubeAPIServer},
+					ObjectMeta: metav1.ObjectMeta{Name: v1alpha1constants.DeploymentNameKubeAPIServer, Namespace: namespace},
 				}
 			)
 
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), clusterKey, &extensionsv1alpha1.Cluster{}).DoAndReturn(clientGet(clusterObject(cluster)))
+
 			// Create mock ensurer
 			ensurer := mockgenericmutator.NewMockEnsurer(ctrl)
-			ensurer.EXPECT().EnsureKubeAPIServerDeployment(context.TODO(), dep).Return(nil)
+			ensurer.EXPECT().EnsureKubeAPIServerDeployment(context.TODO(), dep, cluster).Return(nil)
 
 			// Create mutator
 			mutator := NewMutator(ensurer, nil, nil, nil, logger)
+			err := mutator.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
 
 			// Call Mutate method and check the result
-			err := mutator.Mutate(context.TODO(), dep)
+			err = mutator.Mutate(context.TODO(), dep)
 			Expect(err).To(Not(HaveOccurred()))
 		})
 
 		It("should invoke ensurer.EnsureKubeControllerManagerDeployment with a kube-controller-manager deployment", func() {
 			var (
 				dep = &appsv1.Deployment{
-					ObjectMeta: metav1.ObjectMeta{Name: v1alpha1constants.DeploymentNameKubeControllerManager},
+					ObjectMeta: metav1.ObjectMeta{Name: v1alpha1constants.DeploymentNameKubeControllerManager, Namespace: namespace},
 				}
 			)
 
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), clusterKey, &extensionsv1alpha1.Cluster{}).DoAndReturn(clientGet(clusterObject(cluster)))
+
 			// Create mock ensurer
 			ensurer := mockgenericmutator.NewMockEnsurer(ctrl)
-			ensurer.EXPECT().EnsureKubeControllerManagerDeployment(context.TODO(), dep).Return(nil)
+			ensurer.EXPECT().EnsureKubeControllerManagerDeployment(context.TODO(), dep, cluster).Return(nil)
 
 			// Create mutator
 			mutator := NewMutator(ensurer, nil, nil, nil, logger)
+			err := mutator.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
 
 			// Call Mutate method and check the result
-			err := mutator.Mutate(context.TODO(), dep)
+			err = mutator.Mutate(context.TODO(), dep)
 			Expect(err).To(Not(HaveOccurred()))
 		})
 
@@ -283,7 +301,7 @@ var _ = Describe("Mutator", func() {
 		It("should invoke appropriate ensurer methods with OperatingSystemConfig", func() {
 			var (
 				osc = &extensionsv1alpha1.OperatingSystemConfig{
-					ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"},
+					ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: namespace},
 					Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
 						Purpose: extensionsv1alpha1.OperatingSystemConfigPurposeReconcile,
 						Units: []extensionsv1alpha1.Unit{
@@ -343,11 +361,15 @@ var _ = Describe("Mutator", func() {
 				additionalFile = extensionsv1alpha1.File{Path: "/test/path"}
 			)
 
+			// Create mock client
+			client := mockclient.NewMockClient(ctrl)
+			client.EXPECT().Get(context.TODO(), clusterKey, &extensionsv1alpha1.Cluster{}).DoAndReturn(clientGet(clusterObject(cluster)))
+
 			// Create mock ensurer
 			ensurer := mockgenericmutator.NewMockEnsurer(ctrl)
 			ensurer.EXPECT().EnsureKubeletServiceUnitOptions(context.TODO(), oldUnitOptions).Return(newUnitOptions, nil)
-			ensurer.EXPECT().EnsureKubeletConfiguration(context.TODO(), oldKubeletConfig).DoAndReturn(
-				func(ctx context.Context, kubeletConfig *kubeletconfigv1beta1.KubeletConfiguration) error {
+			ensurer.EXPECT().EnsureKubeletConfiguration(context.TODO(), oldKubeletConfig, cluster).DoAndReturn(
+				func(ctx context.Context, kubeletConfig *kubeletconfigv1beta1.KubeletConfiguration, _ *extensionscontroller.Cluster) error {
 					*kubeletConfig = *newKubeletConfig
 					return nil
 				},
@@ -395,9 +417,11 @@ var _ = Describe("Mutator", func() {
 
 			// Create mutator
 			mutator := NewMutator(ensurer, us, kcc, fcic, logger)
+			err := mutator.(inject.Client).InjectClient(client)
+			Expect(err).To(Not(HaveOccurred()))
 
 			// Call Mutate method and check the result
-			err := mutator.Mutate(context.TODO(), osc)
+			err = mutator.Mutate(context.TODO(), osc)
 			Expect(err).To(Not(HaveOccurred()))
 			checkOperatingSystemConfig(osc)
 		})
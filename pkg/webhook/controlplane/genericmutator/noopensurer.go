@@ -30,17 +30,17 @@ import (
 type NoopEnsurer struct{}
 
 // EnsureKubeAPIServerService ensures that the kube-apiserver service conforms to the provider requirements.
-func (e *NoopEnsurer) EnsureKubeAPIServerService(context.Context, *corev1.Service) error {
+func (e *NoopEnsurer) EnsureKubeAPIServerService(context.Context, *corev1.Service, *extensionscontroller.Cluster) error {
 	return nil
 }
 
 // EnsureKubeAPIServerDeployment ensures that the kube-apiserver deployment conforms to the provider requirements.
-func (e *NoopEnsurer) EnsureKubeAPIServerDeployment(context.Context, *appsv1.Deployment) error {
+func (e *NoopEnsurer) EnsureKubeAPIServerDeployment(context.Context, *appsv1.Deployment, *extensionscontroller.Cluster) error {
 	return nil
 }
 
 // EnsureKubeControllerManagerDeployment ensures that the kube-controller-manager deployment conforms to the provider requirements.
-func (e *NoopEnsurer) EnsureKubeControllerManagerDeployment(context.Context, *appsv1.Deployment) error {
+func (e *NoopEnsurer) EnsureKubeControllerManagerDeployment(context.Context, *appsv1.Deployment, *extensionscontroller.Cluster) error {
 	return nil
 }
 
@@ -60,7 +60,7 @@ func (e *NoopEnsurer) EnsureKubeletServiceUnitOptions(_ context.Context, opts []
 }
 
 // EnsureKubeletConfiguration ensures that the kubelet configuration conforms to the provider requirements.
-func (e *NoopEnsurer) EnsureKubeletConfiguration(context.Context, *kubeletconfigv1beta1.KubeletConfiguration) error {
+func (e *NoopEnsurer) EnsureKubeletConfiguration(context.Context, *kubeletconfigv1beta1.KubeletConfiguration, *extensionscontroller.Cluster) error {
 	return nil
 }
 
@@ -18,6 +18,7 @@ import (
 	"net/http"
 
 	"github.com/pkg/errors"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
@@ -43,6 +44,9 @@ type Webhook struct {
 	Webhook  *admission.Webhook
 	Handler  http.Handler
 	Selector *metav1.LabelSelector
+	// FailurePolicy is the failure policy of the webhook. If not set, it defaults to Fail for seed and to Ignore for
+	// shoot webhooks.
+	FailurePolicy *admissionregistrationv1beta1.FailurePolicyType
 }
 
 // FactoryAggregator aggregates various Factory functions.
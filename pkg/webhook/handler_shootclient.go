@@ -61,12 +61,31 @@ func NewHandlerWithShootClient(mgr manager.Manager, types []runtime.Object, muta
 	}, nil
 }
 
+// NewHandlerWithShootNamespace creates a new handler for the given types, using the given mutator, and logger.
+// In contrast to NewHandlerWithShootClient, no shoot client is created and the shoot namespace is only looked up
+// for objects handled by the mutator.
+func NewHandlerWithShootNamespace(mgr manager.Manager, types []runtime.Object, mutator MutatorWithShootNamespace, logger logr.Logger) (*handlerShootClient, error) {
+	// Build a map of the given types keyed by their GVKs
+	typesMap, err := buildTypesMap(mgr, types)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create and return a handler
+	return &handlerShootClient{
+		typesMap:         typesMap,
+		namespaceMutator: mutator,
+		logger:           logger.WithName("handlerShootClient"),
+	}, nil
+}
+
 type handlerShootClient struct {
-	typesMap map[metav1.GroupVersionKind]runtime.Object
-	mutator  MutatorWithShootClient
-	client   client.Client
-	decoder  *admission.Decoder
-	logger   logr.Logger
+	typesMap         map[metav1.GroupVersionKind]runtime.Object
+	mutator          MutatorWithShootClient
+	namespaceMutator MutatorWithShootNamespace
+	client           client.Client
+	decoder          *admission.Decoder
+	logger           logr.Logger
 }
 
 // InjectDecoder injects the given decoder into the handler.
@@ -79,7 +98,11 @@ func (h *handlerShootClient) InjectDecoder(d *admission.Decoder) error {
 // TODO Replace this with the more generic InjectFunc when controller runtime supports it
 func (h *handlerShootClient) InjectClient(client client.Client) error {
 	h.client = client
-	if _, err := inject.ClientInto(client, h.mutator); err != nil {
+	var mutator interface{} = h.mutator
+	if h.namespaceMutator != nil {
+		mutator = h.namespaceMutator
+	}
+	if _, err := inject.ClientInto(client, mutator); err != nil {
 		return errors.Wrap(err, "could not inject the client into the mutator")
 	}
 	return nil
@@ -87,30 +110,17 @@ func (h *handlerShootClient) InjectClient(client client.Client) error {
 
 func (h *handlerShootClient) HandleWithRequest(ctx context.Context, req admission.Request, r *http.Request) admission.Response {
 	f := func(ctx context.Context, newObj runtime.Object, r *http.Request) error {
-		ipPort := strings.Split(r.RemoteAddr, ":")
-		if len(ipPort) < 1 {
-			return fmt.Errorf("remote address not parseable: %s", r.RemoteAddr)
-		}
-		ip := ipPort[0]
-
-		podList := &corev1.PodList{}
-		if err := h.client.List(ctx, podList, client.MatchingLabels(map[string]string{
-			v1alpha1constants.LabelApp:  v1alpha1constants.LabelKubernetes,
-			v1alpha1constants.LabelRole: v1alpha1constants.LabelAPIServer,
-		})); err != nil {
-			return errors.Wrapf(err, "error while listing all pods")
+		if h.namespaceMutator != nil && !h.namespaceMutator.Handles(newObj) {
+			return nil
 		}
 
-		var shootNamespace string
-		for _, pod := range podList.Items {
-			if pod.Status.PodIP == ip {
-				shootNamespace = pod.Namespace
-				break
-			}
+		shootNamespace, err := h.getShootNamespace(ctx, r)
+		if err != nil {
+			return err
 		}
 
-		if len(shootNamespace) == 0 {
-			return fmt.Errorf("could not find shoot namespace for webhook request")
+		if h.namespaceMutator != nil {
+			return h.namespaceMutator.Mutate(ctx, newObj, shootNamespace)
 		}
 
 		_, shootClient, err := util.NewClientForShoot(ctx, h.client, shootNamespace, client.Options{})
@@ -124,6 +134,31 @@ func (h *handlerShootClient) HandleWithRequest(ctx context.Context, req admissio
 	return handle(ctx, req, r, f, h.typesMap, h.decoder, h.logger)
 }
 
+// getShootNamespace returns the namespace of the kube-apiserver pod the given request originates from.
+func (h *handlerShootClient) getShootNamespace(ctx context.Context, r *http.Request) (string, error) {
+	ipPort := strings.Split(r.RemoteAddr, ":")
+	if len(ipPort) < 1 {
+		return "", fmt.Errorf("remote address not parseable: %s", r.RemoteAddr)
+	}
+	ip := ipPort[0]
+
+	podList := &corev1.PodList{}
+	if err := h.client.List(ctx, podList, client.MatchingLabels(map[string]string{
+		v1alpha1constants.LabelApp:  v1alpha1constants.LabelKubernetes,
+		v1alpha1constants.LabelRole: v1alpha1constants.LabelAPIServer,
+	})); err != nil {
+		return "", errors.Wrapf(err, "error while listing all pods")
+	}
+
+	for _, pod := range podList.Items {
+		if pod.Status.PodIP == ip {
+			return pod.Namespace, nil
+		}
+	}
+
+	return "", fmt.Errorf("could not find shoot namespace for webhook request")
+}
+
 // ServeHTTP is a handler for serving an HTTP endpoint that is used for shoot webhooks.
 func (h *handlerShootClient) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	var body []byte
@@ -32,3 +32,12 @@ type MutatorWithShootClient interface {
 	// Mutate validates and if needed mutates the given object.
 	Mutate(ctx context.Context, obj runtime.Object, shootClient client.Client) error
 }
+
+// MutatorWithShootNamespace validates and if needed mutates objects. It needs the namespace of the shoot's control
+// plane in the seed cluster, which is only looked up for objects the mutator handles.
+type MutatorWithShootNamespace interface {
+	// Handles returns whether the given object is to be mutated.
+	Handles(obj runtime.Object) bool
+	// Mutate validates and if needed mutates the given object.
+	Mutate(ctx context.Context, obj runtime.Object, shootNamespace string) error
+}
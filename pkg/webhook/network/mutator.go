@@ -18,17 +18,22 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/gardener/gardener-extensions/pkg/util"
 	"github.com/gardener/gardener-extensions/pkg/webhook"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-type MutateFn func(*extensionsv1alpha1.Network) error
+// MutateFn mutates the given new Network. The given old Network is the Network currently stored in the cluster,
+// or nil if the Network is being created.
+type MutateFn func(new, old *extensionsv1alpha1.Network) error
 
 // NewMutator creates a new network mutator.
 func NewMutator(logger logr.Logger, mutateFn MutateFn) webhook.Mutator {
@@ -65,5 +70,13 @@ func (m *mutator) Mutate(ctx context.Context, obj runtime.Object) error {
 	if !ok {
 		return fmt.Errorf("could not mutate, object is not of type \"Network\"")
 	}
-	return m.mutateFunc(network)
+
+	oldNetwork := &extensionsv1alpha1.Network{}
+	if err := m.client.Get(ctx, kutil.Key(network.Namespace, network.Name), oldNetwork); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "could not get network '%s'", util.ObjectName(network))
+		}
+		oldNetwork = nil
+	}
+	return m.mutateFunc(network, oldNetwork)
 }
@@ -55,10 +55,16 @@ func RegisterWebhooks(ctx context.Context, mgr manager.Manager, namespace, provi
 		switch webhook.Target {
 		case TargetSeed:
 			webhookToRegister.FailurePolicy = &fail
+			if webhook.FailurePolicy != nil {
+				webhookToRegister.FailurePolicy = webhook.FailurePolicy
+			}
 			webhookToRegister.ClientConfig = buildClientConfigFor(webhook, namespace, providerName, port, mode, url, caBundle)
 			webhooksToRegisterSeed = append(webhooksToRegisterSeed, webhookToRegister)
 		case TargetShoot:
 			webhookToRegister.FailurePolicy = &ignore
+			if webhook.FailurePolicy != nil {
+				webhookToRegister.FailurePolicy = webhook.FailurePolicy
+			}
 			webhookToRegister.ClientConfig = buildClientConfigFor(webhook, namespace, providerName, port, ModeURLWithServiceName, url, caBundle)
 			webhooksToRegisterShoot = append(webhooksToRegisterShoot, webhookToRegister)
 		default:
@@ -16,10 +16,12 @@ package shoot
 
 import (
 	"fmt"
+	"net/http"
 
 	extensionswebhook "github.com/gardener/gardener-extensions/pkg/webhook"
 
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -44,6 +46,12 @@ type AddArgs struct {
 	Mutator extensionswebhook.Mutator
 	// MutatorWithShootClient is a mutator to be used by the admission handler. It needs the shoot client.
 	MutatorWithShootClient extensionswebhook.MutatorWithShootClient
+	// MutatorWithShootNamespace is a mutator to be used by the admission handler. It needs the shoot namespace.
+	MutatorWithShootNamespace extensionswebhook.MutatorWithShootNamespace
+	// FailurePolicy is the failure policy of the webhook. Defaults to Ignore.
+	FailurePolicy *admissionregistrationv1beta1.FailurePolicyType
+	// NamespaceSelector is the namespace selector of the webhook. Defaults to the kube-system namespace.
+	NamespaceSelector *metav1.LabelSelector
 }
 
 // Add creates a new shoot webhook and adds it to the given Manager.
@@ -51,17 +59,21 @@ func Add(mgr manager.Manager, args AddArgs) (*extensionswebhook.Webhook, error)
 	logger.Info("Creating webhook", "name", WebhookName)
 
 	// Build namespace selector from the webhook kind and provider
-	namespaceSelector, err := buildSelector()
-	if err != nil {
-		return nil, err
+	namespaceSelector := args.NamespaceSelector
+	if namespaceSelector == nil {
+		var err error
+		if namespaceSelector, err = buildSelector(); err != nil {
+			return nil, err
+		}
 	}
 
 	wh := &extensionswebhook.Webhook{
-		Name:     WebhookName,
-		Types:    args.Types,
-		Path:     WebhookName,
-		Target:   extensionswebhook.TargetShoot,
-		Selector: namespaceSelector,
+		Name:          WebhookName,
+		Types:         args.Types,
+		Path:          WebhookName,
+		Target:        extensionswebhook.TargetShoot,
+		Selector:      namespaceSelector,
+		FailurePolicy: args.FailurePolicy,
 	}
 
 	switch {
@@ -74,8 +86,16 @@ func Add(mgr manager.Manager, args AddArgs) (*extensionswebhook.Webhook, error)
 		wh.Webhook = &admission.Webhook{Handler: handler}
 		return wh, nil
 
-	case args.MutatorWithShootClient != nil:
-		handler, err := extensionswebhook.NewHandlerWithShootClient(mgr, args.Types, args.MutatorWithShootClient, logger)
+	case args.MutatorWithShootClient != nil, args.MutatorWithShootNamespace != nil:
+		var (
+			handler http.Handler
+			err     error
+		)
+		if args.MutatorWithShootClient != nil {
+			handler, err = extensionswebhook.NewHandlerWithShootClient(mgr, args.Types, args.MutatorWithShootClient, logger)
+		} else {
+			handler, err = extensionswebhook.NewHandlerWithShootNamespace(mgr, args.Types, args.MutatorWithShootNamespace, logger)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -92,7 +112,7 @@ func Add(mgr manager.Manager, args AddArgs) (*extensionswebhook.Webhook, error)
 		return wh, nil
 	}
 
-	return nil, fmt.Errorf("neither mutator nor mutator with shoot client is set")
+	return nil, fmt.Errorf("neither mutator nor mutator with shoot client or shoot namespace is set")
 }
 
 // buildSelector creates and returns a LabelSelector for the given webhook kind and provider.